@@ -0,0 +1,86 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/gitattr"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+type GetAttributesArgs struct {
+	CommitHash plumbing.Hash
+	// Paths are the paths to look up. They don't need to exist in the commit.
+	Paths []string
+	// Attributes are the attribute names to look up (e.g. linguist-generated, merge, eol). If
+	// empty, all the attributes specified for the paths are returned.
+	Attributes []string
+}
+
+type PathAttributes struct {
+	Path string `json:"path"`
+	// Attributes maps the attribute names to their values. The value is "set", "unset",
+	// "unspecified", or the value set with "attr=value", same as git check-attr.
+	Attributes map[string]string `json:"attributes"`
+}
+
+// GetAttributes evaluates the .gitattributes files of the commit for the paths, like git
+// check-attr. Only the trees and the .gitattributes files are fetched.
+func GetAttributes(repoURL string, client *http.Client, args GetAttributesArgs) ([]*PathAttributes, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "GetAttributes", args, func() ([]*PathAttributes, debug.FetchDebugInfo, error) {
+		return getAttributes(repoURL, client, args)
+	})
+}
+
+func getAttributes(repoURL string, client *http.Client, args GetAttributesArgs) ([]*PathAttributes, debug.FetchDebugInfo, error) {
+	var debugInfo debug.FetchDebugInfo
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	defer storage.Close()
+	if err := fetchBlobNone(repoURL, client, storage, []plumbing.Hash{args.CommitHash}, &debugInfo); err != nil {
+		return nil, debugInfo, err
+	}
+	tree, err := getTreeFromCommit(storage, args.CommitHash)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+
+	files, err := gitattr.Files(storage, tree, args.Paths)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	var blobHashes []plumbing.Hash
+	for _, hash := range files {
+		blobHashes = append(blobHashes, hash)
+	}
+	if len(blobHashes) > 0 {
+		blobPack, blobDebugInfo, err := fetch.FetchFullPackfile(repoURL, client, blobHashes, nil)
+		defer blobPack.Close()
+		debugInfo.PackfileSize += blobDebugInfo.PackfileSize
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		if err := parseFetchedPackfile(storage, blobPack, &debugInfo); err != nil {
+			return nil, debugInfo, err
+		}
+	}
+
+	matcher, err := gitattr.NewMatcher(storage, files)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	var ret []*PathAttributes
+	for _, pth := range args.Paths {
+		ret = append(ret, &PathAttributes{
+			Path:       pth,
+			Attributes: matcher.Lookup(pth, args.Attributes),
+		})
+	}
+	return ret, debugInfo, nil
+}
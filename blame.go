@@ -0,0 +1,220 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/blame"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/mailmap"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// DefaultBlameMaxCommits is the default number of commits that GetBlame looks into.
+const DefaultBlameMaxCommits = 1000
+
+type GetBlameArgs struct {
+	CommitHash plumbing.Hash
+	Path       string
+	// MaxCommits is the number of the commits in the history of CommitHash to look into.
+	// Defaults to DefaultBlameMaxCommits. The lines older than that are attributed to the oldest
+	// commits looked into, with Boundary set.
+	MaxCommits int
+	// ApplyMailmap makes the authors canonical with the .mailmap file of CommitHash.
+	ApplyMailmap bool
+}
+
+type BlameLine struct {
+	// LineNumber is the 1-based line number in the file.
+	LineNumber int `json:"lineNumber"`
+	// Content is the line without the newline.
+	Content string `json:"content"`
+	// CommitHash is the commit that introduced the line.
+	CommitHash string `json:"commitHash"`
+	// OriginalLineNumber is the 1-based line number in the file of CommitHash.
+	OriginalLineNumber int             `json:"originalLineNumber"`
+	Author             CommitSignature `json:"author"`
+	// Boundary is true if the line can be older than CommitHash, which is the oldest commit
+	// looked into. Same as the "^" marks of git blame.
+	Boundary bool `json:"boundary,omitempty"`
+}
+
+// GetBlame attributes each line of the file to the commit that introduced it, like git blame.
+// The renames are not followed. The commits and the trees of the history are fetched first, and
+// then only the versions of the file.
+func GetBlame(repoURL string, client *http.Client, args GetBlameArgs) ([]*BlameLine, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "GetBlame", args, func() ([]*BlameLine, debug.FetchDebugInfo, error) {
+		return getBlame(repoURL, client, args)
+	})
+}
+
+func getBlame(repoURL string, client *http.Client, args GetBlameArgs) ([]*BlameLine, debug.FetchDebugInfo, error) {
+	maxCommits := args.MaxCommits
+	if maxCommits <= 0 {
+		maxCommits = DefaultBlameMaxCommits
+	}
+	pack, debugInfo, err := fetch.FetchBlobNoneHistoryPackfile(repoURL, client, []plumbing.Hash{args.CommitHash}, fetch.FetchOptions{Depth: maxCommits})
+	defer pack.Close()
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	defer storage.Close()
+	if err := parseFetchedPackfile(storage, pack, &debugInfo); err != nil {
+		return nil, debugInfo, err
+	}
+
+	commits, err := fileHistory(storage, args.CommitHash, args.Path)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	var blobHashes []plumbing.Hash
+	seen := map[plumbing.Hash]bool{}
+	for _, commit := range commits {
+		if !seen[commit.Blob] {
+			seen[commit.Blob] = true
+			blobHashes = append(blobHashes, commit.Blob)
+		}
+	}
+	blobPack, blobDebugInfo, err := fetch.FetchFullPackfile(repoURL, client, blobHashes, nil)
+	defer blobPack.Close()
+	debugInfo.PackfileSize += blobDebugInfo.PackfileSize
+	debugInfo.Spooled = debugInfo.Spooled || blobDebugInfo.Spooled
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	if err := parseFetchedPackfile(storage, blobPack, &debugInfo); err != nil {
+		return nil, debugInfo, err
+	}
+	blobs := map[plumbing.Hash][]byte{}
+	for _, hash := range blobHashes {
+		content, err := readBlob(storage, hash)
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		blobs[hash] = content
+	}
+
+	lines, err := blame.Blame(commits, blobs, args.CommitHash)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	var m *mailmap.Mailmap
+	if args.ApplyMailmap {
+		tree, err := getTreeFromCommit(storage, args.CommitHash)
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		if m, err = loadMailmap(repoURL, client, storage, tree, &debugInfo); err != nil {
+			return nil, debugInfo, err
+		}
+	}
+	contents := strings.SplitAfter(string(blobs[commits[args.CommitHash].Blob]), "\n")
+	var ret []*BlameLine
+	for i, line := range lines {
+		commit, err := object.GetCommit(storage, line.Commit)
+		if err != nil {
+			return nil, debugInfo, fmt.Errorf("cannot find %q in the fetched packfile: %w", line.Commit.String(), err)
+		}
+		name, email := m.Map(commit.Author.Name, commit.Author.Email)
+		ret = append(ret, &BlameLine{
+			LineNumber:         i + 1,
+			Content:            strings.TrimSuffix(contents[i], "\n"),
+			CommitHash:         line.Commit.String(),
+			OriginalLineNumber: line.OriginalLineNumber + 1,
+			Author: CommitSignature{
+				Name:      name,
+				Email:     email,
+				Timestamp: commit.Author.When,
+			},
+			Boundary: line.Boundary,
+		})
+	}
+	return ret, debugInfo, nil
+}
+
+// fileHistory returns the commits that have the file in the history of the commit. The history
+// is followed only through the commits that have the file.
+func fileHistory(storage *objectStorage, commitHash plumbing.Hash, pth string) (map[plumbing.Hash]*blame.Commit, error) {
+	blobOf := func(commit *object.Commit) (plumbing.Hash, bool, error) {
+		tree, err := commit.Tree()
+		if err != nil {
+			return plumbing.ZeroHash, false, fmt.Errorf("cannot find the tree of %q in the fetched packfile: %w", commit.Hash.String(), err)
+		}
+		entry, err := tree.FindEntry(pth)
+		if err != nil || !entry.Mode.IsFile() {
+			return plumbing.ZeroHash, false, nil
+		}
+		return entry.Hash, true, nil
+	}
+	start, err := object.GetCommit(storage, commitHash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", commitHash.String(), err)
+	}
+	blob, ok, err := blobOf(start)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%q is not a file in %q", pth, commitHash.String())
+	}
+	ret := map[plumbing.Hash]*blame.Commit{commitHash: {Hash: commitHash, Blob: blob}}
+	queue := []*object.Commit{start}
+	for len(queue) > 0 {
+		commit := queue[0]
+		queue = queue[1:]
+		c := ret[commit.Hash]
+		var parents []*object.Commit
+		for _, parentHash := range commit.ParentHashes {
+			parent, err := object.GetCommit(storage, parentHash)
+			if err != nil {
+				// Beyond the fetched history.
+				c.Boundary = true
+				break
+			}
+			parents = append(parents, parent)
+		}
+		if c.Boundary {
+			continue
+		}
+		for _, parent := range parents {
+			parentHash := parent.Hash
+			blob, ok, err := blobOf(parent)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			c.Parents = append(c.Parents, parentHash)
+			if _, ok := ret[parentHash]; !ok {
+				ret[parentHash] = &blame.Commit{Hash: parentHash, Blob: blob}
+				queue = append(queue, parent)
+			}
+		}
+	}
+	return ret, nil
+}
+
+func readBlob(storage *objectStorage, hash plumbing.Hash) ([]byte, error) {
+	blob, err := object.GetBlob(storage, hash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+	}
+	rd, err := blob.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer rd.Close()
+	return io.ReadAll(rd)
+}
@@ -0,0 +1,188 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/changelog"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// DefaultChangelogTemplate is the text/template used when GenerateChangelogArgs.Template is not
+// set. It's executed with *Changelog.
+const DefaultChangelogTemplate = `{{range .Groups}}## {{.Title}}
+
+{{range $e := .Entries}}- {{if $e.Scope}}**{{$e.Scope}}:** {{end}}{{$e.Subject}}{{range $e.References}}{{if not (contains $e.Subject .)}} {{.}}{{end}}{{end}} ({{$e.ShortHash}})
+{{end}}
+{{end}}`
+
+// changelogGroups are the conventional commit types in the order of the changelog sections.
+var changelogGroups = []struct {
+	Type  string
+	Title string
+}{
+	{"feat", "Features"},
+	{"fix", "Bug Fixes"},
+	{"perf", "Performance Improvements"},
+	{"revert", "Reverts"},
+	{"refactor", "Code Refactoring"},
+	{"docs", "Documentation"},
+	{"test", "Tests"},
+	{"build", "Build System"},
+	{"ci", "Continuous Integration"},
+	{"style", "Styles"},
+	{"chore", "Chores"},
+}
+
+type GenerateChangelogArgs struct {
+	// FromRef is the start of the range, exclusive. This is a commit hash, a ref name, or a
+	// branch or tag name. If empty, the range starts from the root commits.
+	FromRef string
+	// ToRef is the end of the range, inclusive.
+	ToRef string
+	// Template is the text/template to render the changelog with. It's executed with
+	// *Changelog. Defaults to DefaultChangelogTemplate.
+	Template string
+}
+
+type Changelog struct {
+	FromHash string `json:"fromHash,omitempty"`
+	ToHash   string `json:"toHash"`
+	// Groups are the non-empty sections of the changelog. The breaking changes come first,
+	// followed by the conventional commit types, and the commits of the other types last.
+	Groups []*ChangelogGroup `json:"groups"`
+	// Markdown is the rendered changelog.
+	Markdown string `json:"markdown"`
+}
+
+type ChangelogGroup struct {
+	// Type is the conventional commit type. "breaking" for the breaking changes, and "other"
+	// for the unknown types and the non-conventional commits.
+	Type    string            `json:"type"`
+	Title   string            `json:"title"`
+	Entries []*ChangelogEntry `json:"entries"`
+}
+
+type ChangelogEntry struct {
+	Hash      string `json:"hash"`
+	ShortHash string `json:"shortHash"`
+	Type      string `json:"type"`
+	Scope     string `json:"scope,omitempty"`
+	Subject   string `json:"subject"`
+	Breaking  bool   `json:"breaking,omitempty"`
+	// References are the PR and issue references (e.g. "#123") in the subject and the
+	// trailers.
+	References []string        `json:"references"`
+	Author     CommitSignature `json:"author"`
+}
+
+// GenerateChangelog renders the changelog of the commits in the range. The commits are grouped
+// by their conventional commit type and ordered from the newest. The merge commits are skipped.
+func GenerateChangelog(repoURL string, client *http.Client, args GenerateChangelogArgs) (*Changelog, debug.FetchDebugInfo, error) {
+	tmplText := args.Template
+	if tmplText == "" {
+		tmplText = DefaultChangelogTemplate
+	}
+	tmpl, err := template.New("changelog").Funcs(template.FuncMap{"contains": strings.Contains}).Parse(tmplText)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, fmt.Errorf("invalid changelog template: %w", err)
+	}
+
+	toHash, err := resolveRevision(repoURL, client, args.ToRef)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, err
+	}
+	result := &Changelog{ToHash: toHash.String()}
+	fetchArgs := FetchCommitsArgs{WantCommitHashes: []plumbing.Hash{toHash}}
+	if args.FromRef != "" {
+		fromHash, err := resolveRevision(repoURL, client, args.FromRef)
+		if err != nil {
+			return nil, debug.FetchDebugInfo{}, err
+		}
+		result.FromHash = fromHash.String()
+		fetchArgs.HaveCommitHashes = []plumbing.Hash{fromHash}
+		fetchArgs.OnlyNewCommits = true
+	}
+	commits, debugInfo, err := FetchCommits(repoURL, client, fetchArgs)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	sort.Slice(commits, func(i, j int) bool {
+		if !commits[i].Committer.Timestamp.Equal(commits[j].Committer.Timestamp) {
+			return commits[i].Committer.Timestamp.After(commits[j].Committer.Timestamp)
+		}
+		return commits[i].Hash < commits[j].Hash
+	})
+
+	groups := map[string]*ChangelogGroup{}
+	addEntry := func(typ, title string, entry *ChangelogEntry) {
+		group, ok := groups[typ]
+		if !ok {
+			group = &ChangelogGroup{Type: typ, Title: title}
+			groups[typ] = group
+		}
+		group.Entries = append(group.Entries, entry)
+	}
+	knownType := map[string]string{}
+	for _, g := range changelogGroups {
+		knownType[g.Type] = g.Title
+	}
+	for _, commit := range commits {
+		if len(commit.ParentHashes) > 1 {
+			continue
+		}
+		parsed := changelog.Parse(commit.Message)
+		entry := &ChangelogEntry{
+			Hash:       commit.Hash,
+			ShortHash:  commit.Hash[:7],
+			Type:       parsed.Type,
+			Scope:      parsed.Scope,
+			Subject:    parsed.Subject,
+			Breaking:   parsed.Breaking,
+			References: parsed.References,
+			Author:     commit.Author,
+		}
+		if entry.References == nil {
+			entry.References = []string{}
+		}
+		if entry.Breaking {
+			addEntry("breaking", "Breaking Changes", entry)
+		}
+		if title, ok := knownType[entry.Type]; ok {
+			addEntry(entry.Type, title, entry)
+		} else {
+			addEntry("other", "Other Changes", entry)
+		}
+	}
+	for _, typ := range append(append([]string{"breaking"}, changelogTypes()...), "other") {
+		if group, ok := groups[typ]; ok {
+			result.Groups = append(result.Groups, group)
+		}
+	}
+	if result.Groups == nil {
+		result.Groups = []*ChangelogGroup{}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, result); err != nil {
+		return nil, debugInfo, fmt.Errorf("failed to render the changelog: %w", err)
+	}
+	result.Markdown = buf.String()
+	return result, debugInfo, nil
+}
+
+func changelogTypes() []string {
+	var ret []string
+	for _, g := range changelogGroups {
+		ret = append(ret, g.Type)
+	}
+	return ret
+}
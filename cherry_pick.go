@@ -0,0 +1,345 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/merge"
+	"github.com/aviator-co/niche-git/internal/pathmatch"
+	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/aviator-co/niche-git/internal/trailer"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// CommitRange is the commits reachable from End but not from Start, same as `Start..End` of git.
+type CommitRange struct {
+	// Start is the commit whose history is excluded from the range.
+	Start plumbing.Hash
+	// End is the last commit of the range.
+	End plumbing.Hash
+}
+
+type PushCherryPickArgs struct {
+	// Commits are the commits to cherry-pick in order.
+	Commits []plumbing.Hash
+	// Ranges are the commit ranges to cherry-pick after Commits. The commits of a range are
+	// cherry-picked from the oldest, same as `git cherry-pick Start..End`. A range cannot have
+	// merge commits.
+	Ranges []CommitRange
+	// CherryPickOnto is the commit where the first cherry-picked commit is created on top of.
+	CherryPickOnto plumbing.Hash
+
+	// Committer is the committer of the created commits. The messages and the authors are
+	// kept.
+	Committer object.Signature
+	// Timezone, if set, is the timezone of the Committer time. By default, it keeps its own
+	// timezone.
+	Timezone *time.Location
+	// SignOff appends a Signed-off-by trailer for the committer to the messages of the created
+	// commits if it's not already there.
+	SignOff bool
+	// Trailers are appended to the messages of the created commits, e.g. Co-authored-by or
+	// Change-Id. A trailer that already exists in a message is not added again.
+	Trailers []Trailer
+
+	// Ref is the ref to push the last created commit to.
+	Ref plumbing.ReferenceName
+	// CurrentRefHash, if set, is the expected current value of the ref. This is used for
+	// compare-and-swap.
+	CurrentRefHash *plumbing.Hash
+	// AdditionalRefUpdates are the ref updates pushed atomically with the update of Ref, e.g. to
+	// move a tracking ref to the created commit with ToCreatedCommit or to delete a temporary
+	// ref.
+	AdditionalRefUpdates []RefUpdate
+
+	// AbortOnConflict makes the operation fail without pushing if any commit has a conflict.
+	AbortOnConflict bool
+
+	// ProtectedPaths are the path patterns that the operation must not modify. If the last
+	// created commit modifies any of them from CherryPickOnto, the operation fails with
+	// ProtectedPathError without pushing. See pathmatch.Match for the pattern syntax.
+	ProtectedPaths []string
+	// AllowProtectedPathChanges overrides ProtectedPaths and lets the operation modify them.
+	AllowProtectedPathChanges bool
+
+	// Signer, if set, signs the created commits.
+	Signer signing.Signer
+	// PackOptions are the options of the pushed packfile.
+	PackOptions PackOptions
+
+	// DryRun makes the operation report the commits to create, their conflicts, and the objects
+	// to push without pushing them, so that the cherry-pick can be previewed. The commits are
+	// not signed in the dry-run mode.
+	DryRun bool
+}
+
+type PushCherryPickResult struct {
+	// CommitHash is the last created commit, which is pushed to Ref.
+	CommitHash plumbing.Hash
+	// Commits are the cherry-picked commits in order.
+	Commits []*CherryPickedCommit
+	// NewObjects is the number of the objects to push, including the commits.
+	NewObjects int
+	// ProtectedFiles are the files that match ProtectedPaths among the files that the last
+	// created commit modifies from CherryPickOnto.
+	ProtectedFiles []string
+}
+
+// CherryPickedCommit is a commit cherry-picked by PushCherryPick.
+type CherryPickedCommit struct {
+	// OriginalCommitHash is the commit that is cherry-picked.
+	OriginalCommitHash plumbing.Hash
+	// CommitHash is the created commit.
+	CommitHash        plumbing.Hash
+	CherryPickedFiles []string
+	ConflictOpenFiles []string
+}
+
+// PushCherryPick cherry-picks the commits one by one, creating a commit for each of them, and
+// pushes the last one to the specified ref. The created commits keep the message encoding, the
+// embedded merge tag, and the other extra headers of the originals. The signatures are dropped.
+func PushCherryPick(repoURL string, client *http.Client, args PushCherryPickArgs) (*PushCherryPickResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	args.Committer = signatureIn(args.Committer, args.Timezone)
+	refNames := withAdditionalRefNames(args.Ref, args.AdditionalRefUpdates)
+	if err := validateRefNames(refNames...); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := trailer.Validate(args.Trailers); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := pathmatch.Validate(args.ProtectedPaths); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if args.DryRun {
+		args.Signer = nil
+	}
+	unlock, err := lockRefs(repoURL, refNames...)
+	defer unlock()
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	commitHashes := append([]plumbing.Hash{}, args.Commits...)
+	var fetchDebugInfo debug.FetchDebugInfo
+	for _, r := range args.Ranges {
+		hashes, err := enumerateCommitRange(repoURL, client, r, &fetchDebugInfo)
+		if err != nil {
+			return nil, fetchDebugInfo, nil, err
+		}
+		commitHashes = append(commitHashes, hashes...)
+	}
+	if len(commitHashes) == 0 {
+		return nil, fetchDebugInfo, nil, errors.New("no commits to cherry-pick")
+	}
+
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	defer storage.Close()
+	if err := fetchCherryPickTrees(repoURL, client, storage, []plumbing.Hash{args.CherryPickOnto}, commitHashes, &fetchDebugInfo); err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	commits, newHashes, err := cherryPickCommits(storage, commitHashes, args.CherryPickOnto, args.Committer, trailer.WithSignOff(args.Trailers, args.SignOff, args.Committer.Name, args.Committer.Email), args.Signer)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	result := &PushCherryPickResult{Commits: commits, NewObjects: len(newHashes)}
+	current := args.CherryPickOnto
+	var conflictFiles []string
+	for _, c := range commits {
+		current = c.CommitHash
+		conflictFiles = append(conflictFiles, c.ConflictOpenFiles...)
+	}
+	result.CommitHash = current
+	if args.AbortOnConflict && len(conflictFiles) > 0 {
+		return result, fetchDebugInfo, nil, &ConflictError{Files: conflictFiles}
+	}
+	if len(args.ProtectedPaths) > 0 {
+		ontoTree, err := getTreeFromCommit(storage, args.CherryPickOnto)
+		if err != nil {
+			return result, fetchDebugInfo, nil, err
+		}
+		lastCommit, err := object.GetCommit(storage, current)
+		if err != nil {
+			return result, fetchDebugInfo, nil, err
+		}
+		result.ProtectedFiles, err = checkProtectedPaths(storage, ontoTree, lastCommit.TreeHash, args.ProtectedPaths, args.AllowProtectedPathChanges)
+		if err != nil {
+			return result, fetchDebugInfo, nil, err
+		}
+	}
+	if args.DryRun {
+		return result, fetchDebugInfo, nil, nil
+	}
+
+	buf, err := encodePushPackfile(storage, newHashes, args.PackOptions)
+	if err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+	pushDebugInfo, err := pushCreatedCommit(repoURL, client, buf, push.RefUpdate{
+		Name:    args.Ref,
+		OldHash: args.CurrentRefHash,
+		NewHash: current,
+	}, args.AdditionalRefUpdates)
+	if err != nil {
+		return result, fetchDebugInfo, &pushDebugInfo, err
+	}
+	return result, fetchDebugInfo, &pushDebugInfo, nil
+}
+
+// enumerateCommitRange returns the commits of the range from the oldest, with the parents before
+// the children. Only the commits are fetched for this.
+func enumerateCommitRange(repoURL string, client *http.Client, r CommitRange, debugInfo *debug.FetchDebugInfo) ([]plumbing.Hash, error) {
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, err
+	}
+	defer storage.Close()
+	f := &historyFetcher{
+		repoURL: repoURL,
+		client:  client,
+		storage: storage,
+		haves:   []plumbing.Hash{r.Start},
+	}
+	err = f.fetchCommits([]plumbing.Hash{r.End})
+	debugInfo.PackfileSize += f.debugInfo.PackfileSize
+	debugInfo.ResponseHeaders = f.debugInfo.ResponseHeaders
+	if err != nil {
+		return nil, err
+	}
+	// The server doesn't send the commits reachable from Start, so the walk stops at them.
+	var ret []plumbing.Hash
+	visited := map[plumbing.Hash]bool{}
+	var visit func(hash plumbing.Hash) error
+	visit = func(hash plumbing.Hash) error {
+		if visited[hash] || hash == r.Start {
+			return nil
+		}
+		visited[hash] = true
+		commit, err := object.GetCommit(f.storage, hash)
+		if err == plumbing.ErrObjectNotFound {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("cannot parse %q in the fetched packfile: %w", hash.String(), err)
+		}
+		if len(commit.ParentHashes) > 1 {
+			return fmt.Errorf("the range %s..%s has a merge commit %q", r.Start.String(), r.End.String(), hash.String())
+		}
+		for _, parent := range commit.ParentHashes {
+			if err := visit(parent); err != nil {
+				return err
+			}
+		}
+		ret = append(ret, hash)
+		return nil
+	}
+	if err := visit(r.End); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// fetchCherryPickTrees fetches the commits to cherry-pick, their first parents, and the commits
+// to cherry-pick onto with the trees.
+func fetchCherryPickTrees(repoURL string, client *http.Client, storage *objectStorage, ontoHashes, commitHashes []plumbing.Hash, debugInfo *debug.FetchDebugInfo) error {
+	if err := fetchBlobNone(repoURL, client, storage, append(append([]plumbing.Hash{}, ontoHashes...), commitHashes...), debugInfo); err != nil {
+		return err
+	}
+	var parentHashes []plumbing.Hash
+	for _, hash := range commitHashes {
+		commit, err := object.GetCommit(storage, hash)
+		if err != nil {
+			return fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+		}
+		if len(commit.ParentHashes) == 0 {
+			return fmt.Errorf("%q is a root commit and cannot be cherry-picked", hash.String())
+		}
+		parentHashes = append(parentHashes, commit.ParentHashes[0])
+	}
+	// The parents are not included in the fetch as it's depth 1.
+	return fetchBlobNone(repoURL, client, storage, parentHashes, debugInfo)
+}
+
+// cherryPickCommits cherry-picks the commits one by one on top of onto, appending the trailers to
+// the messages. The commits, their parents, and onto need to be fetched with
+// fetchCherryPickTrees. Returns the created commits and the new objects to push.
+func cherryPickCommits(storage storer.EncodedObjectStorer, commitHashes []plumbing.Hash, onto plumbing.Hash, committer object.Signature, trailers []Trailer, signer signing.Signer) ([]*CherryPickedCommit, []plumbing.Hash, error) {
+	var ret []*CherryPickedCommit
+	var newHashes []plumbing.Hash
+	current := onto
+	for _, hash := range commitHashes {
+		original, err := object.GetCommit(storage, hash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+		}
+		if len(original.ParentHashes) > 1 {
+			return nil, nil, fmt.Errorf("%q is a merge commit and cannot be cherry-picked", hash.String())
+		}
+		picked, hashes, err := pickCommit(storage, hash, []plumbing.Hash{current}, committer, trailers, signer)
+		if err != nil {
+			return nil, nil, err
+		}
+		ret = append(ret, picked)
+		newHashes = append(newHashes, hashes...)
+		current = picked.CommitHash
+	}
+	return ret, newHashes, nil
+}
+
+// pickCommit applies the changes of the commit from its first parent onto the first of the new
+// parents, and creates a commit with the new parents. It returns the created objects with the
+// commit first.
+func pickCommit(storage storer.EncodedObjectStorer, hash plumbing.Hash, parents []plumbing.Hash, committer object.Signature, trailers []Trailer, signer signing.Signer) (*CherryPickedCommit, []plumbing.Hash, error) {
+	original, err := object.GetCommit(storage, hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+	}
+	treeCPFrom, err := getTreeFromCommit(storage, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	treeCPBase, err := getTreeFromCommit(storage, original.ParentHashes[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	treeCPTo, err := getTreeFromCommit(storage, parents[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	mergeResult, err := merge.MergeTree(storage, treeCPFrom, treeCPTo, treeCPBase, conflictResolver)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to merge the trees: %w", err)
+	}
+	extraHeaders, err := commitExtraHeaders(storage, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	commitHash, err := storeCommitWithHeaders(storage, &object.Commit{
+		Message:      trailer.Append(original.Message, trailers),
+		Author:       original.Author,
+		Committer:    committer,
+		TreeHash:     mergeResult.TreeHash,
+		ParentHashes: parents,
+		Encoding:     original.Encoding,
+		MergeTag:     original.MergeTag,
+	}, extraHeaders, signer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &CherryPickedCommit{
+		OriginalCommitHash: hash,
+		CommitHash:         commitHash,
+		CherryPickedFiles:  mergeResult.FilesPickedEntry1,
+		ConflictOpenFiles:  mergeResult.FilesConflict,
+	}, append([]plumbing.Hash{commitHash}, mergeResult.NewHashes...), nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newCheckIgnoredCmd returns the check-ignored command.
+func newCheckIgnoredCmd(inv *invocation) *cobra.Command {
+	var checkIgnoredArgs struct {
+		repoURL    string
+		commitHash string
+		paths      []string
+
+		outputFile string
+	}
+
+	checkIgnoredCmd := &cobra.Command{
+		Use: "check-ignored",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			paths, debugInfo, fetchErr := nichegit.CheckIgnored(checkIgnoredArgs.repoURL, client, nichegit.CheckIgnoredArgs{
+				CommitHash: plumbing.NewHash(checkIgnoredArgs.commitHash),
+				Paths:      checkIgnoredArgs.paths,
+			})
+			if paths == nil {
+				// Always create an empty slice for JSON output.
+				paths = []*nichegit.PathIgnored{}
+			}
+			output := checkIgnoredOutput{
+				Paths:     paths,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(checkIgnoredArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	checkIgnoredCmd.Flags().StringVar(&checkIgnoredArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	checkIgnoredCmd.Flags().StringVar(&checkIgnoredArgs.commitHash, "commit-hash", "", "Commit hash to read the .gitignore files from")
+	checkIgnoredCmd.Flags().StringSliceVar(&checkIgnoredArgs.paths, "paths", nil, "Paths to check. A path with a trailing slash is checked as a directory")
+	_ = checkIgnoredCmd.MarkFlagRequired("repo-url")
+	_ = checkIgnoredCmd.MarkFlagRequired("commit-hash")
+	_ = checkIgnoredCmd.MarkFlagRequired("paths")
+
+	inv.addOperationFlags(checkIgnoredCmd)
+
+	inv.addFetchFlags(checkIgnoredCmd)
+	inv.addObjectStorageFlag(checkIgnoredCmd)
+	checkIgnoredCmd.Flags().StringVar(&checkIgnoredArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(checkIgnoredCmd)
+	return checkIgnoredCmd
+}
+
+type checkIgnoredOutput struct {
+	Paths     []*nichegit.PathIgnored `json:"paths"`
+	DebugInfo *debug.FetchDebugInfo   `json:"debugInfo,omitempty"`
+	Error     string                  `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode      `json:"errorCode,omitempty"`
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newCheckLinearHistoryCmd returns the check-linear-history command.
+func newCheckLinearHistoryCmd(inv *invocation) *cobra.Command {
+	var checkLinearHistoryArgs struct {
+		repoURL     string
+		ref         string
+		sinceCommit string
+
+		outputFile string
+	}
+
+	checkLinearHistoryCmd := &cobra.Command{
+		Use: "check-linear-history",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var since plumbing.Hash
+			if checkLinearHistoryArgs.sinceCommit != "" {
+				since = plumbing.NewHash(checkLinearHistoryArgs.sinceCommit)
+			}
+			client := inv.newHTTPClient()
+			result, debugInfo, fetchErr := nichegit.CheckLinearHistory(checkLinearHistoryArgs.repoURL, client, nichegit.CheckLinearHistoryArgs{
+				Ref:         plumbing.ReferenceName(checkLinearHistoryArgs.ref),
+				SinceCommit: since,
+			})
+			output := checkLinearHistoryOutput{
+				// Always create an empty slice for JSON output.
+				MergeCommits: []*nichegit.CommitInfo{},
+				DebugInfo:    debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.Linear = result.Linear
+				output.RefHash = result.RefHash.String()
+				output.CommitCount = result.CommitCount
+				if result.MergeCommits != nil {
+					output.MergeCommits = result.MergeCommits
+				}
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(checkLinearHistoryArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	checkLinearHistoryCmd.Flags().StringVar(&checkLinearHistoryArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	checkLinearHistoryCmd.Flags().StringVar(&checkLinearHistoryArgs.ref, "ref", "", "A branch ref name (e.g. refs/heads/foobar) whose history is checked")
+	checkLinearHistoryCmd.Flags().StringVar(&checkLinearHistoryArgs.sinceCommit, "since-commit", "", "Optional ancestor commit hash of the ref. Only the commits after it are checked, like git log SINCE..REF. If not specified, the whole history is checked")
+	_ = checkLinearHistoryCmd.MarkFlagRequired("repo-url")
+	_ = checkLinearHistoryCmd.MarkFlagRequired("ref")
+
+	inv.addOperationFlags(checkLinearHistoryCmd)
+
+	inv.addFetchFlags(checkLinearHistoryCmd)
+	inv.addObjectStorageFlag(checkLinearHistoryCmd)
+	checkLinearHistoryCmd.Flags().StringVar(&checkLinearHistoryArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(checkLinearHistoryCmd)
+	return checkLinearHistoryCmd
+}
+
+type checkLinearHistoryOutput struct {
+	Linear       bool                   `json:"linear"`
+	RefHash      string                 `json:"refHash"`
+	CommitCount  int                    `json:"commitCount"`
+	MergeCommits []*nichegit.CommitInfo `json:"mergeCommits"`
+	DebugInfo    *debug.FetchDebugInfo  `json:"debugInfo,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+	ErrorCode    nichegit.ErrorCode     `json:"errorCode,omitempty"`
+}
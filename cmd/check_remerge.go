@@ -0,0 +1,88 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newCheckRemergeCmd returns the check-remerge command.
+func newCheckRemergeCmd(inv *invocation) *cobra.Command {
+	var checkRemergeArgs struct {
+		repoURL      string
+		prHead       string
+		enqueuedBase string
+		currentTrunk string
+
+		outputFile string
+	}
+
+	checkRemergeCmd := &cobra.Command{
+		Use: "check-remerge",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			result, debugInfo, fetchErr := nichegit.CheckRemerge(checkRemergeArgs.repoURL, client, nichegit.CheckRemergeArgs{
+				PRHead:       plumbing.NewHash(checkRemergeArgs.prHead),
+				EnqueuedBase: plumbing.NewHash(checkRemergeArgs.enqueuedBase),
+				CurrentTrunk: plumbing.NewHash(checkRemergeArgs.currentTrunk),
+			})
+			output := checkRemergeOutput{
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.RemergeNeeded = result.RemergeNeeded
+				output.PRFiles = result.PRFiles
+				output.TrunkFiles = result.TrunkFiles
+				output.OverlappingFiles = result.OverlappingFiles
+			}
+			if output.PRFiles == nil {
+				output.PRFiles = []string{}
+			}
+			if output.TrunkFiles == nil {
+				output.TrunkFiles = []string{}
+			}
+			if output.OverlappingFiles == nil {
+				output.OverlappingFiles = []string{}
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(checkRemergeArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	checkRemergeCmd.Flags().StringVar(&checkRemergeArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	checkRemergeCmd.Flags().StringVar(&checkRemergeArgs.prHead, "pr-head", "", "Head commit hash of the PR")
+	checkRemergeCmd.Flags().StringVar(&checkRemergeArgs.enqueuedBase, "enqueued-base", "", "Trunk commit hash that the squash result was computed on")
+	checkRemergeCmd.Flags().StringVar(&checkRemergeArgs.currentTrunk, "current-trunk", "", "Current trunk commit hash")
+	_ = checkRemergeCmd.MarkFlagRequired("repo-url")
+	_ = checkRemergeCmd.MarkFlagRequired("pr-head")
+	_ = checkRemergeCmd.MarkFlagRequired("enqueued-base")
+	_ = checkRemergeCmd.MarkFlagRequired("current-trunk")
+
+	inv.addOperationFlags(checkRemergeCmd)
+
+	inv.addFetchFlags(checkRemergeCmd)
+	inv.addObjectStorageFlag(checkRemergeCmd)
+	checkRemergeCmd.Flags().StringVar(&checkRemergeArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(checkRemergeCmd)
+	return checkRemergeCmd
+}
+
+type checkRemergeOutput struct {
+	RemergeNeeded    bool                  `json:"remergeNeeded"`
+	PRFiles          []string              `json:"prFiles"`
+	TrunkFiles       []string              `json:"trunkFiles"`
+	OverlappingFiles []string              `json:"overlappingFiles"`
+	DebugInfo        *debug.FetchDebugInfo `json:"debugInfo,omitempty"`
+	Error            string                `json:"error,omitempty"`
+	ErrorCode        nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
@@ -0,0 +1,195 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newCherryPickCmd returns the cherry-pick command.
+func newCherryPickCmd(inv *invocation) *cobra.Command {
+	var cherryPickArgs struct {
+		repoURL         string
+		commits         []string
+		ranges          []string
+		cherryPickOnto  string
+		committer       string
+		committerEmail  string
+		committerTime   string
+		ref             string
+		currentRefHash  string
+		abortOnConflict bool
+		dryRun          bool
+
+		outputFile string
+	}
+
+	cherryPickCmd := &cobra.Command{
+		Use: "cherry-pick",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var currentRefhash *plumbing.Hash
+			if cherryPickArgs.currentRefHash != "" {
+				hash := plumbing.NewHash(cherryPickArgs.currentRefHash)
+				currentRefhash = &hash
+			}
+			var commits []plumbing.Hash
+			for _, commit := range cherryPickArgs.commits {
+				commits = append(commits, plumbing.NewHash(commit))
+			}
+			var ranges []nichegit.CommitRange
+			for _, r := range cherryPickArgs.ranges {
+				start, end, ok := strings.Cut(r, "..")
+				if !ok {
+					return fmt.Errorf("invalid range %q; must be START..END", r)
+				}
+				ranges = append(ranges, nichegit.CommitRange{Start: plumbing.NewHash(start), End: plumbing.NewHash(end)})
+			}
+			committer, err := newSignature(cherryPickArgs.committer, cherryPickArgs.committerEmail, cherryPickArgs.committerTime)
+			if err != nil {
+				return err
+			}
+			timezone, err := inv.parseTimezone()
+			if err != nil {
+				return err
+			}
+			signer, err := inv.newSigner()
+			if err != nil {
+				return err
+			}
+			trailers, err := inv.parseTrailers()
+			if err != nil {
+				return err
+			}
+			additional, err := inv.parseAdditionalRefUpdates()
+			if err != nil {
+				return err
+			}
+
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.PushCherryPick(
+				cherryPickArgs.repoURL,
+				client,
+				nichegit.PushCherryPickArgs{
+					Commits:                   commits,
+					Ranges:                    ranges,
+					CherryPickOnto:            plumbing.NewHash(cherryPickArgs.cherryPickOnto),
+					Committer:                 committer,
+					Timezone:                  timezone,
+					Trailers:                  trailers,
+					SignOff:                   inv.signOff,
+					ProtectedPaths:            inv.protectedPaths,
+					AllowProtectedPathChanges: inv.allowProtectedPathChanges,
+					Ref:                       plumbing.ReferenceName(cherryPickArgs.ref),
+					CurrentRefHash:            currentRefhash,
+					AbortOnConflict:           cherryPickArgs.abortOnConflict,
+					Signer:                    signer,
+					AdditionalRefUpdates:      additional,
+					PackOptions:               inv.packOptions(),
+					DryRun:                    cherryPickArgs.dryRun,
+				},
+			)
+			output := cherryPickOutput{
+				// Always create an empty slice for JSON output.
+				Commits:        []*cherryPickedCommit{},
+				FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			var conflictFiles []string
+			if result != nil {
+				output.ProtectedFiles = result.ProtectedFiles
+				output.CommitHash = result.CommitHash.String()
+				output.NewObjects = result.NewObjects
+				for _, c := range result.Commits {
+					commit := &cherryPickedCommit{
+						OriginalCommitHash: c.OriginalCommitHash.String(),
+						CommitHash:         c.CommitHash.String(),
+						CherryPickedFiles:  c.CherryPickedFiles,
+						ConflictOpenFiles:  c.ConflictOpenFiles,
+					}
+					if commit.CherryPickedFiles == nil {
+						commit.CherryPickedFiles = []string{}
+					}
+					if commit.ConflictOpenFiles == nil {
+						commit.ConflictOpenFiles = []string{}
+					}
+					output.Commits = append(output.Commits, commit)
+					conflictFiles = append(conflictFiles, c.ConflictOpenFiles...)
+				}
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(cherryPickArgs.outputFile, output); err != nil {
+				return err
+			}
+			inv.opStats.conflicted = len(conflictFiles) > 0
+			if pushErr == nil && inv.failOnConflict && len(conflictFiles) > 0 {
+				return &nichegit.ConflictError{Files: conflictFiles}
+			}
+			return pushErr
+		},
+	}
+
+	cherryPickCmd.Flags().StringVar(&cherryPickArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	cherryPickCmd.Flags().StringSliceVar(&cherryPickArgs.commits, "commits", nil, "Commit hashes to cherry-pick in order")
+	cherryPickCmd.Flags().StringArrayVar(&cherryPickArgs.ranges, "range", nil, "Commit range in the form of START..END to cherry-pick from the oldest after --commits, same as git cherry-pick START..END. Can be specified multiple times")
+	cherryPickCmd.Flags().StringVar(&cherryPickArgs.cherryPickOnto, "cherry-pick-onto", "", "Commit hash where the first cherry-picked commit is created on top of")
+	cherryPickCmd.Flags().StringVar(&cherryPickArgs.committer, "committer", "", "Commiter name")
+	cherryPickCmd.Flags().StringVar(&cherryPickArgs.committerEmail, "committer-email", "", "Commiter email address")
+	cherryPickCmd.Flags().StringVar(&cherryPickArgs.committerTime, "committer-time", "", "Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	cherryPickCmd.Flags().StringVar(&inv.signatureTimezone, "timezone", "", "Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used")
+	cherryPickCmd.Flags().StringVar(&cherryPickArgs.ref, "ref", "", "A ref name (e.g. refs/heads/foobar) to push")
+	cherryPickCmd.Flags().StringVar(&cherryPickArgs.currentRefHash, "current-ref-hash", "", "The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.")
+	cherryPickCmd.Flags().StringArrayVar(&inv.additionalRefUpdates, "additional-ref-update", nil, "Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times")
+	cherryPickCmd.Flags().BoolVar(&cherryPickArgs.abortOnConflict, "abort-on-conflict", false, "Abort the operation if any commit has a merge conflict")
+	cherryPickCmd.Flags().BoolVar(&cherryPickArgs.dryRun, "dry-run", false, "Report the commits to create, their conflicts, and the number of the objects to push without pushing them")
+	cherryPickCmd.Flags().StringArrayVar(&inv.commitTrailers, "trailer", nil, "Optional trailer in the form of KEY: VALUE appended to the message of each created commit (e.g. \"Co-authored-by: Foo <foo@example.com>\"). A trailer already in the message is not added again. Can be specified multiple times")
+	cherryPickCmd.Flags().BoolVar(&inv.signOff, "signoff", false, "Add a Signed-off-by trailer for the committer to the messages of the created commits")
+	inv.addProtectedPathFlags(cherryPickCmd)
+	_ = cherryPickCmd.MarkFlagRequired("repo-url")
+	_ = cherryPickCmd.MarkFlagRequired("cherry-pick-onto")
+	_ = cherryPickCmd.MarkFlagRequired("committer")
+	_ = cherryPickCmd.MarkFlagRequired("committer-email")
+	_ = cherryPickCmd.MarkFlagRequired("ref")
+
+	inv.addOperationFlags(cherryPickCmd)
+
+	inv.addSigningFlags(cherryPickCmd, "commits")
+
+	inv.addPackFlags(cherryPickCmd)
+
+	cherryPickCmd.Flags().BoolVar(&inv.failOnConflict, "fail-on-conflict", false, "Exit with a non-zero status if conflicts are reported, even if the commits are pushed")
+	cherryPickCmd.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	inv.addPushTimeoutFlags(cherryPickCmd)
+	inv.addFetchFlags(cherryPickCmd)
+	inv.addObjectStorageFlag(cherryPickCmd)
+	cherryPickCmd.Flags().StringVar(&cherryPickArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(cherryPickCmd)
+	return cherryPickCmd
+}
+
+type cherryPickOutput struct {
+	CommitHash     string                `json:"commitHash"`
+	Commits        []*cherryPickedCommit `json:"commits"`
+	NewObjects     int                   `json:"newObjects"`
+	ProtectedFiles []string              `json:"protectedFiles,omitempty"`
+	FetchDebugInfo *debug.FetchDebugInfo `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo  *debug.PushDebugInfo  `json:"pushDebugInfo,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	ErrorCode      nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
+
+type cherryPickedCommit struct {
+	OriginalCommitHash string   `json:"originalCommitHash"`
+	CommitHash         string   `json:"commitHash"`
+	CherryPickedFiles  []string `json:"cherryPickedFiles"`
+	ConflictOpenFiles  []string `json:"conflictOpenFiles"`
+}
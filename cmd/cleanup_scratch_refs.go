@@ -0,0 +1,86 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/spf13/cobra"
+)
+
+// newCleanupScratchRefsCmd returns the cleanup-scratch-refs command.
+func newCleanupScratchRefsCmd(inv *invocation) *cobra.Command {
+	var cleanupScratchRefsArgs struct {
+		repoURL   string
+		namespace string
+		maxAge    time.Duration
+		dryRun    bool
+
+		outputFile string
+	}
+
+	cleanupScratchRefsCmd := &cobra.Command{
+		Use: "cleanup-scratch-refs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			result, pushDebugInfo, pushErr := nichegit.CleanupScratchRefs(cleanupScratchRefsArgs.repoURL, client, nichegit.CleanupScratchRefsArgs{
+				Namespace: cleanupScratchRefsArgs.namespace,
+				MaxAge:    cleanupScratchRefsArgs.maxAge,
+				DryRun:    cleanupScratchRefsArgs.dryRun,
+			})
+			output := cleanupScratchRefsOutput{
+				DeletedRefs:   []scratchRefOutput{},
+				PushDebugInfo: pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				for _, ref := range result.DeletedRefs {
+					output.DeletedRefs = append(output.DeletedRefs, scratchRefOutput{
+						Name:      ref.Name.String(),
+						Hash:      ref.Hash.String(),
+						Purpose:   ref.Purpose,
+						CreatedAt: ref.CreatedAt,
+					})
+				}
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(cleanupScratchRefsArgs.outputFile, output); err != nil {
+				return err
+			}
+			return pushErr
+		},
+	}
+
+	cleanupScratchRefsCmd.Flags().StringVar(&cleanupScratchRefsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	cleanupScratchRefsCmd.Flags().StringVar(&cleanupScratchRefsArgs.namespace, "namespace", nichegit.DefaultScratchRefNamespace, "Namespace of the scratch refs")
+	cleanupScratchRefsCmd.Flags().DurationVar(&cleanupScratchRefsArgs.maxAge, "max-age", 7*24*time.Hour, "Delete the scratch refs older than this")
+	cleanupScratchRefsCmd.Flags().BoolVar(&cleanupScratchRefsArgs.dryRun, "dry-run", false, "Report the refs to delete without deleting them")
+	_ = cleanupScratchRefsCmd.MarkFlagRequired("repo-url")
+
+	inv.addOperationFlags(cleanupScratchRefsCmd)
+
+	cleanupScratchRefsCmd.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	inv.addPushTimeoutFlags(cleanupScratchRefsCmd)
+	cleanupScratchRefsCmd.Flags().StringVar(&cleanupScratchRefsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(cleanupScratchRefsCmd)
+	return cleanupScratchRefsCmd
+}
+
+type scratchRefOutput struct {
+	Name      string    `json:"name"`
+	Hash      string    `json:"hash"`
+	Purpose   string    `json:"purpose"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type cleanupScratchRefsOutput struct {
+	DeletedRefs   []scratchRefOutput   `json:"deletedRefs"`
+	PushDebugInfo *debug.PushDebugInfo `json:"pushDebugInfo,omitempty"`
+	Error         string               `json:"error,omitempty"`
+	ErrorCode     nichegit.ErrorCode   `json:"errorCode,omitempty"`
+}
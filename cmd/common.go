@@ -4,33 +4,354 @@
 package cmd
 
 import (
-	"encoding/json"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+	_ "time/tzdata"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
 )
 
-var (
+// invocation is the state of a run of a command: the values of its flags, where its output goes,
+// and its deadline and stats. Each run has its own, so that pipe, serve, and grpc-serve can run
+// the commands concurrently. The commands are created for an invocation with newRootCmd, and their flags are
+// bound to its fields.
+type invocation struct {
+	// stdout is where the JSON output goes if the output file is "-". The pipe command
+	// captures the output of each command with it.
+	stdout io.Writer
+
 	authzHeader        string
 	basicAuthzUser     string
 	basicAuthzPassword string
+
+	// debugLevel is the verbosity of the debug info in the JSON output.
+	debugLevel string
+	// outputFormat is the format of the output. See outputFormatJSON, outputFormatNDJSON, and
+	// outputFormatPlain.
+	outputFormat string
+	// quietOutput omits the debug info from the JSON output. Same as the "none" debug level.
+	quietOutput bool
+	// failOnConflict makes the mutating commands fail when conflicts are reported.
+	failOnConflict bool
+	// packfileSpoolThreshold is the packfile size beyond which fetched packfiles are spooled to
+	// disk.
+	packfileSpoolThreshold int64
+	// objectStorageDir is the directory to keep the objects of the operation in. Empty means
+	// memory.
+	objectStorageDir string
+	// fetchProgress prints the progress of the fetches to stderr.
+	fetchProgress bool
+	// packfileURIProtocols are the protocols of the packfile URIs to accept.
+	packfileURIProtocols []string
+	// operationTimeout is the time limit of the whole operation. Zero means no limit.
+	operationTimeout time.Duration
+	// maxRetries is the number of the retries of the requests with DefaultRetryPolicy. Zero
+	// disables retrying.
+	maxRetries int
+	// pushTimeout and refAdvTimeout are the time limits of each push attempt and of its ref
+	// advertisement.
+	pushTimeout   time.Duration
+	refAdvTimeout time.Duration
+	// additionalRefUpdates are the ref updates pushed atomically with the created commit.
+	additionalRefUpdates []string
+	// commitTrailers are the trailers appended to the messages of the created commits.
+	commitTrailers []string
+	// signOff appends a Signed-off-by trailer for the committer to the messages of the created
+	// commits.
+	signOff bool
+	// protectedPaths are the path patterns that the created commits must not modify, unless
+	// allowProtectedPathChanges is set.
+	protectedPaths            []string
+	allowProtectedPathChanges bool
+	// signatureTimezone is the timezone of the signatures of the created commits and tags.
+	signatureTimezone string
+	// webLinkProvider, webLinkTemplates, and webLinkRepoURL specify the web UI URLs added to the
+	// outputs of the read commands.
+	webLinkProvider  string
+	webLinkTemplates []string
+	webLinkRepoURL   string
+	// ctx is the context that the operation runs in, e.g. the one of a gRPC call. Nil means
+	// context.Background().
+	ctx context.Context
+	// operationCtx has the deadline of the running operation. Set by startOperation.
+	operationCtx    context.Context
+	operationCancel context.CancelFunc
+	operationStart  time.Time
+	// opStats is the stats of the running command.
+	opStats operationStats
+
+	// signingKeyFile, signingKeyFormat, and signingKeyPassphrase specify the key to sign the
+	// created commits with.
+	signingKeyFile       string
+	signingKeyFormat     string
+	signingKeyPassphrase string
+
+	// packDeltaCompression and packCompressionLevel specify how the pushed packfiles are encoded.
+	packDeltaCompression bool
+	packCompressionLevel int
+
+	// recordDir is the directory to record the HTTP exchanges of the operation into.
+	recordDir string
+	// replayDir is the directory to replay the recorded HTTP exchanges from instead of reaching
+	// the server.
+	replayDir string
+	// recorder is the recorder or the replayer of the running operation. Set by
+	// PersistentPreRunE.
+	recorder *exchangeRecorder
+}
+
+// newInvocation returns the state of a run of a command that writes to os.Stdout.
+func newInvocation() *invocation {
+	return &invocation{stdout: os.Stdout}
+}
+
+const (
+	// outputFormatJSON writes the output as one indented JSON object.
+	outputFormatJSON = "json"
+	// outputFormatNDJSON writes the elements of the lists in the output as separate lines, and
+	// then the rest of the output as the last line.
+	outputFormatNDJSON = "ndjson"
+	// outputFormatPlain writes the output as plain lines for shell scripts. Only the commands in
+	// plainOutputCommands support this.
+	outputFormatPlain = "plain"
 )
 
-type authnRoundtripper struct{}
+// plainOutputCommands are the commands whose outputs implement plainOutput.
+var plainOutputCommands = map[string]bool{
+	"get-modified-files": true,
+	"ls-refs":            true,
+}
+
+// plainOutput is an output that can be written with outputFormatPlain.
+type plainOutput interface {
+	// writePlain writes the output as lines without the debug info and the error, which is
+	// reported to stderr and with the exit code instead.
+	writePlain(w io.Writer) error
+}
+
+// startOperation starts the deadline of the operation for operationTimeout. The previous
+// operation's one is released.
+func (inv *invocation) startOperation() {
+	if inv.operationCancel != nil {
+		inv.operationCancel()
+	}
+	inv.operationStart = time.Now()
+	ctx := inv.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	inv.operationCtx, inv.operationCancel = ctx, nil
+	if inv.operationTimeout > 0 {
+		inv.operationCtx, inv.operationCancel = context.WithTimeout(inv.operationCtx, inv.operationTimeout)
+	}
+}
+
+// operationTimedOut returns true if the running operation has passed its deadline.
+func (inv *invocation) operationTimedOut() bool {
+	return inv.operationCtx != nil && errors.Is(inv.operationCtx.Err(), context.DeadlineExceeded)
+}
+
+// operationStats is the amount of the data transferred by a command.
+type operationStats struct {
+	// fetchedBytes is the size of the upload-pack responses.
+	fetchedBytes atomic.Int64
+	// pushedBytes is the size of the receive-pack requests.
+	pushedBytes atomic.Int64
+	// conflicted is true if the command reported conflicts.
+	conflicted bool
+	// storedResult is true if an operation of the command returned a stored result.
+	storedResult atomic.Bool
+}
+
+// newHTTPClient returns the client of a command. The operations of the command share its
+// session, so that the ls-refs results and the connections are reused, and the deadline of the
+// operation applies to all the fetches and pushes of the command.
+func (inv *invocation) newHTTPClient() *http.Client {
+	session := nichegit.NewSessionWithOptions(inv.operationCtx, &http.Client{Transport: &authnRoundtripper{inv: inv}}, inv.sessionOptions())
+	// The serve command runs the requests with different credentials in the same process.
+	session.SetCacheScope(inv.credentialScope())
+	return session.Client()
+}
+
+// sessionOptions returns the session options for the flags of the command. They are not set
+// process-wide, as the serve command runs the requests with different flags concurrently.
+func (inv *invocation) sessionOptions() nichegit.SessionOptions {
+	retryPolicy := nichegit.RetryPolicy{}
+	if inv.maxRetries > 0 {
+		retryPolicy = nichegit.DefaultRetryPolicy
+		retryPolicy.MaxRetries = inv.maxRetries
+	}
+	spoolThreshold := inv.packfileSpoolThreshold
+	opts := nichegit.SessionOptions{
+		RetryPolicy: &retryPolicy,
+		PushTimeouts: &nichegit.PushTimeouts{
+			RefAdvertisement: inv.refAdvTimeout,
+			Push:             inv.pushTimeout,
+		},
+		PackfileSpoolThreshold: &spoolThreshold,
+		PackfileURIProtocols:   append([]string{}, inv.packfileURIProtocols...),
+	}
+	if inv.objectStorageDir != "" {
+		opts.StorageProvider = nichegit.NewDiskStorageProvider(inv.objectStorageDir)
+	}
+	if inv.fetchProgress {
+		opts.FetchProgressFunc = newProgressPrinter(os.Stderr).print
+	}
+	opts.ResultStore = inv.sessionResultStore()
+	return opts
+}
+
+// credentialScope returns a hash of the credentials of the command, which separates the results
+// cached in the process by the credentials.
+func (inv *invocation) credentialScope() string {
+	if inv.authzHeader == "" && inv.basicAuthzUser == "" && inv.basicAuthzPassword == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(inv.authzHeader + "\x00" + inv.basicAuthzUser + "\x00" + inv.basicAuthzPassword))
+	return hex.EncodeToString(sum[:])
+}
+
+// authnRoundtripper sets the authorization of the requests of the invocation.
+type authnRoundtripper struct {
+	inv *invocation
+}
 
 func (rt *authnRoundtripper) RoundTrip(req *http.Request) (*http.Response, error) {
-	if authzHeader != "" {
-		req.Header.Set("Authorization", authzHeader)
-	} else if basicAuthzUser != "" && basicAuthzPassword != "" {
-		req.SetBasicAuth(basicAuthzUser, basicAuthzPassword)
+	inv := rt.inv
+	// The packfile URIs are usually on CDNs, which must not get the credentials of the
+	// repository.
+	if !nichegit.IsPackfileURIRequest(req) {
+		if inv.authzHeader != "" {
+			req.Header.Set("Authorization", inv.authzHeader)
+		} else if inv.basicAuthzUser != "" && inv.basicAuthzPassword != "" {
+			req.SetBasicAuth(inv.basicAuthzUser, inv.basicAuthzPassword)
+		}
+	}
+	if strings.HasSuffix(req.URL.Path, "/git-receive-pack") && req.Body != nil {
+		req.Body = &countingReadCloser{ReadCloser: req.Body, n: &inv.opStats.pushedBytes}
+	}
+	var resp *http.Response
+	var err error
+	if inv.recorder != nil {
+		resp, err = inv.recorder.RoundTrip(req, http.DefaultTransport)
+	} else {
+		resp, err = http.DefaultTransport.RoundTrip(req)
 	}
-	return http.DefaultTransport.RoundTrip(req)
+	if err == nil && strings.HasSuffix(req.URL.Path, "/git-upload-pack") {
+		resp.Body = &countingReadCloser{ReadCloser: resp.Body, n: &inv.opStats.fetchedBytes}
+	}
+	return resp, err
+}
+
+// countingReadCloser adds the number of the bytes read to n.
+type countingReadCloser struct {
+	io.ReadCloser
+	n *atomic.Int64
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n.Add(int64(n))
+	return n, err
+}
+
+// newSigner returns the signer for the signing key flags, or nil if no key is specified.
+func (inv *invocation) newSigner() (signing.Signer, error) {
+	if inv.signingKeyFile == "" {
+		return nil, nil
+	}
+	key, err := os.ReadFile(inv.signingKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return signing.NewSigner(signing.Format(inv.signingKeyFormat), key, []byte(inv.signingKeyPassphrase))
+}
+
+// parseOptionalTime parses the time in RFC3339 format. An empty string is the zero time.
+func parseOptionalTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// packOptions returns the pack options for the packfile flags.
+func (inv *invocation) packOptions() nichegit.PackOptions {
+	return nichegit.PackOptions{
+		DeltaCompression: inv.packDeltaCompression,
+		CompressionLevel: inv.packCompressionLevel,
+	}
+}
+
+// parseAdditionalRefUpdates parses --additional-ref-update. The new value HEAD means the created
+// commit.
+func (inv *invocation) parseAdditionalRefUpdates() ([]nichegit.RefUpdate, error) {
+	var ret []nichegit.RefUpdate
+	for _, s := range inv.additionalRefUpdates {
+		toCreatedCommit := false
+		update := s
+		if name, rest, ok := strings.Cut(s, ":HEAD"); ok && (rest == "" || rest[0] == ':') {
+			toCreatedCommit = true
+			update = name + ":" + plumbing.ZeroHash.String() + rest
+		}
+		u, err := parseRefUpdate(update)
+		if err != nil {
+			return nil, fmt.Errorf("invalid additional ref update %q; must be REF:NEW_HASH[:OLD_HASH] or REF:HEAD[:OLD_HASH]", s)
+		}
+		u.ToCreatedCommit = toCreatedCommit
+		ret = append(ret, u)
+	}
+	return ret, nil
+}
+
+// parseTrailers parses --trailer.
+func (inv *invocation) parseTrailers() ([]nichegit.Trailer, error) {
+	var ret []nichegit.Trailer
+	for _, s := range inv.commitTrailers {
+		key, value, ok := strings.Cut(s, ":")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid trailer %q; must be KEY: VALUE", s)
+		}
+		ret = append(ret, nichegit.Trailer{Key: strings.TrimSpace(key), Value: strings.TrimSpace(value)})
+	}
+	return ret, nil
 }
 
-func writeJSON(outputPath string, v any) error {
+// parseTimezone parses --timezone. It returns nil if it's not set.
+func (inv *invocation) parseTimezone() (*time.Location, error) {
+	if inv.signatureTimezone == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{"-07:00", "-0700"} {
+		if t, err := time.Parse(layout, inv.signatureTimezone); err == nil {
+			_, offset := t.Zone()
+			return time.FixedZone(inv.signatureTimezone, offset), nil
+		}
+	}
+	// The timezone database is embedded, so the names resolve the same on every host.
+	loc, err := time.LoadLocation(inv.signatureTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", inv.signatureTimezone, err)
+	}
+	return loc, nil
+}
+
+func (inv *invocation) writeJSON(outputPath string, v any) error {
 	var of io.Writer
 	if outputPath == "-" {
-		of = os.Stdout
+		of = inv.stdout
 	} else {
 		file, err := os.OpenFile(outputPath, os.O_CREATE|os.O_WRONLY, 0644)
 		if err != nil {
@@ -39,10 +360,33 @@ func writeJSON(outputPath string, v any) error {
 		defer file.Close()
 		of = file
 	}
-	enc := json.NewEncoder(of)
-	enc.SetIndent("", "  ")
-	if err := enc.Encode(v); err != nil {
-		return err
+	var extra []jsonField
+	if inv.operationTimeout > 0 && inv.outputDebugLevel() != debug.LevelNone {
+		extra = append(extra, jsonField{key: "budgetDebugInfo", value: &debug.BudgetDebugInfo{
+			TimeoutMillis: inv.operationTimeout.Milliseconds(),
+			ElapsedMillis: time.Since(inv.operationStart).Milliseconds(),
+			TimedOut:      inv.operationTimedOut(),
+		}})
+	}
+	if inv.outputFormat == outputFormatPlain {
+		po, ok := v.(plainOutput)
+		if !ok {
+			return fmt.Errorf("--output-format=%s is not supported by this command", outputFormatPlain)
+		}
+		return po.writePlain(of)
+	}
+	if inv.outputFormat == outputFormatNDJSON {
+		return encodeNDJSON(of, v, extra...)
+	}
+	return encodeJSON(of, v, extra...)
+}
+
+func (inv *invocation) outputDebugLevel() debug.Level {
+	if inv.quietOutput {
+		return debug.LevelNone
+	}
+	if inv.debugLevel == "" {
+		return debug.LevelBasic
 	}
-	return nil
+	return debug.Level(inv.debugLevel)
 }
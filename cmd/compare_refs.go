@@ -0,0 +1,110 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/spf13/cobra"
+)
+
+// newCompareRefsCmd returns the compare-refs command.
+func newCompareRefsCmd(inv *invocation) *cobra.Command {
+	var compareRefsArgs struct {
+		repoURL          string
+		refA             string
+		refB             string
+		maxCommits       int
+		applyReplaceRefs bool
+
+		outputFile string
+	}
+
+	compareRefsCmd := &cobra.Command{
+		Use: "compare-refs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			linker, err := inv.newWebLinker(compareRefsArgs.repoURL)
+			if err != nil {
+				return err
+			}
+			client := inv.newHTTPClient()
+			result, debugInfo, fetchErr := nichegit.CompareRefs(compareRefsArgs.repoURL, client, nichegit.CompareRefsArgs{
+				RefA:             compareRefsArgs.refA,
+				RefB:             compareRefsArgs.refB,
+				MaxCommits:       compareRefsArgs.maxCommits,
+				ApplyReplaceRefs: compareRefsArgs.applyReplaceRefs,
+			})
+			output := compareRefsOutput{
+				// Always create an empty slice for JSON output.
+				AheadCommits:  []*nichegit.CommitInfo{},
+				BehindCommits: []*nichegit.CommitInfo{},
+				DebugInfo:     debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.RefAHash = result.RefAHash.String()
+				output.RefBHash = result.RefBHash.String()
+				output.MergeBase = result.MergeBase.String()
+				output.Ahead = result.Ahead
+				output.Behind = result.Behind
+				if result.AheadCommits != nil {
+					output.AheadCommits = result.AheadCommits
+				}
+				if result.BehindCommits != nil {
+					output.BehindCommits = result.BehindCommits
+				}
+				for _, hash := range result.ReplacedCommits {
+					output.ReplacedCommits = append(output.ReplacedCommits, hash.String())
+				}
+				// The changes of ref A since it diverged from ref B, same as the pull requests.
+				output.CompareWebURL = linker.Compare(output.RefBHash, output.RefAHash)
+				setCommitWebURLs(linker, output.AheadCommits)
+				setCommitWebURLs(linker, output.BehindCommits)
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(compareRefsArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	compareRefsCmd.Flags().StringVar(&compareRefsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	compareRefsCmd.Flags().StringVar(&compareRefsArgs.refA, "ref-a", "", "A revision to compare. A commit hash, a ref name, or a branch or tag name")
+	compareRefsCmd.Flags().StringVar(&compareRefsArgs.refB, "ref-b", "", "The other revision to compare. A commit hash, a ref name, or a branch or tag name")
+	compareRefsCmd.Flags().IntVar(&compareRefsArgs.maxCommits, "max-commits", 0, "Optional maximum number of the commits listed for each side. The ahead and behind counts are not limited. Zero, which is the default, means no limit")
+	compareRefsCmd.Flags().BoolVar(&compareRefsArgs.applyReplaceRefs, "apply-replace-refs", false, "Honor refs/replace/* on the remote when finding the merge base and walking the commits")
+	_ = compareRefsCmd.MarkFlagRequired("repo-url")
+	_ = compareRefsCmd.MarkFlagRequired("ref-a")
+	_ = compareRefsCmd.MarkFlagRequired("ref-b")
+
+	inv.addOperationFlags(compareRefsCmd)
+
+	inv.addFetchFlags(compareRefsCmd)
+	inv.addObjectStorageFlag(compareRefsCmd)
+	compareRefsCmd.Flags().StringVar(&inv.webLinkProvider, "web-links", "", "Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket")
+	compareRefsCmd.Flags().StringArrayVar(&inv.webLinkTemplates, "web-link-template", nil, "Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders")
+	compareRefsCmd.Flags().StringVar(&inv.webLinkRepoURL, "web-link-repo-url", "", "Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix")
+	compareRefsCmd.Flags().StringVar(&compareRefsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(compareRefsCmd)
+	return compareRefsCmd
+}
+
+type compareRefsOutput struct {
+	RefAHash      string                 `json:"refAHash"`
+	RefBHash      string                 `json:"refBHash"`
+	MergeBase     string                 `json:"mergeBase"`
+	Ahead         int                    `json:"ahead"`
+	Behind        int                    `json:"behind"`
+	AheadCommits  []*nichegit.CommitInfo `json:"aheadCommits"`
+	BehindCommits []*nichegit.CommitInfo `json:"behindCommits"`
+	// ReplacedCommits are the commits replaced by refs/replace/*. Set with --apply-replace-refs.
+	ReplacedCommits []string              `json:"replacedCommits,omitempty"`
+	CompareWebURL   string                `json:"compareWebURL,omitempty"`
+	DebugInfo       *debug.FetchDebugInfo `json:"debugInfo,omitempty"`
+	Error           string                `json:"error,omitempty"`
+	ErrorCode       nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newEmptyCommitCmd returns the empty-commit command.
+func newEmptyCommitCmd(inv *invocation) *cobra.Command {
+	var emptyCommitArgs struct {
+		repoURL        string
+		ref            string
+		parent         string
+		commitMessage  string
+		author         string
+		authorEmail    string
+		authorTime     string
+		committer      string
+		committerEmail string
+		committerTime  string
+
+		outputFile string
+	}
+
+	emptyCommitCmd := &cobra.Command{
+		Use: "empty-commit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var parent plumbing.Hash
+			if emptyCommitArgs.parent != "" {
+				parent = plumbing.NewHash(emptyCommitArgs.parent)
+			}
+			author, err := newSignature(emptyCommitArgs.author, emptyCommitArgs.authorEmail, emptyCommitArgs.authorTime)
+			if err != nil {
+				return err
+			}
+			committer, err := newSignature(emptyCommitArgs.committer, emptyCommitArgs.committerEmail, emptyCommitArgs.committerTime)
+			if err != nil {
+				return err
+			}
+			timezone, err := inv.parseTimezone()
+			if err != nil {
+				return err
+			}
+			signer, err := inv.newSigner()
+			if err != nil {
+				return err
+			}
+			trailers, err := inv.parseTrailers()
+			if err != nil {
+				return err
+			}
+			additional, err := inv.parseAdditionalRefUpdates()
+			if err != nil {
+				return err
+			}
+
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.PushEmptyCommit(
+				emptyCommitArgs.repoURL,
+				client,
+				nichegit.PushEmptyCommitArgs{
+					Ref:                  plumbing.ReferenceName(emptyCommitArgs.ref),
+					Parent:               parent,
+					CommitMessage:        emptyCommitArgs.commitMessage,
+					Trailers:             trailers,
+					SignOff:              inv.signOff,
+					ProtectedPaths:       inv.protectedPaths,
+					Author:               author,
+					Committer:            committer,
+					Timezone:             timezone,
+					AdditionalRefUpdates: additional,
+					Signer:               signer,
+					PackOptions:          inv.packOptions(),
+				},
+			)
+			output := emptyCommitOutput{
+				FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.CommitHash = result.CommitHash.String()
+				output.Parent = result.Parent.String()
+				output.CommitMessage = result.CommitMessage
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(emptyCommitArgs.outputFile, output); err != nil {
+				return err
+			}
+			return pushErr
+		},
+	}
+
+	emptyCommitCmd.Flags().StringVar(&emptyCommitArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	emptyCommitCmd.Flags().StringVar(&emptyCommitArgs.ref, "ref", "", "A ref name (e.g. refs/heads/foobar) to push the empty commit to")
+	emptyCommitCmd.Flags().StringVar(&emptyCommitArgs.parent, "parent", "", "Optional commit hash to create the empty commit on top of, which is the expected current value of the ref. If not specified, the current value of the ref is used. The ref is pushed with compare-and-swap against it")
+	emptyCommitCmd.Flags().StringVar(&emptyCommitArgs.commitMessage, "commit-message", "", "Commit message of the empty commit")
+	emptyCommitCmd.Flags().StringVar(&emptyCommitArgs.author, "author", "", "Author name")
+	emptyCommitCmd.Flags().StringVar(&emptyCommitArgs.authorEmail, "author-email", "", "Author email address")
+	emptyCommitCmd.Flags().StringVar(&emptyCommitArgs.authorTime, "author-time", "", "Author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	emptyCommitCmd.Flags().StringVar(&emptyCommitArgs.committer, "committer", "", "Commiter name")
+	emptyCommitCmd.Flags().StringVar(&emptyCommitArgs.committerEmail, "committer-email", "", "Commiter email address")
+	emptyCommitCmd.Flags().StringVar(&emptyCommitArgs.committerTime, "committer-time", "", "Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	emptyCommitCmd.Flags().StringVar(&inv.signatureTimezone, "timezone", "", "Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used")
+	emptyCommitCmd.Flags().StringArrayVar(&inv.additionalRefUpdates, "additional-ref-update", nil, "Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times")
+	emptyCommitCmd.Flags().StringArrayVar(&inv.commitTrailers, "trailer", nil, "Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. \"Deployed-To: production\"). A trailer already in the message is not added again. Can be specified multiple times")
+	emptyCommitCmd.Flags().BoolVar(&inv.signOff, "signoff", false, "Add a Signed-off-by trailer for the committer to the commit message")
+	emptyCommitCmd.Flags().StringSliceVar(&inv.protectedPaths, "protected-paths", nil, "Optional path patterns (e.g. .github/workflows/**) that must not be modified. They are only validated, as the empty commit doesn't modify any file")
+	_ = emptyCommitCmd.MarkFlagRequired("repo-url")
+	_ = emptyCommitCmd.MarkFlagRequired("ref")
+	_ = emptyCommitCmd.MarkFlagRequired("commit-message")
+	_ = emptyCommitCmd.MarkFlagRequired("author")
+	_ = emptyCommitCmd.MarkFlagRequired("author-email")
+	_ = emptyCommitCmd.MarkFlagRequired("committer")
+	_ = emptyCommitCmd.MarkFlagRequired("committer-email")
+
+	inv.addOperationFlags(emptyCommitCmd)
+
+	inv.addSigningFlags(emptyCommitCmd, "commit")
+
+	emptyCommitCmd.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	inv.addPushTimeoutFlags(emptyCommitCmd)
+	inv.addFetchFlags(emptyCommitCmd)
+	inv.addObjectStorageFlag(emptyCommitCmd)
+	emptyCommitCmd.Flags().StringVar(&emptyCommitArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(emptyCommitCmd)
+	return emptyCommitCmd
+}
+
+type emptyCommitOutput struct {
+	CommitHash     string                `json:"commitHash"`
+	Parent         string                `json:"parent"`
+	CommitMessage  string                `json:"commitMessage"`
+	FetchDebugInfo *debug.FetchDebugInfo `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo  *debug.PushDebugInfo  `json:"pushDebugInfo,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	ErrorCode      nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
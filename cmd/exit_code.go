@@ -0,0 +1,61 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+)
+
+// Exit codes of the CLI. The JSON output is written regardless of the exit code.
+const (
+	// exitCodeOK means the operation succeeded.
+	exitCodeOK = 0
+	// exitCodeError means the operation failed for a reason not listed below.
+	exitCodeError = 1
+	// exitCodeConflict means the operation was aborted due to merge conflicts.
+	exitCodeConflict = 2
+	// exitCodePreconditionFailed means a precondition of the operation didn't hold. This
+	// includes a compare-and-swap failure on ref update, the protected paths, Git LFS file
+	// locks, and missing objects on ref update.
+	exitCodePreconditionFailed = 3
+	// exitCodeAuth means the server rejected the credentials.
+	exitCodeAuth = 4
+	// exitCodeNetwork means the server couldn't be reached or responded with a server-side
+	// error.
+	exitCodeNetwork = 5
+	// exitCodeTimeout means the operation was stopped at the --timeout limit, or a push attempt
+	// at --push-timeout or --ref-adv-timeout.
+	exitCodeTimeout = 6
+	// exitCodeTooLarge means the change exceeded --max-changed-files or --max-new-blob-bytes.
+	exitCodeTooLarge = 7
+)
+
+func (inv *invocation) exitCode(err error) int {
+	switch inv.errorCode(err) {
+	case "":
+		return exitCodeOK
+	case nichegit.ErrorCodeTimeout:
+		return exitCodeTimeout
+	case nichegit.ErrorCodeConflict:
+		return exitCodeConflict
+	case nichegit.ErrorCodeRefCASFailed, nichegit.ErrorCodeLeaseBroken, nichegit.ErrorCodeObjectNotFound, nichegit.ErrorCodePreconditionFailed:
+		return exitCodePreconditionFailed
+	case nichegit.ErrorCodeTooLarge:
+		return exitCodeTooLarge
+	case nichegit.ErrorCodeAuthFailed:
+		return exitCodeAuth
+	case nichegit.ErrorCodeNetwork:
+		return exitCodeNetwork
+	}
+	return exitCodeError
+}
+
+// errorCode returns the errorCode field of the outputs.
+func (inv *invocation) errorCode(err error) nichegit.ErrorCode {
+	// The errors are not always wrapped with %w, so the deadline is checked instead of the error.
+	if err != nil && inv.operationTimedOut() {
+		return nichegit.ErrorCodeTimeout
+	}
+	return nichegit.ErrorCodeOf(err)
+}
@@ -0,0 +1,170 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	gogittransport "github.com/go-git/go-git/v5/plumbing/transport"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"success", nil, exitCodeOK},
+		{"unknown", errors.New("broken"), exitCodeError},
+		{"conflict", fmt.Errorf("cherry-pick: %w", &nichegit.ConflictError{Files: []string{"a.txt"}}), exitCodeConflict},
+		{"ref CAS failure", &push.RefUpdateError{RefName: "refs/heads/main", Status: "stale info"}, exitCodePreconditionFailed},
+		{"protected paths", &nichegit.ProtectedPathError{Paths: []string{"a.txt"}}, exitCodePreconditionFailed},
+		{"too large", &nichegit.ChangeTooLargeError{ChangedFiles: 3, MaxChangedFiles: 2}, exitCodeTooLarge},
+		{"authentication", fmt.Errorf("ls-refs: %w", gogittransport.ErrAuthenticationRequired), exitCodeAuth},
+		{"forbidden", &fetch.HTTPStatusError{StatusCode: http.StatusForbidden}, exitCodeAuth},
+		{"rate limited", &fetch.HTTPStatusError{StatusCode: http.StatusTooManyRequests}, exitCodeNetwork},
+		{"server error", &fetch.HTTPStatusError{StatusCode: http.StatusBadGateway}, exitCodeNetwork},
+		{"not found", &fetch.HTTPStatusError{StatusCode: http.StatusNotFound}, exitCodeError},
+		{"network", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, exitCodeNetwork},
+	}
+	for _, tt := range tests {
+		if got := newInvocation().exitCode(tt.err); got != tt.want {
+			t.Errorf("%s: exitCode(%v) = %d, want %d", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	tests := []struct {
+		name         string
+		fault        *nichegittest.Fault
+		args         []string
+		wantCode     nichegit.ErrorCode
+		wantExitCode int
+	}{
+		{
+			name:         "ref CAS failure",
+			args:         []string{"--ref-update", "refs/heads/main:" + base.String() + ":" + base.String()},
+			wantCode:     nichegit.ErrorCodeRefCASFailed,
+			wantExitCode: exitCodePreconditionFailed,
+		},
+		{
+			name:         "broken lease",
+			args:         []string{"--with-lease", "--ref-update", "refs/heads/main:" + base.String() + ":" + base.String()},
+			wantCode:     nichegit.ErrorCodeLeaseBroken,
+			wantExitCode: exitCodePreconditionFailed,
+		},
+		{
+			name:         "unauthorized",
+			fault:        &nichegittest.Fault{StatusCode: http.StatusUnauthorized},
+			args:         []string{"--ref-update", "refs/heads/main:" + base.String() + ":" + head.String()},
+			wantCode:     nichegit.ErrorCodeAuthFailed,
+			wantExitCode: exitCodeAuth,
+		},
+		{
+			name:         "server error",
+			fault:        &nichegittest.Fault{Match: nichegittest.MatchReceivePack, StatusCode: http.StatusServiceUnavailable},
+			args:         []string{"--ref-update", "refs/heads/main:" + base.String() + ":" + head.String()},
+			wantCode:     nichegit.ErrorCodeNetwork,
+			wantExitCode: exitCodeNetwork,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.ClearFaults()
+			if tt.fault != nil {
+				server.InjectFault(*tt.fault)
+			}
+			got := runPipeCommand(&pipeCommand{Command: "update-refs", Args: append([]string{"--repo-url", server.RepoURL()}, tt.args...)}, commandAuthz{})
+			if got.ExitCode != tt.wantExitCode || got.ErrorCode != tt.wantCode {
+				t.Errorf("exit code %d and error code %q, want %d and %q: %s", got.ExitCode, got.ErrorCode, tt.wantExitCode, tt.wantCode, got.Error)
+			}
+			var out struct {
+				Error     string             `json:"error"`
+				ErrorCode nichegit.ErrorCode `json:"errorCode"`
+			}
+			if err := json.Unmarshal(got.Output, &out); err != nil {
+				t.Fatal(err)
+			}
+			if out.ErrorCode != tt.wantCode || out.Error == "" {
+				t.Errorf("the output has the error code %q, want %q: %s", out.ErrorCode, tt.wantCode, got.Output)
+			}
+		})
+	}
+	if got := runPipeCommand(&pipeCommand{Command: "ls-refs", Args: []string{"--repo-url", server.RepoURL()}}, commandAuthz{}); got.ErrorCode != "" {
+		t.Errorf("the error code of a success is %q, want none", got.ErrorCode)
+	}
+}
+
+func TestConflictExitCode(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "base\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature := repo.CommitFile("a.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("a.txt", "main\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	args := []string{
+		"--repo-url", server.RepoURL(),
+		"--cherry-pick-from", feature.String(),
+		"--cherry-pick-to", main.String(),
+		"--cherry-pick-base", base.String(),
+		"--commit-message", "Squashed",
+		"--author", "niche-git", "--author-email", "niche-git@example.com",
+		"--committer", "niche-git", "--committer-email", "niche-git@example.com",
+		"--ref", "refs/heads/squashed",
+		"--current-ref-hash", plumbing.ZeroHash.String(),
+	}
+	got := runPipeCommand(&pipeCommand{Command: "squash-cherry-pick", Args: append(args, "--abort-on-conflict")}, commandAuthz{})
+	if got.ExitCode != exitCodeConflict || got.ErrorCode != nichegit.ErrorCodeConflict {
+		t.Errorf("exit code %d and error code %q, want %d and %q: %s", got.ExitCode, got.ErrorCode, exitCodeConflict, nichegit.ErrorCodeConflict, got.Error)
+	}
+	if hash := repo.RemoteRefHash("refs/heads/squashed"); hash != plumbing.ZeroHash {
+		t.Errorf("refs/heads/squashed is pushed as %s", hash)
+	}
+
+	// Without --abort-on-conflict, the commit with the conflict is pushed and it succeeds.
+	pushed := runPipeCommand(&pipeCommand{Command: "squash-cherry-pick", Args: args}, commandAuthz{})
+	if pushed.ExitCode != exitCodeOK {
+		t.Errorf("exit code %d, want %d: %s", pushed.ExitCode, exitCodeOK, pushed.Error)
+	}
+
+	// With --fail-on-conflict, it fails after the push and the output still has the commit.
+	args[len(args)-1] = repo.RemoteRefHash("refs/heads/squashed").String()
+	got = runPipeCommand(&pipeCommand{Command: "squash-cherry-pick", Args: append(args, "--fail-on-conflict")}, commandAuthz{})
+	if got.ExitCode != exitCodeConflict || got.ErrorCode != nichegit.ErrorCodeConflict {
+		t.Errorf("exit code %d and error code %q, want %d and %q: %s", got.ExitCode, got.ErrorCode, exitCodeConflict, nichegit.ErrorCodeConflict, got.Error)
+	}
+	var out struct {
+		CommitHash        string   `json:"commitHash"`
+		ConflictOpenFiles []string `json:"conflictOpenFiles"`
+	}
+	if err := json.Unmarshal(got.Output, &out); err != nil {
+		t.Fatal(err)
+	}
+	if hash := repo.RemoteRefHash("refs/heads/squashed"); out.CommitHash == "" || hash.String() != out.CommitHash {
+		t.Errorf("refs/heads/squashed is %s, want the commit %q in the output", hash, out.CommitHash)
+	}
+	if len(out.ConflictOpenFiles) != 1 || out.ConflictOpenFiles[0] != "a.txt" {
+		t.Errorf("conflictOpenFiles is %q, want a.txt", out.ConflictOpenFiles)
+	}
+}
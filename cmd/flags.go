@@ -0,0 +1,70 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/spf13/cobra"
+)
+
+// The flags shared by the commands are registered with these helpers. They are bound to the
+// shared variables in common.go, which pipe and serve reset between the commands.
+
+// addOperationFlags adds the flags of the authorization, the debug info, the time limit, the
+// retries, and the recording of the exchanges. Every command that reaches a server has them.
+func (inv *invocation) addOperationFlags(c *cobra.Command) {
+	c.Flags().StringVar(&inv.authzHeader, "authz-header", "", "Optional authorization header")
+	c.Flags().StringVar(&inv.basicAuthzUser, "basic-authz-user", "", "Optional HTTP Basic Auth user")
+	c.Flags().StringVar(&inv.basicAuthzPassword, "basic-authz-password", "", "Optional HTTP Basic Auth password")
+
+	c.Flags().StringVar(&inv.debugLevel, "debug-level", string(debug.LevelBasic), "Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)")
+	c.Flags().DurationVar(&inv.operationTimeout, "timeout", 0, "Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit")
+	c.Flags().IntVar(&inv.maxRetries, "max-retries", 0, "Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying")
+	c.Flags().StringVar(&inv.recordDir, "record-dir", "", "Optional directory to record the HTTP requests and responses of the operation into, without the authorization headers")
+	c.Flags().StringVar(&inv.replayDir, "replay-dir", "", "Optional directory to replay the responses recorded with --record-dir from, instead of reaching the server")
+}
+
+// addFetchFlags adds the flags of how the packfiles are fetched.
+func (inv *invocation) addFetchFlags(c *cobra.Command) {
+	c.Flags().Int64Var(&inv.packfileSpoolThreshold, "packfile-spool-threshold", 0, "Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory")
+	c.Flags().BoolVar(&inv.fetchProgress, "progress", false, "Print the progress of the fetches, including the progress messages of the server, to stderr")
+	c.Flags().StringSliceVar(&inv.packfileURIProtocols, "packfile-uri-protocols", nil, "Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris")
+}
+
+// addObjectStorageFlag adds --object-storage-dir for the commands that parse the fetched objects.
+func (inv *invocation) addObjectStorageFlag(c *cobra.Command) {
+	c.Flags().StringVar(&inv.objectStorageDir, "object-storage-dir", "", "Optional directory to keep the fetched and created objects in instead of memory, for the repositories whose objects don't fit in memory. The objects are in a temporary directory under it, which is removed when the command finishes")
+}
+
+// addPushTimeoutFlags adds the time limits of the push attempts.
+func (inv *invocation) addPushTimeoutFlags(c *cobra.Command) {
+	c.Flags().DurationVar(&inv.pushTimeout, "push-timeout", 0, "Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit")
+	c.Flags().DurationVar(&inv.refAdvTimeout, "ref-adv-timeout", 0, "Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout")
+}
+
+// addPackFlags adds the flags of how the pushed packfile is encoded.
+func (inv *invocation) addPackFlags(c *cobra.Command) {
+	c.Flags().BoolVar(&inv.packDeltaCompression, "delta-compression", false, "Encode the pushed trees and blobs as deltas to the ones in the parent commit when that is smaller")
+	c.Flags().IntVar(&inv.packCompressionLevel, "compression-level", 0, "Optional zlib compression level of the pushed packfile, from 1 (fastest) to 9 (smallest). Zero, which is the default, means the zlib default")
+}
+
+// addSigningFlags adds the flags of the key to sign the created commits with. commits is how the
+// help refers to them, e.g. "commit" for the commands that create one.
+func (inv *invocation) addSigningFlags(c *cobra.Command, commits string) {
+	c.Flags().StringVar(&inv.signingKeyFile, "signing-key-file", "", "Optional private key file to sign the created "+commits+" with")
+	c.Flags().StringVar(&inv.signingKeyFormat, "signing-key-format", string(signing.FormatOpenPGP), "Format of the signing key: openpgp (armored private key) or ssh (OpenSSH private key)")
+	c.Flags().StringVar(&inv.signingKeyPassphrase, "signing-key-passphrase", "", "Optional passphrase of the signing key")
+}
+
+// addProtectedPathFlags adds the guard against modifying the protected paths.
+func (inv *invocation) addProtectedPathFlags(c *cobra.Command) {
+	c.Flags().StringSliceVar(&inv.protectedPaths, "protected-paths", nil, "Optional path patterns (e.g. .github/workflows/**) that must not be modified. The operation aborts if the change touches them")
+	c.Flags().BoolVar(&inv.allowProtectedPathChanges, "allow-protected-path-changes", false, "Allow modifying the paths specified by --protected-paths")
+}
+
+// addOutputFormatFlag adds --output-format for the commands without the plain output.
+func (inv *invocation) addOutputFormatFlag(c *cobra.Command) {
+	c.Flags().StringVar(&inv.outputFormat, "output-format", outputFormatJSON, "Output format: json, or ndjson to write each element of the lists as a line and then the other fields as the last line")
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"os"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/spf13/cobra"
+)
+
+// newGenerateChangelogCmd returns the generate-changelog command.
+func newGenerateChangelogCmd(inv *invocation) *cobra.Command {
+	var generateChangelogArgs struct {
+		repoURL      string
+		fromRef      string
+		toRef        string
+		templateFile string
+
+		outputFile string
+	}
+
+	generateChangelogCmd := &cobra.Command{
+		Use: "generate-changelog",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var tmpl string
+			if generateChangelogArgs.templateFile != "" {
+				bs, err := os.ReadFile(generateChangelogArgs.templateFile)
+				if err != nil {
+					return err
+				}
+				tmpl = string(bs)
+			}
+			client := inv.newHTTPClient()
+			changelog, debugInfo, fetchErr := nichegit.GenerateChangelog(generateChangelogArgs.repoURL, client, nichegit.GenerateChangelogArgs{
+				FromRef:  generateChangelogArgs.fromRef,
+				ToRef:    generateChangelogArgs.toRef,
+				Template: tmpl,
+			})
+			output := generateChangelogOutput{
+				Changelog: changelog,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(generateChangelogArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	generateChangelogCmd.Flags().StringVar(&generateChangelogArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	generateChangelogCmd.Flags().StringVar(&generateChangelogArgs.fromRef, "from-ref", "", "Optional start of the range, exclusive. A commit hash, a ref name, or a branch or tag name. Defaults to the root commits")
+	generateChangelogCmd.Flags().StringVar(&generateChangelogArgs.toRef, "to-ref", "", "End of the range, inclusive. A commit hash, a ref name, or a branch or tag name")
+	generateChangelogCmd.Flags().StringVar(&generateChangelogArgs.templateFile, "template-file", "", "Optional Go text/template file to render the changelog with")
+	_ = generateChangelogCmd.MarkFlagRequired("repo-url")
+	_ = generateChangelogCmd.MarkFlagRequired("to-ref")
+
+	inv.addOperationFlags(generateChangelogCmd)
+
+	inv.addFetchFlags(generateChangelogCmd)
+	inv.addObjectStorageFlag(generateChangelogCmd)
+	generateChangelogCmd.Flags().StringVar(&generateChangelogArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(generateChangelogCmd)
+	return generateChangelogCmd
+}
+
+type generateChangelogOutput struct {
+	Changelog *nichegit.Changelog   `json:"changelog"`
+	DebugInfo *debug.FetchDebugInfo `json:"debugInfo,omitempty"`
+	Error     string                `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
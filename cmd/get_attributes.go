@@ -0,0 +1,74 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newGetAttributesCmd returns the get-attributes command.
+func newGetAttributesCmd(inv *invocation) *cobra.Command {
+	var getAttributesArgs struct {
+		repoURL    string
+		commitHash string
+		paths      []string
+		attributes []string
+
+		outputFile string
+	}
+
+	getAttributesCmd := &cobra.Command{
+		Use: "get-attributes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			paths, debugInfo, fetchErr := nichegit.GetAttributes(getAttributesArgs.repoURL, client, nichegit.GetAttributesArgs{
+				CommitHash: plumbing.NewHash(getAttributesArgs.commitHash),
+				Paths:      getAttributesArgs.paths,
+				Attributes: getAttributesArgs.attributes,
+			})
+			if paths == nil {
+				// Always create an empty slice for JSON output.
+				paths = []*nichegit.PathAttributes{}
+			}
+			output := getAttributesOutput{
+				Paths:     paths,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(getAttributesArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	getAttributesCmd.Flags().StringVar(&getAttributesArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	getAttributesCmd.Flags().StringVar(&getAttributesArgs.commitHash, "commit-hash", "", "Commit hash to read the .gitattributes files from")
+	getAttributesCmd.Flags().StringSliceVar(&getAttributesArgs.paths, "paths", nil, "Paths to look up")
+	getAttributesCmd.Flags().StringSliceVar(&getAttributesArgs.attributes, "attributes", nil, "Optional attribute names to look up. Defaults to all the attributes specified for the paths")
+	_ = getAttributesCmd.MarkFlagRequired("repo-url")
+	_ = getAttributesCmd.MarkFlagRequired("commit-hash")
+	_ = getAttributesCmd.MarkFlagRequired("paths")
+
+	inv.addOperationFlags(getAttributesCmd)
+
+	inv.addFetchFlags(getAttributesCmd)
+	inv.addObjectStorageFlag(getAttributesCmd)
+	getAttributesCmd.Flags().StringVar(&getAttributesArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(getAttributesCmd)
+	return getAttributesCmd
+}
+
+type getAttributesOutput struct {
+	Paths     []*nichegit.PathAttributes `json:"paths"`
+	DebugInfo *debug.FetchDebugInfo      `json:"debugInfo,omitempty"`
+	Error     string                     `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode         `json:"errorCode,omitempty"`
+}
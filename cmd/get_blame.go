@@ -0,0 +1,77 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newGetBlameCmd returns the get-blame command.
+func newGetBlameCmd(inv *invocation) *cobra.Command {
+	var getBlameArgs struct {
+		repoURL      string
+		commitHash   string
+		path         string
+		maxCommits   int
+		applyMailmap bool
+
+		outputFile string
+	}
+
+	getBlameCmd := &cobra.Command{
+		Use: "get-blame",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			lines, debugInfo, fetchErr := nichegit.GetBlame(getBlameArgs.repoURL, client, nichegit.GetBlameArgs{
+				CommitHash:   plumbing.NewHash(getBlameArgs.commitHash),
+				Path:         getBlameArgs.path,
+				MaxCommits:   getBlameArgs.maxCommits,
+				ApplyMailmap: getBlameArgs.applyMailmap,
+			})
+			if lines == nil {
+				// Always create an empty slice for JSON output.
+				lines = []*nichegit.BlameLine{}
+			}
+			output := getBlameOutput{
+				Lines:     lines,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(getBlameArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	getBlameCmd.Flags().StringVar(&getBlameArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	getBlameCmd.Flags().StringVar(&getBlameArgs.commitHash, "commit-hash", "", "Commit hash to blame the file at")
+	getBlameCmd.Flags().StringVar(&getBlameArgs.path, "path", "", "Path of the file")
+	getBlameCmd.Flags().IntVar(&getBlameArgs.maxCommits, "max-commits", nichegit.DefaultBlameMaxCommits, "Number of the commits in the history to look into. The older lines are marked as boundary")
+	getBlameCmd.Flags().BoolVar(&getBlameArgs.applyMailmap, "apply-mailmap", false, "Report the canonical authors with the .mailmap file of the commit")
+	_ = getBlameCmd.MarkFlagRequired("repo-url")
+	_ = getBlameCmd.MarkFlagRequired("commit-hash")
+	_ = getBlameCmd.MarkFlagRequired("path")
+
+	inv.addOperationFlags(getBlameCmd)
+
+	inv.addFetchFlags(getBlameCmd)
+	inv.addObjectStorageFlag(getBlameCmd)
+	getBlameCmd.Flags().StringVar(&getBlameArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(getBlameCmd)
+	return getBlameCmd
+}
+
+type getBlameOutput struct {
+	Lines     []*nichegit.BlameLine `json:"lines"`
+	DebugInfo *debug.FetchDebugInfo `json:"debugInfo,omitempty"`
+	Error     string                `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newGetCommitGraphCmd returns the get-commit-graph command.
+func newGetCommitGraphCmd(inv *invocation) *cobra.Command {
+	var getCommitGraphArgs struct {
+		repoURL          string
+		wantCommitHashes []string
+		haveCommitHashes []string
+		maxCount         int
+
+		outputFile string
+	}
+
+	getCommitGraphCmd := &cobra.Command{
+		Use: "get-commit-graph",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var wantCommitHashes []plumbing.Hash
+			for _, s := range getCommitGraphArgs.wantCommitHashes {
+				wantCommitHashes = append(wantCommitHashes, plumbing.NewHash(s))
+			}
+			var haveCommitHashes []plumbing.Hash
+			for _, s := range getCommitGraphArgs.haveCommitHashes {
+				haveCommitHashes = append(haveCommitHashes, plumbing.NewHash(s))
+			}
+			linker, err := inv.newWebLinker(getCommitGraphArgs.repoURL)
+			if err != nil {
+				return err
+			}
+			client := inv.newHTTPClient()
+			commits, debugInfo, fetchErr := nichegit.GetCommitGraph(getCommitGraphArgs.repoURL, client, nichegit.GetCommitGraphArgs{
+				WantCommitHashes: wantCommitHashes,
+				HaveCommitHashes: haveCommitHashes,
+				MaxCount:         getCommitGraphArgs.maxCount,
+			})
+			if commits == nil {
+				// Always create an empty slice for JSON output.
+				commits = []*nichegit.CommitGraphNode{}
+			}
+			for _, commit := range commits {
+				commit.WebURL = linker.Commit(commit.Hash)
+			}
+			output := getCommitGraphOutput{
+				Commits:   commits,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(getCommitGraphArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	getCommitGraphCmd.Flags().StringVar(&getCommitGraphArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	getCommitGraphCmd.Flags().StringSliceVar(&getCommitGraphArgs.wantCommitHashes, "want-commit-hashes", nil, "Commit hashes of the tips of the graph")
+	getCommitGraphCmd.Flags().StringSliceVar(&getCommitGraphArgs.haveCommitHashes, "have-commit-hashes", nil, "Optional commit hashes whose history is excluded from the graph, like git log ^<hash>")
+	getCommitGraphCmd.Flags().IntVar(&getCommitGraphArgs.maxCount, "max-count", 0, "Optional maximum number of the commits in the graph. The newest commits by the committer time are kept")
+	_ = getCommitGraphCmd.MarkFlagRequired("repo-url")
+	_ = getCommitGraphCmd.MarkFlagRequired("want-commit-hashes")
+
+	inv.addOperationFlags(getCommitGraphCmd)
+
+	inv.addFetchFlags(getCommitGraphCmd)
+	inv.addObjectStorageFlag(getCommitGraphCmd)
+	getCommitGraphCmd.Flags().StringVar(&inv.webLinkProvider, "web-links", "", "Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket")
+	getCommitGraphCmd.Flags().StringArrayVar(&inv.webLinkTemplates, "web-link-template", nil, "Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders")
+	getCommitGraphCmd.Flags().StringVar(&inv.webLinkRepoURL, "web-link-repo-url", "", "Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix")
+	getCommitGraphCmd.Flags().StringVar(&getCommitGraphArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(getCommitGraphCmd)
+	return getCommitGraphCmd
+}
+
+type getCommitGraphOutput struct {
+	Commits   []*nichegit.CommitGraphNode `json:"commits"`
+	DebugInfo *debug.FetchDebugInfo       `json:"debugInfo,omitempty"`
+	Error     string                      `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode          `json:"errorCode,omitempty"`
+}
@@ -4,71 +4,118 @@
 package cmd
 
 import (
-	"net/http"
-
 	nichegit "github.com/aviator-co/niche-git"
 	"github.com/aviator-co/niche-git/debug"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/spf13/cobra"
 )
 
-var (
-	getCommitsArgs struct {
-		repoURL          string
-		wantCommitHashes []string
-		haveCommitHashes []string
+// newGetCommitsCmd returns the get-commits command.
+func newGetCommitsCmd(inv *invocation) *cobra.Command {
+	var getCommitsArgs struct {
+		repoURL           string
+		wantCommitHashes  []string
+		haveCommitHashes  []string
+		applyReplaceRefs  bool
+		resumeDir         string
+		onlyNewCommits    bool
+		mailmapCommitHash string
+		maxCount          int
+		since             string
+		until             string
+		stopAtHashes      []string
 
 		outputFile string
 	}
-)
-
-var getCommitsCmd = &cobra.Command{
-	Use: "get-commits",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		var wantCommitHashes []plumbing.Hash
-		for _, s := range getCommitsArgs.wantCommitHashes {
-			wantCommitHashes = append(wantCommitHashes, plumbing.NewHash(s))
-		}
-		var haveCommitHashes []plumbing.Hash
-		for _, s := range getCommitsArgs.haveCommitHashes {
-			haveCommitHashes = append(haveCommitHashes, plumbing.NewHash(s))
-		}
-		client := &http.Client{Transport: &authnRoundtripper{}}
-		commits, debugInfo, fetchErr := nichegit.FetchCommits(getCommitsArgs.repoURL, client, wantCommitHashes, haveCommitHashes)
-		if commits == nil {
-			// Always create an empty slice for JSON output.
-			commits = []*nichegit.CommitInfo{}
-		}
-		output := getCommitsOutput{
-			Commits:   commits,
-			DebugInfo: debugInfo,
-		}
-		if fetchErr != nil {
-			output.Error = fetchErr.Error()
-		}
-		if err := writeJSON(getCommitsArgs.outputFile, output); err != nil {
-			return err
-		}
-		return fetchErr
-	},
-}
 
-type getCommitsOutput struct {
-	Commits   []*nichegit.CommitInfo `json:"commits"`
-	DebugInfo debug.FetchDebugInfo   `json:"debugInfo"`
-	Error     string                 `json:"error,omitempty"`
-}
+	getCommitsCmd := &cobra.Command{
+		Use: "get-commits",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var wantCommitHashes []plumbing.Hash
+			for _, s := range getCommitsArgs.wantCommitHashes {
+				wantCommitHashes = append(wantCommitHashes, plumbing.NewHash(s))
+			}
+			var haveCommitHashes []plumbing.Hash
+			for _, s := range getCommitsArgs.haveCommitHashes {
+				haveCommitHashes = append(haveCommitHashes, plumbing.NewHash(s))
+			}
+			var stopAtHashes []plumbing.Hash
+			for _, s := range getCommitsArgs.stopAtHashes {
+				stopAtHashes = append(stopAtHashes, plumbing.NewHash(s))
+			}
+			since, err := parseOptionalTime(getCommitsArgs.since)
+			if err != nil {
+				return err
+			}
+			until, err := parseOptionalTime(getCommitsArgs.until)
+			if err != nil {
+				return err
+			}
+			linker, err := inv.newWebLinker(getCommitsArgs.repoURL)
+			if err != nil {
+				return err
+			}
+			client := inv.newHTTPClient()
+			commits, debugInfo, fetchErr := nichegit.FetchCommits(getCommitsArgs.repoURL, client, nichegit.FetchCommitsArgs{
+				WantCommitHashes:  wantCommitHashes,
+				HaveCommitHashes:  haveCommitHashes,
+				ApplyReplaceRefs:  getCommitsArgs.applyReplaceRefs,
+				ResumeDir:         getCommitsArgs.resumeDir,
+				OnlyNewCommits:    getCommitsArgs.onlyNewCommits,
+				MailmapCommitHash: plumbing.NewHash(getCommitsArgs.mailmapCommitHash),
+				MaxCount:          getCommitsArgs.maxCount,
+				Since:             since,
+				Until:             until,
+				StopAtHashes:      stopAtHashes,
+			})
+			if commits == nil {
+				// Always create an empty slice for JSON output.
+				commits = []*nichegit.CommitInfo{}
+			}
+			setCommitWebURLs(linker, commits)
+			output := getCommitsOutput{
+				Commits:   commits,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(getCommitsArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
 
-func init() {
-	rootCmd.AddCommand(getCommitsCmd)
 	getCommitsCmd.Flags().StringVar(&getCommitsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
 	getCommitsCmd.Flags().StringSliceVar(&getCommitsArgs.wantCommitHashes, "want-commit-hashes", nil, "Want commit hashes")
 	getCommitsCmd.Flags().StringSliceVar(&getCommitsArgs.haveCommitHashes, "have-commit-hashes", nil, "Have commit hashes")
+	getCommitsCmd.Flags().BoolVar(&getCommitsArgs.applyReplaceRefs, "apply-replace-refs", false, "Honor refs/replace/* on the remote when reporting the commits")
+	getCommitsCmd.Flags().StringVar(&getCommitsArgs.resumeDir, "resume-dir", "", "Optional directory to save the received data in. Rerunning with the same arguments after a failure resumes the fetch")
+	getCommitsCmd.Flags().BoolVar(&getCommitsArgs.onlyNewCommits, "only-new-commits", false, "Negotiate the have commits with the server and return only the commits not reachable from them. Fails if the server doesn't have some of the have commits")
+	getCommitsCmd.Flags().StringVar(&getCommitsArgs.mailmapCommitHash, "mailmap-commit-hash", "", "Optional commit hash whose .mailmap file makes the authors and the committers canonical")
+	getCommitsCmd.Flags().IntVar(&getCommitsArgs.maxCount, "max-count", 0, "Optional maximum number of the commits to return. The history is walked newest first and fetched only as deep as needed")
+	getCommitsCmd.Flags().StringVar(&getCommitsArgs.since, "since", "", "Optional committer time in RFC3339 format (e.g. 2024-01-01T00:00:00Z) to stop the walk at the older commits")
+	getCommitsCmd.Flags().StringVar(&getCommitsArgs.until, "until", "", "Optional committer time in RFC3339 format (e.g. 2024-01-01T00:00:00Z) to skip the newer commits")
+	getCommitsCmd.Flags().StringSliceVar(&getCommitsArgs.stopAtHashes, "stop-at-hashes", nil, "Optional commit hashes whose history is excluded, like git log ^<hash>")
 	_ = getCommitsCmd.MarkFlagRequired("repo-url")
 
-	getCommitsCmd.Flags().StringVar(&authzHeader, "authz-header", "", "Optional authorization header")
-	getCommitsCmd.Flags().StringVar(&basicAuthzUser, "basic-authz-user", "", "Optional HTTP Basic Auth user")
-	getCommitsCmd.Flags().StringVar(&basicAuthzPassword, "basic-authz-password", "", "Optional HTTP Basic Auth password")
+	inv.addOperationFlags(getCommitsCmd)
 
+	inv.addFetchFlags(getCommitsCmd)
+	inv.addObjectStorageFlag(getCommitsCmd)
+	getCommitsCmd.Flags().StringVar(&inv.webLinkProvider, "web-links", "", "Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket")
+	getCommitsCmd.Flags().StringArrayVar(&inv.webLinkTemplates, "web-link-template", nil, "Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders")
+	getCommitsCmd.Flags().StringVar(&inv.webLinkRepoURL, "web-link-repo-url", "", "Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix")
 	getCommitsCmd.Flags().StringVar(&getCommitsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(getCommitsCmd)
+	return getCommitsCmd
+}
+
+type getCommitsOutput struct {
+	Commits   []*nichegit.CommitInfo `json:"commits"`
+	DebugInfo *debug.FetchDebugInfo  `json:"debugInfo,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode     `json:"errorCode,omitempty"`
 }
@@ -0,0 +1,92 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newGetFileHistoryCmd returns the get-file-history command.
+func newGetFileHistoryCmd(inv *invocation) *cobra.Command {
+	var getFileHistoryArgs struct {
+		repoURL    string
+		commitHash string
+		paths      []string
+		pageSize   int
+		maxCommits int
+		cursor     string
+
+		outputFile string
+	}
+
+	getFileHistoryCmd := &cobra.Command{
+		Use: "get-file-history",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			linker, err := inv.newWebLinker(getFileHistoryArgs.repoURL)
+			if err != nil {
+				return err
+			}
+			client := inv.newHTTPClient()
+			result, debugInfo, fetchErr := nichegit.GetFileHistory(getFileHistoryArgs.repoURL, client, nichegit.GetFileHistoryArgs{
+				CommitHash: plumbing.NewHash(getFileHistoryArgs.commitHash),
+				Paths:      getFileHistoryArgs.paths,
+				PageSize:   getFileHistoryArgs.pageSize,
+				MaxCommits: getFileHistoryArgs.maxCommits,
+				Cursor:     getFileHistoryArgs.cursor,
+			})
+			output := getFileHistoryOutput{
+				// Always create an empty slice for JSON output.
+				Commits:   []*nichegit.CommitInfo{},
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				if result.Commits != nil {
+					output.Commits = result.Commits
+				}
+				setCommitWebURLs(linker, output.Commits)
+				output.NextCursor = result.NextCursor
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(getFileHistoryArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	getFileHistoryCmd.Flags().StringVar(&getFileHistoryArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	getFileHistoryCmd.Flags().StringVar(&getFileHistoryArgs.commitHash, "commit-hash", "", "Commit hash to take the history of")
+	getFileHistoryCmd.Flags().StringSliceVar(&getFileHistoryArgs.paths, "paths", nil, "Files or directories to list the modifying commits of")
+	getFileHistoryCmd.Flags().IntVar(&getFileHistoryArgs.pageSize, "page-size", nichegit.DefaultFileHistoryPageSize, "Maximum number of the commits to return")
+	getFileHistoryCmd.Flags().IntVar(&getFileHistoryArgs.maxCommits, "max-commits", nichegit.DefaultFileHistoryMaxCommits, "Maximum number of the commits to look into for the page")
+	getFileHistoryCmd.Flags().StringVar(&getFileHistoryArgs.cursor, "cursor", "", "Optional nextCursor of the previous output to get the next page")
+	_ = getFileHistoryCmd.MarkFlagRequired("repo-url")
+	_ = getFileHistoryCmd.MarkFlagRequired("commit-hash")
+	_ = getFileHistoryCmd.MarkFlagRequired("paths")
+
+	inv.addOperationFlags(getFileHistoryCmd)
+
+	inv.addFetchFlags(getFileHistoryCmd)
+	inv.addObjectStorageFlag(getFileHistoryCmd)
+	getFileHistoryCmd.Flags().StringVar(&inv.webLinkProvider, "web-links", "", "Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket")
+	getFileHistoryCmd.Flags().StringArrayVar(&inv.webLinkTemplates, "web-link-template", nil, "Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders")
+	getFileHistoryCmd.Flags().StringVar(&inv.webLinkRepoURL, "web-link-repo-url", "", "Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix")
+	getFileHistoryCmd.Flags().StringVar(&getFileHistoryArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(getFileHistoryCmd)
+	return getFileHistoryCmd
+}
+
+type getFileHistoryOutput struct {
+	Commits    []*nichegit.CommitInfo `json:"commits"`
+	NextCursor string                 `json:"nextCursor,omitempty"`
+	DebugInfo  *debug.FetchDebugInfo  `json:"debugInfo,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+	ErrorCode  nichegit.ErrorCode     `json:"errorCode,omitempty"`
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newGetFileOwnersCmd returns the get-file-owners command.
+func newGetFileOwnersCmd(inv *invocation) *cobra.Command {
+	var getFileOwnersArgs struct {
+		repoURL        string
+		baseCommitHash string
+		headCommitHash string
+		maxCommits     int
+		applyMailmap   bool
+
+		outputFile string
+	}
+
+	getFileOwnersCmd := &cobra.Command{
+		Use: "get-file-owners",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			files, debugInfo, fetchErr := nichegit.FetchFileOwners(getFileOwnersArgs.repoURL, client, nichegit.FetchFileOwnersArgs{
+				BaseCommitHash: plumbing.NewHash(getFileOwnersArgs.baseCommitHash),
+				HeadCommitHash: plumbing.NewHash(getFileOwnersArgs.headCommitHash),
+				MaxCommits:     getFileOwnersArgs.maxCommits,
+				ApplyMailmap:   getFileOwnersArgs.applyMailmap,
+			})
+			if files == nil {
+				// Always create an empty slice for JSON output.
+				files = []*nichegit.FileOwners{}
+			}
+			output := getFileOwnersOutput{
+				Files:     files,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(getFileOwnersArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	getFileOwnersCmd.Flags().StringVar(&getFileOwnersArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	getFileOwnersCmd.Flags().StringVar(&getFileOwnersArgs.baseCommitHash, "base-commit-hash", "", "Commit hash that the change is made on. The owners are taken from its history")
+	getFileOwnersCmd.Flags().StringVar(&getFileOwnersArgs.headCommitHash, "head-commit-hash", "", "Commit hash with the change")
+	getFileOwnersCmd.Flags().IntVar(&getFileOwnersArgs.maxCommits, "max-commits", nichegit.DefaultFileOwnersMaxCommits, "Number of the commits in the history of the base commit to look into")
+	getFileOwnersCmd.Flags().BoolVar(&getFileOwnersArgs.applyMailmap, "apply-mailmap", false, "Count the commits of the aliases for the canonical authors with the .mailmap file of the base commit")
+	_ = getFileOwnersCmd.MarkFlagRequired("repo-url")
+	_ = getFileOwnersCmd.MarkFlagRequired("base-commit-hash")
+	_ = getFileOwnersCmd.MarkFlagRequired("head-commit-hash")
+
+	inv.addOperationFlags(getFileOwnersCmd)
+
+	inv.addFetchFlags(getFileOwnersCmd)
+	inv.addObjectStorageFlag(getFileOwnersCmd)
+	getFileOwnersCmd.Flags().StringVar(&getFileOwnersArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(getFileOwnersCmd)
+	return getFileOwnersCmd
+}
+
+type getFileOwnersOutput struct {
+	Files     []*nichegit.FileOwners `json:"files"`
+	DebugInfo *debug.FetchDebugInfo  `json:"debugInfo,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode     `json:"errorCode,omitempty"`
+}
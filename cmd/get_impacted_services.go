@@ -0,0 +1,90 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newGetImpactedServicesCmd returns the get-impacted-services command.
+func newGetImpactedServicesCmd(inv *invocation) *cobra.Command {
+	var getImpactedServicesArgs struct {
+		repoURL      string
+		commitHash1  string
+		commitHash2  string
+		manifestFile string
+
+		outputFile string
+	}
+
+	getImpactedServicesCmd := &cobra.Command{
+		Use: "get-impacted-services",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bs, err := os.ReadFile(getImpactedServicesArgs.manifestFile)
+			if err != nil {
+				return err
+			}
+			var services []nichegit.ServicePatterns
+			if err := json.Unmarshal(bs, &services); err != nil {
+				return fmt.Errorf("cannot parse the manifest file: %w", err)
+			}
+
+			client := inv.newHTTPClient()
+			result, debugInfo, fetchErr := nichegit.FetchImpactedServices(getImpactedServicesArgs.repoURL, client, nichegit.FetchImpactedServicesArgs{
+				CommitHash1: plumbing.NewHash(getImpactedServicesArgs.commitHash1),
+				CommitHash2: plumbing.NewHash(getImpactedServicesArgs.commitHash2),
+				Services:    services,
+			})
+			output := getImpactedServicesOutput{
+				Services:       []*nichegit.ImpactedService{},
+				UnmatchedFiles: []string{},
+				DebugInfo:      debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.Services = result.Services
+				output.UnmatchedFiles = result.UnmatchedFiles
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(getImpactedServicesArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	getImpactedServicesCmd.Flags().StringVar(&getImpactedServicesArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	getImpactedServicesCmd.Flags().StringVar(&getImpactedServicesArgs.commitHash1, "commit-hash1", "", "First commit hash")
+	getImpactedServicesCmd.Flags().StringVar(&getImpactedServicesArgs.commitHash2, "commit-hash2", "", "Second commit hash")
+	getImpactedServicesCmd.Flags().StringVar(&getImpactedServicesArgs.manifestFile, "manifest-file", "", `JSON file of the services, e.g. [{"name": "api", "patterns": ["services/api/**"]}]`)
+	_ = getImpactedServicesCmd.MarkFlagRequired("repo-url")
+	_ = getImpactedServicesCmd.MarkFlagRequired("commit-hash1")
+	_ = getImpactedServicesCmd.MarkFlagRequired("commit-hash2")
+	_ = getImpactedServicesCmd.MarkFlagRequired("manifest-file")
+
+	inv.addOperationFlags(getImpactedServicesCmd)
+
+	inv.addFetchFlags(getImpactedServicesCmd)
+	inv.addObjectStorageFlag(getImpactedServicesCmd)
+	getImpactedServicesCmd.Flags().StringVar(&getImpactedServicesArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(getImpactedServicesCmd)
+	return getImpactedServicesCmd
+}
+
+type getImpactedServicesOutput struct {
+	Services       []*nichegit.ImpactedService `json:"services"`
+	UnmatchedFiles []string                    `json:"unmatchedFiles"`
+	DebugInfo      *debug.FetchDebugInfo       `json:"debugInfo,omitempty"`
+	Error          string                      `json:"error,omitempty"`
+	ErrorCode      nichegit.ErrorCode          `json:"errorCode,omitempty"`
+}
@@ -4,7 +4,9 @@
 package cmd
 
 import (
-	"net/http"
+	"fmt"
+	"io"
+	"regexp"
 	"sort"
 
 	nichegit "github.com/aviator-co/niche-git"
@@ -13,63 +15,111 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var (
-	getModifiedFilesArgs struct {
-		repoURL     string
-		commitHash1 string
-		commitHash2 string
+// newGetModifiedFilesCmd returns the get-modified-files command.
+func newGetModifiedFilesCmd(inv *invocation) *cobra.Command {
+	var getModifiedFilesArgs struct {
+		repoURL         string
+		commitHash1     string
+		commitHash2     string
+		renameThreshold int
+		matchPattern    string
+		maxMatches      int
 
 		outputFile string
 	}
-)
-
-var getModifiedFilesCmd = &cobra.Command{
-	Use: "get-modified-files",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		client := &http.Client{Transport: &authnRoundtripper{}}
-		files, debugInfo, fetchErr := nichegit.FetchModifiedFiles(
-			getModifiedFilesArgs.repoURL,
-			client,
-			plumbing.NewHash(getModifiedFilesArgs.commitHash1),
-			plumbing.NewHash(getModifiedFilesArgs.commitHash2),
-		)
-		if files == nil {
-			// Always create an empty slice for JSON output.
-			files = []string{}
-		}
-		output := getModifiedFilesOutput{
-			Files:     files,
-			DebugInfo: debugInfo,
-		}
-		sort.Strings(output.Files)
-		if fetchErr != nil {
-			output.Error = fetchErr.Error()
-		}
-		if err := writeJSON(getModifiedFilesArgs.outputFile, output); err != nil {
-			return err
-		}
-		return fetchErr
-	},
-}
 
-type getModifiedFilesOutput struct {
-	Files     []string             `json:"files"`
-	DebugInfo debug.FetchDebugInfo `json:"debugInfo"`
-	Error     string               `json:"error,omitempty"`
-}
+	getModifiedFilesCmd := &cobra.Command{
+		Use: "get-modified-files",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var matchPattern *regexp.Regexp
+			if getModifiedFilesArgs.matchPattern != "" {
+				var err error
+				if matchPattern, err = regexp.Compile(getModifiedFilesArgs.matchPattern); err != nil {
+					return fmt.Errorf("invalid --match-pattern: %w", err)
+				}
+			}
+			linker, err := inv.newWebLinker(getModifiedFilesArgs.repoURL)
+			if err != nil {
+				return err
+			}
+			client := inv.newHTTPClient()
+			result, debugInfo, fetchErr := nichegit.FetchModifiedFilesWithRenames(
+				getModifiedFilesArgs.repoURL,
+				client,
+				nichegit.FetchModifiedFilesArgs{
+					CommitHash1:     plumbing.NewHash(getModifiedFilesArgs.commitHash1),
+					CommitHash2:     plumbing.NewHash(getModifiedFilesArgs.commitHash2),
+					RenameThreshold: getModifiedFilesArgs.renameThreshold,
+					MatchPattern:    matchPattern,
+					MaxMatches:      getModifiedFilesArgs.maxMatches,
+				},
+			)
+			output := getModifiedFilesOutput{
+				CompareWebURL: linker.Compare(getModifiedFilesArgs.commitHash1, getModifiedFilesArgs.commitHash2),
+				DebugInfo:     debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.Files = result.Files
+				output.Submodules = result.Submodules
+				output.Renames = result.Renames
+				output.Matches = result.Matches
+			}
+			if output.Files == nil {
+				// Always create an empty slice for JSON output.
+				output.Files = []string{}
+			}
+			sort.Strings(output.Files)
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(getModifiedFilesArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
 
-func init() {
-	rootCmd.AddCommand(getModifiedFilesCmd)
 	getModifiedFilesCmd.Flags().StringVar(&getModifiedFilesArgs.repoURL, "repo-url", "", "Git reposiotry URL")
 	getModifiedFilesCmd.Flags().StringVar(&getModifiedFilesArgs.commitHash1, "commit-hash1", "", "First commit hash")
 	getModifiedFilesCmd.Flags().StringVar(&getModifiedFilesArgs.commitHash2, "commit-hash2", "", "Second commit hash")
+	getModifiedFilesCmd.Flags().IntVar(&getModifiedFilesArgs.renameThreshold, "rename-threshold", 0, "Optional similarity in percent (e.g. 50, which is git's default) for a deleted file and an added file to be reported as a rename. Zero, which is the default, disables the rename detection")
+	getModifiedFilesCmd.Flags().StringVar(&getModifiedFilesArgs.matchPattern, "match-pattern", "", "Optional regular expression (Go RE2 syntax) to report the matches of in the modified files before and after the change, with the capture groups and the line numbers. The blobs of the modified files are fetched for this")
+	getModifiedFilesCmd.Flags().IntVar(&getModifiedFilesArgs.maxMatches, "max-matches", nichegit.DefaultMaxMatches, "Maximum number of the matches of --match-pattern reported per side of a file")
 	_ = getModifiedFilesCmd.MarkFlagRequired("repo-url")
 	_ = getModifiedFilesCmd.MarkFlagRequired("commit-hash1")
 	_ = getModifiedFilesCmd.MarkFlagRequired("commit-hash2")
 
-	getModifiedFilesCmd.Flags().StringVar(&authzHeader, "authz-header", "", "Optional authorization header")
-	getModifiedFilesCmd.Flags().StringVar(&basicAuthzUser, "basic-authz-user", "", "Optional HTTP Basic Auth user")
-	getModifiedFilesCmd.Flags().StringVar(&basicAuthzPassword, "basic-authz-password", "", "Optional HTTP Basic Auth password")
+	inv.addOperationFlags(getModifiedFilesCmd)
 
+	inv.addFetchFlags(getModifiedFilesCmd)
+	inv.addObjectStorageFlag(getModifiedFilesCmd)
+	getModifiedFilesCmd.Flags().StringVar(&inv.webLinkProvider, "web-links", "", "Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket")
+	getModifiedFilesCmd.Flags().StringArrayVar(&inv.webLinkTemplates, "web-link-template", nil, "Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders")
+	getModifiedFilesCmd.Flags().StringVar(&inv.webLinkRepoURL, "web-link-repo-url", "", "Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix")
 	getModifiedFilesCmd.Flags().StringVar(&getModifiedFilesArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	getModifiedFilesCmd.Flags().StringVar(&inv.outputFormat, "output-format", outputFormatJSON, "Output format: json, ndjson to write each element of the lists as a line and then the other fields as the last line, or plain to write the modified files one per line")
+	return getModifiedFilesCmd
+}
+
+type getModifiedFilesOutput struct {
+	Files      []string                `json:"files"`
+	Submodules []string                `json:"submodules,omitempty"`
+	Renames    []*nichegit.RenamedFile `json:"renames,omitempty"`
+	Matches    []*nichegit.FileMatches `json:"matches,omitempty"`
+	// CompareWebURL is the web UI URL of the changes from commit 1 to commit 2.
+	CompareWebURL string                `json:"compareWebURL,omitempty"`
+	DebugInfo     *debug.FetchDebugInfo `json:"debugInfo,omitempty"`
+	Error         string                `json:"error,omitempty"`
+	ErrorCode     nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
+
+// writePlain writes the modified files one per line.
+func (o getModifiedFilesOutput) writePlain(w io.Writer) error {
+	for _, file := range o.Files {
+		if _, err := fmt.Fprintln(w, file); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,83 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"encoding/base64"
+	"unicode/utf8"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newGetObjectCmd returns the get-object command.
+func newGetObjectCmd(inv *invocation) *cobra.Command {
+	var getObjectArgs struct {
+		repoURL string
+		hash    string
+		pretty  bool
+
+		outputFile string
+	}
+
+	getObjectCmd := &cobra.Command{
+		Use: "get-object",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			result, debugInfo, fetchErr := nichegit.GetObject(getObjectArgs.repoURL, client, nichegit.GetObjectArgs{
+				Hash:   plumbing.NewHash(getObjectArgs.hash),
+				Pretty: getObjectArgs.pretty,
+			})
+			output := getObjectOutput{
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.Hash = result.Hash.String()
+				output.Type = result.Type.String()
+				output.Size = result.Size
+				if utf8.Valid(result.Content) {
+					output.Content = string(result.Content)
+				} else {
+					output.ContentBase64 = base64.StdEncoding.EncodeToString(result.Content)
+				}
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(getObjectArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	getObjectCmd.Flags().StringVar(&getObjectArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	getObjectCmd.Flags().StringVar(&getObjectArgs.hash, "hash", "", "Hash of the object to get")
+	getObjectCmd.Flags().BoolVar(&getObjectArgs.pretty, "pretty", false, "Format the content like git cat-file -p. This lists the tree entries as text instead of the raw tree")
+	_ = getObjectCmd.MarkFlagRequired("repo-url")
+	_ = getObjectCmd.MarkFlagRequired("hash")
+
+	inv.addOperationFlags(getObjectCmd)
+
+	inv.addFetchFlags(getObjectCmd)
+	inv.addObjectStorageFlag(getObjectCmd)
+	getObjectCmd.Flags().StringVar(&getObjectArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(getObjectCmd)
+	return getObjectCmd
+}
+
+type getObjectOutput struct {
+	Hash string `json:"hash"`
+	Type string `json:"type"`
+	Size int64  `json:"size"`
+	// Content is the content if it's valid UTF-8. Otherwise, ContentBase64 has it.
+	Content       string                `json:"content,omitempty"`
+	ContentBase64 string                `json:"contentBase64,omitempty"`
+	DebugInfo     *debug.FetchDebugInfo `json:"debugInfo,omitempty"`
+	Error         string                `json:"error,omitempty"`
+	ErrorCode     nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newGetTreeCmd returns the get-tree command.
+func newGetTreeCmd(inv *invocation) *cobra.Command {
+	var getTreeArgs struct {
+		repoURL    string
+		commitHash string
+		path       string
+		depth      int
+		skipSizes  bool
+
+		outputFile string
+	}
+
+	getTreeCmd := &cobra.Command{
+		Use: "get-tree",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			linker, err := inv.newWebLinker(getTreeArgs.repoURL)
+			if err != nil {
+				return err
+			}
+			client := inv.newHTTPClient()
+			entries, debugInfo, fetchErr := nichegit.GetTree(getTreeArgs.repoURL, client, nichegit.GetTreeArgs{
+				CommitHash: plumbing.NewHash(getTreeArgs.commitHash),
+				Path:       getTreeArgs.path,
+				Depth:      getTreeArgs.depth,
+				SkipSizes:  getTreeArgs.skipSizes,
+			})
+			if entries == nil {
+				// Always create an empty slice for JSON output.
+				entries = []*nichegit.TreeEntry{}
+			}
+			for _, entry := range entries {
+				if entry.Type == "blob" {
+					entry.WebURL = linker.Blob(getTreeArgs.commitHash, entry.Path)
+				}
+			}
+			output := getTreeOutput{
+				Entries:   entries,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(getTreeArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	getTreeCmd.Flags().StringVar(&getTreeArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	getTreeCmd.Flags().StringVar(&getTreeArgs.commitHash, "commit-hash", "", "Commit hash to list the tree of")
+	getTreeCmd.Flags().StringVar(&getTreeArgs.path, "path", "", "Optional directory to list. Empty, which is the default, means the root directory")
+	getTreeCmd.Flags().IntVar(&getTreeArgs.depth, "depth", 0, "Optional number of the levels to list under --path. 1 lists only the direct entries. Zero, which is the default, lists all the entries recursively")
+	getTreeCmd.Flags().BoolVar(&getTreeArgs.skipSizes, "skip-sizes", false, "Skip looking up the blob sizes")
+	_ = getTreeCmd.MarkFlagRequired("repo-url")
+	_ = getTreeCmd.MarkFlagRequired("commit-hash")
+
+	inv.addOperationFlags(getTreeCmd)
+
+	inv.addFetchFlags(getTreeCmd)
+	inv.addObjectStorageFlag(getTreeCmd)
+	getTreeCmd.Flags().StringVar(&inv.webLinkProvider, "web-links", "", "Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket")
+	getTreeCmd.Flags().StringArrayVar(&inv.webLinkTemplates, "web-link-template", nil, "Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders")
+	getTreeCmd.Flags().StringVar(&inv.webLinkRepoURL, "web-link-repo-url", "", "Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix")
+	getTreeCmd.Flags().StringVar(&getTreeArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(getTreeCmd)
+	return getTreeCmd
+}
+
+type getTreeOutput struct {
+	Entries   []*nichegit.TreeEntry `json:"entries"`
+	DebugInfo *debug.FetchDebugInfo `json:"debugInfo,omitempty"`
+	Error     string                `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
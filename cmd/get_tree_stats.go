@@ -0,0 +1,72 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newGetTreeStatsCmd returns the get-tree-stats command.
+func newGetTreeStatsCmd(inv *invocation) *cobra.Command {
+	var getTreeStatsArgs struct {
+		repoURL      string
+		commitHash   string
+		largestFiles int
+
+		outputFile string
+	}
+
+	getTreeStatsCmd := &cobra.Command{
+		Use: "get-tree-stats",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			stats, debugInfo, fetchErr := nichegit.GetTreeStats(getTreeStatsArgs.repoURL, client, nichegit.GetTreeStatsArgs{
+				CommitHash:   plumbing.NewHash(getTreeStatsArgs.commitHash),
+				LargestFiles: getTreeStatsArgs.largestFiles,
+			})
+			output := getTreeStatsOutput{
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if stats != nil {
+				output.TreeStats = *stats
+			}
+			if output.LargestFiles == nil {
+				// Always create an empty slice for JSON output.
+				output.LargestFiles = []*nichegit.FileSize{}
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(getTreeStatsArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	getTreeStatsCmd.Flags().StringVar(&getTreeStatsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	getTreeStatsCmd.Flags().StringVar(&getTreeStatsArgs.commitHash, "commit-hash", "", "Commit hash to take the statistics of")
+	getTreeStatsCmd.Flags().IntVar(&getTreeStatsArgs.largestFiles, "largest-files", nichegit.DefaultLargestFiles, "Number of the largest files to report. Negative skips the file size lookup")
+	_ = getTreeStatsCmd.MarkFlagRequired("repo-url")
+	_ = getTreeStatsCmd.MarkFlagRequired("commit-hash")
+
+	inv.addOperationFlags(getTreeStatsCmd)
+
+	inv.addFetchFlags(getTreeStatsCmd)
+	inv.addObjectStorageFlag(getTreeStatsCmd)
+	getTreeStatsCmd.Flags().StringVar(&getTreeStatsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(getTreeStatsCmd)
+	return getTreeStatsCmd
+}
+
+type getTreeStatsOutput struct {
+	nichegit.TreeStats
+	DebugInfo *debug.FetchDebugInfo `json:"debugInfo,omitempty"`
+	Error     string                `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
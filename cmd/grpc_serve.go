@@ -0,0 +1,374 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegitpb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// grpc-serve runs a gRPC server of the NicheGit service in nichegitpb, which runs the commands in
+// the process like serve. The fields of a request are the flags of the command, and the response
+// is its JSON output. The standard health service reports NOT_SERVING once the server starts
+// shutting down.
+//
+// A command that fails responds with a status of the code for its exit code, with the response in
+// the details if the command wrote one. The requests that the command rejects before running get
+// INVALID_ARGUMENT, and the file:// URLs get PERMISSION_DENIED. The flags of the local files are
+// not in the requests.
+//
+// The commands share the HTTP connections, the ls-refs results, the object cache, and the stored
+// results if enabled. The "authorization" metadata of the call applies to the command, and the
+// authorization flags of grpc-serve apply if the call doesn't have one.
+func newGRPCServeCmd(inv *invocation) *cobra.Command {
+	var grpcServeArgs struct {
+		listen          string
+		lsRefsCacheTTL  time.Duration
+		objectCacheSize int64
+		resultStoreDir  string
+		authz           commandAuthz
+	}
+
+	grpcServe := &cobra.Command{
+		Use: "grpc-serve",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nichegit.SetLsRefsCacheTTL(grpcServeArgs.lsRefsCacheTTL)
+			defer nichegit.SetLsRefsCacheTTL(0)
+			nichegit.SetObjectCacheSize(grpcServeArgs.objectCacheSize)
+			defer nichegit.SetObjectCacheSize(0)
+			if grpcServeArgs.resultStoreDir != "" {
+				store, err := nichegit.NewDirResultStore(grpcServeArgs.resultStoreDir)
+				if err != nil {
+					return err
+				}
+				defer func(orig nichegit.ResultStore) { resultStore = orig }(resultStore)
+				resultStore = store
+			}
+
+			lis, err := net.Listen("tcp", grpcServeArgs.listen)
+			if err != nil {
+				return err
+			}
+			server, healthServer := newGRPCServer(grpcServeArgs.authz)
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- server.Serve(lis)
+			}()
+			select {
+			case err := <-errCh:
+				return err
+			case <-ctx.Done():
+			}
+			healthServer.Shutdown()
+			// Let the running commands finish.
+			stopped := make(chan struct{})
+			go func() {
+				server.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-time.After(time.Minute):
+				server.Stop()
+			}
+			return <-errCh
+		},
+	}
+
+	grpcServe.Flags().StringVar(&grpcServeArgs.listen, "listen", "localhost:9090", "Address to listen on")
+	grpcServe.Flags().DurationVar(&grpcServeArgs.lsRefsCacheTTL, "ls-refs-cache-ttl", 30*time.Second, "Duration to reuse the ls-refs results across the requests. A push to the repository drops them. Zero disables it")
+	grpcServe.Flags().Int64Var(&grpcServeArgs.objectCacheSize, "object-cache-size", 0, "Size in bytes of the commits and the trees to reuse across the requests. Zero, which is the default, disables it")
+	grpcServe.Flags().StringVar(&grpcServeArgs.resultStoreDir, "result-store-dir", "", "Optional directory to store the outputs of the read commands that take commit hashes in. Requesting a command with the same arguments returns the stored output instead. See the README for the commands")
+
+	grpcServe.Flags().StringVar(&grpcServeArgs.authz.header, "authz-header", "", "Optional authorization header for the calls without authorization metadata")
+	grpcServe.Flags().StringVar(&grpcServeArgs.authz.basicUser, "basic-authz-user", "", "Optional HTTP Basic Auth user for the calls without authorization metadata")
+	grpcServe.Flags().StringVar(&grpcServeArgs.authz.basicPassword, "basic-authz-password", "", "Optional HTTP Basic Auth password for the calls without authorization metadata")
+	return grpcServe
+}
+
+// newGRPCServer returns the gRPC server of the NicheGit service and the health service.
+func newGRPCServer(authz commandAuthz) (*grpc.Server, *health.Server) {
+	server := grpc.NewServer()
+	nichegitpb.RegisterNicheGitServer(server, &grpcServer{authz: authz})
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	return server, healthServer
+}
+
+// grpcCodes are the status codes of the exit codes.
+var grpcCodes = map[int]codes.Code{
+	exitCodeError:              codes.Unknown,
+	exitCodeConflict:           codes.Aborted,
+	exitCodePreconditionFailed: codes.FailedPrecondition,
+	exitCodeAuth:               codes.Unauthenticated,
+	exitCodeNetwork:            codes.Unavailable,
+	exitCodeTimeout:            codes.DeadlineExceeded,
+	exitCodeTooLarge:           codes.ResourceExhausted,
+}
+
+// runGRPCCommand runs the command with the flags in req, and returns resp with the JSON output of
+// the command.
+func runGRPCCommand[T proto.Message](ctx context.Context, authz commandAuthz, command string, req proto.Message, resp T) (T, error) {
+	var zero T
+	inv := newInvocation()
+	inv.ctx = ctx
+	sub, err := findPipeCommand(inv, command)
+	if err != nil {
+		return zero, status.Error(codes.Unimplemented, err.Error())
+	}
+	if err := setGRPCRequestFlags(sub.Flags(), req); err != nil {
+		return zero, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := checkServerFlags(sub.Flags(), "grpc-serve"); err != nil {
+		return zero, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get("authorization")) > 0 {
+		authz = commandAuthz{header: md.Get("authorization")[0]}
+	}
+
+	var out bytes.Buffer
+	_, runErr := inv.runParsedCommand(sub, authz, &out)
+	if out.Len() == 0 {
+		if runErr == nil {
+			return zero, status.Errorf(codes.Internal, "%s didn't write the output", command)
+		}
+		return zero, status.Error(codes.InvalidArgument, runErr.Error())
+	}
+	// The fields that the output has and the response doesn't are dropped rather than failing the
+	// command that has run.
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(out.Bytes(), resp); err != nil {
+		return zero, status.Errorf(codes.Internal, "cannot parse the output of %s: %v", command, err)
+	}
+	if runErr == nil {
+		return resp, nil
+	}
+	st := status.New(grpcCodes[inv.exitCode(runErr)], runErr.Error())
+	if withDetails, err := st.WithDetails(protoadapt.MessageV1Of(resp)); err == nil {
+		st = withDetails
+	}
+	return zero, st.Err()
+}
+
+// setGRPCRequestFlags sets the flags to the fields of req that are set. The name of a field is the
+// flag name with the hyphens replaced with underscores.
+func setGRPCRequestFlags(flags *pflag.FlagSet, req proto.Message) error {
+	var err error
+	req.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := strings.ReplaceAll(string(fd.Name()), "_", "-")
+		f := flags.Lookup(name)
+		if f == nil {
+			err = fmt.Errorf("unknown flag --%s", name)
+			return false
+		}
+		var values []string
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				values = append(values, list.Get(i).String())
+			}
+			if f.Value.Type() == "stringSlice" {
+				// A slice flag takes a comma-separated value, with the commas in the
+				// values quoted.
+				var b strings.Builder
+				w := csv.NewWriter(&b)
+				if err = w.Write(values); err != nil {
+					return false
+				}
+				w.Flush()
+				values = []string{strings.TrimSuffix(b.String(), "\n")}
+			}
+		case fd.Message() != nil && fd.Message().FullName() == "google.protobuf.Duration":
+			d, ok := v.Message().Interface().(*durationpb.Duration)
+			if !ok {
+				err = fmt.Errorf("--%s is not a duration", name)
+				return false
+			}
+			values = []string{d.AsDuration().String()}
+		default:
+			values = []string{v.String()}
+		}
+		for _, value := range values {
+			if err = flags.Set(name, value); err != nil {
+				err = fmt.Errorf("invalid --%s: %w", name, err)
+				return false
+			}
+		}
+		return true
+	})
+	return err
+}
+
+// grpcServer implements the NicheGit service with the commands.
+type grpcServer struct {
+	nichegitpb.UnimplementedNicheGitServer
+	// authz is the authorization of the calls without authorization metadata.
+	authz commandAuthz
+}
+
+func (s *grpcServer) CheckIgnored(ctx context.Context, req *nichegitpb.CheckIgnoredRequest) (*nichegitpb.CheckIgnoredResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "check-ignored", req, &nichegitpb.CheckIgnoredResponse{})
+}
+
+func (s *grpcServer) CheckLinearHistory(ctx context.Context, req *nichegitpb.CheckLinearHistoryRequest) (*nichegitpb.CheckLinearHistoryResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "check-linear-history", req, &nichegitpb.CheckLinearHistoryResponse{})
+}
+
+func (s *grpcServer) CheckRemerge(ctx context.Context, req *nichegitpb.CheckRemergeRequest) (*nichegitpb.CheckRemergeResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "check-remerge", req, &nichegitpb.CheckRemergeResponse{})
+}
+
+func (s *grpcServer) CherryPick(ctx context.Context, req *nichegitpb.CherryPickRequest) (*nichegitpb.CherryPickResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "cherry-pick", req, &nichegitpb.CherryPickResponse{})
+}
+
+func (s *grpcServer) CleanupScratchRefs(ctx context.Context, req *nichegitpb.CleanupScratchRefsRequest) (*nichegitpb.CleanupScratchRefsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "cleanup-scratch-refs", req, &nichegitpb.CleanupScratchRefsResponse{})
+}
+
+func (s *grpcServer) CompareRefs(ctx context.Context, req *nichegitpb.CompareRefsRequest) (*nichegitpb.CompareRefsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "compare-refs", req, &nichegitpb.CompareRefsResponse{})
+}
+
+func (s *grpcServer) EmptyCommit(ctx context.Context, req *nichegitpb.EmptyCommitRequest) (*nichegitpb.EmptyCommitResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "empty-commit", req, &nichegitpb.EmptyCommitResponse{})
+}
+
+func (s *grpcServer) GenerateChangelog(ctx context.Context, req *nichegitpb.GenerateChangelogRequest) (*nichegitpb.GenerateChangelogResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "generate-changelog", req, &nichegitpb.GenerateChangelogResponse{})
+}
+
+func (s *grpcServer) GetAttributes(ctx context.Context, req *nichegitpb.GetAttributesRequest) (*nichegitpb.GetAttributesResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-attributes", req, &nichegitpb.GetAttributesResponse{})
+}
+
+func (s *grpcServer) GetBlame(ctx context.Context, req *nichegitpb.GetBlameRequest) (*nichegitpb.GetBlameResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-blame", req, &nichegitpb.GetBlameResponse{})
+}
+
+func (s *grpcServer) GetCommitGraph(ctx context.Context, req *nichegitpb.GetCommitGraphRequest) (*nichegitpb.GetCommitGraphResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-commit-graph", req, &nichegitpb.GetCommitGraphResponse{})
+}
+
+func (s *grpcServer) GetCommits(ctx context.Context, req *nichegitpb.GetCommitsRequest) (*nichegitpb.GetCommitsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-commits", req, &nichegitpb.GetCommitsResponse{})
+}
+
+func (s *grpcServer) GetCommitsSinceSemverTag(ctx context.Context, req *nichegitpb.GetCommitsSinceSemverTagRequest) (*nichegitpb.GetCommitsSinceSemverTagResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-commits-since-semver-tag", req, &nichegitpb.GetCommitsSinceSemverTagResponse{})
+}
+
+func (s *grpcServer) GetFileHistory(ctx context.Context, req *nichegitpb.GetFileHistoryRequest) (*nichegitpb.GetFileHistoryResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-file-history", req, &nichegitpb.GetFileHistoryResponse{})
+}
+
+func (s *grpcServer) GetFileOwners(ctx context.Context, req *nichegitpb.GetFileOwnersRequest) (*nichegitpb.GetFileOwnersResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-file-owners", req, &nichegitpb.GetFileOwnersResponse{})
+}
+
+func (s *grpcServer) GetImpactedServices(ctx context.Context, req *nichegitpb.GetImpactedServicesRequest) (*nichegitpb.GetImpactedServicesResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-impacted-services", req, &nichegitpb.GetImpactedServicesResponse{})
+}
+
+func (s *grpcServer) GetLatestSemverTag(ctx context.Context, req *nichegitpb.GetLatestSemverTagRequest) (*nichegitpb.GetLatestSemverTagResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-latest-semver-tag", req, &nichegitpb.GetLatestSemverTagResponse{})
+}
+
+func (s *grpcServer) GetModifiedFiles(ctx context.Context, req *nichegitpb.GetModifiedFilesRequest) (*nichegitpb.GetModifiedFilesResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-modified-files", req, &nichegitpb.GetModifiedFilesResponse{})
+}
+
+func (s *grpcServer) GetObject(ctx context.Context, req *nichegitpb.GetObjectRequest) (*nichegitpb.GetObjectResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-object", req, &nichegitpb.GetObjectResponse{})
+}
+
+func (s *grpcServer) GetTree(ctx context.Context, req *nichegitpb.GetTreeRequest) (*nichegitpb.GetTreeResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-tree", req, &nichegitpb.GetTreeResponse{})
+}
+
+func (s *grpcServer) GetTreeStats(ctx context.Context, req *nichegitpb.GetTreeStatsRequest) (*nichegitpb.GetTreeStatsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "get-tree-stats", req, &nichegitpb.GetTreeStatsResponse{})
+}
+
+func (s *grpcServer) HasObjects(ctx context.Context, req *nichegitpb.HasObjectsRequest) (*nichegitpb.HasObjectsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "has-objects", req, &nichegitpb.HasObjectsResponse{})
+}
+
+func (s *grpcServer) LsRefs(ctx context.Context, req *nichegitpb.LsRefsRequest) (*nichegitpb.LsRefsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "ls-refs", req, &nichegitpb.LsRefsResponse{})
+}
+
+func (s *grpcServer) MergeBranches(ctx context.Context, req *nichegitpb.MergeBranchesRequest) (*nichegitpb.MergeBranchesResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "merge-branches", req, &nichegitpb.MergeBranchesResponse{})
+}
+
+func (s *grpcServer) PathsExist(ctx context.Context, req *nichegitpb.PathsExistRequest) (*nichegitpb.PathsExistResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "paths-exist", req, &nichegitpb.PathsExistResponse{})
+}
+
+func (s *grpcServer) PushNextSemverTag(ctx context.Context, req *nichegitpb.PushNextSemverTagRequest) (*nichegitpb.PushNextSemverTagResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "push-next-semver-tag", req, &nichegitpb.PushNextSemverTagResponse{})
+}
+
+func (s *grpcServer) RebaseRefs(ctx context.Context, req *nichegitpb.RebaseRefsRequest) (*nichegitpb.RebaseRefsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "rebase-refs", req, &nichegitpb.RebaseRefsResponse{})
+}
+
+func (s *grpcServer) ResolveConflicts(ctx context.Context, req *nichegitpb.ResolveConflictsRequest) (*nichegitpb.ResolveConflictsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "resolve-conflicts", req, &nichegitpb.ResolveConflictsResponse{})
+}
+
+func (s *grpcServer) RestoreRefs(ctx context.Context, req *nichegitpb.RestoreRefsRequest) (*nichegitpb.RestoreRefsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "restore-refs", req, &nichegitpb.RestoreRefsResponse{})
+}
+
+func (s *grpcServer) Revert(ctx context.Context, req *nichegitpb.RevertRequest) (*nichegitpb.RevertResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "revert", req, &nichegitpb.RevertResponse{})
+}
+
+func (s *grpcServer) RevertMerge(ctx context.Context, req *nichegitpb.RevertMergeRequest) (*nichegitpb.RevertMergeResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "revert-merge", req, &nichegitpb.RevertMergeResponse{})
+}
+
+func (s *grpcServer) RewordCommits(ctx context.Context, req *nichegitpb.RewordCommitsRequest) (*nichegitpb.RewordCommitsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "reword-commits", req, &nichegitpb.RewordCommitsResponse{})
+}
+
+func (s *grpcServer) SnapshotRefs(ctx context.Context, req *nichegitpb.SnapshotRefsRequest) (*nichegitpb.SnapshotRefsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "snapshot-refs", req, &nichegitpb.SnapshotRefsResponse{})
+}
+
+func (s *grpcServer) SquashCherryPick(ctx context.Context, req *nichegitpb.SquashCherryPickRequest) (*nichegitpb.SquashCherryPickResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "squash-cherry-pick", req, &nichegitpb.SquashCherryPickResponse{})
+}
+
+func (s *grpcServer) UpdateRefs(ctx context.Context, req *nichegitpb.UpdateRefsRequest) (*nichegitpb.UpdateRefsResponse, error) {
+	return runGRPCCommand(ctx, s.authz, "update-refs", req, &nichegitpb.UpdateRefsResponse{})
+}
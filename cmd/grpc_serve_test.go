@@ -0,0 +1,333 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegitpb"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// newGRPCTestClient starts a gRPC server of the NicheGit service and returns its client.
+func newGRPCTestClient(t *testing.T) nichegitpb.NicheGitClient {
+	t.Helper()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, _ := newGRPCServer(commandAuthz{})
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return nichegitpb.NewNicheGitClient(conn)
+}
+
+func TestGRPCServe(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+	client := newGRPCTestClient(t)
+	ctx := context.Background()
+
+	resp, err := client.GetModifiedFiles(ctx, &nichegitpb.GetModifiedFilesRequest{
+		RepoUrl:     repoURL,
+		CommitHash1: base.String(),
+		CommitHash2: head.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Files) != 1 || resp.Files[0] != "b.txt" {
+		t.Errorf("files is %q, want b.txt", resp.Files)
+	}
+
+	// A failed command has its response in the details.
+	_, err = client.UpdateRefs(ctx, &nichegitpb.UpdateRefsRequest{
+		RepoUrl:   repoURL,
+		RefUpdate: []string{"refs/heads/main:" + base.String() + ":" + base.String()},
+	})
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition {
+		t.Fatalf("the code of a ref CAS failure is %s, want %s: %v", st.Code(), codes.FailedPrecondition, err)
+	}
+	if details := st.Details(); len(details) != 1 {
+		t.Errorf("the details are %v, want the response", details)
+	} else if detail, ok := details[0].(*nichegitpb.UpdateRefsResponse); !ok || detail.ErrorCode != string(nichegit.ErrorCodeRefCASFailed) {
+		t.Errorf("the detail is %v, want the response with %s", details[0], nichegit.ErrorCodeRefCASFailed)
+	}
+
+	if _, err := client.GetModifiedFiles(ctx, &nichegitpb.GetModifiedFilesRequest{RepoUrl: repoURL}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("the code without the required flags is %s, want %s: %v", status.Code(err), codes.InvalidArgument, err)
+	}
+	if _, err := client.LsRefs(ctx, &nichegitpb.LsRefsRequest{RepoUrl: "file://" + repo.Dir}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("the code of a file:// URL is %s, want %s: %v", status.Code(err), codes.PermissionDenied, err)
+	}
+}
+
+func TestGRPCServeAuthorization(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "a")
+	repo.Push("main")
+	gitServer := nichegittest.NewServer(t, repo)
+	gitServer.RequireBasicAuth("user", "password")
+	client := newGRPCTestClient(t)
+
+	req := &nichegitpb.LsRefsRequest{RepoUrl: gitServer.RepoURL()}
+	if _, err := client.LsRefs(context.Background(), req); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("the code without the credentials is %s, want %s: %v", status.Code(err), codes.Unauthenticated, err)
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("user:password")))
+	resp, err := client.LsRefs(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Refs) == 0 {
+		t.Error("no refs are returned")
+	}
+}
+
+func TestGRPCServeDeadline(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "a")
+	repo.Push("main")
+	gitServer := nichegittest.NewServer(t, repo)
+	gitServer.InjectFault(nichegittest.Fault{Match: nichegittest.MatchUploadPack, Delay: 2 * time.Second})
+
+	// The command stops at the deadline of the call.
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	_, err := (&grpcServer{}).LsRefs(ctx, &nichegitpb.LsRefsRequest{RepoUrl: gitServer.RepoURL()})
+	st := status.Convert(err)
+	if st.Code() != codes.DeadlineExceeded {
+		t.Fatalf("the code at the deadline is %s, want %s: %v", st.Code(), codes.DeadlineExceeded, err)
+	}
+	if details := st.Details(); len(details) != 1 {
+		t.Errorf("the details are %v, want the response", details)
+	} else if detail, ok := details[0].(*nichegitpb.LsRefsResponse); !ok || detail.ErrorCode != string(nichegit.ErrorCodeTimeout) {
+		t.Errorf("the detail is %v, want the response with %s", details[0], nichegit.ErrorCodeTimeout)
+	}
+}
+
+func TestSetGRPCRequestFlags(t *testing.T) {
+	sub, err := findPipeCommand(newInvocation(), "check-ignored")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = setGRPCRequestFlags(sub.Flags(), &nichegitpb.CheckIgnoredRequest{
+		RepoUrl:    "https://example.com/repo.git",
+		Paths:      []string{"a,b", `"c"`},
+		MaxRetries: 3,
+		Timeout:    durationpb.New(time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The commas and the quotes in the values of a slice flag are kept.
+	if paths, _ := sub.Flags().GetStringSlice("paths"); !reflect.DeepEqual(paths, []string{"a,b", `"c"`}) {
+		t.Errorf("--paths is %q", paths)
+	}
+	if maxRetries, _ := sub.Flags().GetInt("max-retries"); maxRetries != 3 {
+		t.Errorf("--max-retries is %d, want 3", maxRetries)
+	}
+	if timeout, _ := sub.Flags().GetDuration("timeout"); timeout != time.Minute {
+		t.Errorf("--timeout is %s, want 1m", timeout)
+	}
+	if sub.Flags().Changed("commit-hash") {
+		t.Error("--commit-hash is set without the field")
+	}
+}
+
+// grpcExcludedFlag returns true if the flag is not in the requests of the NicheGit service.
+func grpcExcludedFlag(name string) bool {
+	switch name {
+	case "help", "output-file", "output-format", "authz-header", "basic-authz-user", "basic-authz-password", "signing-key-format", "signing-key-passphrase", "progress":
+		return true
+	}
+	return strings.HasSuffix(name, "-file") || strings.HasSuffix(name, "-dir")
+}
+
+// grpcCommandName returns the command of the method, e.g. get-tree for GetTree.
+func grpcCommandName(method protoreflect.Name) string {
+	var b strings.Builder
+	for i, r := range method {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func TestGRPCRequestFields(t *testing.T) {
+	flagKinds := map[string]string{
+		"string":      "string",
+		"bool":        "bool",
+		"int":         "int32",
+		"int64":       "int64",
+		"duration":    "google.protobuf.Duration",
+		"stringArray": "repeated string",
+		"stringSlice": "repeated string",
+	}
+	methods := nichegitpb.File_nichegit_proto.Services().ByName("NicheGit").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		m := methods.Get(i)
+		command := grpcCommandName(m.Name())
+		sub, err := findPipeCommand(newInvocation(), command)
+		if err != nil {
+			t.Errorf("%s: %v", m.Name(), err)
+			continue
+		}
+		fields := m.Input().Fields()
+		for j := 0; j < fields.Len(); j++ {
+			fd := fields.Get(j)
+			name := strings.ReplaceAll(string(fd.Name()), "_", "-")
+			f := sub.Flags().Lookup(name)
+			if f == nil || grpcExcludedFlag(name) {
+				t.Errorf("%s has no flag --%s for %s", command, name, fd.FullName())
+				continue
+			}
+			kind := fd.Kind().String()
+			if fd.Message() != nil {
+				kind = string(fd.Message().FullName())
+			}
+			if fd.IsList() {
+				kind = "repeated " + kind
+			}
+			if want := flagKinds[f.Value.Type()]; kind != want {
+				t.Errorf("%s is %s, want %s for --%s", fd.FullName(), kind, want, name)
+			}
+		}
+		sub.Flags().VisitAll(func(f *pflag.Flag) {
+			if !grpcExcludedFlag(f.Name) && fields.ByName(protoreflect.Name(strings.ReplaceAll(f.Name, "-", "_"))) == nil {
+				t.Errorf("%s has no field for --%s of %s", m.Input().FullName(), f.Name, command)
+			}
+		})
+	}
+}
+
+func TestGRPCResponseFields(t *testing.T) {
+	outputs := map[string]any{
+		"check-ignored":                checkIgnoredOutput{},
+		"check-linear-history":         checkLinearHistoryOutput{},
+		"check-remerge":                checkRemergeOutput{},
+		"cherry-pick":                  cherryPickOutput{},
+		"cleanup-scratch-refs":         cleanupScratchRefsOutput{},
+		"compare-refs":                 compareRefsOutput{},
+		"empty-commit":                 emptyCommitOutput{},
+		"generate-changelog":           generateChangelogOutput{},
+		"get-attributes":               getAttributesOutput{},
+		"get-blame":                    getBlameOutput{},
+		"get-commit-graph":             getCommitGraphOutput{},
+		"get-commits":                  getCommitsOutput{},
+		"get-commits-since-semver-tag": getCommitsSinceSemverTagOutput{},
+		"get-file-history":             getFileHistoryOutput{},
+		"get-file-owners":              getFileOwnersOutput{},
+		"get-impacted-services":        getImpactedServicesOutput{},
+		"get-latest-semver-tag":        getLatestSemverTagOutput{},
+		"get-modified-files":           getModifiedFilesOutput{},
+		"get-object":                   getObjectOutput{},
+		"get-tree":                     getTreeOutput{},
+		"get-tree-stats":               getTreeStatsOutput{},
+		"has-objects":                  hasObjectsOutput{},
+		"ls-refs":                      lsRefsOutput{},
+		"merge-branches":               mergeBranchesOutput{},
+		"paths-exist":                  pathsExistOutput{},
+		"push-next-semver-tag":         pushNextSemverTagOutput{},
+		"rebase-refs":                  rebaseRefsOutput{},
+		"resolve-conflicts":            resolveConflictsOutput{},
+		"restore-refs":                 restoreRefsOutput{},
+		"revert":                       revertOutput{},
+		"revert-merge":                 revertMergeOutput{},
+		"reword-commits":               rewordCommitsOutput{},
+		"snapshot-refs":                snapshotRefsOutput{},
+		"squash-cherry-pick":           squashCherryPickOutput{},
+		"update-refs":                  updateRefsOutput{},
+	}
+	methods := nichegitpb.File_nichegit_proto.Services().ByName("NicheGit").Methods()
+	if methods.Len() != len(outputs) {
+		t.Errorf("the service has %d methods, want %d", methods.Len(), len(outputs))
+	}
+	for i := 0; i < methods.Len(); i++ {
+		m := methods.Get(i)
+		command := grpcCommandName(m.Name())
+		output, ok := outputs[command]
+		if !ok {
+			t.Errorf("no output for %s", command)
+			continue
+		}
+		checkGRPCResponseFields(t, reflect.TypeOf(output), m.Output())
+		if m.Output().Fields().ByJSONName("budgetDebugInfo") == nil {
+			t.Errorf("%s has no budgetDebugInfo", m.Output().FullName())
+		}
+	}
+}
+
+// checkGRPCResponseFields checks that the message has the fields of all the JSON keys of the
+// struct.
+func checkGRPCResponseFields(t *testing.T, st reflect.Type, md protoreflect.MessageDescriptor) {
+	t.Helper()
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		key, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if !f.IsExported() || key == "-" {
+			continue
+		}
+		ft := f.Type
+		if f.Anonymous && key == "" {
+			// The fields of an embedded struct are in the object of the struct.
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			checkGRPCResponseFields(t, ft, md)
+			continue
+		}
+		if key == "" {
+			key = f.Name
+		}
+		fd := md.Fields().ByJSONName(key)
+		if fd == nil {
+			t.Errorf("%s has no field for %q of %s", md.FullName(), key, st)
+			continue
+		}
+		for ft.Kind() == reflect.Pointer || ft.Kind() == reflect.Slice || ft.Kind() == reflect.Map {
+			ft = ft.Elem()
+		}
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if ft.Kind() == reflect.Struct && ft != reflect.TypeOf(time.Time{}) {
+			if fd.Message() == nil {
+				t.Errorf("%s is not a message for %s", fd.FullName(), ft)
+				continue
+			}
+			checkGRPCResponseFields(t, ft, fd.Message())
+		}
+	}
+}
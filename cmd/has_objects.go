@@ -0,0 +1,83 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"fmt"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newHasObjectsCmd returns the has-objects command.
+func newHasObjectsCmd(inv *invocation) *cobra.Command {
+	var hasObjectsArgs struct {
+		repoURL string
+		hashes  []string
+
+		outputFile string
+	}
+
+	hasObjectsCmd := &cobra.Command{
+		Use: "has-objects",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var hashes []plumbing.Hash
+			for _, s := range hasObjectsArgs.hashes {
+				if !plumbing.IsHash(s) {
+					return fmt.Errorf("invalid object hash %q", s)
+				}
+				hashes = append(hashes, plumbing.NewHash(s))
+			}
+
+			client := inv.newHTTPClient()
+			result, debugInfo, fetchErr := nichegit.HasObjects(hasObjectsArgs.repoURL, client, nichegit.HasObjectsArgs{
+				Hashes: hashes,
+			})
+			output := hasObjectsOutput{
+				// Always create empty slices for JSON output.
+				Existing:  []string{},
+				Missing:   []string{},
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				for _, hash := range result.Existing {
+					output.Existing = append(output.Existing, hash.String())
+				}
+				for _, hash := range result.Missing {
+					output.Missing = append(output.Missing, hash.String())
+				}
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(hasObjectsArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	hasObjectsCmd.Flags().StringVar(&hasObjectsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	hasObjectsCmd.Flags().StringSliceVar(&hasObjectsArgs.hashes, "hashes", nil, "Comma-separated hashes of the objects to look up")
+	_ = hasObjectsCmd.MarkFlagRequired("repo-url")
+	_ = hasObjectsCmd.MarkFlagRequired("hashes")
+
+	inv.addOperationFlags(hasObjectsCmd)
+
+	inv.addFetchFlags(hasObjectsCmd)
+	hasObjectsCmd.Flags().StringVar(&hasObjectsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(hasObjectsCmd)
+	return hasObjectsCmd
+}
+
+type hasObjectsOutput struct {
+	Existing  []string              `json:"existing"`
+	Missing   []string              `json:"missing"`
+	DebugInfo *debug.FetchDebugInfo `json:"debugInfo,omitempty"`
+	Error     string                `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
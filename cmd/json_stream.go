@@ -0,0 +1,248 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// encodeJSON writes the output struct as indented JSON, same as json.Encoder with two-space
+// indentation. The fields are written one by one, and the elements of the slice fields one by
+// one, so that a large list is never marshaled into one buffer. The extra fields are added at the
+// end. Values other than structs are encoded as a whole.
+func encodeJSON(w io.Writer, v any, extra ...jsonField) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct || rv.Type().Implements(jsonMarshalerType) {
+		if len(extra) > 0 {
+			var err error
+			if v, err = addJSONFields(v, extra); err != nil {
+				return err
+			}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	first := true
+	var writeErr error
+	write := func(format string, args ...any) {
+		if writeErr == nil {
+			_, writeErr = fmt.Fprintf(w, format, args...)
+		}
+	}
+	writeField := func(key string, fv reflect.Value) error {
+		keyJSON, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		if first {
+			write("{\n  %s: ", keyJSON)
+		} else {
+			write(",\n  %s: ", keyJSON)
+		}
+		first = false
+		if !isStreamedSlice(fv) {
+			bs, err := json.MarshalIndent(fv.Interface(), "  ", "  ")
+			if err != nil {
+				return err
+			}
+			write("%s", bs)
+			return writeErr
+		}
+		if fv.Len() == 0 {
+			write("[]")
+			return writeErr
+		}
+		write("[")
+		for i := 0; i < fv.Len(); i++ {
+			bs, err := json.MarshalIndent(fv.Index(i).Interface(), "    ", "  ")
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				write(",")
+			}
+			write("\n    %s", bs)
+			if writeErr != nil {
+				return writeErr
+			}
+		}
+		write("\n  ]")
+		return writeErr
+	}
+	if err := visitJSONFields(rv, writeField); err != nil {
+		return err
+	}
+	for _, field := range extra {
+		if err := writeField(field.key, reflect.ValueOf(field.value)); err != nil {
+			return err
+		}
+	}
+	if first {
+		write("{}\n")
+	} else {
+		write("\n}\n")
+	}
+	return writeErr
+}
+
+// encodeNDJSON writes the output struct as newline-delimited JSON. Each element of the slice
+// fields is written as a line of its own, {"<key>": <element>}, as soon as it's marshaled, and
+// then the other fields are written as the last line. The empty slices don't have a line. extra
+// is the same as encodeJSON. Values other than structs are written as one line.
+func encodeNDJSON(w io.Writer, v any, extra ...jsonField) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct || rv.Type().Implements(jsonMarshalerType) {
+		if len(extra) > 0 {
+			var err error
+			if v, err = addJSONFields(v, extra); err != nil {
+				return err
+			}
+		}
+		return json.NewEncoder(w).Encode(v)
+	}
+	var last bytes.Buffer
+	last.WriteString("{")
+	writeField := func(key string, fv reflect.Value) error {
+		keyJSON, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		if !isStreamedSlice(fv) {
+			bs, err := json.Marshal(fv.Interface())
+			if err != nil {
+				return err
+			}
+			if last.Len() > 1 {
+				last.WriteString(",")
+			}
+			fmt.Fprintf(&last, "%s:%s", keyJSON, bs)
+			return nil
+		}
+		for i := 0; i < fv.Len(); i++ {
+			bs, err := json.Marshal(fv.Index(i).Interface())
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "{%s:%s}\n", keyJSON, bs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := visitJSONFields(rv, writeField); err != nil {
+		return err
+	}
+	for _, field := range extra {
+		if err := writeField(field.key, reflect.ValueOf(field.value)); err != nil {
+			return err
+		}
+	}
+	last.WriteString("}\n")
+	_, err := w.Write(last.Bytes())
+	return err
+}
+
+// isStreamedSlice returns true if the field is a list whose elements are written one by one.
+func isStreamedSlice(fv reflect.Value) bool {
+	return fv.Kind() == reflect.Slice && !fv.IsNil() && fv.Type().Elem().Kind() != reflect.Uint8 && !fv.Type().Implements(jsonMarshalerType)
+}
+
+// jsonField is a top-level field added to the output by encodeJSON.
+type jsonField struct {
+	key   string
+	value any
+}
+
+// addJSONFields adds the extra fields to the JSON object.
+func addJSONFields(v any, extra []jsonField) (any, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(bs, &m); err != nil {
+		// Not an object.
+		return v, nil
+	}
+	for _, field := range extra {
+		bs, err := json.Marshal(field.value)
+		if err != nil {
+			return nil, err
+		}
+		m[field.key] = bs
+	}
+	return m, nil
+}
+
+// visitJSONFields calls fn with the JSON keys and the values of the struct fields in the order
+// that encoding/json writes them. The fields of the embedded structs are flattened.
+func visitJSONFields(rv reflect.Value, fn func(key string, fv reflect.Value) error) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		fv := rv.Field(i)
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					continue
+				}
+				ft, fv = ft.Elem(), fv.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if err := visitJSONFields(fv, fn); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if strings.Contains(","+opts+",", ",omitempty,") && isEmptyJSONValue(fv) {
+			continue
+		}
+		if err := fn(name, fv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isEmptyJSONValue reports whether the value is omitted with omitempty, same as encoding/json.
+func isEmptyJSONValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEncodeJSON(t *testing.T) {
+	type item struct {
+		Name string   `json:"name"`
+		Tags []string `json:"tags,omitempty"`
+	}
+	type embedded struct {
+		Count int `json:"count"`
+	}
+	type output struct {
+		embedded
+		Items     []*item               `json:"items"`
+		Empty     []string              `json:"empty"`
+		Nil       []string              `json:"nil"`
+		Bytes     []byte                `json:"bytes"`
+		Omitted   string                `json:"omitted,omitempty"`
+		Map       map[string]int        `json:"map"`
+		DebugInfo *debug.FetchDebugInfo `json:"debugInfo,omitempty"`
+		Error     string                `json:"error,omitempty"`
+	}
+	for _, v := range []any{
+		output{
+			embedded: embedded{Count: 2},
+			Items:    []*item{{Name: "a", Tags: []string{"x", "<y>"}}, {Name: "b"}},
+			Empty:    []string{},
+			Bytes:    []byte("bytes"),
+			Map:      map[string]int{"b": 2, "a": 1},
+		},
+		&output{Error: "failed"},
+		struct{}{},
+		[]string{"not", "a", "struct"},
+	} {
+		var want bytes.Buffer
+		enc := json.NewEncoder(&want)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(v); err != nil {
+			t.Fatal(err)
+		}
+		var got bytes.Buffer
+		if err := encodeJSON(&got, v); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want.String(), got.String()); diff != "" {
+			t.Errorf("encodeJSON(%T) differs from json.Encoder (-want +got):\n%s", v, diff)
+		}
+	}
+
+	var got bytes.Buffer
+	if err := encodeJSON(&got, output{DebugInfo: debug.FetchDebugInfo{}.Trim(debug.LevelNone)}); err != nil {
+		t.Fatal(err)
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(got.Bytes(), &m); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m["debugInfo"]; ok {
+		t.Errorf("the debug info is not omitted: %s", got.String())
+	}
+}
+
+func TestEncodeNDJSON(t *testing.T) {
+	type item struct {
+		Name string `json:"name"`
+	}
+	type output struct {
+		Items     []*item               `json:"items"`
+		Empty     []string              `json:"empty"`
+		Renames   []string              `json:"renames,omitempty"`
+		Count     int                   `json:"count"`
+		DebugInfo *debug.FetchDebugInfo `json:"debugInfo,omitempty"`
+		Error     string                `json:"error,omitempty"`
+	}
+	v := output{
+		Items:   []*item{{Name: "a"}, {Name: "b"}},
+		Empty:   []string{},
+		Renames: []string{"c"},
+		Count:   2,
+		Error:   "failed",
+	}
+	var got bytes.Buffer
+	if err := encodeNDJSON(&got, v, jsonField{key: "extra", value: true}); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"items":{"name":"a"}}
+{"items":{"name":"b"}}
+{"renames":"c"}
+{"count":2,"error":"failed","extra":true}
+`
+	if diff := cmp.Diff(want, got.String()); diff != "" {
+		t.Errorf("encodeNDJSON diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteJSONPlain(t *testing.T) {
+	inv := newInvocation()
+	inv.outputFormat = outputFormatPlain
+	path := filepath.Join(t.TempDir(), "output")
+	tests := []struct {
+		output any
+		want   string
+	}{
+		{getModifiedFilesOutput{Files: []string{"a.txt", "dir/b.txt"}, Error: "ignored"}, "a.txt\ndir/b.txt\n"},
+		{lsRefsOutput{Refs: []*nichegit.RefInfo{{Name: "refs/heads/main", Hash: "3c2a3fdc388747b9eaf4a4a4f2035c1c9ddb26d0"}}}, "3c2a3fdc388747b9eaf4a4a4f2035c1c9ddb26d0\trefs/heads/main\n"},
+		{getModifiedFilesOutput{Files: []string{}}, ""},
+	}
+	for _, tt := range tests {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			t.Fatal(err)
+		}
+		if err := inv.writeJSON(path, tt.output); err != nil {
+			t.Fatal(err)
+		}
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("writeJSON(%T) = %q, want %q", tt.output, got, tt.want)
+		}
+	}
+	if err := inv.writeJSON(path, cherryPickOutput{}); err == nil {
+		t.Errorf("writeJSON of an output without the plain format succeeded")
+	}
+}
+
+func TestWriteJSONQuiet(t *testing.T) {
+	inv := newInvocation()
+	inv.quietOutput = true
+	path := filepath.Join(t.TempDir(), "output")
+	fetchDebugInfo := debug.FetchDebugInfo{PackfileSize: 10}
+	pushDebugInfo := &debug.PushDebugInfo{PackfileSize: 20}
+	if err := inv.writeJSON(path, cherryPickOutput{
+		CommitHash:     "3c2a3fdc388747b9eaf4a4a4f2035c1c9ddb26d0",
+		Commits:        []*cherryPickedCommit{},
+		NewObjects:     3,
+		FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+		PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+		Error:          "failed",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The debug info is omitted, and the other keys are in the order of the fields.
+	want := `{
+  "commitHash": "3c2a3fdc388747b9eaf4a4a4f2035c1c9ddb26d0",
+  "commits": [],
+  "newObjects": 3,
+  "error": "failed"
+}
+`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("writeJSON diff (-want +got):\n%s", diff)
+	}
+}
@@ -4,60 +4,71 @@
 package cmd
 
 import (
-	"net/http"
+	"fmt"
+	"io"
 
 	nichegit "github.com/aviator-co/niche-git"
 	"github.com/aviator-co/niche-git/debug"
 	"github.com/spf13/cobra"
 )
 
-var (
-	lsRefsArgs struct {
+// newLsRefsCmd returns the ls-refs command.
+func newLsRefsCmd(inv *invocation) *cobra.Command {
+	var lsRefsArgs struct {
 		repoURL     string
 		refPrefixes []string
 
 		outputFile string
 	}
-)
-
-var lsRefsCmd = &cobra.Command{
-	Use: "ls-refs",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		client := &http.Client{Transport: &authnRoundtripper{}}
-		refs, debugInfo, fetchErr := nichegit.LsRefs(lsRefsArgs.repoURL, client, lsRefsArgs.refPrefixes)
-		if refs == nil {
-			// Always create an empty slice for JSON output.
-			refs = []*nichegit.RefInfo{}
-		}
-		output := lsRefsOutput{
-			Refs:      refs,
-			DebugInfo: debugInfo,
-		}
-		if fetchErr != nil {
-			output.Error = fetchErr.Error()
-		}
-		if err := writeJSON(lsRefsArgs.outputFile, output); err != nil {
-			return err
-		}
-		return fetchErr
-	},
-}
 
-type lsRefsOutput struct {
-	Refs      []*nichegit.RefInfo   `json:"refs"`
-	DebugInfo debug.LsRefsDebugInfo `json:"debugInfo"`
-	Error     string                `json:"error,omitempty"`
-}
+	lsRefsCmd := &cobra.Command{
+		Use: "ls-refs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			refs, debugInfo, fetchErr := nichegit.LsRefs(lsRefsArgs.repoURL, client, lsRefsArgs.refPrefixes)
+			if refs == nil {
+				// Always create an empty slice for JSON output.
+				refs = []*nichegit.RefInfo{}
+			}
+			output := lsRefsOutput{
+				Refs:      refs,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(lsRefsArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
 
-func init() {
-	rootCmd.AddCommand(lsRefsCmd)
 	lsRefsCmd.Flags().StringVar(&lsRefsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
 	lsRefsCmd.Flags().StringSliceVar(&lsRefsArgs.refPrefixes, "ref-prefixes", nil, "Ref prefixes")
 	_ = lsRefsCmd.MarkFlagRequired("repo-url")
 
-	lsRefsCmd.Flags().StringVar(&authzHeader, "authz-header", "", "Optional authorization header")
-	lsRefsCmd.Flags().StringVar(&basicAuthzUser, "basic-authz-user", "", "Optional HTTP Basic Auth user")
-	lsRefsCmd.Flags().StringVar(&basicAuthzPassword, "basic-authz-password", "", "Optional HTTP Basic Auth password")
+	inv.addOperationFlags(lsRefsCmd)
 
 	lsRefsCmd.Flags().StringVar(&lsRefsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	lsRefsCmd.Flags().StringVar(&inv.outputFormat, "output-format", outputFormatJSON, "Output format: json, ndjson to write each element of the lists as a line and then the other fields as the last line, or plain to write each ref as the hash and the name separated by a tab")
+	return lsRefsCmd
+}
+
+type lsRefsOutput struct {
+	Refs      []*nichegit.RefInfo    `json:"refs"`
+	DebugInfo *debug.LsRefsDebugInfo `json:"debugInfo,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode     `json:"errorCode,omitempty"`
+}
+
+// writePlain writes the refs as "<hash>\t<name>" lines.
+func (o lsRefsOutput) writePlain(w io.Writer) error {
+	for _, ref := range o.Refs {
+		if _, err := fmt.Fprintf(w, "%s\t%s\n", ref.Hash, ref.Name); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,204 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newMergeBranchesCmd returns the merge-branches command.
+func newMergeBranchesCmd(inv *invocation) *cobra.Command {
+	var mergeBranchesArgs struct {
+		repoURL         string
+		into            string
+		from            string
+		fromFirstParent bool
+		embedMergeTag   bool
+		commitMessage   string
+		author          string
+		authorEmail     string
+		authorTime      string
+		committer       string
+		committerEmail  string
+		committerTime   string
+		ref             string
+		currentRefHash  string
+		abortOnConflict bool
+		includeHunks    bool
+		stubThreshold   int64
+		renameThreshold int
+
+		outputFile string
+	}
+
+	mergeBranches := &cobra.Command{
+		Use: "merge-branches",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var currentRefhash *plumbing.Hash
+			if mergeBranchesArgs.currentRefHash != "" {
+				hash := plumbing.NewHash(mergeBranchesArgs.currentRefHash)
+				currentRefhash = &hash
+			}
+			author, err := newSignature(mergeBranchesArgs.author, mergeBranchesArgs.authorEmail, mergeBranchesArgs.authorTime)
+			if err != nil {
+				return err
+			}
+			committer, err := newSignature(mergeBranchesArgs.committer, mergeBranchesArgs.committerEmail, mergeBranchesArgs.committerTime)
+			if err != nil {
+				return err
+			}
+			timezone, err := inv.parseTimezone()
+			if err != nil {
+				return err
+			}
+			signer, err := inv.newSigner()
+			if err != nil {
+				return err
+			}
+			trailers, err := inv.parseTrailers()
+			if err != nil {
+				return err
+			}
+			additional, err := inv.parseAdditionalRefUpdates()
+			if err != nil {
+				return err
+			}
+
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.MergeBranches(
+				mergeBranchesArgs.repoURL,
+				client,
+				nichegit.MergeBranchesArgs{
+					Into:                      mergeBranchesArgs.into,
+					From:                      mergeBranchesArgs.from,
+					FromFirstParent:           mergeBranchesArgs.fromFirstParent,
+					EmbedMergeTag:             mergeBranchesArgs.embedMergeTag,
+					CommitMessage:             mergeBranchesArgs.commitMessage,
+					Author:                    author,
+					Committer:                 committer,
+					Timezone:                  timezone,
+					Trailers:                  trailers,
+					SignOff:                   inv.signOff,
+					ProtectedPaths:            inv.protectedPaths,
+					AllowProtectedPathChanges: inv.allowProtectedPathChanges,
+					Ref:                       plumbing.ReferenceName(mergeBranchesArgs.ref),
+					CurrentRefHash:            currentRefhash,
+					AbortOnConflict:           mergeBranchesArgs.abortOnConflict,
+					IncludeConflictHunks:      mergeBranchesArgs.includeHunks,
+					ConflictStubThreshold:     mergeBranchesArgs.stubThreshold,
+					RenameThreshold:           mergeBranchesArgs.renameThreshold,
+					Signer:                    signer,
+					AdditionalRefUpdates:      additional,
+					PackOptions:               inv.packOptions(),
+				},
+			)
+			output := mergeBranchesOutput{
+				FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.ProtectedFiles = result.ProtectedFiles
+				output.CommitHash = result.CommitHash.String()
+				output.MergeBase = result.MergeBase.String()
+				output.UpToDate = result.UpToDate
+				output.MergedFiles = result.MergedFiles
+				output.ConflictResolvedFiles = result.ConflictResolvedFiles
+				output.ConflictOpenFiles = result.ConflictOpenFiles
+				output.ConflictStubFiles = result.ConflictStubFiles
+				output.ConflictSubmodules = result.ConflictSubmodules
+				output.ConflictContents = result.ConflictContents
+				output.Renames = result.Renames
+			}
+			if output.MergedFiles == nil {
+				output.MergedFiles = []string{}
+			}
+			if output.ConflictResolvedFiles == nil {
+				output.ConflictResolvedFiles = []string{}
+			}
+			if output.ConflictOpenFiles == nil {
+				output.ConflictOpenFiles = []string{}
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(mergeBranchesArgs.outputFile, output); err != nil {
+				return err
+			}
+			inv.opStats.conflicted = len(output.ConflictOpenFiles) > 0
+			if pushErr == nil && inv.failOnConflict && len(output.ConflictOpenFiles) > 0 {
+				return &nichegit.ConflictError{Files: output.ConflictOpenFiles}
+			}
+			return pushErr
+		},
+	}
+
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.into, "into", "", "Revision to merge into (e.g. refs/heads/main). It becomes the first parent")
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.from, "from", "", "Revision to merge. It becomes the second parent")
+	mergeBranches.Flags().BoolVar(&mergeBranchesArgs.fromFirstParent, "from-first-parent", false, "Make --from the first parent and --into the second parent")
+	mergeBranches.Flags().BoolVar(&mergeBranchesArgs.embedMergeTag, "embed-merge-tag", false, "If --from is a signed annotated tag, embed it in the mergetag header of the merge commit like git merge")
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.commitMessage, "commit-message", "", "Optional commit message of the merge commit. Defaults to the git-merge style message")
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.author, "author", "", "Author name")
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.authorEmail, "author-email", "", "Author email address")
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.authorTime, "author-time", "", "Author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.committer, "committer", "", "Commiter name")
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.committerEmail, "committer-email", "", "Commiter email address")
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.committerTime, "committer-time", "", "Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	mergeBranches.Flags().StringVar(&inv.signatureTimezone, "timezone", "", "Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used")
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.ref, "ref", "", "A ref name (e.g. refs/heads/foobar) to push")
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.currentRefHash, "current-ref-hash", "", "The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.")
+	mergeBranches.Flags().StringArrayVar(&inv.additionalRefUpdates, "additional-ref-update", nil, "Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times")
+	mergeBranches.Flags().BoolVar(&mergeBranchesArgs.abortOnConflict, "abort-on-conflict", false, "Abort the operation if there is a merge conflict")
+	mergeBranches.Flags().BoolVar(&mergeBranchesArgs.includeHunks, "include-conflict-hunks", false, "Include the conflicting hunks of the files with conflicts in the output")
+	mergeBranches.Flags().Int64Var(&mergeBranchesArgs.stubThreshold, "conflict-stub-threshold", 0, "Optional size in bytes beyond which a conflicted file is written as a conflict stub that refers to the blobs of the sides instead of having the conflict markers. Zero, which is the default, always writes the conflict markers")
+	mergeBranches.Flags().IntVar(&mergeBranchesArgs.renameThreshold, "rename-threshold", 0, "Optional similarity in percent (e.g. 50, which is git's default) for a deleted file and an added file to be a rename. The changes to the old path are applied to the renamed file. Zero, which is the default, disables the rename detection")
+	mergeBranches.Flags().StringArrayVar(&inv.commitTrailers, "trailer", nil, "Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. \"Co-authored-by: Foo <foo@example.com>\"). A trailer already in the message is not added again. Can be specified multiple times")
+	mergeBranches.Flags().BoolVar(&inv.signOff, "signoff", false, "Add a Signed-off-by trailer for the committer to the commit message")
+	inv.addProtectedPathFlags(mergeBranches)
+	_ = mergeBranches.MarkFlagRequired("repo-url")
+	_ = mergeBranches.MarkFlagRequired("into")
+	_ = mergeBranches.MarkFlagRequired("from")
+	_ = mergeBranches.MarkFlagRequired("author")
+	_ = mergeBranches.MarkFlagRequired("author-email")
+	_ = mergeBranches.MarkFlagRequired("committer")
+	_ = mergeBranches.MarkFlagRequired("committer-email")
+	_ = mergeBranches.MarkFlagRequired("ref")
+
+	inv.addOperationFlags(mergeBranches)
+
+	inv.addSigningFlags(mergeBranches, "commit")
+
+	inv.addPackFlags(mergeBranches)
+
+	mergeBranches.Flags().BoolVar(&inv.failOnConflict, "fail-on-conflict", false, "Exit with a non-zero status if conflicts are reported, even if the commit is pushed")
+	mergeBranches.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	inv.addPushTimeoutFlags(mergeBranches)
+	inv.addFetchFlags(mergeBranches)
+	inv.addObjectStorageFlag(mergeBranches)
+	mergeBranches.Flags().StringVar(&mergeBranchesArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(mergeBranches)
+	return mergeBranches
+}
+
+type mergeBranchesOutput struct {
+	CommitHash            string                      `json:"commitHash"`
+	MergeBase             string                      `json:"mergeBase"`
+	UpToDate              bool                        `json:"upToDate"`
+	MergedFiles           []string                    `json:"mergedFiles"`
+	ConflictResolvedFiles []string                    `json:"conflictResolvedFiles"`
+	ConflictOpenFiles     []string                    `json:"conflictOpenFiles"`
+	ConflictStubFiles     []string                    `json:"conflictStubFiles,omitempty"`
+	ConflictSubmodules    []string                    `json:"conflictSubmodules,omitempty"`
+	ConflictContents      []*nichegit.ConflictContent `json:"conflictContents,omitempty"`
+	Renames               []*nichegit.RenamedFile     `json:"renames,omitempty"`
+	ProtectedFiles        []string                    `json:"protectedFiles,omitempty"`
+	FetchDebugInfo        *debug.FetchDebugInfo       `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo         *debug.PushDebugInfo        `json:"pushDebugInfo,omitempty"`
+	Error                 string                      `json:"error,omitempty"`
+	ErrorCode             nichegit.ErrorCode          `json:"errorCode,omitempty"`
+}
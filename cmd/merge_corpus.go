@@ -0,0 +1,98 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"fmt"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/internal/merge"
+	"github.com/spf13/cobra"
+)
+
+// mergeTest is a developer command that runs the merge of the files on a corpus of the cases and
+// reports the files that are merged differently from the expected ones. See merge.RunCorpusCase
+// for the layout of a case.
+func newMergeCorpusCmd(inv *invocation) *cobra.Command {
+	var mergeTestArgs struct {
+		corpusDir string
+
+		outputFile string
+	}
+
+	mergeTest := &cobra.Command{
+		Use: "merge-test",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			results, runErr := merge.RunCorpus(mergeTestArgs.corpusDir)
+			output := mergeTestOutput{Cases: []*mergeTestCase{}}
+			for _, result := range results {
+				c := &mergeTestCase{
+					Name:          result.Name,
+					ConflictFiles: result.FilesConflict,
+					Mismatches:    []*mergeTestMismatch{},
+				}
+				if c.ConflictFiles == nil {
+					c.ConflictFiles = []string{}
+				}
+				for _, m := range result.Mismatches {
+					c.Mismatches = append(c.Mismatches, &mergeTestMismatch{
+						Path:     m.Path,
+						Expected: contentString(m.Expected),
+						Actual:   contentString(m.Actual),
+					})
+				}
+				if len(c.Mismatches) > 0 {
+					output.Failed++
+				}
+				output.Cases = append(output.Cases, c)
+			}
+			if runErr == nil && output.Failed > 0 {
+				runErr = fmt.Errorf("%d of %d cases failed", output.Failed, len(output.Cases))
+			}
+			if runErr != nil {
+				output.Error = runErr.Error()
+				output.ErrorCode = inv.errorCode(runErr)
+			}
+			if err := inv.writeJSON(mergeTestArgs.outputFile, output); err != nil {
+				return err
+			}
+			return runErr
+		},
+	}
+
+	mergeTest.Flags().StringVar(&mergeTestArgs.corpusDir, "corpus-dir", "", "Directory of the cases. Each case is a directory with base, ours, theirs, and expected directories")
+	_ = mergeTest.MarkFlagRequired("corpus-dir")
+
+	mergeTest.Flags().StringVar(&mergeTestArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(mergeTest)
+	return mergeTest
+}
+
+type mergeTestOutput struct {
+	Cases     []*mergeTestCase   `json:"cases"`
+	Failed    int                `json:"failed"`
+	Error     string             `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode `json:"errorCode,omitempty"`
+}
+
+type mergeTestCase struct {
+	Name          string               `json:"name"`
+	ConflictFiles []string             `json:"conflictFiles"`
+	Mismatches    []*mergeTestMismatch `json:"mismatches"`
+}
+
+type mergeTestMismatch struct {
+	Path string `json:"path"`
+	// Expected and Actual are null if the file doesn't exist on the side.
+	Expected *string `json:"expected"`
+	Actual   *string `json:"actual"`
+}
+
+func contentString(content []byte) *string {
+	if content == nil {
+		return nil
+	}
+	s := string(content)
+	return &s
+}
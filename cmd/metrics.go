@@ -0,0 +1,115 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// commandDurationBuckets are the upper bounds of the command duration histogram in seconds.
+var commandDurationBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+// serveMetrics is the per-command metrics of serve. They are written in the Prometheus text
+// format.
+type serveMetrics struct {
+	mu       sync.Mutex
+	commands map[string]*commandMetrics
+}
+
+type commandMetrics struct {
+	// runs is the number of the runs by the exit code.
+	runs map[int]int64
+	// durationBuckets is the number of the runs within each of commandDurationBuckets.
+	durationBuckets []int64
+	durationSum     float64
+	durationCount   int64
+	fetchedBytes    int64
+	pushedBytes     int64
+	conflicts       int64
+}
+
+func newServeMetrics() *serveMetrics {
+	return &serveMetrics{commands: map[string]*commandMetrics{}}
+}
+
+// record adds a run of the command.
+func (m *serveMetrics) record(command string, exitCode int, duration time.Duration, fetchedBytes, pushedBytes int64, conflicted bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.commands[command]
+	if !ok {
+		c = &commandMetrics{runs: map[int]int64{}, durationBuckets: make([]int64, len(commandDurationBuckets))}
+		m.commands[command] = c
+	}
+	c.runs[exitCode]++
+	seconds := duration.Seconds()
+	for i, le := range commandDurationBuckets {
+		if seconds <= le {
+			c.durationBuckets[i]++
+		}
+	}
+	c.durationSum += seconds
+	c.durationCount++
+	c.fetchedBytes += fetchedBytes
+	c.pushedBytes += pushedBytes
+	if conflicted || exitCode == exitCodeConflict {
+		c.conflicts++
+	}
+}
+
+// write writes the metrics in the Prometheus text format.
+func (m *serveMetrics) write(w io.Writer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var commands []string
+	for command := range m.commands {
+		commands = append(commands, command)
+	}
+	sort.Strings(commands)
+
+	var writeErr error
+	write := func(format string, args ...any) {
+		if writeErr == nil {
+			_, writeErr = fmt.Fprintf(w, format, args...)
+		}
+	}
+	writeCounter := func(name, help string, value func(c *commandMetrics) int64) {
+		write("# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+		for _, command := range commands {
+			write("%s{command=%q} %d\n", name, command, value(m.commands[command]))
+		}
+	}
+
+	write("# HELP niche_git_commands_total Number of the command runs by the exit code.\n# TYPE niche_git_commands_total counter\n")
+	for _, command := range commands {
+		c := m.commands[command]
+		var exitCodes []int
+		for exitCode := range c.runs {
+			exitCodes = append(exitCodes, exitCode)
+		}
+		sort.Ints(exitCodes)
+		for _, exitCode := range exitCodes {
+			write("niche_git_commands_total{command=%q,exit_code=\"%d\"} %d\n", command, exitCode, c.runs[exitCode])
+		}
+	}
+	write("# HELP niche_git_command_duration_seconds Duration of the command runs.\n# TYPE niche_git_command_duration_seconds histogram\n")
+	for _, command := range commands {
+		c := m.commands[command]
+		for i, le := range commandDurationBuckets {
+			write("niche_git_command_duration_seconds_bucket{command=%q,le=%q} %d\n", command, strconv.FormatFloat(le, 'g', -1, 64), c.durationBuckets[i])
+		}
+		write("niche_git_command_duration_seconds_bucket{command=%q,le=\"+Inf\"} %d\n", command, c.durationCount)
+		write("niche_git_command_duration_seconds_sum{command=%q} %s\n", command, strconv.FormatFloat(c.durationSum, 'g', -1, 64))
+		write("niche_git_command_duration_seconds_count{command=%q} %d\n", command, c.durationCount)
+	}
+	writeCounter("niche_git_fetched_bytes_total", "Size of the upload-pack responses.", func(c *commandMetrics) int64 { return c.fetchedBytes })
+	writeCounter("niche_git_pushed_bytes_total", "Size of the receive-pack requests.", func(c *commandMetrics) int64 { return c.pushedBytes })
+	writeCounter("niche_git_conflicts_total", "Number of the command runs that reported conflicts.", func(c *commandMetrics) int64 { return c.conflicts })
+	return writeErr
+}
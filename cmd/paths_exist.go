@@ -0,0 +1,68 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newPathsExistCmd returns the paths-exist command.
+func newPathsExistCmd(inv *invocation) *cobra.Command {
+	var pathsExistArgs struct {
+		repoURL    string
+		commitHash string
+		paths      []string
+
+		outputFile string
+	}
+
+	pathsExistCmd := &cobra.Command{
+		Use: "paths-exist",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			paths, debugInfo, fetchErr := nichegit.PathsExist(pathsExistArgs.repoURL, client, plumbing.NewHash(pathsExistArgs.commitHash), pathsExistArgs.paths)
+			if paths == nil {
+				// Always create an empty slice for JSON output.
+				paths = []*nichegit.PathExistence{}
+			}
+			output := pathsExistOutput{
+				Paths:     paths,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(pathsExistArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	pathsExistCmd.Flags().StringVar(&pathsExistArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	pathsExistCmd.Flags().StringVar(&pathsExistArgs.commitHash, "commit-hash", "", "Commit hash to look up the paths in")
+	pathsExistCmd.Flags().StringSliceVar(&pathsExistArgs.paths, "paths", nil, "Paths to check")
+	_ = pathsExistCmd.MarkFlagRequired("repo-url")
+	_ = pathsExistCmd.MarkFlagRequired("commit-hash")
+	_ = pathsExistCmd.MarkFlagRequired("paths")
+
+	inv.addOperationFlags(pathsExistCmd)
+
+	inv.addFetchFlags(pathsExistCmd)
+	inv.addObjectStorageFlag(pathsExistCmd)
+	pathsExistCmd.Flags().StringVar(&pathsExistArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(pathsExistCmd)
+	return pathsExistCmd
+}
+
+type pathsExistOutput struct {
+	Paths     []*nichegit.PathExistence `json:"paths"`
+	DebugInfo *debug.FetchDebugInfo     `json:"debugInfo,omitempty"`
+	Error     string                    `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode        `json:"errorCode,omitempty"`
+}
@@ -0,0 +1,290 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/spf13/cobra"
+)
+
+// pipe runs the commands read as a JSON array of pipeCommand in one process, and writes a JSON
+// array of pipeOutput in the same order. The outputs are streamed as the commands finish.
+//
+// The commands share the HTTP connections, the ls-refs results, and the object cache if enabled.
+// The authorization flags of pipe apply to the commands that don't specify their own. With a
+// ResultStore, the successful outputs are stored and the identical runs are skipped.
+func newPipeCmd(inv *invocation) *cobra.Command {
+	var pipeArgs struct {
+		inputFile       string
+		lsRefsCacheTTL  time.Duration
+		objectCacheSize int64
+		resultStoreDir  string
+		authz           commandAuthz
+
+		outputFile string
+	}
+
+	pipe := &cobra.Command{
+		Use: "pipe",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var input io.Reader = os.Stdin
+			if pipeArgs.inputFile != "-" {
+				file, err := os.Open(pipeArgs.inputFile)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				input = file
+			}
+			var commands []*pipeCommand
+			if err := json.NewDecoder(input).Decode(&commands); err != nil {
+				return fmt.Errorf("cannot parse the input: %w", err)
+			}
+
+			var of io.Writer = inv.stdout
+			if pipeArgs.outputFile != "-" {
+				file, err := os.OpenFile(pipeArgs.outputFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				of = file
+			}
+
+			nichegit.SetLsRefsCacheTTL(pipeArgs.lsRefsCacheTTL)
+			defer nichegit.SetLsRefsCacheTTL(0)
+			nichegit.SetObjectCacheSize(pipeArgs.objectCacheSize)
+			defer nichegit.SetObjectCacheSize(0)
+			if pipeArgs.resultStoreDir != "" {
+				store, err := nichegit.NewDirResultStore(pipeArgs.resultStoreDir)
+				if err != nil {
+					return err
+				}
+				defer func(orig nichegit.ResultStore) { resultStore = orig }(resultStore)
+				resultStore = store
+			}
+
+			if _, err := io.WriteString(of, "["); err != nil {
+				return err
+			}
+			failed := 0
+			for i, c := range commands {
+				sep := ",\n  "
+				if i == 0 {
+					sep = "\n  "
+				}
+				if _, err := io.WriteString(of, sep); err != nil {
+					return err
+				}
+				output, err := newInvocation().writePipeOutput(of, c, pipeArgs.authz, "  ")
+				if err != nil {
+					return err
+				}
+				if output.ExitCode != exitCodeOK {
+					failed++
+				}
+			}
+			if _, err := io.WriteString(of, "\n]\n"); err != nil {
+				return err
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d commands failed", failed, len(commands))
+			}
+			return nil
+		},
+	}
+
+	pipe.Flags().StringVar(&pipeArgs.inputFile, "input-file", "-", "Optional input file path. '-', which is the default, means stdin")
+	pipe.Flags().DurationVar(&pipeArgs.lsRefsCacheTTL, "ls-refs-cache-ttl", 30*time.Second, "Duration to reuse the ls-refs results across the commands. A push to the repository drops them. Zero disables it")
+	pipe.Flags().Int64Var(&pipeArgs.objectCacheSize, "object-cache-size", 0, "Size in bytes of the commits and the trees to reuse across the commands. Zero, which is the default, disables it")
+	pipe.Flags().StringVar(&pipeArgs.resultStoreDir, "result-store-dir", "", "Optional directory to store the outputs of the read commands that take commit hashes in. Rerunning a command with the same arguments returns the stored output instead. See the README for the commands")
+
+	pipe.Flags().StringVar(&pipeArgs.authz.header, "authz-header", "", "Optional authorization header")
+	pipe.Flags().StringVar(&pipeArgs.authz.basicUser, "basic-authz-user", "", "Optional HTTP Basic Auth user")
+	pipe.Flags().StringVar(&pipeArgs.authz.basicPassword, "basic-authz-password", "", "Optional HTTP Basic Auth password")
+
+	pipe.Flags().StringVar(&pipeArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	return pipe
+}
+
+// pipeCommand is an element of the pipe input.
+type pipeCommand struct {
+	// Command is the name of the command (e.g. get-modified-files).
+	Command string `json:"command"`
+	// Args are the command line arguments of the command.
+	Args []string `json:"args"`
+}
+
+// commandAuthz is the authorization that applies to the commands run in the process that don't
+// specify their own.
+type commandAuthz struct {
+	header        string
+	basicUser     string
+	basicPassword string
+}
+
+// pipeOutput is an element of the pipe output.
+type pipeOutput struct {
+	Command string `json:"command"`
+	// Output is the JSON output of the command. Null if the command didn't write one, e.g. the
+	// arguments are invalid or --output-file is specified.
+	Output    json.RawMessage    `json:"output"`
+	ExitCode  int                `json:"exitCode"`
+	Error     string             `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode `json:"errorCode,omitempty"`
+	// Cached is true if the output is a stored result of an identical run. See ResultStore.
+	Cached bool `json:"cached,omitempty"`
+}
+
+// writePipeOutput runs the command in the process with the state of inv and writes its
+// pipeOutput, indented with the prefix. inv must be a new invocation for each command. The JSON output of the command is streamed into the Output field as it's written. The
+// returned pipeOutput doesn't have the Output. The error is returned only if writing fails.
+func (inv *invocation) writePipeOutput(w io.Writer, c *pipeCommand, authz commandAuthz, prefix string) (*pipeOutput, error) {
+	ret := &pipeOutput{Command: c.Command}
+	command, err := json.Marshal(c.Command)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fmt.Fprintf(w, "{\n%s  \"command\": %s,\n%s  \"output\": ", prefix, command, prefix); err != nil {
+		return nil, err
+	}
+	out := &indentWriter{w: w, prefix: prefix + "  "}
+	sub, err := findPipeCommand(inv, c.Command)
+	if err == nil {
+		ret.Cached, err = inv.executePipeCommand(sub, c.Args, authz, out)
+	}
+	if out.err != nil {
+		return nil, out.err
+	}
+	if !out.written {
+		if _, err := io.WriteString(w, "null"); err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		ret.ExitCode = inv.exitCode(err)
+		ret.Error = err.Error()
+		ret.ErrorCode = inv.errorCode(err)
+	}
+	if _, err := fmt.Fprintf(w, ",\n%s  \"exitCode\": %d", prefix, ret.ExitCode); err != nil {
+		return nil, err
+	}
+	if ret.Error != "" {
+		errorJSON, err := json.Marshal(ret.Error)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := fmt.Fprintf(w, ",\n%s  \"error\": %s", prefix, errorJSON); err != nil {
+			return nil, err
+		}
+	}
+	if ret.ErrorCode != "" {
+		if _, err := fmt.Fprintf(w, ",\n%s  \"errorCode\": %q", prefix, ret.ErrorCode); err != nil {
+			return nil, err
+		}
+	}
+	if ret.Cached {
+		if _, err := fmt.Fprintf(w, ",\n%s  \"cached\": true", prefix); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "\n%s}", prefix); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// indentWriter indents the JSON written by a command with the prefix so that it can be embedded
+// in another indented JSON. The trailing newline is dropped.
+type indentWriter struct {
+	w       io.Writer
+	prefix  string
+	written bool
+	// newline is true if a newline is written but the prefix for the next line is not yet.
+	newline bool
+	err     error
+}
+
+func (iw *indentWriter) Write(p []byte) (int, error) {
+	if iw.err != nil {
+		return 0, iw.err
+	}
+	var buf bytes.Buffer
+	for _, b := range p {
+		if iw.newline {
+			buf.WriteByte('\n')
+			buf.WriteString(iw.prefix)
+			iw.newline = false
+		}
+		// JSON strings don't have raw newlines, so all of them are between the tokens.
+		if b == '\n' {
+			iw.newline = true
+			continue
+		}
+		buf.WriteByte(b)
+	}
+	if buf.Len() > 0 {
+		iw.written = true
+		if _, err := iw.w.Write(buf.Bytes()); err != nil {
+			iw.err = err
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// findPipeCommand returns the command that can run in the process. The command is newly created
+// with the state of inv, so the commands found with different invocations can run concurrently.
+func findPipeCommand(inv *invocation, name string) (*cobra.Command, error) {
+	root := newRootCmd(inv)
+	sub, _, err := root.Find([]string{name})
+	if err != nil || sub == root || sub.Name() == "pipe" || sub.Name() == "serve" || sub.Name() == "grpc-serve" || sub.RunE == nil {
+		return nil, fmt.Errorf("unknown command %q", name)
+	}
+	return sub, nil
+}
+
+// executePipeCommand runs the command. The returned bool is true if the command got a stored
+// result from the ResultStore.
+func (inv *invocation) executePipeCommand(sub *cobra.Command, args []string, authz commandAuthz, out io.Writer) (bool, error) {
+	if err := sub.ParseFlags(args); err != nil {
+		return false, err
+	}
+	return inv.runParsedCommand(sub, authz, out)
+}
+
+// runParsedCommand runs the command whose flags are set, and writes its JSON output to out. The
+// returned bool is true if the command got a stored result from the ResultStore.
+func (inv *invocation) runParsedCommand(sub *cobra.Command, authz commandAuthz, out io.Writer) (bool, error) {
+	if err := sub.ValidateRequiredFlags(); err != nil {
+		return false, err
+	}
+	if inv.outputFormat == outputFormatNDJSON || inv.outputFormat == outputFormatPlain {
+		// The output is embedded in the JSON output of pipe.
+		return false, fmt.Errorf("--output-format=%s cannot be used in pipe or serve", inv.outputFormat)
+	}
+	if !sub.Flags().Changed("authz-header") && !sub.Flags().Changed("basic-authz-user") && !sub.Flags().Changed("basic-authz-password") {
+		inv.authzHeader = authz.header
+		inv.basicAuthzUser = authz.basicUser
+		inv.basicAuthzPassword = authz.basicPassword
+	}
+	if err := sub.Root().PersistentPreRunE(sub, sub.Flags().Args()); err != nil {
+		return false, err
+	}
+	defer func() {
+		if inv.operationCancel != nil {
+			inv.operationCancel()
+		}
+	}()
+	inv.stdout = out
+	err := sub.RunE(sub, sub.Flags().Args())
+	return inv.opStats.storedResult.Load(), err
+}
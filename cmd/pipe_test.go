@@ -0,0 +1,125 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aviator-co/niche-git/nichegittest"
+)
+
+// runPipeCommand runs the command with writePipeOutput and parses the output.
+func runPipeCommand(c *pipeCommand, authz commandAuthz) *pipeOutput {
+	var buf bytes.Buffer
+	if _, err := newInvocation().writePipeOutput(&buf, c, authz, "  "); err != nil {
+		panic(err)
+	}
+	var ret pipeOutput
+	if err := json.Unmarshal(buf.Bytes(), &ret); err != nil {
+		panic(fmt.Sprintf("invalid output: %v\n%s", err, buf.String()))
+	}
+	if string(ret.Output) == "null" {
+		ret.Output = nil
+	}
+	return &ret
+}
+
+func TestRunPipeCommand(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+
+	args := []string{"--repo-url", repo.FileURL(), "--commit-hash1", base.String(), "--commit-hash2", head.String()}
+	quiet := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: append(args, "--debug-level", "none")}, commandAuthz{})
+	if quiet.ExitCode != exitCodeOK {
+		t.Fatalf("get-modified-files failed: %s", quiet.Error)
+	}
+	// --debug-level of the previous run must not leak into this one.
+	verbose := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: args}, commandAuthz{})
+	if verbose.ExitCode != exitCodeOK {
+		t.Fatalf("get-modified-files failed: %s", verbose.Error)
+	}
+	var quietOut, verboseOut map[string]json.RawMessage
+	if err := json.Unmarshal(quiet.Output, &quietOut); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(verbose.Output, &verboseOut); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := quietOut["debugInfo"]; ok {
+		t.Errorf("the debug info is not omitted with --debug-level none: %s", quiet.Output)
+	}
+	if _, ok := verboseOut["debugInfo"]; !ok {
+		t.Errorf("the debug info is omitted with the default debug level: %s", verbose.Output)
+	}
+	var files []string
+	if err := json.Unmarshal(verboseOut["files"], &files); err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0] != "b.txt" {
+		t.Errorf("files is %q, want b.txt", files)
+	}
+
+	missing := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: []string{"--repo-url", repo.FileURL()}}, commandAuthz{})
+	if missing.ExitCode != exitCodeError || missing.Output != nil {
+		t.Errorf("expected a failure without the required flags: %+v", missing)
+	}
+	unknown := runPipeCommand(&pipeCommand{Command: "pipe"}, commandAuthz{})
+	if unknown.ExitCode != exitCodeError {
+		t.Errorf("expected pipe to be rejected: %+v", unknown)
+	}
+}
+
+func TestRunPipeCommandGetCommitsBounded(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "1\n", "1")
+	repo.CommitFile("a.txt", "2\n", "2")
+	repo.CommitFile("a.txt", "3\n", "3")
+	head := repo.CommitFile("a.txt", "4\n", "4")
+	repo.Push("main")
+
+	args := []string{"--repo-url", repo.FileURL(), "--want-commit-hashes", head.String(), "--stop-at-hashes", base.String(), "--max-count", "2"}
+	out := runPipeCommand(&pipeCommand{Command: "get-commits", Args: args}, commandAuthz{})
+	if out.ExitCode != exitCodeOK {
+		t.Fatalf("get-commits failed: %s", out.Error)
+	}
+	var output struct {
+		Commits []struct {
+			Message string `json:"message"`
+		} `json:"commits"`
+	}
+	if err := json.Unmarshal(out.Output, &output); err != nil {
+		t.Fatal(err)
+	}
+	var messages []string
+	for _, c := range output.Commits {
+		messages = append(messages, strings.TrimSpace(c.Message))
+	}
+	if len(messages) != 2 || messages[0] != "4" || messages[1] != "3" {
+		t.Errorf("messages are %q, want 4 and 3", messages)
+	}
+
+	// --max-count of the previous run must not leak into this one.
+	args = []string{"--repo-url", repo.FileURL(), "--want-commit-hashes", head.String(), "--stop-at-hashes", base.String()}
+	out = runPipeCommand(&pipeCommand{Command: "get-commits", Args: args}, commandAuthz{})
+	if out.ExitCode != exitCodeOK {
+		t.Fatalf("get-commits failed: %s", out.Error)
+	}
+	if err := json.Unmarshal(out.Output, &output); err != nil {
+		t.Fatal(err)
+	}
+	if len(output.Commits) != 3 {
+		t.Errorf("got %d commits, want 3", len(output.Commits))
+	}
+
+	invalid := runPipeCommand(&pipeCommand{Command: "get-commits", Args: append(args, "--since", "yesterday")}, commandAuthz{})
+	if invalid.ExitCode != exitCodeError {
+		t.Errorf("expected a failure for the invalid --since: %+v", invalid)
+	}
+}
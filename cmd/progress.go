@@ -0,0 +1,77 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+)
+
+// progressInterval is the minimum interval of the progress lines of the same phase.
+const progressInterval = 500 * time.Millisecond
+
+// progressPrinter prints the progress of the fetches for --progress. The lines that the server
+// updates in place with "\r" and the receiving and the indexing progress are printed at most once
+// in progressInterval, but the last indexing progress of a packfile is always printed.
+type progressPrinter struct {
+	w   io.Writer
+	now func() time.Time
+
+	mu   sync.Mutex
+	last map[nichegit.FetchPhase]time.Time
+}
+
+func newProgressPrinter(w io.Writer) *progressPrinter {
+	return &progressPrinter{w: w, now: time.Now, last: map[nichegit.FetchPhase]time.Time{}}
+}
+
+func (p *progressPrinter) print(progress nichegit.FetchProgress) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	throttled := now.Sub(p.last[progress.Phase]) < progressInterval
+	switch progress.Phase {
+	case nichegit.FetchPhaseRemote:
+		for _, line := range splitProgressLines(progress.Message) {
+			if strings.HasSuffix(line, "\r") && throttled {
+				continue
+			}
+			fmt.Fprintf(p.w, "remote: %s\n", strings.TrimRight(line, "\r\n"))
+		}
+	case nichegit.FetchPhaseReceiving:
+		if throttled {
+			return
+		}
+		fmt.Fprintf(p.w, "Receiving packfile: %d bytes\n", progress.ReceivedBytes)
+	case nichegit.FetchPhaseIndexing:
+		if throttled && progress.IndexedObjects != progress.TotalObjects {
+			return
+		}
+		fmt.Fprintf(p.w, "Indexing objects: %d/%d\n", progress.IndexedObjects, progress.TotalObjects)
+	}
+	p.last[progress.Phase] = now
+}
+
+// splitProgressLines splits the message of the server into the lines that end with "\r" or "\n".
+// A line without the terminator is returned as is.
+func splitProgressLines(message string) []string {
+	var lines []string
+	for message != "" {
+		i := strings.IndexAny(message, "\r\n")
+		if i < 0 {
+			lines = append(lines, message)
+			break
+		}
+		if strings.TrimSpace(message[:i]) != "" {
+			lines = append(lines, message[:i+1])
+		}
+		message = message[i+1:]
+	}
+	return lines
+}
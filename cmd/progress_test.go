@@ -0,0 +1,45 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProgressPrinter(t *testing.T) {
+	var buf bytes.Buffer
+	p := newProgressPrinter(&buf)
+	now := time.Unix(0, 0)
+	p.now = func() time.Time { return now }
+
+	p.print(nichegit.FetchProgress{Phase: nichegit.FetchPhaseRemote, Message: "Counting objects:  50% (1/2)\r"})
+	// Throttled.
+	p.print(nichegit.FetchProgress{Phase: nichegit.FetchPhaseRemote, Message: "Counting objects: 100% (2/2)\r"})
+	// The lines that end with "\n" are not throttled.
+	p.print(nichegit.FetchProgress{Phase: nichegit.FetchPhaseRemote, Message: "Counting objects: 100% (2/2), done.\nTotal 2 (delta 0)\n"})
+	p.print(nichegit.FetchProgress{Phase: nichegit.FetchPhaseReceiving, ReceivedBytes: 100})
+	p.print(nichegit.FetchProgress{Phase: nichegit.FetchPhaseReceiving, ReceivedBytes: 200})
+	now = now.Add(progressInterval)
+	p.print(nichegit.FetchProgress{Phase: nichegit.FetchPhaseReceiving, ReceivedBytes: 300})
+	p.print(nichegit.FetchProgress{Phase: nichegit.FetchPhaseIndexing, IndexedObjects: 1, TotalObjects: 2})
+	// The last one is not throttled.
+	p.print(nichegit.FetchProgress{Phase: nichegit.FetchPhaseIndexing, IndexedObjects: 2, TotalObjects: 2})
+
+	want := `remote: Counting objects:  50% (1/2)
+remote: Counting objects: 100% (2/2), done.
+remote: Total 2 (delta 0)
+Receiving packfile: 100 bytes
+Receiving packfile: 300 bytes
+Indexing objects: 1/2
+Indexing objects: 2/2
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+}
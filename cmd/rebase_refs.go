@@ -0,0 +1,177 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newRebaseRefsCmd returns the rebase-refs command.
+func newRebaseRefsCmd(inv *invocation) *cobra.Command {
+	var rebaseRefsArgs struct {
+		repoURL         string
+		refPrefix       string
+		onto            string
+		committer       string
+		committerEmail  string
+		committerTime   string
+		abortOnConflict bool
+		dryRun          bool
+		pushUnaffected  bool
+		mergeCommits    string
+
+		outputFile string
+	}
+
+	rebaseRefsCmd := &cobra.Command{
+		Use: "rebase-refs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			committer, err := newSignature(rebaseRefsArgs.committer, rebaseRefsArgs.committerEmail, rebaseRefsArgs.committerTime)
+			if err != nil {
+				return err
+			}
+			timezone, err := inv.parseTimezone()
+			if err != nil {
+				return err
+			}
+			signer, err := inv.newSigner()
+			if err != nil {
+				return err
+			}
+			trailers, err := inv.parseTrailers()
+			if err != nil {
+				return err
+			}
+
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.PushRebaseRefs(
+				rebaseRefsArgs.repoURL,
+				client,
+				nichegit.PushRebaseRefsArgs{
+					RefPrefix:                 rebaseRefsArgs.refPrefix,
+					Onto:                      plumbing.NewHash(rebaseRefsArgs.onto),
+					Committer:                 committer,
+					Timezone:                  timezone,
+					Trailers:                  trailers,
+					SignOff:                   inv.signOff,
+					ProtectedPaths:            inv.protectedPaths,
+					AllowProtectedPathChanges: inv.allowProtectedPathChanges,
+					AbortOnConflict:           rebaseRefsArgs.abortOnConflict,
+					Signer:                    signer,
+					PackOptions:               inv.packOptions(),
+					DryRun:                    rebaseRefsArgs.dryRun,
+					PushUnaffectedRefs:        rebaseRefsArgs.pushUnaffected,
+					MergeCommits:              nichegit.MergeCommitMode(rebaseRefsArgs.mergeCommits),
+				},
+			)
+			output := rebaseRefsOutput{
+				// Always create an empty slice for JSON output.
+				Refs:           []*rebasedRef{},
+				FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			var conflictFiles []string
+			if result != nil {
+				output.NewObjects = result.NewObjects
+				for _, r := range result.Refs {
+					ref := &rebasedRef{
+						Name:           r.Name.String(),
+						OldHash:        r.OldHash.String(),
+						NewHash:        r.NewHash.String(),
+						Base:           r.Base.String(),
+						Commits:        []*cherryPickedCommit{},
+						Deferred:       r.Deferred,
+						ProtectedFiles: r.ProtectedFiles,
+					}
+					for _, c := range r.Commits {
+						commit := &cherryPickedCommit{
+							OriginalCommitHash: c.OriginalCommitHash.String(),
+							CommitHash:         c.CommitHash.String(),
+							CherryPickedFiles:  c.CherryPickedFiles,
+							ConflictOpenFiles:  c.ConflictOpenFiles,
+						}
+						if commit.CherryPickedFiles == nil {
+							commit.CherryPickedFiles = []string{}
+						}
+						if commit.ConflictOpenFiles == nil {
+							commit.ConflictOpenFiles = []string{}
+						}
+						ref.Commits = append(ref.Commits, commit)
+						conflictFiles = append(conflictFiles, c.ConflictOpenFiles...)
+					}
+					output.Refs = append(output.Refs, ref)
+				}
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(rebaseRefsArgs.outputFile, output); err != nil {
+				return err
+			}
+			inv.opStats.conflicted = len(conflictFiles) > 0
+			if pushErr == nil && inv.failOnConflict && len(conflictFiles) > 0 {
+				return &nichegit.ConflictError{Files: conflictFiles}
+			}
+			return pushErr
+		},
+	}
+
+	rebaseRefsCmd.Flags().StringVar(&rebaseRefsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	rebaseRefsCmd.Flags().StringVar(&rebaseRefsArgs.refPrefix, "ref-prefix", "", "Prefix of the refs to rebase (e.g. refs/heads/stack/user1/)")
+	rebaseRefsCmd.Flags().StringVar(&rebaseRefsArgs.onto, "onto", "", "Commit hash to rebase the refs onto")
+	rebaseRefsCmd.Flags().StringVar(&rebaseRefsArgs.committer, "committer", "", "Commiter name")
+	rebaseRefsCmd.Flags().StringVar(&rebaseRefsArgs.committerEmail, "committer-email", "", "Commiter email address")
+	rebaseRefsCmd.Flags().StringVar(&rebaseRefsArgs.committerTime, "committer-time", "", "Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	rebaseRefsCmd.Flags().StringVar(&inv.signatureTimezone, "timezone", "", "Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used")
+	rebaseRefsCmd.Flags().BoolVar(&rebaseRefsArgs.abortOnConflict, "abort-on-conflict", false, "Abort the operation if any commit has a merge conflict")
+	rebaseRefsCmd.Flags().BoolVar(&rebaseRefsArgs.dryRun, "dry-run", false, "Report the rebased refs, their conflicts, and the number of the objects to push without pushing them")
+	rebaseRefsCmd.Flags().StringVar(&rebaseRefsArgs.mergeCommits, "merge-commits", string(nichegit.MergeCommitReject), "How to rebase the merge commits of the refs: reject (fail the operation), flatten (drop them and rebase the other commits in a line, like git rebase), or preserve (recreate them, like git rebase --rebase-merges)")
+	rebaseRefsCmd.Flags().BoolVar(&rebaseRefsArgs.pushUnaffected, "push-unaffected-refs", false, "If the atomic push fails because some refs are updated by others meanwhile, push the other refs without the atomicity. The updated refs and the refs stacked on them are reported as deferred")
+	rebaseRefsCmd.Flags().StringArrayVar(&inv.commitTrailers, "trailer", nil, "Optional trailer in the form of KEY: VALUE appended to the message of each created commit (e.g. \"Co-authored-by: Foo <foo@example.com>\"). A trailer already in the message is not added again. Can be specified multiple times")
+	rebaseRefsCmd.Flags().BoolVar(&inv.signOff, "signoff", false, "Add a Signed-off-by trailer for the committer to the messages of the created commits")
+	inv.addProtectedPathFlags(rebaseRefsCmd)
+	_ = rebaseRefsCmd.MarkFlagRequired("repo-url")
+	_ = rebaseRefsCmd.MarkFlagRequired("ref-prefix")
+	_ = rebaseRefsCmd.MarkFlagRequired("onto")
+	_ = rebaseRefsCmd.MarkFlagRequired("committer")
+	_ = rebaseRefsCmd.MarkFlagRequired("committer-email")
+
+	inv.addOperationFlags(rebaseRefsCmd)
+
+	inv.addSigningFlags(rebaseRefsCmd, "commits")
+
+	inv.addPackFlags(rebaseRefsCmd)
+
+	rebaseRefsCmd.Flags().BoolVar(&inv.failOnConflict, "fail-on-conflict", false, "Exit with a non-zero status if conflicts are reported, even if the refs are pushed")
+	rebaseRefsCmd.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	inv.addPushTimeoutFlags(rebaseRefsCmd)
+	inv.addFetchFlags(rebaseRefsCmd)
+	inv.addObjectStorageFlag(rebaseRefsCmd)
+	rebaseRefsCmd.Flags().StringVar(&rebaseRefsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(rebaseRefsCmd)
+	return rebaseRefsCmd
+}
+
+type rebaseRefsOutput struct {
+	Refs           []*rebasedRef         `json:"refs"`
+	NewObjects     int                   `json:"newObjects"`
+	FetchDebugInfo *debug.FetchDebugInfo `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo  *debug.PushDebugInfo  `json:"pushDebugInfo,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	ErrorCode      nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
+
+type rebasedRef struct {
+	Name           string                `json:"name"`
+	OldHash        string                `json:"oldHash"`
+	NewHash        string                `json:"newHash"`
+	Base           string                `json:"base,omitempty"`
+	Commits        []*cherryPickedCommit `json:"commits"`
+	Deferred       bool                  `json:"deferred,omitempty"`
+	ProtectedFiles []string              `json:"protectedFiles,omitempty"`
+}
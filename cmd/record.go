@@ -0,0 +1,150 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// recordedExchange is an HTTP request and its response recorded by --record-dir. The
+// Authorization header is not recorded.
+type recordedExchange struct {
+	Method         string      `json:"method"`
+	URL            string      `json:"url"`
+	RequestHeader  http.Header `json:"requestHeader"`
+	RequestBody    []byte      `json:"requestBody"`
+	StatusCode     int         `json:"statusCode"`
+	ResponseHeader http.Header `json:"responseHeader"`
+	ResponseBody   []byte      `json:"responseBody"`
+}
+
+// exchangeRecorder records the HTTP exchanges of an operation to the numbered files in the
+// directory, or replays them in the same order.
+type exchangeRecorder struct {
+	dir    string
+	replay bool
+
+	mu  sync.Mutex
+	seq int
+}
+
+// newExchangeRecorder returns the recorder for --record-dir or --replay-dir, or nil if neither is
+// set.
+func (inv *invocation) newExchangeRecorder() (*exchangeRecorder, error) {
+	if inv.recordDir != "" && inv.replayDir != "" {
+		return nil, errors.New("--record-dir and --replay-dir cannot be used together")
+	}
+	if inv.recordDir != "" {
+		if err := os.MkdirAll(inv.recordDir, 0755); err != nil {
+			return nil, err
+		}
+		return &exchangeRecorder{dir: inv.recordDir}, nil
+	}
+	if inv.replayDir != "" {
+		return &exchangeRecorder{dir: inv.replayDir, replay: true}, nil
+	}
+	return nil, nil
+}
+
+func (r *exchangeRecorder) nextPath() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	return filepath.Join(r.dir, fmt.Sprintf("%04d.json", r.seq))
+}
+
+func (r *exchangeRecorder) RoundTrip(req *http.Request, inner http.RoundTripper) (*http.Response, error) {
+	if r.replay {
+		return r.replayRoundTrip(req)
+	}
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+	resp, err := inner.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	reqHeader := req.Header.Clone()
+	reqHeader.Del("Authorization")
+	bs, err := json.MarshalIndent(&recordedExchange{
+		Method:         req.Method,
+		URL:            redactedURL(req),
+		RequestHeader:  reqHeader,
+		RequestBody:    reqBody,
+		StatusCode:     resp.StatusCode,
+		ResponseHeader: resp.Header,
+		ResponseBody:   respBody,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(r.nextPath(), bs, 0644); err != nil {
+		return nil, fmt.Errorf("cannot record the HTTP exchange: %w", err)
+	}
+	return resp, nil
+}
+
+// replayRoundTrip responds with the next recorded exchange. The request needs to have the same
+// method and URL as the recorded one. The bodies are not compared, as the created objects can
+// differ by the timestamps.
+func (r *exchangeRecorder) replayRoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+		req.Body.Close()
+	}
+	pth := r.nextPath()
+	bs, err := os.ReadFile(pth)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("no more recorded HTTP exchanges for %s %s", req.Method, redactedURL(req))
+	} else if err != nil {
+		return nil, err
+	}
+	var exchange recordedExchange
+	if err := json.Unmarshal(bs, &exchange); err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", pth, err)
+	}
+	if exchange.Method != req.Method || exchange.URL != redactedURL(req) {
+		return nil, fmt.Errorf("the request %s %s differs from the recorded %s %s in %s", req.Method, redactedURL(req), exchange.Method, exchange.URL, pth)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", exchange.StatusCode, http.StatusText(exchange.StatusCode)),
+		StatusCode:    exchange.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        exchange.ResponseHeader,
+		Body:          io.NopCloser(bytes.NewReader(exchange.ResponseBody)),
+		ContentLength: int64(len(exchange.ResponseBody)),
+		Request:       req,
+	}, nil
+}
+
+// redactedURL returns the URL of the request without the user info.
+func redactedURL(req *http.Request) string {
+	u := *req.URL
+	u.User = nil
+	return u.String()
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aviator-co/niche-git/nichegittest"
+)
+
+func TestRecordReplay(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	dir := t.TempDir()
+	args := []string{"--repo-url", server.RepoURL(), "--commit-hash1", base.String(), "--commit-hash2", head.String(), "--debug-level", "none", "--authz-header", "Bearer secret"}
+
+	recorded := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: append(args, "--record-dir", dir)}, commandAuthz{})
+	if recorded.ExitCode != exitCodeOK {
+		t.Fatalf("get-modified-files failed: %s", recorded.Error)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("nothing is recorded")
+	}
+	for _, file := range files {
+		bs, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(bs), "secret") {
+			t.Errorf("%s has the authorization header", file)
+		}
+	}
+
+	// The server is not reached in the replay.
+	server.InjectFault(nichegittest.Fault{StatusCode: 500})
+	replayed := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: append(args, "--replay-dir", dir)}, commandAuthz{})
+	if replayed.ExitCode != exitCodeOK {
+		t.Fatalf("replay failed: %s", replayed.Error)
+	}
+	if string(replayed.Output) != string(recorded.Output) {
+		t.Errorf("the replayed output differs:\n%s\nwant:\n%s", replayed.Output, recorded.Output)
+	}
+
+	other := runPipeCommand(&pipeCommand{Command: "ls-refs", Args: []string{"--repo-url", server.RepoURL() + "/other", "--replay-dir", dir}}, commandAuthz{})
+	if other.ExitCode == exitCodeOK {
+		t.Error("the replay of a different request is expected to fail")
+	}
+}
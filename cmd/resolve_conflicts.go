@@ -0,0 +1,190 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newResolveConflictsCmd returns the resolve-conflicts command.
+func newResolveConflictsCmd(inv *invocation) *cobra.Command {
+	var resolveConflictsArgs struct {
+		repoURL        string
+		conflictCommit string
+		resolutions    []string
+		deletePaths    []string
+		commitMessage  string
+		author         string
+		authorEmail    string
+		authorTime     string
+		committer      string
+		committerEmail string
+		committerTime  string
+		ref            string
+		currentRefHash string
+		conflictRef    string
+
+		outputFile string
+	}
+
+	resolveConflictsCmd := &cobra.Command{
+		Use: "resolve-conflicts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resolutions := map[string][]byte{}
+			for _, s := range resolveConflictsArgs.resolutions {
+				pth, file, ok := strings.Cut(s, "=")
+				if !ok || pth == "" || file == "" {
+					return fmt.Errorf("invalid resolution %q; must be PATH=FILE", s)
+				}
+				bs, err := os.ReadFile(file)
+				if err != nil {
+					return err
+				}
+				resolutions[pth] = bs
+			}
+			for _, pth := range resolveConflictsArgs.deletePaths {
+				resolutions[pth] = nil
+			}
+			var currentRefhash *plumbing.Hash
+			if resolveConflictsArgs.currentRefHash != "" {
+				hash := plumbing.NewHash(resolveConflictsArgs.currentRefHash)
+				currentRefhash = &hash
+			}
+			author, err := newSignature(resolveConflictsArgs.author, resolveConflictsArgs.authorEmail, resolveConflictsArgs.authorTime)
+			if err != nil {
+				return err
+			}
+			committer, err := newSignature(resolveConflictsArgs.committer, resolveConflictsArgs.committerEmail, resolveConflictsArgs.committerTime)
+			if err != nil {
+				return err
+			}
+			timezone, err := inv.parseTimezone()
+			if err != nil {
+				return err
+			}
+			signer, err := inv.newSigner()
+			if err != nil {
+				return err
+			}
+			trailers, err := inv.parseTrailers()
+			if err != nil {
+				return err
+			}
+			additional, err := inv.parseAdditionalRefUpdates()
+			if err != nil {
+				return err
+			}
+
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.ResolveConflicts(
+				resolveConflictsArgs.repoURL,
+				client,
+				nichegit.ResolveConflictsArgs{
+					ConflictCommit:            plumbing.NewHash(resolveConflictsArgs.conflictCommit),
+					Resolutions:               resolutions,
+					CommitMessage:             resolveConflictsArgs.commitMessage,
+					Author:                    author,
+					Committer:                 committer,
+					Timezone:                  timezone,
+					Trailers:                  trailers,
+					SignOff:                   inv.signOff,
+					ProtectedPaths:            inv.protectedPaths,
+					AllowProtectedPathChanges: inv.allowProtectedPathChanges,
+					Ref:                       plumbing.ReferenceName(resolveConflictsArgs.ref),
+					CurrentRefHash:            currentRefhash,
+					ConflictRef:               plumbing.ReferenceName(resolveConflictsArgs.conflictRef),
+					Signer:                    signer,
+					AdditionalRefUpdates:      additional,
+					PackOptions:               inv.packOptions(),
+				},
+			)
+			output := resolveConflictsOutput{
+				FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.ProtectedFiles = result.ProtectedFiles
+				if !result.CommitHash.IsZero() {
+					output.CommitHash = result.CommitHash.String()
+				}
+				output.ResolvedFiles = result.ResolvedFiles
+				output.UnresolvedFiles = result.UnresolvedFiles
+			}
+			if output.ResolvedFiles == nil {
+				output.ResolvedFiles = []string{}
+			}
+			if output.UnresolvedFiles == nil {
+				output.UnresolvedFiles = []string{}
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(resolveConflictsArgs.outputFile, output); err != nil {
+				return err
+			}
+			inv.opStats.conflicted = len(output.UnresolvedFiles) > 0
+			return pushErr
+		},
+	}
+
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.conflictCommit, "conflict-commit", "", "Commit hash of the commit with the conflicts, which is pushed to the conflict ref")
+	resolveConflictsCmd.Flags().StringArrayVar(&resolveConflictsArgs.resolutions, "resolution", nil, "Resolution in the form of PATH=FILE. The conflicting file at PATH is replaced with the content of the local FILE. Can be specified multiple times")
+	resolveConflictsCmd.Flags().StringArrayVar(&resolveConflictsArgs.deletePaths, "delete-path", nil, "Path of a conflicting file to resolve by deleting it. Can be specified multiple times")
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.commitMessage, "commit-message", "", "Optional commit message of the resolved commit. Defaults to the message of the conflict commit")
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.author, "author", "", "Author name")
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.authorEmail, "author-email", "", "Author email address")
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.authorTime, "author-time", "", "Author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.committer, "committer", "", "Commiter name")
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.committerEmail, "committer-email", "", "Commiter email address")
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.committerTime, "committer-time", "", "Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	resolveConflictsCmd.Flags().StringVar(&inv.signatureTimezone, "timezone", "", "Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used")
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.ref, "ref", "", "A ref name (e.g. refs/heads/foobar) to push")
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.currentRefHash, "current-ref-hash", "", "The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.")
+	resolveConflictsCmd.Flags().StringArrayVar(&inv.additionalRefUpdates, "additional-ref-update", nil, "Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times")
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.conflictRef, "conflict-ref", "", "Optional ref of the conflict commit. It's deleted atomically with the push if it still points to the conflict commit")
+	resolveConflictsCmd.Flags().StringArrayVar(&inv.commitTrailers, "trailer", nil, "Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. \"Co-authored-by: Foo <foo@example.com>\"). A trailer already in the message is not added again. Can be specified multiple times")
+	resolveConflictsCmd.Flags().BoolVar(&inv.signOff, "signoff", false, "Add a Signed-off-by trailer for the committer to the commit message")
+	inv.addProtectedPathFlags(resolveConflictsCmd)
+	_ = resolveConflictsCmd.MarkFlagRequired("repo-url")
+	_ = resolveConflictsCmd.MarkFlagRequired("conflict-commit")
+	_ = resolveConflictsCmd.MarkFlagRequired("author")
+	_ = resolveConflictsCmd.MarkFlagRequired("author-email")
+	_ = resolveConflictsCmd.MarkFlagRequired("committer")
+	_ = resolveConflictsCmd.MarkFlagRequired("committer-email")
+	_ = resolveConflictsCmd.MarkFlagRequired("ref")
+
+	inv.addOperationFlags(resolveConflictsCmd)
+
+	inv.addSigningFlags(resolveConflictsCmd, "commit")
+
+	inv.addPackFlags(resolveConflictsCmd)
+
+	resolveConflictsCmd.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	inv.addPushTimeoutFlags(resolveConflictsCmd)
+	inv.addFetchFlags(resolveConflictsCmd)
+	inv.addObjectStorageFlag(resolveConflictsCmd)
+	resolveConflictsCmd.Flags().StringVar(&resolveConflictsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(resolveConflictsCmd)
+	return resolveConflictsCmd
+}
+
+type resolveConflictsOutput struct {
+	CommitHash      string                `json:"commitHash"`
+	ResolvedFiles   []string              `json:"resolvedFiles"`
+	UnresolvedFiles []string              `json:"unresolvedFiles"`
+	ProtectedFiles  []string              `json:"protectedFiles,omitempty"`
+	FetchDebugInfo  *debug.FetchDebugInfo `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo   *debug.PushDebugInfo  `json:"pushDebugInfo,omitempty"`
+	Error           string                `json:"error,omitempty"`
+	ErrorCode       nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
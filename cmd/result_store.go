@@ -0,0 +1,36 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+)
+
+// resultStore is the store of the results of the read operations run by pipe and serve, set with
+// --result-store-dir. Nil disables it. See nichegit.ResultStore for the operations that use it.
+var resultStore nichegit.ResultStore
+
+// sessionResultStore returns the store for the session of a command. The recorded and the
+// replayed exchanges need the requests, so the store is not used with them.
+func (inv *invocation) sessionResultStore() nichegit.ResultStore {
+	if resultStore == nil || inv.recordDir != "" || inv.replayDir != "" {
+		return nil
+	}
+	return hitRecordingResultStore{ResultStore: resultStore, inv: inv}
+}
+
+// hitRecordingResultStore notes in opStats that the command got a stored result, which pipe and
+// serve report as "cached".
+type hitRecordingResultStore struct {
+	nichegit.ResultStore
+	inv *invocation
+}
+
+func (s hitRecordingResultStore) Get(key string) ([]byte, bool, error) {
+	result, ok, err := s.ResultStore.Get(key)
+	if ok && err == nil {
+		s.inv.opStats.storedResult.Store(true)
+	}
+	return result, ok, err
+}
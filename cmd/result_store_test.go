@@ -0,0 +1,84 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+)
+
+func TestResultStore(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	dir := t.TempDir()
+	store, err := nichegit.NewDirResultStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resultStore = store
+	defer func() { resultStore = nil }()
+	// The debug info of a stored result differs from the one of the fetch.
+	args := []string{"--repo-url", server.RepoURL(), "--commit-hash1", base.String(), "--commit-hash2", head.String(), "--debug-level", "none"}
+
+	first := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: args}, commandAuthz{})
+	if first.ExitCode != exitCodeOK || first.Cached {
+		t.Fatalf("get-modified-files failed or is cached: %+v", first)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("stored %d results, want 1", len(files))
+	}
+
+	// The server is not reached for the stored result.
+	server.InjectFault(nichegittest.Fault{StatusCode: 500})
+	second := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: args}, commandAuthz{})
+	if second.ExitCode != exitCodeOK || !second.Cached {
+		t.Fatalf("the stored result is not used: %+v", second)
+	}
+	if string(second.Output) != string(first.Output) {
+		t.Errorf("the stored output differs:\n%s\nwant:\n%s", second.Output, first.Output)
+	}
+
+	// A different authorization doesn't share the result.
+	other := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: args}, commandAuthz{header: "Bearer other"})
+	if other.ExitCode == exitCodeOK || other.Cached {
+		t.Errorf("the stored result is used for a different authorization: %+v", other)
+	}
+	// The commands that take refs always run, and the failures are not stored.
+	lsRefs := runPipeCommand(&pipeCommand{Command: "ls-refs", Args: []string{"--repo-url", server.RepoURL()}}, commandAuthz{})
+	if lsRefs.ExitCode == exitCodeOK || lsRefs.Cached {
+		t.Errorf("ls-refs is expected to reach the server: %+v", lsRefs)
+	}
+	server.ClearFaults()
+	if other := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: args}, commandAuthz{header: "Bearer other"}); other.ExitCode != exitCodeOK || other.Cached {
+		t.Errorf("the failed run is stored: %+v", other)
+	}
+
+	// A broken store fails the command rather than running it without the store.
+	resultStore = brokenResultStore{}
+	broken := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: args}, commandAuthz{})
+	if broken.ExitCode == exitCodeOK {
+		t.Errorf("expected a failure with the broken store: %+v", broken)
+	}
+}
+
+type brokenResultStore struct{}
+
+func (brokenResultStore) Get(key string) ([]byte, bool, error) {
+	return nil, false, errors.New("broken")
+}
+
+func (brokenResultStore) Put(key string, result []byte) error {
+	return errors.New("broken")
+}
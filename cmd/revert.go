@@ -0,0 +1,180 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newRevertCmd returns the revert command.
+func newRevertCmd(inv *invocation) *cobra.Command {
+	var revertArgs struct {
+		repoURL         string
+		commit          string
+		mainline        int
+		revertOnto      string
+		commitMessage   string
+		author          string
+		authorEmail     string
+		authorTime      string
+		committer       string
+		committerEmail  string
+		committerTime   string
+		ref             string
+		currentRefHash  string
+		abortOnConflict bool
+		includeHunks    bool
+
+		outputFile string
+	}
+
+	revertCmd := &cobra.Command{
+		Use: "revert",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var currentRefhash *plumbing.Hash
+			if revertArgs.currentRefHash != "" {
+				hash := plumbing.NewHash(revertArgs.currentRefHash)
+				currentRefhash = &hash
+			}
+			author, err := newSignature(revertArgs.author, revertArgs.authorEmail, revertArgs.authorTime)
+			if err != nil {
+				return err
+			}
+			committer, err := newSignature(revertArgs.committer, revertArgs.committerEmail, revertArgs.committerTime)
+			if err != nil {
+				return err
+			}
+			timezone, err := inv.parseTimezone()
+			if err != nil {
+				return err
+			}
+			signer, err := inv.newSigner()
+			if err != nil {
+				return err
+			}
+			trailers, err := inv.parseTrailers()
+			if err != nil {
+				return err
+			}
+			additional, err := inv.parseAdditionalRefUpdates()
+			if err != nil {
+				return err
+			}
+
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.PushRevert(
+				revertArgs.repoURL,
+				client,
+				nichegit.PushRevertArgs{
+					Commit:                    plumbing.NewHash(revertArgs.commit),
+					Mainline:                  revertArgs.mainline,
+					RevertOnto:                plumbing.NewHash(revertArgs.revertOnto),
+					CommitMessage:             revertArgs.commitMessage,
+					Author:                    author,
+					Committer:                 committer,
+					Timezone:                  timezone,
+					Trailers:                  trailers,
+					SignOff:                   inv.signOff,
+					ProtectedPaths:            inv.protectedPaths,
+					AllowProtectedPathChanges: inv.allowProtectedPathChanges,
+					Ref:                       plumbing.ReferenceName(revertArgs.ref),
+					CurrentRefHash:            currentRefhash,
+					AbortOnConflict:           revertArgs.abortOnConflict,
+					IncludeConflictHunks:      revertArgs.includeHunks,
+					Signer:                    signer,
+					AdditionalRefUpdates:      additional,
+					PackOptions:               inv.packOptions(),
+				},
+			)
+			output := revertOutput{
+				FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.ProtectedFiles = result.ProtectedFiles
+				output.CommitHash = result.CommitHash.String()
+				output.RevertedFiles = result.RevertedFiles
+				output.ConflictOpenFiles = result.ConflictOpenFiles
+				output.ConflictContents = result.ConflictContents
+			}
+			if output.RevertedFiles == nil {
+				output.RevertedFiles = []string{}
+			}
+			if output.ConflictOpenFiles == nil {
+				output.ConflictOpenFiles = []string{}
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(revertArgs.outputFile, output); err != nil {
+				return err
+			}
+			inv.opStats.conflicted = len(output.ConflictOpenFiles) > 0
+			if pushErr == nil && inv.failOnConflict && len(output.ConflictOpenFiles) > 0 {
+				return &nichegit.ConflictError{Files: output.ConflictOpenFiles}
+			}
+			return pushErr
+		},
+	}
+
+	revertCmd.Flags().StringVar(&revertArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	revertCmd.Flags().StringVar(&revertArgs.commit, "commit", "", "Commit hash of the commit to revert")
+	revertCmd.Flags().IntVar(&revertArgs.mainline, "mainline", 0, "The parent number (starting from 1) of the mainline. Required if the commit is a merge commit. The changes the merge made relative to this parent are reverted")
+	revertCmd.Flags().StringVar(&revertArgs.revertOnto, "revert-onto", "", "Commit hash where the revert commit is created on top of")
+	revertCmd.Flags().StringVar(&revertArgs.commitMessage, "commit-message", "", "Optional commit message of the revert commit. Defaults to the git-revert style message")
+	revertCmd.Flags().StringVar(&revertArgs.author, "author", "", "Author name")
+	revertCmd.Flags().StringVar(&revertArgs.authorEmail, "author-email", "", "Author email address")
+	revertCmd.Flags().StringVar(&revertArgs.authorTime, "author-time", "", "Author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	revertCmd.Flags().StringVar(&revertArgs.committer, "committer", "", "Commiter name")
+	revertCmd.Flags().StringVar(&revertArgs.committerEmail, "committer-email", "", "Commiter email address")
+	revertCmd.Flags().StringVar(&revertArgs.committerTime, "committer-time", "", "Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	revertCmd.Flags().StringVar(&inv.signatureTimezone, "timezone", "", "Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used")
+	revertCmd.Flags().StringVar(&revertArgs.ref, "ref", "", "A ref name (e.g. refs/heads/foobar) to push")
+	revertCmd.Flags().StringVar(&revertArgs.currentRefHash, "current-ref-hash", "", "The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.")
+	revertCmd.Flags().StringArrayVar(&inv.additionalRefUpdates, "additional-ref-update", nil, "Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times")
+	revertCmd.Flags().BoolVar(&revertArgs.abortOnConflict, "abort-on-conflict", false, "Abort the operation if there is a merge conflict")
+	revertCmd.Flags().BoolVar(&revertArgs.includeHunks, "include-conflict-hunks", false, "Include the conflicting hunks of the files with conflicts in the output")
+	revertCmd.Flags().StringArrayVar(&inv.commitTrailers, "trailer", nil, "Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. \"Co-authored-by: Foo <foo@example.com>\"). A trailer already in the message is not added again. Can be specified multiple times")
+	revertCmd.Flags().BoolVar(&inv.signOff, "signoff", false, "Add a Signed-off-by trailer for the committer to the commit message")
+	inv.addProtectedPathFlags(revertCmd)
+	_ = revertCmd.MarkFlagRequired("repo-url")
+	_ = revertCmd.MarkFlagRequired("commit")
+	_ = revertCmd.MarkFlagRequired("revert-onto")
+	_ = revertCmd.MarkFlagRequired("author")
+	_ = revertCmd.MarkFlagRequired("author-email")
+	_ = revertCmd.MarkFlagRequired("committer")
+	_ = revertCmd.MarkFlagRequired("committer-email")
+	_ = revertCmd.MarkFlagRequired("ref")
+
+	inv.addOperationFlags(revertCmd)
+
+	inv.addSigningFlags(revertCmd, "commit")
+
+	inv.addPackFlags(revertCmd)
+
+	revertCmd.Flags().BoolVar(&inv.failOnConflict, "fail-on-conflict", false, "Exit with a non-zero status if conflicts are reported, even if the commit is pushed")
+	revertCmd.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	inv.addPushTimeoutFlags(revertCmd)
+	inv.addFetchFlags(revertCmd)
+	inv.addObjectStorageFlag(revertCmd)
+	revertCmd.Flags().StringVar(&revertArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(revertCmd)
+	return revertCmd
+}
+
+type revertOutput struct {
+	CommitHash        string                      `json:"commitHash"`
+	RevertedFiles     []string                    `json:"revertedFiles"`
+	ConflictOpenFiles []string                    `json:"conflictOpenFiles"`
+	ConflictContents  []*nichegit.ConflictContent `json:"conflictContents,omitempty"`
+	ProtectedFiles    []string                    `json:"protectedFiles,omitempty"`
+	FetchDebugInfo    *debug.FetchDebugInfo       `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo     *debug.PushDebugInfo        `json:"pushDebugInfo,omitempty"`
+	Error             string                      `json:"error,omitempty"`
+	ErrorCode         nichegit.ErrorCode          `json:"errorCode,omitempty"`
+}
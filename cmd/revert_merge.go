@@ -0,0 +1,180 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newRevertMergeCmd returns the revert-merge command.
+func newRevertMergeCmd(inv *invocation) *cobra.Command {
+	var revertMergeArgs struct {
+		repoURL         string
+		mergeCommit     string
+		mainline        int
+		revertOnto      string
+		commitMessage   string
+		author          string
+		authorEmail     string
+		authorTime      string
+		committer       string
+		committerEmail  string
+		committerTime   string
+		ref             string
+		currentRefHash  string
+		abortOnConflict bool
+		includeHunks    bool
+
+		outputFile string
+	}
+
+	revertMerge := &cobra.Command{
+		Use: "revert-merge",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var currentRefhash *plumbing.Hash
+			if revertMergeArgs.currentRefHash != "" {
+				hash := plumbing.NewHash(revertMergeArgs.currentRefHash)
+				currentRefhash = &hash
+			}
+			author, err := newSignature(revertMergeArgs.author, revertMergeArgs.authorEmail, revertMergeArgs.authorTime)
+			if err != nil {
+				return err
+			}
+			committer, err := newSignature(revertMergeArgs.committer, revertMergeArgs.committerEmail, revertMergeArgs.committerTime)
+			if err != nil {
+				return err
+			}
+			timezone, err := inv.parseTimezone()
+			if err != nil {
+				return err
+			}
+			signer, err := inv.newSigner()
+			if err != nil {
+				return err
+			}
+			trailers, err := inv.parseTrailers()
+			if err != nil {
+				return err
+			}
+			additional, err := inv.parseAdditionalRefUpdates()
+			if err != nil {
+				return err
+			}
+
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.PushRevertMerge(
+				revertMergeArgs.repoURL,
+				client,
+				nichegit.PushRevertMergeArgs{
+					MergeCommit:               plumbing.NewHash(revertMergeArgs.mergeCommit),
+					Mainline:                  revertMergeArgs.mainline,
+					RevertOnto:                plumbing.NewHash(revertMergeArgs.revertOnto),
+					CommitMessage:             revertMergeArgs.commitMessage,
+					Author:                    author,
+					Committer:                 committer,
+					Timezone:                  timezone,
+					Trailers:                  trailers,
+					SignOff:                   inv.signOff,
+					ProtectedPaths:            inv.protectedPaths,
+					AllowProtectedPathChanges: inv.allowProtectedPathChanges,
+					Ref:                       plumbing.ReferenceName(revertMergeArgs.ref),
+					CurrentRefHash:            currentRefhash,
+					AbortOnConflict:           revertMergeArgs.abortOnConflict,
+					IncludeConflictHunks:      revertMergeArgs.includeHunks,
+					Signer:                    signer,
+					AdditionalRefUpdates:      additional,
+					PackOptions:               inv.packOptions(),
+				},
+			)
+			output := revertMergeOutput{
+				FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.ProtectedFiles = result.ProtectedFiles
+				output.CommitHash = result.CommitHash.String()
+				output.RevertedFiles = result.RevertedFiles
+				output.ConflictOpenFiles = result.ConflictOpenFiles
+				output.ConflictContents = result.ConflictContents
+			}
+			if output.RevertedFiles == nil {
+				output.RevertedFiles = []string{}
+			}
+			if output.ConflictOpenFiles == nil {
+				output.ConflictOpenFiles = []string{}
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(revertMergeArgs.outputFile, output); err != nil {
+				return err
+			}
+			inv.opStats.conflicted = len(output.ConflictOpenFiles) > 0
+			if pushErr == nil && inv.failOnConflict && len(output.ConflictOpenFiles) > 0 {
+				return &nichegit.ConflictError{Files: output.ConflictOpenFiles}
+			}
+			return pushErr
+		},
+	}
+
+	revertMerge.Flags().StringVar(&revertMergeArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	revertMerge.Flags().StringVar(&revertMergeArgs.mergeCommit, "merge-commit", "", "Commit hash of the merge commit to revert")
+	revertMerge.Flags().IntVar(&revertMergeArgs.mainline, "mainline", 1, "The parent number (starting from 1) of the mainline. The changes the merge made relative to this parent are reverted")
+	revertMerge.Flags().StringVar(&revertMergeArgs.revertOnto, "revert-onto", "", "Commit hash where the revert commit is created on top of")
+	revertMerge.Flags().StringVar(&revertMergeArgs.commitMessage, "commit-message", "", "Optional commit message of the revert commit. Defaults to the git-revert style message")
+	revertMerge.Flags().StringVar(&revertMergeArgs.author, "author", "", "Author name")
+	revertMerge.Flags().StringVar(&revertMergeArgs.authorEmail, "author-email", "", "Author email address")
+	revertMerge.Flags().StringVar(&revertMergeArgs.authorTime, "author-time", "", "Author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	revertMerge.Flags().StringVar(&revertMergeArgs.committer, "committer", "", "Commiter name")
+	revertMerge.Flags().StringVar(&revertMergeArgs.committerEmail, "committer-email", "", "Commiter email address")
+	revertMerge.Flags().StringVar(&revertMergeArgs.committerTime, "committer-time", "", "Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	revertMerge.Flags().StringVar(&inv.signatureTimezone, "timezone", "", "Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used")
+	revertMerge.Flags().StringVar(&revertMergeArgs.ref, "ref", "", "A ref name (e.g. refs/heads/foobar) to push")
+	revertMerge.Flags().StringVar(&revertMergeArgs.currentRefHash, "current-ref-hash", "", "The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.")
+	revertMerge.Flags().StringArrayVar(&inv.additionalRefUpdates, "additional-ref-update", nil, "Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times")
+	revertMerge.Flags().BoolVar(&revertMergeArgs.abortOnConflict, "abort-on-conflict", false, "Abort the operation if there is a merge conflict")
+	revertMerge.Flags().BoolVar(&revertMergeArgs.includeHunks, "include-conflict-hunks", false, "Include the conflicting hunks of the files with conflicts in the output")
+	revertMerge.Flags().StringArrayVar(&inv.commitTrailers, "trailer", nil, "Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. \"Co-authored-by: Foo <foo@example.com>\"). A trailer already in the message is not added again. Can be specified multiple times")
+	revertMerge.Flags().BoolVar(&inv.signOff, "signoff", false, "Add a Signed-off-by trailer for the committer to the commit message")
+	inv.addProtectedPathFlags(revertMerge)
+	_ = revertMerge.MarkFlagRequired("repo-url")
+	_ = revertMerge.MarkFlagRequired("merge-commit")
+	_ = revertMerge.MarkFlagRequired("revert-onto")
+	_ = revertMerge.MarkFlagRequired("author")
+	_ = revertMerge.MarkFlagRequired("author-email")
+	_ = revertMerge.MarkFlagRequired("committer")
+	_ = revertMerge.MarkFlagRequired("committer-email")
+	_ = revertMerge.MarkFlagRequired("ref")
+
+	inv.addOperationFlags(revertMerge)
+
+	inv.addSigningFlags(revertMerge, "commit")
+
+	inv.addPackFlags(revertMerge)
+
+	revertMerge.Flags().BoolVar(&inv.failOnConflict, "fail-on-conflict", false, "Exit with a non-zero status if conflicts are reported, even if the commit is pushed")
+	revertMerge.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	inv.addPushTimeoutFlags(revertMerge)
+	inv.addFetchFlags(revertMerge)
+	inv.addObjectStorageFlag(revertMerge)
+	revertMerge.Flags().StringVar(&revertMergeArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(revertMerge)
+	return revertMerge
+}
+
+type revertMergeOutput struct {
+	CommitHash        string                      `json:"commitHash"`
+	RevertedFiles     []string                    `json:"revertedFiles"`
+	ConflictOpenFiles []string                    `json:"conflictOpenFiles"`
+	ConflictContents  []*nichegit.ConflictContent `json:"conflictContents,omitempty"`
+	ProtectedFiles    []string                    `json:"protectedFiles,omitempty"`
+	FetchDebugInfo    *debug.FetchDebugInfo       `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo     *debug.PushDebugInfo        `json:"pushDebugInfo,omitempty"`
+	Error             string                      `json:"error,omitempty"`
+	ErrorCode         nichegit.ErrorCode          `json:"errorCode,omitempty"`
+}
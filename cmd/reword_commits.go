@@ -0,0 +1,169 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/spf13/cobra"
+)
+
+// newRewordCommitsCmd returns the reword-commits command.
+func newRewordCommitsCmd(inv *invocation) *cobra.Command {
+	var rewordCommitsArgs struct {
+		repoURL         string
+		ref             string
+		count           int
+		messageTemplate string
+		author          string
+		authorEmail     string
+		authorTime      string
+		committer       string
+		committerEmail  string
+		committerTime   string
+		dryRun          bool
+
+		outputFile string
+	}
+
+	rewordCommitsCmd := &cobra.Command{
+		Use: "reword-commits",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var author *object.Signature
+			if rewordCommitsArgs.author != "" || rewordCommitsArgs.authorEmail != "" {
+				sig, err := newSignature(rewordCommitsArgs.author, rewordCommitsArgs.authorEmail, rewordCommitsArgs.authorTime)
+				if err != nil {
+					return err
+				}
+				if rewordCommitsArgs.authorTime == "" {
+					// Keep the original author times.
+					sig.When = time.Time{}
+				}
+				author = &sig
+			}
+			committer, err := newSignature(rewordCommitsArgs.committer, rewordCommitsArgs.committerEmail, rewordCommitsArgs.committerTime)
+			if err != nil {
+				return err
+			}
+			timezone, err := inv.parseTimezone()
+			if err != nil {
+				return err
+			}
+			signer, err := inv.newSigner()
+			if err != nil {
+				return err
+			}
+			trailers, err := inv.parseTrailers()
+			if err != nil {
+				return err
+			}
+			additional, err := inv.parseAdditionalRefUpdates()
+			if err != nil {
+				return err
+			}
+
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.PushRewordCommits(
+				rewordCommitsArgs.repoURL,
+				client,
+				nichegit.PushRewordCommitsArgs{
+					Ref:                       plumbing.ReferenceName(rewordCommitsArgs.ref),
+					Count:                     rewordCommitsArgs.count,
+					MessageTemplate:           rewordCommitsArgs.messageTemplate,
+					Author:                    author,
+					Committer:                 committer,
+					Timezone:                  timezone,
+					Trailers:                  trailers,
+					SignOff:                   inv.signOff,
+					ProtectedPaths:            inv.protectedPaths,
+					AllowProtectedPathChanges: inv.allowProtectedPathChanges,
+					AdditionalRefUpdates:      additional,
+					Signer:                    signer,
+					PackOptions:               inv.packOptions(),
+					DryRun:                    rewordCommitsArgs.dryRun,
+				},
+			)
+			output := rewordCommitsOutput{
+				// Always create an empty slice for JSON output.
+				Commits:        []*rewordedCommit{},
+				FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.ProtectedFiles = result.ProtectedFiles
+				output.CommitHash = result.CommitHash.String()
+				for _, c := range result.Commits {
+					output.Commits = append(output.Commits, &rewordedCommit{
+						OriginalCommitHash: c.OriginalCommitHash.String(),
+						CommitHash:         c.CommitHash.String(),
+						Message:            c.Message,
+					})
+				}
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(rewordCommitsArgs.outputFile, output); err != nil {
+				return err
+			}
+			return pushErr
+		},
+	}
+
+	rewordCommitsCmd.Flags().StringVar(&rewordCommitsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	rewordCommitsCmd.Flags().StringVar(&rewordCommitsArgs.ref, "ref", "", "A branch ref name (e.g. refs/heads/foobar) whose commits are rewritten. It's pushed with compare-and-swap against its current value")
+	rewordCommitsCmd.Flags().IntVar(&rewordCommitsArgs.count, "count", 1, "Number of the commits to rewrite from the tip along the first parents")
+	rewordCommitsCmd.Flags().StringVar(&rewordCommitsArgs.messageTemplate, "message-template", "", "Optional Go text/template of the new commit messages. It's executed with .Hash, .ShortHash, .Message, .Subject, .Body, .Author, and .Index of each commit, and can use appendTrailer MESSAGE KEY VALUE. If empty, the messages are kept")
+	rewordCommitsCmd.Flags().StringVar(&rewordCommitsArgs.author, "author", "", "Optional author name to replace the authors of the commits with")
+	rewordCommitsCmd.Flags().StringVar(&rewordCommitsArgs.authorEmail, "author-email", "", "Optional author email address to replace the authors of the commits with")
+	rewordCommitsCmd.Flags().StringVar(&rewordCommitsArgs.authorTime, "author-time", "", "Optional author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z). If empty, the original author times are kept")
+	rewordCommitsCmd.Flags().StringVar(&rewordCommitsArgs.committer, "committer", "", "Commiter name")
+	rewordCommitsCmd.Flags().StringVar(&rewordCommitsArgs.committerEmail, "committer-email", "", "Commiter email address")
+	rewordCommitsCmd.Flags().StringVar(&rewordCommitsArgs.committerTime, "committer-time", "", "Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	rewordCommitsCmd.Flags().StringVar(&inv.signatureTimezone, "timezone", "", "Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used")
+	rewordCommitsCmd.Flags().StringArrayVar(&inv.additionalRefUpdates, "additional-ref-update", nil, "Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commits. NEW_HASH can be HEAD for the new tip. Can be specified multiple times")
+	rewordCommitsCmd.Flags().BoolVar(&rewordCommitsArgs.dryRun, "dry-run", false, "Report the rewritten commits and their messages without pushing them")
+	rewordCommitsCmd.Flags().StringArrayVar(&inv.commitTrailers, "trailer", nil, "Optional trailer in the form of KEY: VALUE appended to the message of each created commit (e.g. \"Co-authored-by: Foo <foo@example.com>\"). A trailer already in the message is not added again. Can be specified multiple times")
+	rewordCommitsCmd.Flags().BoolVar(&inv.signOff, "signoff", false, "Add a Signed-off-by trailer for the committer to the messages of the created commits")
+	inv.addProtectedPathFlags(rewordCommitsCmd)
+	_ = rewordCommitsCmd.MarkFlagRequired("repo-url")
+	_ = rewordCommitsCmd.MarkFlagRequired("ref")
+	_ = rewordCommitsCmd.MarkFlagRequired("committer")
+	_ = rewordCommitsCmd.MarkFlagRequired("committer-email")
+
+	inv.addOperationFlags(rewordCommitsCmd)
+
+	inv.addSigningFlags(rewordCommitsCmd, "commits")
+
+	rewordCommitsCmd.Flags().IntVar(&inv.packCompressionLevel, "compression-level", 0, "Optional zlib compression level of the pushed packfile, from 1 (fastest) to 9 (smallest). Zero, which is the default, means the zlib default")
+
+	rewordCommitsCmd.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	inv.addPushTimeoutFlags(rewordCommitsCmd)
+	inv.addFetchFlags(rewordCommitsCmd)
+	inv.addObjectStorageFlag(rewordCommitsCmd)
+	rewordCommitsCmd.Flags().StringVar(&rewordCommitsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(rewordCommitsCmd)
+	return rewordCommitsCmd
+}
+
+type rewordCommitsOutput struct {
+	CommitHash     string                `json:"commitHash"`
+	Commits        []*rewordedCommit     `json:"commits"`
+	ProtectedFiles []string              `json:"protectedFiles,omitempty"`
+	FetchDebugInfo *debug.FetchDebugInfo `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo  *debug.PushDebugInfo  `json:"pushDebugInfo,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	ErrorCode      nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
+
+type rewordedCommit struct {
+	OriginalCommitHash string `json:"originalCommitHash"`
+	CommitHash         string `json:"commitHash"`
+	Message            string `json:"message"`
+}
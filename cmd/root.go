@@ -4,21 +4,84 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 
+	"github.com/aviator-co/niche-git/debug"
 	"github.com/spf13/cobra"
 )
 
-var rootCmd = &cobra.Command{
-	Use: "niche-git",
-	SilenceUsage: true,
+// newRootCmd returns the niche-git command with all the subcommands, whose flags are bound to
+// the fields of inv.
+func newRootCmd(inv *invocation) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:          "niche-git",
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if inv.debugLevel != "" {
+				if _, err := debug.ParseLevel(inv.debugLevel); err != nil {
+					return err
+				}
+			}
+			if inv.outputFormat != "" && inv.outputFormat != outputFormatJSON && inv.outputFormat != outputFormatNDJSON && inv.outputFormat != outputFormatPlain {
+				return fmt.Errorf("unknown output format %q", inv.outputFormat)
+			}
+			if inv.outputFormat == outputFormatPlain && !plainOutputCommands[cmd.Name()] {
+				return fmt.Errorf("--output-format=%s is not supported by %s", outputFormatPlain, cmd.Name())
+			}
+			var err error
+			if inv.recorder, err = inv.newExchangeRecorder(); err != nil {
+				return err
+			}
+			inv.startOperation()
+			return nil
+		},
+	}
+	rootCmd.AddCommand(newCheckIgnoredCmd(inv))
+	rootCmd.AddCommand(newCheckLinearHistoryCmd(inv))
+	rootCmd.AddCommand(newCheckRemergeCmd(inv))
+	rootCmd.AddCommand(newCherryPickCmd(inv))
+	rootCmd.AddCommand(newCleanupScratchRefsCmd(inv))
+	rootCmd.AddCommand(newCompareRefsCmd(inv))
+	rootCmd.AddCommand(newEmptyCommitCmd(inv))
+	rootCmd.AddCommand(newGenerateChangelogCmd(inv))
+	rootCmd.AddCommand(newGetAttributesCmd(inv))
+	rootCmd.AddCommand(newGetBlameCmd(inv))
+	rootCmd.AddCommand(newGetCommitGraphCmd(inv))
+	rootCmd.AddCommand(newGetCommitsCmd(inv))
+	rootCmd.AddCommand(newGetFileHistoryCmd(inv))
+	rootCmd.AddCommand(newGetFileOwnersCmd(inv))
+	rootCmd.AddCommand(newGetImpactedServicesCmd(inv))
+	rootCmd.AddCommand(newGetModifiedFilesCmd(inv))
+	rootCmd.AddCommand(newGetObjectCmd(inv))
+	rootCmd.AddCommand(newGetTreeCmd(inv))
+	rootCmd.AddCommand(newGetTreeStatsCmd(inv))
+	rootCmd.AddCommand(newGRPCServeCmd(inv))
+	rootCmd.AddCommand(newHasObjectsCmd(inv))
+	rootCmd.AddCommand(newLsRefsCmd(inv))
+	rootCmd.AddCommand(newMergeBranchesCmd(inv))
+	rootCmd.AddCommand(newMergeCorpusCmd(inv))
+	rootCmd.AddCommand(newPathsExistCmd(inv))
+	rootCmd.AddCommand(newPipeCmd(inv))
+	rootCmd.AddCommand(newRebaseRefsCmd(inv))
+	rootCmd.AddCommand(newResolveConflictsCmd(inv))
+	rootCmd.AddCommand(newRevertCmd(inv))
+	rootCmd.AddCommand(newRevertMergeCmd(inv))
+	rootCmd.AddCommand(newRewordCommitsCmd(inv))
+	rootCmd.AddCommand(newSemverTagsCmds(inv)...)
+	rootCmd.AddCommand(newServeCmd(inv))
+	rootCmd.AddCommand(newSnapshotRefsCmds(inv)...)
+	rootCmd.AddCommand(newSquashCherryPickCmd(inv))
+	rootCmd.AddCommand(newUpdateRefsCmd(inv))
+	return rootCmd
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	err := rootCmd.Execute()
+	inv := newInvocation()
+	err := newRootCmd(inv).Execute()
 	if err != nil {
-		os.Exit(1)
+		os.Exit(inv.exitCode(err))
 	}
 }
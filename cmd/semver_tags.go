@@ -0,0 +1,184 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newSemverTagsCmds returns the get-latest-semver-tag, get-commits-since-semver-tag, and
+// push-next-semver-tag commands.
+func newSemverTagsCmds(inv *invocation) []*cobra.Command {
+	var semverTagArgs struct {
+		repoURL            string
+		tagPrefix          string
+		includePrereleases bool
+
+		outputFile string
+	}
+
+	var getCommitsSinceSemverTagArgs struct {
+		headCommitHash string
+	}
+
+	var pushNextSemverTagArgs struct {
+		bump           string
+		initialVersion string
+		commitHash     string
+		message        string
+		tagger         string
+		taggerEmail    string
+		taggerTime     string
+	}
+
+	findLatestSemverTagArgs := func() nichegit.FindLatestSemverTagArgs {
+		return nichegit.FindLatestSemverTagArgs{
+			TagPrefix:          semverTagArgs.tagPrefix,
+			IncludePrereleases: semverTagArgs.includePrereleases,
+		}
+	}
+
+	getLatestSemverTagCmd := &cobra.Command{
+		Use: "get-latest-semver-tag",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			tag, debugInfo, lsRefsErr := nichegit.FindLatestSemverTag(semverTagArgs.repoURL, client, findLatestSemverTagArgs())
+			output := getLatestSemverTagOutput{
+				Tag:       tag,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if lsRefsErr != nil {
+				output.Error = lsRefsErr.Error()
+				output.ErrorCode = inv.errorCode(lsRefsErr)
+			}
+			if err := inv.writeJSON(semverTagArgs.outputFile, output); err != nil {
+				return err
+			}
+			return lsRefsErr
+		},
+	}
+
+	getCommitsSinceSemverTagCmd := &cobra.Command{
+		Use: "get-commits-since-semver-tag",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			tag, commits, debugInfo, fetchErr := nichegit.FetchCommitsSinceSemverTag(semverTagArgs.repoURL, client, nichegit.FetchCommitsSinceSemverTagArgs{
+				FindLatestSemverTagArgs: findLatestSemverTagArgs(),
+				HeadCommitHash:          plumbing.NewHash(getCommitsSinceSemverTagArgs.headCommitHash),
+			})
+			if commits == nil {
+				// Always create an empty slice for JSON output.
+				commits = []*nichegit.CommitInfo{}
+			}
+			output := getCommitsSinceSemverTagOutput{
+				Tag:       tag,
+				Commits:   commits,
+				DebugInfo: debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(semverTagArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	pushNextSemverTagCmd := &cobra.Command{
+		Use: "push-next-semver-tag",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tagger, err := newSignature(pushNextSemverTagArgs.tagger, pushNextSemverTagArgs.taggerEmail, pushNextSemverTagArgs.taggerTime)
+			if err != nil {
+				return err
+			}
+			timezone, err := inv.parseTimezone()
+			if err != nil {
+				return err
+			}
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.PushNextSemverTag(semverTagArgs.repoURL, client, nichegit.PushNextSemverTagArgs{
+				FindLatestSemverTagArgs: findLatestSemverTagArgs(),
+				Bump:                    pushNextSemverTagArgs.bump,
+				InitialVersion:          pushNextSemverTagArgs.initialVersion,
+				CommitHash:              plumbing.NewHash(pushNextSemverTagArgs.commitHash),
+				Message:                 pushNextSemverTagArgs.message,
+				Tagger:                  tagger,
+				Timezone:                timezone,
+			})
+			output := pushNextSemverTagOutput{
+				FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.PreviousTag = result.PreviousTag
+				output.Tag = result.Tag
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(semverTagArgs.outputFile, output); err != nil {
+				return err
+			}
+			return pushErr
+		},
+	}
+
+	for _, cmd := range []*cobra.Command{getLatestSemverTagCmd, getCommitsSinceSemverTagCmd, pushNextSemverTagCmd} {
+		cmd.Flags().StringVar(&semverTagArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+		cmd.Flags().StringVar(&semverTagArgs.tagPrefix, "tag-prefix", "v", "The part of the tag names before the version (e.g. v or service-a/v)")
+		cmd.Flags().BoolVar(&semverTagArgs.includePrereleases, "include-prereleases", false, "Consider the pre-release versions (e.g. 1.2.3-rc.1) as the latest tag")
+		_ = cmd.MarkFlagRequired("repo-url")
+
+		inv.addOperationFlags(cmd)
+		cmd.Flags().StringVar(&semverTagArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+		inv.addOutputFormatFlag(cmd)
+	}
+
+	getCommitsSinceSemverTagCmd.Flags().StringVar(&getCommitsSinceSemverTagArgs.headCommitHash, "head-commit-hash", "", "Commit hash of the end of the range")
+	_ = getCommitsSinceSemverTagCmd.MarkFlagRequired("head-commit-hash")
+	inv.addFetchFlags(getCommitsSinceSemverTagCmd)
+	inv.addObjectStorageFlag(getCommitsSinceSemverTagCmd)
+
+	pushNextSemverTagCmd.Flags().StringVar(&pushNextSemverTagArgs.bump, "bump", "patch", "Version part to increment: major, minor, or patch")
+	pushNextSemverTagCmd.Flags().StringVar(&pushNextSemverTagArgs.initialVersion, "initial-version", "0.1.0", "Version to use if there's no tag yet")
+	pushNextSemverTagCmd.Flags().StringVar(&pushNextSemverTagArgs.commitHash, "commit-hash", "", "Commit hash to tag")
+	pushNextSemverTagCmd.Flags().StringVar(&pushNextSemverTagArgs.message, "message", "", "Optional tag message. If set, an annotated tag is created")
+	pushNextSemverTagCmd.Flags().StringVar(&pushNextSemverTagArgs.tagger, "tagger", "", "Tagger name of the annotated tag")
+	pushNextSemverTagCmd.Flags().StringVar(&pushNextSemverTagArgs.taggerEmail, "tagger-email", "", "Tagger email address of the annotated tag")
+	pushNextSemverTagCmd.Flags().StringVar(&pushNextSemverTagArgs.taggerTime, "tagger-time", "", "Tag time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	pushNextSemverTagCmd.Flags().StringVar(&inv.signatureTimezone, "timezone", "", "Optional timezone of the tag time, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used")
+	inv.addPushTimeoutFlags(pushNextSemverTagCmd)
+	_ = pushNextSemverTagCmd.MarkFlagRequired("commit-hash")
+	return []*cobra.Command{getLatestSemverTagCmd, getCommitsSinceSemverTagCmd, pushNextSemverTagCmd}
+}
+
+type getLatestSemverTagOutput struct {
+	Tag       *nichegit.SemverTag    `json:"tag"`
+	DebugInfo *debug.LsRefsDebugInfo `json:"debugInfo,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode     `json:"errorCode,omitempty"`
+}
+
+type getCommitsSinceSemverTagOutput struct {
+	Tag       *nichegit.SemverTag    `json:"tag"`
+	Commits   []*nichegit.CommitInfo `json:"commits"`
+	DebugInfo *debug.FetchDebugInfo  `json:"debugInfo,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode     `json:"errorCode,omitempty"`
+}
+
+type pushNextSemverTagOutput struct {
+	PreviousTag    *nichegit.SemverTag   `json:"previousTag"`
+	Tag            *nichegit.SemverTag   `json:"tag"`
+	FetchDebugInfo *debug.FetchDebugInfo `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo  *debug.PushDebugInfo  `json:"pushDebugInfo,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	ErrorCode      nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/internal/fileurl"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// serve runs an HTTP server that runs the commands in the process, like pipe. "POST /<command>"
+// takes a serveRequest and responds with a pipeOutput. The response status is 200 whenever the
+// command runs, and the result is in the exit code of the output. Unknown commands get 404.
+// "GET /healthz" responds with 200 while the process is up, and "GET /readyz" with 200 until it
+// starts shutting down. "GET /metrics" responds with the per-command metrics in the Prometheus
+// text format.
+//
+// The commands share the HTTP connections, the ls-refs results, the object cache, and the stored
+// results if enabled. The Authorization header of the request applies to the command if it doesn't specify its own
+// authorization flags, and the authorization flags of serve apply if neither does.
+//
+// The clients can't make the commands read or write the local files of the server. The flags of
+// the local files and directories (e.g. --output-file and --record-dir) and the file:// URLs are
+// rejected with 403.
+//
+// The requests run concurrently, each with a command of its own, so a long-running command, e.g. a
+// fetch of a large repository, doesn't hold up the other requests.
+func newServeCmd(inv *invocation) *cobra.Command {
+	var serveArgs struct {
+		listen          string
+		lsRefsCacheTTL  time.Duration
+		objectCacheSize int64
+		resultStoreDir  string
+		authz           commandAuthz
+	}
+
+	serve := &cobra.Command{
+		Use: "serve",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nichegit.SetLsRefsCacheTTL(serveArgs.lsRefsCacheTTL)
+			defer nichegit.SetLsRefsCacheTTL(0)
+			nichegit.SetObjectCacheSize(serveArgs.objectCacheSize)
+			defer nichegit.SetObjectCacheSize(0)
+			if serveArgs.resultStoreDir != "" {
+				store, err := nichegit.NewDirResultStore(serveArgs.resultStoreDir)
+				if err != nil {
+					return err
+				}
+				defer func(orig nichegit.ResultStore) { resultStore = orig }(resultStore)
+				resultStore = store
+			}
+
+			var shuttingDown atomic.Bool
+			server := &http.Server{
+				Addr:              serveArgs.listen,
+				Handler:           newServeHandler(serveArgs.authz, &shuttingDown),
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- server.ListenAndServe()
+			}()
+			select {
+			case err := <-errCh:
+				return err
+			case <-ctx.Done():
+			}
+			shuttingDown.Store(true)
+			// Let the running command finish.
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			if err := server.Shutdown(shutdownCtx); err != nil {
+				return err
+			}
+			if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+
+	serve.Flags().StringVar(&serveArgs.listen, "listen", "localhost:8080", "Address to listen on")
+	serve.Flags().DurationVar(&serveArgs.lsRefsCacheTTL, "ls-refs-cache-ttl", 30*time.Second, "Duration to reuse the ls-refs results across the requests. A push to the repository drops them. Zero disables it")
+	serve.Flags().Int64Var(&serveArgs.objectCacheSize, "object-cache-size", 0, "Size in bytes of the commits and the trees to reuse across the requests. Zero, which is the default, disables it")
+	serve.Flags().StringVar(&serveArgs.resultStoreDir, "result-store-dir", "", "Optional directory to store the outputs of the read commands that take commit hashes in. Requesting a command with the same arguments returns the stored output instead. See the README for the commands")
+
+	serve.Flags().StringVar(&serveArgs.authz.header, "authz-header", "", "Optional authorization header for the requests without one")
+	serve.Flags().StringVar(&serveArgs.authz.basicUser, "basic-authz-user", "", "Optional HTTP Basic Auth user for the requests without an authorization header")
+	serve.Flags().StringVar(&serveArgs.authz.basicPassword, "basic-authz-password", "", "Optional HTTP Basic Auth password for the requests without an authorization header")
+	return serve
+}
+
+// serveRequest is the request body of the serve endpoints.
+type serveRequest struct {
+	// Args are the command line arguments of the command.
+	Args []string `json:"args"`
+}
+
+func newServeHandler(authz commandAuthz, shuttingDown *atomic.Bool) http.Handler {
+	metrics := newServeMetrics()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if shuttingDown.Load() {
+			http.Error(w, "shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_ = metrics.write(w)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		command := strings.TrimPrefix(r.URL.Path, "/")
+		// The arguments are checked with a command of their own, as parsing them sets its flags.
+		sub, err := findPipeCommand(newInvocation(), command)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		var req serveRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("cannot parse the request: %v", err), http.StatusBadRequest)
+			return
+		}
+		requestAuthz := authz
+		if header := r.Header.Get("Authorization"); header != "" {
+			requestAuthz = commandAuthz{header: header}
+		}
+		if err := checkServeArgs(sub, req.Args); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		start := time.Now()
+		// The output is streamed to the client. Nothing can be done if the client is gone, and
+		// the exit code is unknown then.
+		inv := newInvocation()
+		output, err := inv.writePipeOutput(w, &pipeCommand{Command: command, Args: req.Args}, requestAuthz, "")
+		if err != nil {
+			return
+		}
+		metrics.record(command, output.ExitCode, time.Since(start), inv.opStats.fetchedBytes.Load(), inv.opStats.pushedBytes.Load(), inv.opStats.conflicted)
+		_, _ = io.WriteString(w, "\n")
+	})
+	return mux
+}
+
+// checkServeArgs rejects the arguments that make the command access the local files of the
+// server: the flags named with "-file" or "-dir", which all take local paths, and the file:// URLs.
+// The arguments are parsed into the flags of sub, so sub must not be the command that runs. The
+// arguments that fail to parse are left to the command to report.
+func checkServeArgs(sub *cobra.Command, args []string) error {
+	if err := sub.ParseFlags(args); err != nil {
+		return nil
+	}
+	if err := checkServerFlags(sub.Flags(), "serve"); err != nil {
+		return err
+	}
+	for _, arg := range sub.Flags().Args() {
+		if fileurl.IsFileURL(arg) {
+			return errors.New("file:// URLs cannot be used in serve")
+		}
+	}
+	return nil
+}
+
+// checkServerFlags rejects the set flags that make the command access the local files of the
+// server, named with "-file" or "-dir" or having file:// URLs. server is the name of the command
+// that serves the commands, for the errors.
+func checkServerFlags(flags *pflag.FlagSet, server string) error {
+	var err error
+	flags.Visit(func(f *pflag.Flag) {
+		if err != nil {
+			return
+		}
+		if strings.HasSuffix(f.Name, "-file") || strings.HasSuffix(f.Name, "-dir") {
+			err = fmt.Errorf("--%s cannot be used in %s", f.Name, server)
+			return
+		}
+		values := []string{f.Value.String()}
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			values = sv.GetSlice()
+		}
+		for _, v := range values {
+			if fileurl.IsFileURL(v) {
+				err = fmt.Errorf("--%s cannot be a file:// URL in %s", f.Name, server)
+				return
+			}
+		}
+	})
+	return err
+}
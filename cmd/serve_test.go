@@ -0,0 +1,246 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+)
+
+func TestServe(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+	var shuttingDown atomic.Bool
+	server := httptest.NewServer(newServeHandler(commandAuthz{}, &shuttingDown))
+	defer server.Close()
+
+	post := func(command string, args ...string) *http.Response {
+		body, err := json.Marshal(&serveRequest{Args: args})
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.Post(server.URL+"/"+command, "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+	resp := post("get-modified-files", "--repo-url", repoURL, "--commit-hash1", base.String(), "--commit-hash2", head.String())
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status is %d", resp.StatusCode)
+	}
+	var output pipeOutput
+	if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+		t.Fatal(err)
+	}
+	if output.ExitCode != exitCodeOK {
+		t.Fatalf("get-modified-files failed: %s", output.Error)
+	}
+	var files struct {
+		Files []string `json:"files"`
+	}
+	if err := json.Unmarshal(output.Output, &files); err != nil {
+		t.Fatal(err)
+	}
+	if len(files.Files) != 1 || files.Files[0] != "b.txt" {
+		t.Errorf("files is %q, want b.txt", files.Files)
+	}
+
+	resp = post("get-modified-files", "--repo-url", repoURL)
+	if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || output.ExitCode != exitCodeError {
+		t.Errorf("expected a failure without the required flags: %d %+v", resp.StatusCode, output)
+	}
+
+	// The local files of the server are not accessible.
+	outputFile := filepath.Join(t.TempDir(), "output.json")
+	for _, args := range [][]string{
+		{"--repo-url", repo.FileURL(), "--commit-hash1", base.String(), "--commit-hash2", head.String()},
+		{"--repo-url=" + repo.FileURL(), "--commit-hash1", base.String(), "--commit-hash2", head.String()},
+		{"--repo-url", repoURL, "--commit-hash1", base.String(), "--commit-hash2", head.String(), "--output-file", outputFile},
+		{"--repo-url", repoURL, "--commit-hash1", base.String(), "--commit-hash2", head.String(), "--record-dir", t.TempDir()},
+	} {
+		if resp := post("get-modified-files", args...); resp.StatusCode != http.StatusForbidden {
+			t.Errorf("status of %q is %d, want 403", args, resp.StatusCode)
+		}
+	}
+	if _, err := os.Stat(outputFile); !os.IsNotExist(err) {
+		t.Errorf("the output file is written: %v", err)
+	}
+
+	for _, command := range []string{"serve", "pipe", "no-such-command"} {
+		if resp := post(command); resp.StatusCode != http.StatusNotFound {
+			t.Errorf("status of %s is %d, want 404", command, resp.StatusCode)
+		}
+	}
+
+	get := func(pth string) (int, string) {
+		resp, err := http.Get(server.URL + pth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(body)
+	}
+	status, metrics := get("/metrics")
+	if status != http.StatusOK {
+		t.Fatalf("status of /metrics is %d", status)
+	}
+	for _, want := range []string{
+		`niche_git_commands_total{command="get-modified-files",exit_code="0"} 1`,
+		`niche_git_commands_total{command="get-modified-files",exit_code="1"} 1`,
+		`niche_git_command_duration_seconds_count{command="get-modified-files"} 2`,
+		`niche_git_conflicts_total{command="get-modified-files"} 0`,
+	} {
+		if !strings.Contains(metrics, want) {
+			t.Errorf("metrics doesn't have %q:\n%s", want, metrics)
+		}
+	}
+	m := regexp.MustCompile(`niche_git_fetched_bytes_total\{command="get-modified-files"\} (\d+)`).FindStringSubmatch(metrics)
+	if m == nil {
+		t.Fatalf("metrics doesn't have the fetched bytes:\n%s", metrics)
+	}
+	if n, _ := strconv.Atoi(m[1]); n == 0 {
+		t.Errorf("fetched bytes is 0")
+	}
+
+	if status, _ := get("/readyz"); status != http.StatusOK {
+		t.Errorf("status of /readyz is %d, want 200", status)
+	}
+	shuttingDown.Store(true)
+	if status, _ := get("/readyz"); status != http.StatusServiceUnavailable {
+		t.Errorf("status of /readyz is %d while shutting down, want 503", status)
+	}
+	if status, _ := get("/healthz"); status != http.StatusOK {
+		t.Errorf("status of /healthz is %d, want 200", status)
+	}
+}
+
+func TestServeCacheByCredentials(t *testing.T) {
+	nichegit.SetLsRefsCacheTTL(time.Minute)
+	t.Cleanup(func() { nichegit.SetLsRefsCacheTTL(0) })
+
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "a")
+	repo.Push("main")
+	gitServer := nichegittest.NewServer(t, repo)
+	gitServer.RequireBasicAuth("user", "password")
+	var shuttingDown atomic.Bool
+	server := httptest.NewServer(newServeHandler(commandAuthz{}, &shuttingDown))
+	defer server.Close()
+
+	lsRefs := func(authorization string) pipeOutput {
+		t.Helper()
+		body, err := json.Marshal(&serveRequest{Args: []string{"--repo-url", gitServer.RepoURL()}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/ls-refs", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", authorization)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var output pipeOutput
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			t.Fatal(err)
+		}
+		return output
+	}
+	valid := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:password"))
+	if output := lsRefs(valid); output.ExitCode != exitCodeOK {
+		t.Fatalf("ls-refs failed: %s", output.Error)
+	}
+	// The refs cached for the valid credentials are not returned for the others.
+	invalid := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:wrong"))
+	if output := lsRefs(invalid); output.ExitCode != exitCodeAuth {
+		t.Errorf("ls-refs with the wrong credentials exited with %d, want %d: %s", output.ExitCode, exitCodeAuth, output.Output)
+	}
+}
+
+func TestServeConcurrentRequests(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+	gitServer := nichegittest.NewServer(t, repo)
+	// The Git server of the slow request holds it until the other request finishes.
+	started := make(chan struct{})
+	release := make(chan struct{})
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		http.NotFound(w, r)
+	}))
+	defer slowServer.Close()
+	var shuttingDown atomic.Bool
+	server := httptest.NewServer(newServeHandler(commandAuthz{}, &shuttingDown))
+	defer server.Close()
+
+	post := func(command string, args ...string) pipeOutput {
+		body, err := json.Marshal(&serveRequest{Args: args})
+		if err != nil {
+			t.Error(err)
+			return pipeOutput{}
+		}
+		resp, err := http.Post(server.URL+"/"+command, "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Error(err)
+			return pipeOutput{}
+		}
+		defer resp.Body.Close()
+		var output pipeOutput
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			t.Error(err)
+		}
+		return output
+	}
+
+	slowDone := make(chan pipeOutput)
+	go func() {
+		slowDone <- post("ls-refs", "--repo-url", slowServer.URL+"/repo.git")
+	}()
+	<-started
+	output := post("get-modified-files", "--repo-url", gitServer.RepoURL(), "--commit-hash1", base.String(), "--commit-hash2", head.String())
+	close(release)
+	if output.ExitCode != exitCodeOK {
+		t.Errorf("get-modified-files failed while ls-refs is running: %s", output.Error)
+	}
+	if !strings.Contains(string(output.Output), "b.txt") {
+		t.Errorf("get-modified-files output is %s, want b.txt", output.Output)
+	}
+	if slow := <-slowDone; slow.ExitCode == exitCodeOK {
+		t.Errorf("ls-refs against the failing server succeeded")
+	}
+}
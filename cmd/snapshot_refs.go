@@ -0,0 +1,128 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/spf13/cobra"
+)
+
+// newSnapshotRefsCmds returns the snapshot-refs and restore-refs commands.
+func newSnapshotRefsCmds(inv *invocation) []*cobra.Command {
+	var snapshotRefsArgs struct {
+		repoURL     string
+		refPrefixes []string
+
+		outputFile string
+	}
+
+	var restoreRefsArgs struct {
+		repoURL      string
+		snapshotFile string
+
+		outputFile string
+	}
+
+	snapshotRefsCmd := &cobra.Command{
+		Use: "snapshot-refs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := inv.newHTTPClient()
+			snapshot, debugInfo, fetchErr := nichegit.SnapshotRefs(snapshotRefsArgs.repoURL, client, snapshotRefsArgs.refPrefixes)
+			if snapshot == nil {
+				snapshot = &nichegit.RefSnapshot{RefPrefixes: snapshotRefsArgs.refPrefixes, Refs: map[string]string{}}
+			}
+			output := snapshotRefsOutput{
+				RefSnapshot: snapshot,
+				DebugInfo:   debugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if fetchErr != nil {
+				output.Error = fetchErr.Error()
+				output.ErrorCode = inv.errorCode(fetchErr)
+			}
+			if err := inv.writeJSON(snapshotRefsArgs.outputFile, output); err != nil {
+				return err
+			}
+			return fetchErr
+		},
+	}
+
+	restoreRefsCmd := &cobra.Command{
+		Use: "restore-refs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bs, err := os.ReadFile(restoreRefsArgs.snapshotFile)
+			if err != nil {
+				return err
+			}
+			var snapshot nichegit.RefSnapshot
+			if err := json.Unmarshal(bs, &snapshot); err != nil {
+				return fmt.Errorf("cannot parse the snapshot file: %w", err)
+			}
+
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.RestoreRefs(restoreRefsArgs.repoURL, client, &snapshot)
+			output := restoreRefsOutput{
+				UpdatedRefs:    []string{},
+				DeletedRefs:    []string{},
+				FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.UpdatedRefs = append(output.UpdatedRefs, result.UpdatedRefs...)
+				output.DeletedRefs = append(output.DeletedRefs, result.DeletedRefs...)
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(restoreRefsArgs.outputFile, output); err != nil {
+				return err
+			}
+			return pushErr
+		},
+	}
+
+	snapshotRefsCmd.Flags().StringVar(&snapshotRefsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	snapshotRefsCmd.Flags().StringSliceVar(&snapshotRefsArgs.refPrefixes, "ref-prefixes", nil, "Ref prefixes")
+	_ = snapshotRefsCmd.MarkFlagRequired("repo-url")
+
+	inv.addOperationFlags(snapshotRefsCmd)
+
+	inv.addPushTimeoutFlags(snapshotRefsCmd)
+	snapshotRefsCmd.Flags().StringVar(&snapshotRefsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(snapshotRefsCmd)
+
+	restoreRefsCmd.Flags().StringVar(&restoreRefsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	restoreRefsCmd.Flags().StringVar(&restoreRefsArgs.snapshotFile, "snapshot-file", "", "The output file of snapshot-refs")
+	_ = restoreRefsCmd.MarkFlagRequired("repo-url")
+	_ = restoreRefsCmd.MarkFlagRequired("snapshot-file")
+
+	inv.addOperationFlags(restoreRefsCmd)
+
+	restoreRefsCmd.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	restoreRefsCmd.Flags().StringVar(&restoreRefsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(restoreRefsCmd)
+	return []*cobra.Command{snapshotRefsCmd, restoreRefsCmd}
+}
+
+// snapshotRefsOutput is the snapshot file. restore-refs reads it back as nichegit.RefSnapshot.
+type snapshotRefsOutput struct {
+	*nichegit.RefSnapshot
+	DebugInfo *debug.LsRefsDebugInfo `json:"debugInfo,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+	ErrorCode nichegit.ErrorCode     `json:"errorCode,omitempty"`
+}
+
+type restoreRefsOutput struct {
+	UpdatedRefs    []string              `json:"updatedRefs"`
+	DeletedRefs    []string              `json:"deletedRefs"`
+	FetchDebugInfo *debug.FetchDebugInfo `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo  *debug.PushDebugInfo  `json:"pushDebugInfo,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	ErrorCode      nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
@@ -4,7 +4,6 @@
 package cmd
 
 import (
-	"net/http"
 	"time"
 
 	nichegit "github.com/aviator-co/niche-git"
@@ -14,8 +13,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var (
-	squashCherryPickArgs struct {
+// newSquashCherryPickCmd returns the squash-cherry-pick command.
+func newSquashCherryPickCmd(inv *invocation) *cobra.Command {
+	var squashCherryPickArgs struct {
 		repoURL         string
 		cherryPickFrom  string
 		cherryPickTo    string
@@ -30,101 +30,128 @@ var (
 		ref             string
 		currentRefHash  string
 		abortOnConflict bool
+		includeHunks    bool
+		renameThreshold int
+		conflictRefNS   string
+		pathScope       []string
+		maxChangedFiles int
+		maxNewBlobBytes int64
+		verifyLFSLocks  bool
 
 		outputFile string
 	}
-)
 
-var squashCherryPick = &cobra.Command{
-	Use: "squash-cherry-pick",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		var currentRefhash *plumbing.Hash
-		if squashCherryPickArgs.currentRefHash != "" {
-			hash := plumbing.NewHash(squashCherryPickArgs.currentRefHash)
-			currentRefhash = &hash
-		}
-		author, err := newSignature(squashCherryPickArgs.author, squashCherryPickArgs.authorEmail, squashCherryPickArgs.authorTime)
-		if err != nil {
-			return err
-		}
-		committer, err := newSignature(squashCherryPickArgs.committer, squashCherryPickArgs.committerEmail, squashCherryPickArgs.committerTime)
-		if err != nil {
-			return err
-		}
+	squashCherryPick := &cobra.Command{
+		Use: "squash-cherry-pick",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var currentRefhash *plumbing.Hash
+			if squashCherryPickArgs.currentRefHash != "" {
+				hash := plumbing.NewHash(squashCherryPickArgs.currentRefHash)
+				currentRefhash = &hash
+			}
+			author, err := newSignature(squashCherryPickArgs.author, squashCherryPickArgs.authorEmail, squashCherryPickArgs.authorTime)
+			if err != nil {
+				return err
+			}
+			committer, err := newSignature(squashCherryPickArgs.committer, squashCherryPickArgs.committerEmail, squashCherryPickArgs.committerTime)
+			if err != nil {
+				return err
+			}
+			timezone, err := inv.parseTimezone()
+			if err != nil {
+				return err
+			}
+			signer, err := inv.newSigner()
+			if err != nil {
+				return err
+			}
+			trailers, err := inv.parseTrailers()
+			if err != nil {
+				return err
+			}
+			additional, err := inv.parseAdditionalRefUpdates()
+			if err != nil {
+				return err
+			}
 
-		client := &http.Client{Transport: &authnRoundtripper{}}
-		result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.PushSquashCherryPick(
-			squashCherryPickArgs.repoURL,
-			client,
-			plumbing.NewHash(squashCherryPickArgs.cherryPickFrom),
-			plumbing.NewHash(squashCherryPickArgs.cherryPickTo),
-			plumbing.NewHash(squashCherryPickArgs.cherryPickBase),
-			squashCherryPickArgs.commitMessage,
-			author,
-			committer,
-			plumbing.ReferenceName(squashCherryPickArgs.ref),
-			currentRefhash,
-			squashCherryPickArgs.abortOnConflict,
-		)
-		output := squashCherryPickOutput{
-			FetchDebugInfo: fetchDebugInfo,
-			PushDebugInfo:  pushDebugInfo,
-		}
-		if result != nil {
-			output.CommitHash = result.CommitHash.String()
-			output.CherryPickedFiles = result.CherryPickedFiles
-			output.ConflictOpenFiles = result.ConflictOpenFiles
-			output.ConflictResolvedFiles = result.ConflictResolvedFiles
-		}
-		if output.CherryPickedFiles == nil {
-			output.CherryPickedFiles = []string{}
-		}
-		if output.ConflictOpenFiles == nil {
-			output.ConflictOpenFiles = []string{}
-		}
-		if output.ConflictResolvedFiles == nil {
-			output.ConflictResolvedFiles = []string{}
-		}
-		if pushErr != nil {
-			output.Error = pushErr.Error()
-		}
-		if err := writeJSON(squashCherryPickArgs.outputFile, output); err != nil {
-			return err
-		}
-		return pushErr
-	},
-}
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := nichegit.PushSquashCherryPick(
+				squashCherryPickArgs.repoURL,
+				client,
+				nichegit.PushSquashCherryPickArgs{
+					CherryPickFrom:       plumbing.NewHash(squashCherryPickArgs.cherryPickFrom),
+					CherryPickTo:         plumbing.NewHash(squashCherryPickArgs.cherryPickTo),
+					CherryPickBase:       plumbing.NewHash(squashCherryPickArgs.cherryPickBase),
+					CommitMessage:        squashCherryPickArgs.commitMessage,
+					Author:               author,
+					Committer:            committer,
+					Timezone:             timezone,
+					Trailers:             trailers,
+					Ref:                  plumbing.ReferenceName(squashCherryPickArgs.ref),
+					CurrentRefHash:       currentRefhash,
+					AbortOnConflict:      squashCherryPickArgs.abortOnConflict,
+					IncludeConflictHunks: squashCherryPickArgs.includeHunks,
+					RenameThreshold:      squashCherryPickArgs.renameThreshold,
+					PathScope:            squashCherryPickArgs.pathScope,
 
-func newSignature(name, email, timestamp string) (object.Signature, error) {
-	var t time.Time
-	if timestamp == "" {
-		t = time.Now()
-	} else {
-		var err error
-		t, err = time.Parse(time.RFC3339, timestamp)
-		if err != nil {
-			return object.Signature{}, err
-		}
-	}
-	return object.Signature{
-		Name:  name,
-		Email: email,
-		When:  t,
-	}, nil
-}
+					ConflictRefNamespace: squashCherryPickArgs.conflictRefNS,
 
-type squashCherryPickOutput struct {
-	CommitHash            string               `json:"commitHash"`
-	CherryPickedFiles     []string             `json:"cherryPickedFiles"`
-	ConflictOpenFiles     []string             `json:"conflictOpenFiles"`
-	ConflictResolvedFiles []string             `json:"conflictResolvedFiles"`
-	FetchDebugInfo        debug.FetchDebugInfo `json:"fetchDebugInfo"`
-	PushDebugInfo         *debug.PushDebugInfo `json:"pushDebugInfo"`
-	Error                 string               `json:"error,omitempty"`
-}
+					ProtectedPaths:            inv.protectedPaths,
+					AllowProtectedPathChanges: inv.allowProtectedPathChanges,
+					MaxChangedFiles:           squashCherryPickArgs.maxChangedFiles,
+					MaxNewBlobBytes:           squashCherryPickArgs.maxNewBlobBytes,
+					SignOff:                   inv.signOff,
+					VerifyLFSLocks:            squashCherryPickArgs.verifyLFSLocks,
+					Signer:                    signer,
+					AdditionalRefUpdates:      additional,
+					PackOptions:               inv.packOptions(),
+				},
+			)
+			output := squashCherryPickOutput{
+				FetchDebugInfo: fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:  pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				output.CommitHash = result.CommitHash.String()
+				output.CherryPickedFiles = result.CherryPickedFiles
+				output.ConflictOpenFiles = result.ConflictOpenFiles
+				output.ConflictContents = result.ConflictContents
+				output.Renames = result.Renames
+				output.ConflictResolvedFiles = result.ConflictResolvedFiles
+				output.SkippedFiles = result.SkippedFiles
+				output.ProtectedFiles = result.ProtectedFiles
+				output.ConflictRef = result.ConflictRef.String()
+			}
+			if output.CherryPickedFiles == nil {
+				output.CherryPickedFiles = []string{}
+			}
+			if output.ConflictOpenFiles == nil {
+				output.ConflictOpenFiles = []string{}
+			}
+			if output.ConflictResolvedFiles == nil {
+				output.ConflictResolvedFiles = []string{}
+			}
+			if output.SkippedFiles == nil {
+				output.SkippedFiles = []string{}
+			}
+			if output.ProtectedFiles == nil {
+				output.ProtectedFiles = []string{}
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(squashCherryPickArgs.outputFile, output); err != nil {
+				return err
+			}
+			inv.opStats.conflicted = len(output.ConflictOpenFiles) > 0
+			if pushErr == nil && inv.failOnConflict && len(output.ConflictOpenFiles) > 0 {
+				return &nichegit.ConflictError{Files: output.ConflictOpenFiles}
+			}
+			return pushErr
+		},
+	}
 
-func init() {
-	rootCmd.AddCommand(squashCherryPick)
 	squashCherryPick.Flags().StringVar(&squashCherryPickArgs.repoURL, "repo-url", "", "Git reposiotry URL")
 	squashCherryPick.Flags().StringVar(&squashCherryPickArgs.cherryPickFrom, "cherry-pick-from", "", "Commit hash where cherry-pick from")
 	squashCherryPick.Flags().StringVar(&squashCherryPickArgs.cherryPickTo, "cherry-pick-to", "", "Commit hash where cherry-pick to")
@@ -136,9 +163,21 @@ func init() {
 	squashCherryPick.Flags().StringVar(&squashCherryPickArgs.committer, "committer", "", "Commiter name")
 	squashCherryPick.Flags().StringVar(&squashCherryPickArgs.committerEmail, "committer-email", "", "Commiter email address")
 	squashCherryPick.Flags().StringVar(&squashCherryPickArgs.committerTime, "committer-time", "", "Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)")
+	squashCherryPick.Flags().StringVar(&inv.signatureTimezone, "timezone", "", "Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used")
 	squashCherryPick.Flags().StringVar(&squashCherryPickArgs.ref, "ref", "", "A ref name (e.g. refs/heads/foobar) to push")
 	squashCherryPick.Flags().StringVar(&squashCherryPickArgs.currentRefHash, "current-ref-hash", "", "The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.")
+	squashCherryPick.Flags().StringArrayVar(&inv.additionalRefUpdates, "additional-ref-update", nil, "Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times")
 	squashCherryPick.Flags().BoolVar(&squashCherryPickArgs.abortOnConflict, "abort-on-conflict", false, "Abort the operation if there is a merge conflict")
+	squashCherryPick.Flags().BoolVar(&squashCherryPickArgs.includeHunks, "include-conflict-hunks", false, "Include the conflicting hunks of the files with conflicts in the output")
+	squashCherryPick.Flags().IntVar(&squashCherryPickArgs.renameThreshold, "rename-threshold", 0, "Optional similarity in percent (e.g. 50, which is git's default) for a deleted file and an added file to be a rename. The changes to the old path are applied to the renamed file. Zero, which is the default, disables the rename detection")
+	squashCherryPick.Flags().StringVar(&squashCherryPickArgs.conflictRefNS, "conflict-ref-namespace", "", "Optional scratch ref namespace (e.g. refs/niche-git/tmp/). With --abort-on-conflict, the commit with the conflicts is pushed to a new ref under it, which cleanup-scratch-refs expires")
+	squashCherryPick.Flags().StringSliceVar(&squashCherryPickArgs.pathScope, "path-scope", nil, "Optional path prefixes to restrict the cherry-pick to. The changes outside of them are discarded")
+	inv.addProtectedPathFlags(squashCherryPick)
+	squashCherryPick.Flags().IntVar(&squashCherryPickArgs.maxChangedFiles, "max-changed-files", 0, "Optional maximum number of files the change can modify. The operation aborts if exceeded")
+	squashCherryPick.Flags().Int64Var(&squashCherryPickArgs.maxNewBlobBytes, "max-new-blob-bytes", 0, "Optional maximum total bytes of the files that the commit adds or modifies. The operation aborts if exceeded")
+	squashCherryPick.Flags().BoolVar(&inv.signOff, "signoff", false, "Add a Signed-off-by trailer for the committer to the commit message")
+	squashCherryPick.Flags().BoolVar(&squashCherryPickArgs.verifyLFSLocks, "verify-lfs-locks", false, "Verify Git LFS file locks before pushing. The operation aborts if the change modifies files locked by others")
+	squashCherryPick.Flags().StringArrayVar(&inv.commitTrailers, "trailer", nil, "Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. \"Co-authored-by: Foo <foo@example.com>\"). A trailer already in the message is not added again. Can be specified multiple times")
 	_ = squashCherryPick.MarkFlagRequired("repo-url")
 	_ = squashCherryPick.MarkFlagRequired("cherry-pick-from")
 	_ = squashCherryPick.MarkFlagRequired("cherry-pick-to")
@@ -150,9 +189,52 @@ func init() {
 	_ = squashCherryPick.MarkFlagRequired("committer-email")
 	_ = squashCherryPick.MarkFlagRequired("ref")
 
-	squashCherryPick.Flags().StringVar(&authzHeader, "authz-header", "", "Optional authorization header")
-	squashCherryPick.Flags().StringVar(&basicAuthzUser, "basic-authz-user", "", "Optional HTTP Basic Auth user")
-	squashCherryPick.Flags().StringVar(&basicAuthzPassword, "basic-authz-password", "", "Optional HTTP Basic Auth password")
+	inv.addOperationFlags(squashCherryPick)
+
+	inv.addSigningFlags(squashCherryPick, "commit")
+
+	inv.addPackFlags(squashCherryPick)
 
+	squashCherryPick.Flags().BoolVar(&inv.failOnConflict, "fail-on-conflict", false, "Exit with a non-zero status if conflicts are reported, even if the commit is pushed")
+	squashCherryPick.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	inv.addPushTimeoutFlags(squashCherryPick)
+	inv.addFetchFlags(squashCherryPick)
+	inv.addObjectStorageFlag(squashCherryPick)
 	squashCherryPick.Flags().StringVar(&squashCherryPickArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(squashCherryPick)
+	return squashCherryPick
+}
+
+func newSignature(name, email, timestamp string) (object.Signature, error) {
+	var t time.Time
+	if timestamp == "" {
+		t = time.Now()
+	} else {
+		var err error
+		t, err = time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			return object.Signature{}, err
+		}
+	}
+	return object.Signature{
+		Name:  name,
+		Email: email,
+		When:  t,
+	}, nil
+}
+
+type squashCherryPickOutput struct {
+	CommitHash            string                      `json:"commitHash"`
+	CherryPickedFiles     []string                    `json:"cherryPickedFiles"`
+	ConflictOpenFiles     []string                    `json:"conflictOpenFiles"`
+	ConflictContents      []*nichegit.ConflictContent `json:"conflictContents,omitempty"`
+	Renames               []*nichegit.RenamedFile     `json:"renames,omitempty"`
+	ConflictResolvedFiles []string                    `json:"conflictResolvedFiles"`
+	SkippedFiles          []string                    `json:"skippedFiles"`
+	ProtectedFiles        []string                    `json:"protectedFiles"`
+	ConflictRef           string                      `json:"conflictRef,omitempty"`
+	FetchDebugInfo        *debug.FetchDebugInfo       `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo         *debug.PushDebugInfo        `json:"pushDebugInfo,omitempty"`
+	Error                 string                      `json:"error,omitempty"`
+	ErrorCode             nichegit.ErrorCode          `json:"errorCode,omitempty"`
 }
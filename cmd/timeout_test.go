@@ -0,0 +1,87 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestTimeout(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	args := []string{"--repo-url", server.RepoURL(), "--commit-hash1", base.String(), "--commit-hash2", head.String(), "--timeout", "5s"}
+
+	budget := func(output *pipeOutput) *debug.BudgetDebugInfo {
+		t.Helper()
+		var out struct {
+			BudgetDebugInfo *debug.BudgetDebugInfo `json:"budgetDebugInfo"`
+		}
+		if err := json.Unmarshal(output.Output, &out); err != nil {
+			t.Fatal(err)
+		}
+		if out.BudgetDebugInfo == nil {
+			t.Fatalf("no budget in the output: %s", output.Output)
+		}
+		return out.BudgetDebugInfo
+	}
+
+	ok := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: args}, commandAuthz{})
+	if ok.ExitCode != exitCodeOK {
+		t.Fatalf("get-modified-files failed: %s", ok.Error)
+	}
+	if b := budget(ok); b.TimeoutMillis != 5000 || b.TimedOut {
+		t.Errorf("unexpected budget: %+v", b)
+	}
+
+	server.InjectFault(nichegittest.Fault{Match: nichegittest.MatchUploadPack, Delay: 2 * time.Second})
+	args[len(args)-1] = "100ms"
+	start := time.Now()
+	timedOut := runPipeCommand(&pipeCommand{Command: "get-modified-files", Args: args}, commandAuthz{})
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("the operation took %v beyond the timeout", elapsed)
+	}
+	if timedOut.ExitCode != exitCodeTimeout || timedOut.ErrorCode != nichegit.ErrorCodeTimeout {
+		t.Errorf("exit code is %d and error code is %q, want %d and %q: %s", timedOut.ExitCode, timedOut.ErrorCode, exitCodeTimeout, nichegit.ErrorCodeTimeout, timedOut.Error)
+	}
+	if b := budget(timedOut); b.TimeoutMillis != 100 || !b.TimedOut {
+		t.Errorf("unexpected budget: %+v", b)
+	}
+}
+
+func TestPushTimeout(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	server.InjectFault(nichegittest.Fault{
+		Match: func(r *http.Request) bool { return r.Method == http.MethodGet && nichegittest.MatchReceivePack(r) },
+		Delay: 2 * time.Second,
+	})
+
+	// The ref advertisement timeout applies within the longer --timeout.
+	start := time.Now()
+	got := runPipeCommand(&pipeCommand{Command: "update-refs", Args: []string{
+		"--repo-url", server.RepoURL(),
+		"--ref-update", "refs/heads/new:" + base.String() + ":" + plumbing.ZeroHash.String(),
+		"--timeout", "5s",
+		"--ref-adv-timeout", "100ms",
+	}}, commandAuthz{})
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("the push took %v beyond the ref advertisement timeout", elapsed)
+	}
+	if got.ExitCode != exitCodeTimeout || got.ErrorCode != nichegit.ErrorCodeTimeout {
+		t.Errorf("exit code is %d and error code is %q, want %d and %q: %s", got.ExitCode, got.ErrorCode, exitCodeTimeout, nichegit.ErrorCodeTimeout, got.Error)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+)
+
+// newUpdateRefsCmd returns the update-refs command.
+func newUpdateRefsCmd(inv *invocation) *cobra.Command {
+	var updateRefsArgs struct {
+		repoURL       string
+		refUpdates    []string
+		sourceRepoURL string
+		withLease     bool
+
+		outputFile string
+	}
+
+	updateRefs := &cobra.Command{
+		Use: "update-refs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var refUpdates []nichegit.RefUpdate
+			for _, s := range updateRefsArgs.refUpdates {
+				u, err := parseRefUpdate(s)
+				if err != nil {
+					return err
+				}
+				refUpdates = append(refUpdates, u)
+			}
+
+			pushFunc := nichegit.PushUpdateRefs
+			if updateRefsArgs.withLease {
+				for _, u := range refUpdates {
+					if u.OldHash == nil {
+						return fmt.Errorf("%s: --with-lease needs OLD_HASH in all the ref updates", u.Name)
+					}
+				}
+				pushFunc = nichegit.PushWithLease
+			}
+
+			client := inv.newHTTPClient()
+			result, fetchDebugInfo, pushDebugInfo, pushErr := pushFunc(
+				updateRefsArgs.repoURL,
+				client,
+				nichegit.PushUpdateRefsArgs{
+					RefUpdates:    refUpdates,
+					SourceRepoURL: updateRefsArgs.sourceRepoURL,
+				},
+			)
+			output := updateRefsOutput{
+				ForwardedObjects: []string{},
+				MissingObjects:   []string{},
+				FetchDebugInfo:   fetchDebugInfo.Trim(inv.outputDebugLevel()),
+				PushDebugInfo:    pushDebugInfo.Trim(inv.outputDebugLevel()),
+			}
+			if result != nil {
+				for _, hash := range result.ForwardedObjects {
+					output.ForwardedObjects = append(output.ForwardedObjects, hash.String())
+				}
+			}
+			var missingObjectErr *nichegit.MissingObjectError
+			if errors.As(pushErr, &missingObjectErr) {
+				for _, hash := range missingObjectErr.Hashes {
+					output.MissingObjects = append(output.MissingObjects, hash.String())
+				}
+			}
+			var leaseBrokenErr *nichegit.LeaseBrokenError
+			if errors.As(pushErr, &leaseBrokenErr) {
+				for _, ref := range leaseBrokenErr.Refs {
+					output.BrokenLeases = append(output.BrokenLeases, &brokenLeaseOutput{
+						Name:     ref.Name.String(),
+						Expected: ref.Expected.String(),
+						Actual:   ref.Actual.String(),
+					})
+				}
+			}
+			if pushErr != nil {
+				output.Error = pushErr.Error()
+				output.ErrorCode = inv.errorCode(pushErr)
+			}
+			if err := inv.writeJSON(updateRefsArgs.outputFile, output); err != nil {
+				return err
+			}
+			return pushErr
+		},
+	}
+
+	updateRefs.Flags().StringVar(&updateRefsArgs.repoURL, "repo-url", "", "Git reposiotry URL")
+	updateRefs.Flags().StringArrayVar(&updateRefsArgs.refUpdates, "ref-update", nil, "Ref update in the form of REF:NEW_HASH[:OLD_HASH]. OLD_HASH is used for compare-and-swap. Can be specified multiple times")
+	updateRefs.Flags().StringVar(&updateRefsArgs.sourceRepoURL, "source-repo-url", "", "Optional repository URL to copy the new values from if they are missing in the repository")
+	updateRefs.Flags().BoolVar(&updateRefsArgs.withLease, "with-lease", false, "Check that the refs are at OLD_HASH right before pushing, like git push --force-with-lease, and fail with LEASE_BROKEN if not. All the ref updates need OLD_HASH")
+	_ = updateRefs.MarkFlagRequired("repo-url")
+	_ = updateRefs.MarkFlagRequired("ref-update")
+
+	inv.addOperationFlags(updateRefs)
+
+	updateRefs.Flags().BoolVar(&inv.quietOutput, "quiet", false, "Omit the debug info from the output")
+	inv.addPushTimeoutFlags(updateRefs)
+	updateRefs.Flags().StringVar(&updateRefsArgs.outputFile, "output-file", "-", "Optional output file path. '-', which is the default, means stdout")
+	inv.addOutputFormatFlag(updateRefs)
+	return updateRefs
+}
+
+// parseRefUpdate parses "REF:NEW_HASH[:OLD_HASH]".
+func parseRefUpdate(s string) (nichegit.RefUpdate, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 || !plumbing.IsHash(parts[1]) || (len(parts) == 3 && !plumbing.IsHash(parts[2])) {
+		return nichegit.RefUpdate{}, fmt.Errorf("invalid ref update %q; must be REF:NEW_HASH[:OLD_HASH]", s)
+	}
+	u := nichegit.RefUpdate{
+		Name:    plumbing.ReferenceName(parts[0]),
+		NewHash: plumbing.NewHash(parts[1]),
+	}
+	if len(parts) == 3 {
+		hash := plumbing.NewHash(parts[2])
+		u.OldHash = &hash
+	}
+	return u, nil
+}
+
+type updateRefsOutput struct {
+	ForwardedObjects []string              `json:"forwardedObjects"`
+	MissingObjects   []string              `json:"missingObjects"`
+	BrokenLeases     []*brokenLeaseOutput  `json:"brokenLeases,omitempty"`
+	FetchDebugInfo   *debug.FetchDebugInfo `json:"fetchDebugInfo,omitempty"`
+	PushDebugInfo    *debug.PushDebugInfo  `json:"pushDebugInfo,omitempty"`
+	Error            string                `json:"error,omitempty"`
+	ErrorCode        nichegit.ErrorCode    `json:"errorCode,omitempty"`
+}
+
+type brokenLeaseOutput struct {
+	Name     string `json:"name"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
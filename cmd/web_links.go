@@ -0,0 +1,55 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/weblink"
+)
+
+// newWebLinker returns the linker for the web link flags, or nil if the web links are not
+// enabled. --web-link-template overrides the templates of --web-links.
+func (inv *invocation) newWebLinker(repoURL string) (*weblink.Linker, error) {
+	if inv.webLinkProvider == "" && len(inv.webLinkTemplates) == 0 {
+		return nil, nil
+	}
+	var templates weblink.Templates
+	if inv.webLinkProvider != "" {
+		var err error
+		if templates, err = weblink.ProviderTemplates(weblink.Provider(inv.webLinkProvider)); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range inv.webLinkTemplates {
+		kind, template, ok := strings.Cut(s, "=")
+		switch {
+		case ok && kind == "commit":
+			templates.Commit = template
+		case ok && kind == "blob":
+			templates.Blob = template
+		case ok && kind == "compare":
+			templates.Compare = template
+		default:
+			return nil, fmt.Errorf("invalid web link template %q; must be commit=TEMPLATE, blob=TEMPLATE, or compare=TEMPLATE", s)
+		}
+	}
+	webRepoURL := inv.webLinkRepoURL
+	if webRepoURL == "" {
+		var err error
+		if webRepoURL, err = weblink.WebRepoURL(repoURL); err != nil {
+			return nil, err
+		}
+	}
+	return weblink.NewLinker(webRepoURL, templates)
+}
+
+// setCommitWebURLs sets the web URLs of the commits. Nothing is set with a nil linker.
+func setCommitWebURLs(linker *weblink.Linker, commits []*nichegit.CommitInfo) {
+	for _, commit := range commits {
+		commit.WebURL = linker.Commit(commit.Hash)
+	}
+}
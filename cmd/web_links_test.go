@@ -0,0 +1,114 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/aviator-co/niche-git/weblink"
+)
+
+func TestWebLinks(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("dir/b c.txt", "b\n", "b")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	webArgs := []string{"--web-links", "github", "--web-link-repo-url", "https://github.com/o/r"}
+
+	got := runPipeCommand(&pipeCommand{Command: "get-commits", Args: append([]string{
+		"--repo-url", server.RepoURL(),
+		"--want-commit-hashes", head.String(),
+		"--max-count", "1",
+	}, webArgs...)}, commandAuthz{})
+	if got.Error != "" {
+		t.Fatal(got.Error)
+	}
+	var commits getCommitsOutput
+	if err := json.Unmarshal(got.Output, &commits); err != nil {
+		t.Fatal(err)
+	}
+	if len(commits.Commits) != 1 || commits.Commits[0].WebURL != "https://github.com/o/r/commit/"+head.String() {
+		t.Errorf("get-commits returned %s, want the web URL of %s", got.Output, head)
+	}
+
+	got = runPipeCommand(&pipeCommand{Command: "get-tree", Args: append([]string{
+		"--repo-url", server.RepoURL(),
+		"--commit-hash", head.String(),
+	}, webArgs...)}, commandAuthz{})
+	if got.Error != "" {
+		t.Fatal(got.Error)
+	}
+	var tree getTreeOutput
+	if err := json.Unmarshal(got.Output, &tree); err != nil {
+		t.Fatal(err)
+	}
+	webURLs := map[string]string{}
+	for _, entry := range tree.Entries {
+		webURLs[entry.Path] = entry.WebURL
+	}
+	wantWebURLs := map[string]string{
+		"a.txt":       "https://github.com/o/r/blob/" + head.String() + "/a.txt",
+		"dir":         "",
+		"dir/b c.txt": "https://github.com/o/r/blob/" + head.String() + "/dir/b%20c.txt",
+	}
+	for pth, want := range wantWebURLs {
+		if webURLs[pth] != want {
+			t.Errorf("the web URL of %q is %q, want %q", pth, webURLs[pth], want)
+		}
+	}
+
+	// The template overrides the provider's, and the repository URL is derived from --repo-url
+	// without --web-link-repo-url.
+	got = runPipeCommand(&pipeCommand{Command: "compare-refs", Args: []string{
+		"--repo-url", server.RepoURL(),
+		"--ref-a", head.String(),
+		"--ref-b", base.String(),
+		"--web-links", "gitlab",
+		"--web-link-template", "compare={repo}/diff/{base}..{head}",
+	}}, commandAuthz{})
+	if got.Error != "" {
+		t.Fatal(got.Error)
+	}
+	webRepoURL, err := weblink.WebRepoURL(server.RepoURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var compare compareRefsOutput
+	if err := json.Unmarshal(got.Output, &compare); err != nil {
+		t.Fatal(err)
+	}
+	if want := webRepoURL + "/diff/" + base.String() + ".." + head.String(); compare.CompareWebURL != want {
+		t.Errorf("compare-refs returned the compare URL %q, want %q", compare.CompareWebURL, want)
+	}
+	if len(compare.AheadCommits) != 1 || compare.AheadCommits[0].WebURL != webRepoURL+"/-/commit/"+head.String() {
+		t.Errorf("compare-refs returned %s, want the GitLab web URL of %s", got.Output, head)
+	}
+
+	// The web links are off without the flags, including the ones of the previous commands.
+	got = runPipeCommand(&pipeCommand{Command: "get-commits", Args: []string{
+		"--repo-url", server.RepoURL(),
+		"--want-commit-hashes", head.String(),
+	}}, commandAuthz{})
+	var noLinks getCommitsOutput
+	if err := json.Unmarshal(got.Output, &noLinks); err != nil {
+		t.Fatal(err)
+	}
+	for _, commit := range noLinks.Commits {
+		if commit.WebURL != "" {
+			t.Errorf("get-commits without --web-links returned the web URL %q", commit.WebURL)
+		}
+	}
+
+	got = runPipeCommand(&pipeCommand{Command: "get-commits", Args: []string{
+		"--repo-url", server.RepoURL(),
+		"--want-commit-hashes", head.String(),
+		"--web-link-template", "commit={repo}/c/{hash}",
+	}}, commandAuthz{})
+	if got.Error == "" {
+		t.Error("get-commits succeeded with an unknown placeholder, want an error")
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+type GetCommitGraphArgs struct {
+	// WantCommitHashes are the tips of the graph.
+	WantCommitHashes []plumbing.Hash
+	// HaveCommitHashes exclude the commits reachable from them, like git log ^<hash>. This is
+	// usually the trunk that the stack is based on.
+	HaveCommitHashes []plumbing.Hash
+	// MaxCount, if positive, is the maximum number of the commits in the graph. The newest
+	// commits by the committer time are kept.
+	MaxCount int
+}
+
+// CommitGraphNode is a commit in the graph returned by GetCommitGraph.
+type CommitGraphNode struct {
+	Hash string `json:"hash"`
+	// ParentHashes are the hashes of the parent commits, including the ones outside of the
+	// graph.
+	ParentHashes []string `json:"parentHashes"`
+	// Generation is one more than the largest generation of the parents in the graph. The
+	// parents outside of the graph count as zero, so a commit whose parents are all outside is
+	// 1. Unlike the generation numbers of git's commit-graph, which count from the root commits,
+	// these are relative to the graph.
+	Generation int `json:"generation"`
+	// AuthorTime is the author timestamp of the commit.
+	AuthorTime time.Time `json:"authorTime"`
+	// WebURL is the page of the commit on the web UI. Left empty, same as CommitInfo.WebURL.
+	WebURL string `json:"webURL,omitempty"`
+}
+
+// GetCommitGraph returns the commits reachable from the wants but not from the haves with their
+// parents and generation numbers, so that the DAG can be drawn without deriving it from the
+// commit list. The commits are sorted by the generations, newest first, so that a commit comes
+// before its parents like git log --topo-order. Only the commits are fetched.
+func GetCommitGraph(repoURL string, client *http.Client, args GetCommitGraphArgs) ([]*CommitGraphNode, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "GetCommitGraph", args, func() ([]*CommitGraphNode, debug.FetchDebugInfo, error) {
+		return getCommitGraph(repoURL, client, args)
+	})
+}
+
+func getCommitGraph(repoURL string, client *http.Client, args GetCommitGraphArgs) ([]*CommitGraphNode, debug.FetchDebugInfo, error) {
+	commits, debugInfo, err := FetchCommits(repoURL, client, FetchCommitsArgs{
+		WantCommitHashes: args.WantCommitHashes,
+		StopAtHashes:     args.HaveCommitHashes,
+		MaxCount:         args.MaxCount,
+	})
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	nodes := map[string]*CommitGraphNode{}
+	var ret []*CommitGraphNode
+	for _, commit := range commits {
+		if _, ok := nodes[commit.Hash]; ok {
+			continue
+		}
+		node := &CommitGraphNode{
+			Hash:         commit.Hash,
+			ParentHashes: commit.ParentHashes,
+			AuthorTime:   commit.Author.Timestamp,
+		}
+		nodes[commit.Hash] = node
+		ret = append(ret, node)
+	}
+	computeGenerations(nodes)
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Generation != ret[j].Generation {
+			return ret[i].Generation > ret[j].Generation
+		}
+		return ret[i].AuthorTime.After(ret[j].AuthorTime)
+	})
+	return ret, debugInfo, nil
+}
+
+// computeGenerations sets the generations of the nodes. The graph is walked with a stack rather
+// than recursively, as a long history would be too deep for the recursion.
+func computeGenerations(nodes map[string]*CommitGraphNode) {
+	for _, start := range nodes {
+		if start.Generation != 0 {
+			continue
+		}
+		stack := []*CommitGraphNode{start}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			if node.Generation != 0 {
+				// Pushed again through another child.
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			generation := 1
+			pending := false
+			for _, hash := range node.ParentHashes {
+				parent, ok := nodes[hash]
+				if !ok {
+					continue
+				}
+				if parent.Generation == 0 {
+					stack = append(stack, parent)
+					pending = true
+					continue
+				}
+				generation = max(generation, parent.Generation+1)
+			}
+			if pending {
+				continue
+			}
+			node.Generation = generation
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
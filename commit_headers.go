@@ -0,0 +1,132 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
+	formatcfg "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// knownCommitHeaders are the commit headers that object.Commit has, and the signatures, which
+// don't hold for a rewritten commit.
+var knownCommitHeaders = map[string]bool{
+	"tree":          true,
+	"parent":        true,
+	"author":        true,
+	"committer":     true,
+	"encoding":      true,
+	"mergetag":      true,
+	"gpgsig":        true,
+	"gpgsig-sha256": true,
+}
+
+// commitExtraHeaders returns the headers of the commit that object.Commit drops, e.g. the ones
+// added by other tools, as the raw lines including the continuation lines.
+func commitExtraHeaders(storage storer.EncodedObjectStorer, hash plumbing.Hash) (string, error) {
+	obj, err := storage.EncodedObject(plumbing.CommitObject, hash)
+	if err != nil {
+		return "", fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+	}
+	rd, err := obj.Reader()
+	if err != nil {
+		return "", err
+	}
+	defer rd.Close()
+	content, err := io.ReadAll(rd)
+	if err != nil {
+		return "", err
+	}
+	headers, _, _ := bytes.Cut(content, []byte("\n\n"))
+	var sb strings.Builder
+	keep := false
+	for _, line := range strings.Split(string(headers), "\n") {
+		if strings.HasPrefix(line, " ") {
+			// A continuation of the previous header.
+			if keep {
+				sb.WriteString(line + "\n")
+			}
+			continue
+		}
+		key, _, _ := strings.Cut(line, " ")
+		keep = !knownCommitHeaders[key]
+		if keep {
+			sb.WriteString(line + "\n")
+		}
+	}
+	return sb.String(), nil
+}
+
+// commitSignatureHeader returns the header of the commit signatures. git uses gpgsig-sha256 in
+// the SHA-256 repositories, while go-git always encodes the signatures as gpgsig.
+func commitSignatureHeader() string {
+	if fetch.ObjectFormat == formatcfg.SHA1 {
+		return "gpgsig"
+	}
+	return "gpgsig-" + string(fetch.ObjectFormat)
+}
+
+// storeCommitWithHeaders is storeCommit that adds the extra headers returned by
+// commitExtraHeaders to the commit. The headers are signed along with the rest of the commit.
+func storeCommitWithHeaders(storage storer.EncodedObjectStorer, commit *object.Commit, extraHeaders string, signer signing.Signer) (plumbing.Hash, error) {
+	if extraHeaders == "" && (signer == nil || commitSignatureHeader() == "gpgsig") {
+		return storeCommit(storage, commit, signer)
+	}
+	unsigned := &plumbing.MemoryObject{}
+	if err := commit.EncodeWithoutSignature(unsigned); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to create a commit: %w", err)
+	}
+	rd, err := unsigned.Reader()
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to create a commit: %w", err)
+	}
+	content, err := io.ReadAll(rd)
+	rd.Close()
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to create a commit: %w", err)
+	}
+	headers, message, _ := bytes.Cut(content, []byte("\n\n"))
+	var buf bytes.Buffer
+	buf.Write(headers)
+	buf.WriteString("\n")
+	buf.WriteString(extraHeaders)
+	if signer != nil {
+		payload := buf.String() + "\n" + string(message)
+		sig, err := signer.Sign(strings.NewReader(payload))
+		if err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("failed to sign the commit: %w", err)
+		}
+		lines := strings.Split(strings.TrimSuffix(string(sig), "\n"), "\n")
+		buf.WriteString(commitSignatureHeader() + " " + strings.Join(lines, "\n ") + "\n")
+	}
+	buf.WriteString("\n")
+	buf.Write(message)
+
+	obj := storage.NewEncodedObject()
+	obj.SetType(plumbing.CommitObject)
+	w, err := obj.Writer()
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to create a commit: %w", err)
+	}
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		w.Close()
+		return plumbing.ZeroHash, fmt.Errorf("failed to create a commit: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to create a commit: %w", err)
+	}
+	commitHash, err := storage.SetEncodedObject(obj)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to create a commit: %w", err)
+	}
+	return commitHash, nil
+}
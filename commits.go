@@ -4,17 +4,17 @@
 package nichegit
 
 import (
-	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aviator-co/niche-git/debug"
 	"github.com/aviator-co/niche-git/internal/fetch"
 	"github.com/go-git/go-git/v5/plumbing"
-	"github.com/go-git/go-git/v5/plumbing/format/packfile"
 	"github.com/go-git/go-git/v5/plumbing/object"
-	"github.com/go-git/go-git/v5/storage/memory"
 )
 
 type CommitSignature struct {
@@ -41,34 +41,510 @@ type CommitInfo struct {
 
 	// ParentHashes are the hashes of the parent commits.
 	ParentHashes []string `json:"parentHashes"`
+
+	// ReplacedBy is the hash of the replacement commit if the commit is replaced by
+	// refs/replace/*. In this case, the other fields are the ones of the replacement.
+	ReplacedBy string `json:"replacedBy,omitempty"`
+
+	// WebURL is the page of the commit on the web UI of the hosting service. The operations
+	// leave it empty. It's for the callers that link the commits with the weblink package.
+	WebURL string `json:"webURL,omitempty"`
 }
 
-func FetchCommits(repoURL string, client *http.Client, wantCommitHashes, haveCommitHashes []plumbing.Hash) ([]*CommitInfo, debug.FetchDebugInfo, error) {
-	packfilebs, debugInfo, err := fetch.FetchCommitOnlyPackfile(repoURL, client, wantCommitHashes, haveCommitHashes)
-	if err != nil {
-		return nil, debugInfo, err
+type FetchCommitsArgs struct {
+	// WantCommitHashes are the commits to fetch. Their ancestors are fetched as well.
+	WantCommitHashes []plumbing.Hash
+	// HaveCommitHashes are the commits that the caller already has.
+	HaveCommitHashes []plumbing.Hash
+
+	// ApplyReplaceRefs makes the operation honor refs/replace/* on the remote. A commit that
+	// has a replacement is reported with the replacement's content, and the history of the
+	// replacement is fetched as well.
+	ApplyReplaceRefs bool
+
+	// ResumeDir, if set, makes the fetch resumable. The received packfiles, including the
+	// partially received ones, are saved in this directory. If the fetch fails, calling this
+	// again with the same arguments fetches only the commits that are not received yet. The
+	// saved data is removed when the fetch succeeds.
+	ResumeDir string
+
+	// OnlyNewCommits makes the result contain only the commits that are not reachable from
+	// HaveCommitHashes. The haves are negotiated with the server, and the operation fails if the
+	// server doesn't have some of them. The commits that the server sends regardless are dropped
+	// from the result.
+	OnlyNewCommits bool
+
+	// MailmapCommitHash, if set, makes the authors and the committers canonical with the
+	// .mailmap file of this commit, like git log's %aN and %aE.
+	MailmapCommitHash plumbing.Hash
+
+	// MaxCount, Since, Until, and StopAtHashes bound the history. If any of them is set, the
+	// history of the wants is walked newest first by the committer time like git log, and is
+	// fetched only as deep as the walk goes. The result is in the order of the walk. These cannot
+	// be combined with ApplyReplaceRefs or ResumeDir.
+	//
+	// MaxCount is the maximum number of the commits to return.
+	MaxCount int
+	// Since stops the walk at the commits whose committer timestamps are older than it, like
+	// git log --since. The history is fetched with deepen-since in one round.
+	Since time.Time
+	// Until skips the commits whose committer timestamps are newer than it, like git log
+	// --until. The walk continues to their parents.
+	Until time.Time
+	// StopAtHashes excludes the commits reachable from them, like git log ^<hash>. They are
+	// sent as haves so that the server doesn't send their history.
+	StopAtHashes []plumbing.Hash
+}
+
+// boundedCommitsFetchDepth is the depth of the history fetched in each round of the walk of
+// FetchCommits bounded by MaxCount or StopAtHashes.
+const boundedCommitsFetchDepth = 100
+
+func (args FetchCommitsArgs) bounded() bool {
+	return args.MaxCount > 0 || !args.Since.IsZero() || !args.Until.IsZero() || len(args.StopAtHashes) > 0
+}
+
+func FetchCommits(repoURL string, client *http.Client, args FetchCommitsArgs) ([]*CommitInfo, debug.FetchDebugInfo, error) {
+	// The replace refs are resolved with ls-refs, so the result can change.
+	if args.ApplyReplaceRefs {
+		return fetchCommits(repoURL, client, args)
+	}
+	return withResultStore(repoURL, client, "FetchCommits", args, func() ([]*CommitInfo, debug.FetchDebugInfo, error) {
+		return fetchCommits(repoURL, client, args)
+	})
+}
+
+func fetchCommits(repoURL string, client *http.Client, args FetchCommitsArgs) ([]*CommitInfo, debug.FetchDebugInfo, error) {
+	if args.bounded() {
+		return fetchCommitHistory(repoURL, client, args)
+	}
+	var replacements map[plumbing.Hash]plumbing.Hash
+	if args.ApplyReplaceRefs {
+		var err error
+		replacements, err = fetchReplaceRefs(repoURL, client)
+		if err != nil {
+			return nil, debug.FetchDebugInfo{}, err
+		}
 	}
 
-	storage := memory.NewStorage()
-	parser, err := packfile.NewParserWithStorage(packfile.NewScanner(bytes.NewReader(packfilebs)), storage)
+	storage, err := newObjectStorage(client)
 	if err != nil {
-		return nil, debugInfo, fmt.Errorf("failed to parse packfile: %v", err)
+		return nil, debug.FetchDebugInfo{}, err
 	}
-	if _, err := parser.Parse(); err != nil {
-		return nil, debugInfo, fmt.Errorf("failed to parse packfile: %v", err)
+	defer storage.Close()
+	wants := args.WantCommitHashes
+	var debugInfo debug.FetchDebugInfo
+	var resumer *fetchResumer
+	if args.ResumeDir != "" {
+		var err error
+		resumer, err = openFetchResumer(args.ResumeDir, args.WantCommitHashes, args.HaveCommitHashes)
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		debugInfo.ResumedPackfileSize, err = resumer.load(storage)
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		if debugInfo.ResumedPackfileSize > 0 {
+			wants = missingCommits(storage, wants)
+		}
 	}
+	fetched := map[plumbing.Hash]bool{}
+	for len(wants) > 0 {
+		var pack *fetch.Packfile
+		var fetchDebugInfo debug.FetchDebugInfo
+		var err error
+		if args.OnlyNewCommits {
+			var acks []plumbing.Hash
+			pack, acks, fetchDebugInfo, err = fetch.NegotiateCommitOnlyPackfile(repoURL, client, wants, args.HaveCommitHashes)
+			if err == nil {
+				err = checkAcknowledgedHaves(args.HaveCommitHashes, acks)
+			}
+		} else {
+			pack, fetchDebugInfo, err = fetch.FetchCommitOnlyPackfile(repoURL, client, wants, args.HaveCommitHashes)
+		}
+		if resumer != nil {
+			if saveErr := resumer.save(pack); saveErr != nil && err == nil {
+				err = saveErr
+			}
+		}
+		if debugInfo.ResponseHeaders == nil {
+			fetchDebugInfo.ResumedPackfileSize = debugInfo.ResumedPackfileSize
+			debugInfo = fetchDebugInfo
+		} else {
+			debugInfo.PackfileSize += fetchDebugInfo.PackfileSize
+			if fetchDebugInfo.Fallback != "" {
+				debugInfo.Fallback = fetchDebugInfo.Fallback
+			}
+			debugInfo.Spooled = debugInfo.Spooled || fetchDebugInfo.Spooled
+		}
+		if err != nil {
+			pack.Close()
+			return nil, debugInfo, err
+		}
+		err = parseFetchedPackfile(storage, pack, &debugInfo)
+		pack.Close()
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		for _, hash := range wants {
+			fetched[hash] = true
+		}
 
+		// Fetch the history of the replacements that are not fetched yet.
+		wants = nil
+		for _, hash := range storage.hashes(plumbing.CommitObject) {
+			if replacement, ok := replacements[hash]; ok && !fetched[replacement] {
+				if !storage.has(plumbing.CommitObject, replacement) {
+					wants = append(wants, replacement)
+				}
+				fetched[replacement] = true
+			}
+		}
+	}
+
+	if resumer != nil {
+		if err := resumer.clear(); err != nil {
+			return nil, debugInfo, err
+		}
+	}
+
+	// The replacements fetched only for the replaced commits are reported under the original
+	// hashes. Those reachable from the wants are the commits of the history too.
+	fetchedForReplacement := map[plumbing.Hash]bool{}
+	if len(replacements) > 0 {
+		fromWants := reachableCommits(storage, args.WantCommitHashes)
+		for original, replacement := range replacements {
+			if fromWants[original] && !fromWants[replacement] {
+				fetchedForReplacement[replacement] = true
+			}
+		}
+	}
+	var reachableFromHaves map[plumbing.Hash]bool
+	if args.OnlyNewCommits {
+		reachableFromHaves = reachableCommits(storage, args.HaveCommitHashes)
+		if n := len(reachableFromHaves); n > 0 {
+			debugInfo.Warnings = append(debugInfo.Warnings, fmt.Sprintf("dropped %d commits reachable from the have commits", n))
+		}
+	}
 	var ret []*CommitInfo
-	for hash := range storage.Commits {
+	for _, hash := range storage.hashes(plumbing.CommitObject) {
+		if fetchedForReplacement[hash] {
+			continue
+		}
+		if reachableFromHaves[hash] {
+			continue
+		}
 		commit, err := object.GetCommit(storage, hash)
 		if err != nil {
-			return nil, debugInfo, fmt.Errorf("cannot parse %q in the fetched packfile: %v", hash, err)
+			return nil, debugInfo, fmt.Errorf("cannot parse %q in the fetched packfile: %w", hash, err)
+		}
+		if replacement, ok := replacements[hash]; ok {
+			replacementCommit, err := object.GetCommit(storage, replacement)
+			if err != nil {
+				return nil, debugInfo, fmt.Errorf("cannot parse the replacement %q in the fetched packfile: %w", replacement, err)
+			}
+			info := convertCommitInfo(replacementCommit)
+			info.Hash = hash.String()
+			info.ReplacedBy = replacement.String()
+			ret = append(ret, info)
+			continue
 		}
 		ret = append(ret, convertCommitInfo(commit))
 	}
+	if err := applyMailmapCommit(repoURL, client, args.MailmapCommitHash, ret, &debugInfo); err != nil {
+		return nil, debugInfo, err
+	}
 	return ret, debugInfo, nil
 }
 
+// fetchCommitHistory is FetchCommits bounded by MaxCount, Since, Until, and StopAtHashes.
+func fetchCommitHistory(repoURL string, client *http.Client, args FetchCommitsArgs) ([]*CommitInfo, debug.FetchDebugInfo, error) {
+	if args.ApplyReplaceRefs || args.ResumeDir != "" {
+		return nil, debug.FetchDebugInfo{}, errors.New("the history bounds cannot be combined with the replace refs or the resume dir")
+	}
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, err
+	}
+	defer storage.Close()
+	f := &historyFetcher{
+		repoURL: repoURL,
+		client:  client,
+		storage: storage,
+		haves:   append(append([]plumbing.Hash{}, args.HaveCommitHashes...), args.StopAtHashes...),
+	}
+	if !args.Since.IsZero() {
+		f.opts.Since = args.Since
+	} else {
+		f.opts.Depth = boundedCommitsFetchDepth
+		if args.MaxCount > 0 && args.MaxCount < f.opts.Depth {
+			f.opts.Depth = args.MaxCount
+		}
+	}
+	if err := f.fetchCommits(args.WantCommitHashes); err != nil {
+		return nil, f.debugInfo, err
+	}
+
+	stops := map[plumbing.Hash]bool{}
+	for _, hash := range args.StopAtHashes {
+		stops[hash] = true
+	}
+	if args.OnlyNewCommits {
+		for _, hash := range args.HaveCommitHashes {
+			stops[hash] = true
+		}
+	}
+	var queue []*object.Commit
+	seen := map[plumbing.Hash]bool{}
+	// push adds the fetched commits to the queue. The commits that the server didn't send are
+	// beyond the history limit or reachable from the haves.
+	push := func(hashes []plumbing.Hash) {
+		for _, hash := range hashes {
+			if seen[hash] || stops[hash] {
+				continue
+			}
+			seen[hash] = true
+			if commit, err := object.GetCommit(f.storage, hash); err == nil {
+				queue = insertByCommitterTime(queue, commit)
+			}
+		}
+	}
+	push(args.WantCommitHashes)
+
+	var ret []*CommitInfo
+	for len(queue) > 0 && (args.MaxCount <= 0 || len(ret) < args.MaxCount) {
+		commit := queue[0]
+		queue = queue[1:]
+		if !args.Since.IsZero() && commit.Committer.When.Before(args.Since) {
+			continue
+		}
+		if args.Until.IsZero() || !commit.Committer.When.After(args.Until) {
+			ret = append(ret, convertCommitInfo(commit))
+			if len(ret) == args.MaxCount {
+				break
+			}
+		}
+		if args.Since.IsZero() {
+			// deepen-since has fetched all the history since then.
+			if err := f.fetchCommits(commit.ParentHashes); err != nil {
+				return nil, f.debugInfo, err
+			}
+		}
+		push(commit.ParentHashes)
+	}
+	if err := applyMailmapCommit(repoURL, client, args.MailmapCommitHash, ret, &f.debugInfo); err != nil {
+		return nil, f.debugInfo, err
+	}
+	return ret, f.debugInfo, nil
+}
+
+// insertByCommitterTime inserts the commit to the queue ordered newest first. The commits with
+// the same time keep the order they are inserted.
+func insertByCommitterTime(queue []*object.Commit, commit *object.Commit) []*object.Commit {
+	i := sort.Search(len(queue), func(i int) bool { return queue[i].Committer.When.Before(commit.Committer.When) })
+	queue = append(queue, nil)
+	copy(queue[i+1:], queue[i:])
+	queue[i] = commit
+	return queue
+}
+
+// historyFetcher fetches the history of the commits round by round without the trees.
+type historyFetcher struct {
+	repoURL string
+	client  *http.Client
+	storage *objectStorage
+	// opts limits the history fetched in each round.
+	opts fetch.FetchOptions
+	// haves are sent as haves in addition to the commits wanted in the previous rounds.
+	haves     []plumbing.Hash
+	debugInfo debug.FetchDebugInfo
+
+	// wanted are the commits wanted in the previous rounds. They are sent as haves.
+	wanted []plumbing.Hash
+}
+
+// fetchCommits fetches the history of the commits that are not fetched or wanted yet. A commit
+// that was wanted but not sent is reachable from the haves.
+func (f *historyFetcher) fetchCommits(hashes []plumbing.Hash) error {
+	wanted := map[plumbing.Hash]bool{}
+	for _, hash := range f.wanted {
+		wanted[hash] = true
+	}
+	var wants []plumbing.Hash
+	for _, hash := range hashes {
+		if !f.storage.has(plumbing.CommitObject, hash) && !wanted[hash] {
+			wants = append(wants, hash)
+		}
+	}
+	if len(wants) == 0 {
+		return nil
+	}
+	// The commits whose parents are not fetched are sent as shallows, so that the server doesn't
+	// take the history beyond them as the client's.
+	var shallows []plumbing.Hash
+	for _, hash := range f.storage.hashes(plumbing.CommitObject) {
+		commit, err := object.GetCommit(f.storage, hash)
+		if err != nil {
+			return fmt.Errorf("cannot parse %q in the fetched packfile: %w", hash.String(), err)
+		}
+		for _, parent := range commit.ParentHashes {
+			if !f.storage.has(plumbing.CommitObject, parent) {
+				shallows = append(shallows, hash)
+				break
+			}
+		}
+	}
+	haves := append(append([]plumbing.Hash{}, f.haves...), f.wanted...)
+	pack, packDebugInfo, err := fetch.FetchCommitOnlyHistoryPackfile(f.repoURL, f.client, wants, haves, shallows, f.opts)
+	defer pack.Close()
+	f.debugInfo.ResponseHeaders = packDebugInfo.ResponseHeaders
+	f.debugInfo.PackfileSize += packDebugInfo.PackfileSize
+	if packDebugInfo.Fallback != "" {
+		f.debugInfo.Fallback = packDebugInfo.Fallback
+	}
+	f.debugInfo.Spooled = f.debugInfo.Spooled || packDebugInfo.Spooled
+	if err != nil {
+		return err
+	}
+	if err := parseFetchedPackfile(f.storage, pack, &f.debugInfo); err != nil {
+		return err
+	}
+	f.wanted = append(f.wanted, wants...)
+	return nil
+}
+
+// applyMailmapCommit makes the authors and the committers canonical with the .mailmap file of the
+// commit. Does nothing if the commit hash is zero.
+func applyMailmapCommit(repoURL string, client *http.Client, commitHash plumbing.Hash, commits []*CommitInfo, debugInfo *debug.FetchDebugInfo) error {
+	if commitHash.IsZero() {
+		return nil
+	}
+	m, err := fetchMailmap(repoURL, client, commitHash, debugInfo)
+	if err != nil {
+		return err
+	}
+	for _, info := range commits {
+		applyMailmap(info, m)
+	}
+	return nil
+}
+
+// checkAcknowledgedHaves returns an error if the server didn't acknowledge some of the haves.
+// The server cannot exclude the history of the haves that it doesn't have.
+func checkAcknowledgedHaves(haves, acks []plumbing.Hash) error {
+	acked := map[plumbing.Hash]bool{}
+	for _, hash := range acks {
+		acked[hash] = true
+	}
+	var missing []string
+	for _, hash := range haves {
+		if !acked[hash] {
+			missing = append(missing, hash.String())
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the server doesn't have the have commits: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// reachableCommits returns the commits in the storage that are reachable from the given commits,
+// including themselves.
+func reachableCommits(storage *objectStorage, hashes []plumbing.Hash) map[plumbing.Hash]bool {
+	ret := map[plumbing.Hash]bool{}
+	queue := append([]plumbing.Hash{}, hashes...)
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+		if ret[hash] {
+			continue
+		}
+		commit, err := object.GetCommit(storage, hash)
+		if err != nil {
+			continue
+		}
+		ret[hash] = true
+		queue = append(queue, commit.ParentHashes...)
+	}
+	return ret
+}
+
+// commitReplacements maps the objects replaced by refs/replace/* to their replacements. A nil
+// map replaces nothing.
+type commitReplacements map[plumbing.Hash]plumbing.Hash
+
+// resolve returns the replacement of the commit, or the commit itself if it's not replaced.
+func (r commitReplacements) resolve(hash plumbing.Hash) plumbing.Hash {
+	if replacement, ok := r[hash]; ok {
+		return replacement
+	}
+	return hash
+}
+
+// getCommit returns the commit with the content of its replacement, if any, under its own hash.
+func (r commitReplacements) getCommit(storage *objectStorage, hash plumbing.Hash) (*object.Commit, error) {
+	replacement, ok := r[hash]
+	if !ok {
+		return object.GetCommit(storage, hash)
+	}
+	commit, err := object.GetCommit(storage, replacement)
+	if err != nil {
+		return nil, err
+	}
+	replaced := *commit
+	replaced.Hash = hash
+	return &replaced, nil
+}
+
+// replacedIn returns the replaced commits in the histories fetched into the storage, which are
+// the tips or the parents of the fetched commits, sorted.
+func (r commitReplacements) replacedIn(storage *objectStorage, tips ...plumbing.Hash) ([]plumbing.Hash, error) {
+	if len(r) == 0 {
+		return nil, nil
+	}
+	found := map[plumbing.Hash]bool{}
+	for _, hash := range tips {
+		if _, ok := r[hash]; ok {
+			found[hash] = true
+		}
+	}
+	for _, hash := range storage.hashes(plumbing.CommitObject) {
+		commit, err := object.GetCommit(storage, hash)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %q in the fetched packfile: %w", hash.String(), err)
+		}
+		for _, parent := range commit.ParentHashes {
+			if _, ok := r[parent]; ok {
+				found[parent] = true
+			}
+		}
+	}
+	var ret []plumbing.Hash
+	for hash := range found {
+		ret = append(ret, hash)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].String() < ret[j].String() })
+	return ret, nil
+}
+
+// fetchReplaceRefs returns the mapping from the replaced objects to their replacements.
+func fetchReplaceRefs(repoURL string, client *http.Client) (commitReplacements, error) {
+	refs, _, err := LsRefs(repoURL, client, []string{"refs/replace/"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the replace refs: %w", err)
+	}
+	ret := commitReplacements{}
+	for _, ref := range refs {
+		original := strings.TrimPrefix(ref.Name, "refs/replace/")
+		if !plumbing.IsHash(original) {
+			continue
+		}
+		ret[plumbing.NewHash(original)] = plumbing.NewHash(ref.Hash)
+	}
+	return ret, nil
+}
+
 func convertCommitInfo(commit *object.Commit) *CommitInfo {
 	var parentHashes []string
 	for _, parent := range commit.ParentHashes {
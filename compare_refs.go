@@ -0,0 +1,191 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+type CompareRefsArgs struct {
+	// RefA and RefB are the revisions to compare. Each is a commit hash, a ref name, or a branch
+	// or tag name.
+	RefA string
+	RefB string
+	// MaxCommits, if positive, limits the number of the commits in AheadCommits and
+	// BehindCommits. Ahead and Behind are counted regardless.
+	MaxCommits int
+	// ApplyReplaceRefs makes the operation honor refs/replace/* on the remote. The replaced
+	// commits are walked with the parents of their replacements and reported with their
+	// contents, like git does by default.
+	ApplyReplaceRefs bool
+}
+
+type CompareRefsResult struct {
+	RefAHash plumbing.Hash
+	RefBHash plumbing.Hash
+	// MergeBase is the best common ancestor of RefA and RefB, like git merge-base.
+	MergeBase plumbing.Hash
+	// Ahead is the number of the commits reachable from RefA but not from RefB, like git
+	// rev-list --count RefB..RefA.
+	Ahead int
+	// Behind is the number of the commits reachable from RefB but not from RefA.
+	Behind int
+	// AheadCommits and BehindCommits are those commits, newest first.
+	AheadCommits  []*CommitInfo
+	BehindCommits []*CommitInfo
+	// ReplacedCommits are the commits in the fetched histories that are replaced by
+	// refs/replace/*. Set with ApplyReplaceRefs.
+	ReplacedCommits []plumbing.Hash
+}
+
+// CompareRefs returns how two revisions have diverged: the merge base, and the commits that only
+// one of them has, like git rev-list --left-right RefA...RefB. Only the commits are fetched, and
+// the histories are shared among the merge base and the commit lists. It fails if the revisions
+// have no common ancestor.
+func CompareRefs(repoURL string, client *http.Client, args CompareRefsArgs) (*CompareRefsResult, debug.FetchDebugInfo, error) {
+	hashA, err := resolveRevision(repoURL, client, args.RefA)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, err
+	}
+	hashB, err := resolveRevision(repoURL, client, args.RefB)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, err
+	}
+	var replacements commitReplacements
+	if args.ApplyReplaceRefs {
+		if replacements, err = fetchReplaceRefs(repoURL, client); err != nil {
+			return nil, debug.FetchDebugInfo{}, err
+		}
+	}
+	result := &CompareRefsResult{RefAHash: hashA, RefBHash: hashB}
+	if hashA == hashB {
+		result.MergeBase = hashA
+		return result, debug.FetchDebugInfo{}, nil
+	}
+
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, err
+	}
+	defer storage.Close()
+	mergeBase, debugInfo, err := fetchMergeBases(repoURL, client, storage, hashA, hashB, replacements, MergeBaseOptions{LatestAcrossRoots: true})
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	result.MergeBase = mergeBase.MergeBase
+
+	// The parents that fetchMergeBase didn't fetch are fetched as the walk reaches them.
+	f := &historyFetcher{
+		repoURL:   repoURL,
+		client:    client,
+		storage:   storage,
+		opts:      fetch.FetchOptions{Depth: boundedCommitsFetchDepth},
+		debugInfo: debugInfo,
+	}
+	sides, err := walkDivergence(f, replacements, hashA, hashB)
+	if err != nil {
+		return nil, f.debugInfo, err
+	}
+	commitInfo := func(commit *object.Commit) *CommitInfo {
+		info := convertCommitInfo(commit)
+		if replacement, ok := replacements[commit.Hash]; ok {
+			info.ReplacedBy = replacement.String()
+		}
+		return info
+	}
+	for _, commit := range sides.commits {
+		switch sides.flags[commit.Hash] {
+		case divergenceA:
+			result.Ahead++
+			if args.MaxCommits <= 0 || len(result.AheadCommits) < args.MaxCommits {
+				result.AheadCommits = append(result.AheadCommits, commitInfo(commit))
+			}
+		case divergenceB:
+			result.Behind++
+			if args.MaxCommits <= 0 || len(result.BehindCommits) < args.MaxCommits {
+				result.BehindCommits = append(result.BehindCommits, commitInfo(commit))
+			}
+		}
+	}
+	if result.ReplacedCommits, err = replacements.replacedIn(storage, hashA, hashB); err != nil {
+		return nil, f.debugInfo, err
+	}
+	return result, f.debugInfo, nil
+}
+
+const (
+	divergenceA    = 1
+	divergenceB    = 2
+	divergenceBoth = divergenceA | divergenceB
+)
+
+// divergence is the result of walkDivergence.
+type divergence struct {
+	// commits are the walked commits in the order of the walk, newest first.
+	commits []*object.Commit
+	// flags are divergenceA and divergenceB of the commits reachable from each side.
+	flags map[plumbing.Hash]int
+}
+
+// walkDivergence walks the histories of the two commits newest first by the committer time, and
+// marks the commits with the sides that reach them. The walk stops when all the commits left in
+// the queue are reachable from both sides, which is the same heuristic as git rev-list. The
+// parents that are not in the storage are fetched. The replaced commits are walked with the
+// parents of their replacements.
+func walkDivergence(f *historyFetcher, replacements commitReplacements, hashA, hashB plumbing.Hash) (*divergence, error) {
+	ret := &divergence{flags: map[plumbing.Hash]int{hashA: divergenceA, hashB: divergenceB}}
+	var queue []*object.Commit
+	// The commits are queued again when their flags change.
+	enqueue := func(hash plumbing.Hash) error {
+		if err := f.fetchCommits([]plumbing.Hash{replacements.resolve(hash)}); err != nil {
+			return err
+		}
+		commit, err := replacements.getCommit(f.storage, hash)
+		if err != nil {
+			return fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+		}
+		queue = insertByCommitterTime(queue, commit)
+		return nil
+	}
+	for _, hash := range []plumbing.Hash{hashA, hashB} {
+		if err := enqueue(hash); err != nil {
+			return nil, err
+		}
+	}
+	walked := map[plumbing.Hash]bool{}
+	for len(queue) > 0 && !allDivergenceBoth(queue, ret.flags) {
+		commit := queue[0]
+		queue = queue[1:]
+		if !walked[commit.Hash] {
+			walked[commit.Hash] = true
+			ret.commits = append(ret.commits, commit)
+		}
+		flags := ret.flags[commit.Hash]
+		for _, parent := range commit.ParentHashes {
+			if ret.flags[parent]|flags == ret.flags[parent] {
+				continue
+			}
+			ret.flags[parent] |= flags
+			if err := enqueue(parent); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ret, nil
+}
+
+func allDivergenceBoth(queue []*object.Commit, flags map[plumbing.Hash]int) bool {
+	for _, commit := range queue {
+		if flags[commit.Hash] != divergenceBoth {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/merge"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// ConflictContent is the conflicting hunks of a file, so that the conflict can be rendered
+// without fetching the file.
+type ConflictContent struct {
+	Path string `json:"path"`
+	// OursLabel, BaseLabel, and TheirsLabel name the sides, same as the conflict markers.
+	OursLabel   string `json:"oursLabel"`
+	BaseLabel   string `json:"baseLabel"`
+	TheirsLabel string `json:"theirsLabel"`
+	// Unmergeable is true if the file cannot be merged line by line, e.g. a binary file or a
+	// modify/delete conflict. Hunks is empty in this case.
+	Unmergeable bool            `json:"unmergeable,omitempty"`
+	Hunks       []*ConflictHunk `json:"hunks"`
+}
+
+// ConflictHunk is a part of the file that both sides changed differently, same as a hunk with
+// the conflict markers in the diff3 style. The lines don't have the newlines.
+type ConflictHunk struct {
+	// OursStartLine, BaseStartLine, and TheirsStartLine are the 1-based line numbers of the
+	// hunk in each side. For an empty side, this is the line after the hunk.
+	OursStartLine   int      `json:"oursStartLine"`
+	BaseStartLine   int      `json:"baseStartLine"`
+	TheirsStartLine int      `json:"theirsStartLine"`
+	OursLines       []string `json:"oursLines"`
+	BaseLines       []string `json:"baseLines"`
+	TheirsLines     []string `json:"theirsLines"`
+}
+
+// conflictContents returns the conflicting hunks of the files. The blobs that are not in the
+// storage are fetched.
+func conflictContents(repoURL string, client *http.Client, storage *objectStorage, paths []string, ours, base, theirs *object.Tree, labels merge.Labels, debugInfo *debug.FetchDebugInfo) ([]*ConflictContent, error) {
+	type sides struct {
+		ours, base, theirs *object.TreeEntry
+	}
+	entries := map[string]sides{}
+	var missing []plumbing.Hash
+	for _, pth := range paths {
+		s := sides{ours: findEntry(ours, pth), base: findEntry(base, pth), theirs: findEntry(theirs, pth)}
+		if !lineMergeable(s.ours, s.base, s.theirs) {
+			continue
+		}
+		entries[pth] = s
+		for _, entry := range []*object.TreeEntry{s.ours, s.base, s.theirs} {
+			if entry != nil && storage.HasEncodedObject(entry.Hash) != nil {
+				missing = append(missing, entry.Hash)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		pack, blobDebugInfo, err := fetch.FetchFullPackfile(repoURL, client, missing, nil)
+		defer pack.Close()
+		debugInfo.PackfileSize += blobDebugInfo.PackfileSize
+		debugInfo.Spooled = debugInfo.Spooled || blobDebugInfo.Spooled
+		if err != nil {
+			return nil, err
+		}
+		if err := parseFetchedPackfile(storage, pack, debugInfo); err != nil {
+			return nil, err
+		}
+	}
+
+	readEntry := func(entry *object.TreeEntry) ([]byte, error) {
+		if entry == nil {
+			return nil, nil
+		}
+		return readBlob(storage, entry.Hash)
+	}
+	var ret []*ConflictContent
+	for _, pth := range paths {
+		content := &ConflictContent{
+			Path:        pth,
+			OursLabel:   labels.Ours,
+			BaseLabel:   labels.Base,
+			TheirsLabel: labels.Theirs,
+			Hunks:       []*ConflictHunk{},
+		}
+		ret = append(ret, content)
+		s, ok := entries[pth]
+		if !ok {
+			content.Unmergeable = true
+			continue
+		}
+		oursContent, err := readEntry(s.ours)
+		if err != nil {
+			return nil, err
+		}
+		baseContent, err := readEntry(s.base)
+		if err != nil {
+			return nil, err
+		}
+		theirsContent, err := readEntry(s.theirs)
+		if err != nil {
+			return nil, err
+		}
+		if merge.IsBinary(oursContent) || merge.IsBinary(baseContent) || merge.IsBinary(theirsContent) {
+			content.Unmergeable = true
+			continue
+		}
+		for _, h := range merge.Conflicts(baseContent, oursContent, theirsContent) {
+			content.Hunks = append(content.Hunks, &ConflictHunk{
+				OursStartLine:   h.OursStart + 1,
+				BaseStartLine:   h.BaseStart + 1,
+				TheirsStartLine: h.TheirsStart + 1,
+				OursLines:       trimNewlines(h.Ours),
+				BaseLines:       trimNewlines(h.Base),
+				TheirsLines:     trimNewlines(h.Theirs),
+			})
+		}
+	}
+	return ret, nil
+}
+
+// findEntry returns the entry at the path in the tree, or nil if it doesn't exist.
+func findEntry(tree *object.Tree, pth string) *object.TreeEntry {
+	if tree == nil {
+		return nil
+	}
+	entry, err := tree.FindEntry(pth)
+	if err != nil {
+		return nil
+	}
+	return entry
+}
+
+// lineMergeable returns true if the entries are regular files that can be merged line by line.
+// The base can be missing for an add/add conflict.
+func lineMergeable(ours, base, theirs *object.TreeEntry) bool {
+	isFile := func(entry *object.TreeEntry) bool {
+		return entry.Mode == filemode.Regular || entry.Mode == filemode.Executable
+	}
+	return ours != nil && isFile(ours) && theirs != nil && isFile(theirs) && (base == nil || isFile(base))
+}
+
+func trimNewlines(lines []string) []string {
+	ret := make([]string, 0, len(lines))
+	for _, line := range lines {
+		ret = append(ret, strings.TrimSuffix(line, "\n"))
+	}
+	return ret
+}
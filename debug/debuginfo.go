@@ -3,16 +3,151 @@
 
 package debug
 
+import (
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// Level is the verbosity of the debug info.
+type Level string
+
+const (
+	// LevelNone omits the debug info entirely.
+	LevelNone Level = "none"
+	// LevelBasic keeps only the sizes and the statuses.
+	LevelBasic Level = "basic"
+	// LevelFull keeps everything including the HTTP response headers.
+	LevelFull Level = "full"
+)
+
+// ParseLevel parses the debug level name.
+func ParseLevel(s string) (Level, error) {
+	switch level := Level(s); level {
+	case LevelNone, LevelBasic, LevelFull:
+		return level, nil
+	}
+	return "", fmt.Errorf("unknown debug level %q", s)
+}
+
 type FetchDebugInfo struct {
 	// ResponseHeaders is a map of response headers.
-	ResponseHeaders map[string][]string `json:"responseHeaders"`
-	// PackfileSize is the size of the packfile in bytes.
+	ResponseHeaders map[string][]string `json:"responseHeaders,omitempty"`
+	// PackfileSize is the size of the packfile in bytes, including the packfiles downloaded
+	// from the packfile URIs.
 	PackfileSize int `json:"packfileSize"`
+	// PackfileURIs is the number of the packfiles that the server offloaded to the packfile
+	// URIs.
+	PackfileURIs int `json:"packfileURIs,omitempty"`
+	// Fallback is the fetch strategy used when the server refused to serve the objects by
+	// their IDs, e.g. "want-ref" on a server that hides refs. Empty if not used.
+	Fallback string `json:"fallback,omitempty"`
+	// ResumedPackfileSize is the size of the packfiles loaded from a previous attempt of a
+	// resumable fetch.
+	ResumedPackfileSize int `json:"resumedPackfileSize,omitempty"`
+	// Spooled is true if the packfile exceeded the spool threshold and was kept in a temporary
+	// file instead of memory.
+	Spooled bool `json:"spooled,omitempty"`
+	// CachedObjects is the number of the objects loaded from the object cache instead of being
+	// fetched.
+	CachedObjects int `json:"cachedObjects,omitempty"`
+	// StoredResult is true if the result is the one stored in the ResultStore for an identical
+	// call, and nothing was fetched.
+	StoredResult bool `json:"storedResult,omitempty"`
+	// NegotiationRounds is the number of the requests that sent the haves before the packfile
+	// was sent, and CommonHaves is the number of the haves that the server acknowledged.
+	NegotiationRounds int `json:"negotiationRounds,omitempty"`
+	CommonHaves       int `json:"commonHaves,omitempty"`
+	// ObjectStats is the breakdown of the parsed objects.
+	ObjectStats ObjectStats `json:"objectStats"`
+	// Warnings are the inefficiencies found in the fetch, such as objects fetched more than
+	// once in the same operation.
+	Warnings []string `json:"warnings,omitempty"`
+	// Iterations are the rounds of the operations that deepen the history step by step, in
+	// the order of the rounds.
+	Iterations []*FetchIteration `json:"iterations,omitempty"`
+}
+
+// FetchIteration is a round of a fetch that deepens the history step by step.
+type FetchIteration struct {
+	// Wants is the number of the commits that the round fetched the history from.
+	Wants int `json:"wants"`
+	// Depth is the depth of the history fetched in the round. Zero if the round is limited by
+	// Since.
+	Depth int `json:"depth,omitempty"`
+	// Since is the time that the history was fetched back to in the round, in RFC 3339. Empty
+	// if the round is limited by Depth.
+	Since string `json:"since,omitempty"`
+	// PackfileSize is the size of the packfile of the round in bytes.
+	PackfileSize int `json:"packfileSize"`
+	// Commits is the number of the commits fetched in the round.
+	Commits int `json:"commits"`
+	// Duplicates is the number of the objects that had been fetched in the earlier rounds.
+	Duplicates int `json:"duplicates"`
+}
+
+// ObjectStats is the number and the total inflated size of the objects of each type.
+type ObjectStats struct {
+	Commits     int   `json:"commits"`
+	CommitBytes int64 `json:"commitBytes"`
+	Trees       int   `json:"trees"`
+	TreeBytes   int64 `json:"treeBytes"`
+	Blobs       int   `json:"blobs"`
+	BlobBytes   int64 `json:"blobBytes"`
+	Tags        int   `json:"tags"`
+	TagBytes    int64 `json:"tagBytes"`
+
+	// Duplicates is the number of objects that had been fetched already in the operation.
+	Duplicates int `json:"duplicates"`
+}
+
+// Add counts an object.
+func (s *ObjectStats) Add(t plumbing.ObjectType, size int64) {
+	switch t {
+	case plumbing.CommitObject:
+		s.Commits++
+		s.CommitBytes += size
+	case plumbing.TreeObject:
+		s.Trees++
+		s.TreeBytes += size
+	case plumbing.BlobObject:
+		s.Blobs++
+		s.BlobBytes += size
+	case plumbing.TagObject:
+		s.Tags++
+		s.TagBytes += size
+	}
+}
+
+// Trim returns a copy of the debug info that has only the fields for the level. It returns nil
+// for LevelNone.
+func (info FetchDebugInfo) Trim(level Level) *FetchDebugInfo {
+	switch level {
+	case LevelNone:
+		return nil
+	case LevelBasic:
+		info.ResponseHeaders = nil
+	}
+	return &info
 }
 
 type LsRefsDebugInfo struct {
 	// ResponseHeaders is the headers of the HTTP response when fetching the packfile.
-	ResponseHeaders map[string][]string `json:"responseHeaders"`
+	ResponseHeaders map[string][]string `json:"responseHeaders,omitempty"`
+	// Cached is true if the result is from the ls-refs cache.
+	Cached bool `json:"cached,omitempty"`
+}
+
+// Trim returns a copy of the debug info that has only the fields for the level. It returns nil
+// for LevelNone.
+func (info LsRefsDebugInfo) Trim(level Level) *LsRefsDebugInfo {
+	switch level {
+	case LevelNone:
+		return nil
+	case LevelBasic:
+		return &LsRefsDebugInfo{Cached: info.Cached}
+	}
+	return &info
 }
 
 type PushCommandStatus struct {
@@ -27,12 +162,36 @@ type PushDebugInfo struct {
 	PackfileSize int `json:"packfileSize"`
 
 	// RefAdvHeaders is the headers of the HTTP response in calling /info/refs
-	RefAdvResponseHeaders map[string][]string `json:"refAdvResponseHeaders"`
+	RefAdvResponseHeaders map[string][]string `json:"refAdvResponseHeaders,omitempty"`
 	// PushResponseHeaders is the headers of the HTTP response in calling /git-receive-pack
-	PushResponseHeaders map[string][]string `json:"pushResponseHeaders"`
+	PushResponseHeaders map[string][]string `json:"pushResponseHeaders,omitempty"`
 
 	// UnpackStatus is the status sent from the server for unpacking the packfile.
 	UnpackStatus string `json:"unpackStatus"`
 	// CommandStatuses is the status of each command sent to the server.
 	CommandStatuses []*PushCommandStatus `json:"commandStatuses"`
 }
+
+// Trim returns a copy of the debug info that has only the fields for the level. It returns nil
+// for LevelNone.
+func (info *PushDebugInfo) Trim(level Level) *PushDebugInfo {
+	if info == nil || level == LevelNone {
+		return nil
+	}
+	ret := *info
+	if level == LevelBasic {
+		ret.RefAdvResponseHeaders = nil
+		ret.PushResponseHeaders = nil
+	}
+	return &ret
+}
+
+// BudgetDebugInfo is the time spent on an operation that has a time limit.
+type BudgetDebugInfo struct {
+	// TimeoutMillis is the time limit of the operation in milliseconds.
+	TimeoutMillis int64 `json:"timeoutMillis"`
+	// ElapsedMillis is the time spent on the operation in milliseconds.
+	ElapsedMillis int64 `json:"elapsedMillis"`
+	// TimedOut is true if the operation was stopped at the time limit.
+	TimedOut bool `json:"timedOut,omitempty"`
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package debug
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseLevel(t *testing.T) {
+	for _, s := range []string{"none", "basic", "full"} {
+		if level, err := ParseLevel(s); err != nil || string(level) != s {
+			t.Errorf("ParseLevel(%q) = %q, %v", s, level, err)
+		}
+	}
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Error("expected an error for an unknown level")
+	}
+}
+
+func TestTrim(t *testing.T) {
+	headers := map[string][]string{"Content-Type": {"application/x-git-upload-pack-result"}}
+	fetch := FetchDebugInfo{ResponseHeaders: headers, PackfileSize: 10}
+	lsRefs := LsRefsDebugInfo{ResponseHeaders: headers, Cached: true}
+	push := &PushDebugInfo{PackfileSize: 20, RefAdvResponseHeaders: headers, PushResponseHeaders: headers, UnpackStatus: "ok"}
+
+	if got := fetch.Trim(LevelNone); got != nil {
+		t.Errorf("FetchDebugInfo.Trim(none) = %+v, want nil", got)
+	}
+	if got := lsRefs.Trim(LevelNone); got != nil {
+		t.Errorf("LsRefsDebugInfo.Trim(none) = %+v, want nil", got)
+	}
+	if got := push.Trim(LevelNone); got != nil {
+		t.Errorf("PushDebugInfo.Trim(none) = %+v, want nil", got)
+	}
+
+	// The basic level drops the headers only.
+	if diff := cmp.Diff(&FetchDebugInfo{PackfileSize: 10}, fetch.Trim(LevelBasic)); diff != "" {
+		t.Errorf("FetchDebugInfo.Trim(basic) diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(&LsRefsDebugInfo{Cached: true}, lsRefs.Trim(LevelBasic)); diff != "" {
+		t.Errorf("LsRefsDebugInfo.Trim(basic) diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(&PushDebugInfo{PackfileSize: 20, UnpackStatus: "ok"}, push.Trim(LevelBasic)); diff != "" {
+		t.Errorf("PushDebugInfo.Trim(basic) diff (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(&fetch, fetch.Trim(LevelFull)); diff != "" {
+		t.Errorf("FetchDebugInfo.Trim(full) diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(&lsRefs, lsRefs.Trim(LevelFull)); diff != "" {
+		t.Errorf("LsRefsDebugInfo.Trim(full) diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(push, push.Trim(LevelFull)); diff != "" {
+		t.Errorf("PushDebugInfo.Trim(full) diff (-want +got):\n%s", diff)
+	}
+	// Trim doesn't modify the original.
+	if push.PushResponseHeaders == nil {
+		t.Error("PushDebugInfo.Trim(basic) modified the original")
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetAttributes(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile(".gitattributes", "*.pb.go linguist-generated\n*.txt eol=lf\n", "root attributes")
+	repo.CommitFile("api/.gitattributes", "legacy.pb.go -linguist-generated\n", "api attributes")
+	head := repo.CommitFile("api/service.pb.go", "package api\n", "service")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	paths := []string{"api/service.pb.go", "api/legacy.pb.go", "README.txt"}
+	got, _, err := nichegit.GetAttributes(server.RepoURL(), &http.Client{}, nichegit.GetAttributesArgs{
+		CommitHash: head,
+		Paths:      paths,
+		Attributes: []string{"linguist-generated", "eol"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*nichegit.PathAttributes{
+		{Path: "api/service.pb.go", Attributes: map[string]string{"linguist-generated": "set", "eol": "unspecified"}},
+		{Path: "api/legacy.pb.go", Attributes: map[string]string{"linguist-generated": "unset", "eol": "unspecified"}},
+		{Path: "README.txt", Attributes: map[string]string{"linguist-generated": "unspecified", "eol": "lf"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetAttributes diff (-want +got):\n%s", diff)
+	}
+
+	// Cross-check with git check-attr.
+	for _, pa := range got {
+		for _, line := range strings.Split(repo.Git("check-attr", "linguist-generated", "eol", "--", pa.Path), "\n") {
+			parts := strings.SplitN(line, ": ", 3)
+			if len(parts) != 3 {
+				t.Fatalf("unexpected git check-attr output: %q", line)
+			}
+			if pa.Attributes[parts[1]] != parts[2] {
+				t.Errorf("%s: %s is %q, git check-attr says %q", pa.Path, parts[1], pa.Attributes[parts[1]], parts[2])
+			}
+		}
+	}
+}
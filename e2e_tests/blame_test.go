@@ -0,0 +1,83 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetBlame(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("file.txt", "1\n2\n3\n4\n5\n6\n", "initial")
+	repo.CommitFile("other.txt", "other\n", "unrelated")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("file.txt", "1\n2\nfeature\n4\n5\n6\n", "feature change")
+	repo.Git("checkout", "--quiet", "main")
+	repo.CommitFile("file.txt", "0\n1\n2\n3\n4\n5\nmain\n", "main change")
+	repo.Git("merge", "--quiet", "--no-edit", "feature")
+	repo.CommitFile("file.txt", "0\n1\n2\nfeature\nnew\n4\n5\nmain\n", "add a line")
+	head := repo.RevParse("HEAD")
+	repo.Push("main")
+
+	lines, _, err := nichegit.GetBlame(nichegittest.NewServer(t, repo).RepoURL(), &http.Client{}, nichegit.GetBlameArgs{
+		CommitHash: head,
+		Path:       "file.txt",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(gitBlame(t, repo, head.String(), "file.txt"), blameSummary(lines)); diff != "" {
+		t.Errorf("blame differs from git blame (-want +got):\n%s", diff)
+	}
+	if lines[4].Content != "new" || lines[4].Author.Name == "" {
+		t.Errorf("unexpected line: %+v", lines[4])
+	}
+
+	// Only the last two commits are looked into.
+	lines, _, err = nichegit.GetBlame(repo.FileURL(), &http.Client{}, nichegit.GetBlameArgs{
+		CommitHash: head,
+		Path:       "file.txt",
+		MaxCommits: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range lines {
+		if line.Content == "new" {
+			if line.Boundary || line.CommitHash != head.String() {
+				t.Errorf("the new line is expected to be attributed to the head: %+v", line)
+			}
+		} else if !line.Boundary {
+			t.Errorf("the old line is expected to be a boundary: %+v", line)
+		}
+	}
+}
+
+// gitBlame returns the commit and the original line number of each line from git blame.
+func gitBlame(t *testing.T, repo *nichegittest.TempRepo, rev, pth string) []string {
+	var ret []string
+	for _, line := range strings.Split(repo.Git("blame", "--porcelain", rev, "--", pth), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 3 && plumbing.IsHash(fields[0]) {
+			ret = append(ret, fields[0]+":"+fields[1])
+		}
+	}
+	return ret
+}
+
+func blameSummary(lines []*nichegit.BlameLine) []string {
+	var ret []string
+	for _, line := range lines {
+		ret = append(ret, line.CommitHash+":"+strconv.Itoa(line.OriginalLineNumber))
+	}
+	return ret
+}
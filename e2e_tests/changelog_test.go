@@ -0,0 +1,71 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGenerateChangelog(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "0\n", "chore: initial commit")
+	repo.Git("tag", "v1.0.0", base.String())
+	// The commits need distinct committer timestamps to be ordered.
+	commit := func(message, date string) {
+		t.Setenv("GIT_COMMITTER_DATE", date)
+		repo.Git("commit", "--quiet", "--allow-empty", "--message", message)
+	}
+	commit("feat(api): add the endpoint (#12)", "2024-01-01T00:00:00Z")
+	commit("fix!: drop the flag\n\nFixes: #3", "2024-01-02T00:00:00Z")
+	commit("Update the README", "2024-01-03T00:00:00Z")
+	repo.Push("main", "--tags")
+	server := nichegittest.NewServer(t, repo)
+
+	changelog, _, err := nichegit.GenerateChangelog(server.RepoURL(), &http.Client{}, nichegit.GenerateChangelogArgs{
+		FromRef: "v1.0.0",
+		ToRef:   "main",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var types []string
+	for _, g := range changelog.Groups {
+		types = append(types, g.Type)
+	}
+	if diff := cmp.Diff([]string{"breaking", "feat", "fix", "other"}, types); diff != "" {
+		t.Errorf("group types diff (-want +got):\n%s", diff)
+	}
+
+	fix := changelog.Groups[0].Entries[0]
+	feat := changelog.Groups[1].Entries[0]
+	other := changelog.Groups[3].Entries[0]
+	want := "## Breaking Changes\n\n" +
+		"- drop the flag #3 (" + fix.ShortHash + ")\n\n" +
+		"## Features\n\n" +
+		"- **api:** add the endpoint (#12) (" + feat.ShortHash + ")\n\n" +
+		"## Bug Fixes\n\n" +
+		"- drop the flag #3 (" + fix.ShortHash + ")\n\n" +
+		"## Other Changes\n\n" +
+		"- Update the README (" + other.ShortHash + ")\n\n"
+	if diff := cmp.Diff(want, changelog.Markdown); diff != "" {
+		t.Errorf("Markdown diff (-want +got):\n%s", diff)
+	}
+
+	changelog, _, err = nichegit.GenerateChangelog(server.RepoURL(), &http.Client{}, nichegit.GenerateChangelogArgs{
+		FromRef:  "v1.0.0",
+		ToRef:    "main",
+		Template: "{{range .Groups}}{{.Type}}={{len .Entries}};{{end}}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := changelog.Markdown, "breaking=1;feat=1;fix=1;other=1;"; got != want {
+		t.Errorf("Markdown = %q, want %q", got, want)
+	}
+}
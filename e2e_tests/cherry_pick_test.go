@@ -0,0 +1,234 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestPushCherryPickRange(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	first := repo.CommitFile("b.txt", "b\n", "Add b")
+	repo.CommitFile("c.txt", "c\n", "Add c")
+	feature := repo.CommitFile("b.txt", "b2\n", "Update b")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("d.txt", "d\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.PushCherryPickArgs{
+		Ranges:         []nichegit.CommitRange{{Start: first, End: feature}},
+		Commits:        []plumbing.Hash{first},
+		CherryPickOnto: main,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/backport"),
+		CurrentRefHash: &plumbing.ZeroHash,
+		DryRun:         true,
+	}
+	plan, _, pushDebugInfo, err := nichegit.PushCherryPick(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pushDebugInfo != nil || !repo.RemoteRefHash("refs/heads/backport").IsZero() {
+		t.Errorf("pushed in the dry-run mode")
+	}
+	// 3 commits, 3 root trees, and no new blobs.
+	if len(plan.Commits) != 3 || plan.NewObjects != 6 {
+		t.Errorf("the plan has %d commits and %d new objects, want 3 and 6", len(plan.Commits), plan.NewObjects)
+	}
+
+	args.DryRun = false
+	result, _, _, err := nichegit.PushCherryPick(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.CommitHash != plan.CommitHash {
+		t.Errorf("pushed %s, but the plan was %s", result.CommitHash, plan.CommitHash)
+	}
+	var originals []plumbing.Hash
+	for _, c := range result.Commits {
+		originals = append(originals, c.OriginalCommitHash)
+	}
+	if want := repo.Git("rev-list", "--reverse", base.String()+".."+feature.String()); commitLines(originals) != want {
+		t.Errorf("cherry-picked %v, want\n%s", originals, want)
+	}
+
+	repo.Git("fetch", "--quiet", "origin", "backport")
+	if got := repo.RevParse("FETCH_HEAD"); got != result.CommitHash {
+		t.Errorf("backport is %s, want %s", got, result.CommitHash)
+	}
+	if got, want := repo.Git("log", "--format=%s", main.String()+"..FETCH_HEAD"), "Update b\nAdd c\nAdd b"; got != want {
+		t.Errorf("the commits are %q, want %q", got, want)
+	}
+	if got := repo.Git("log", "-1", "--format=%P", "FETCH_HEAD~2"); got != main.String() {
+		t.Errorf("the parent of the first commit is %s, want %s", got, main)
+	}
+	if got := repo.Git("show", "FETCH_HEAD:b.txt"); got != "b2" {
+		t.Errorf("b.txt is %q", got)
+	}
+	if got := repo.Git("show", "FETCH_HEAD:d.txt"); got != "d" {
+		t.Errorf("d.txt is %q", got)
+	}
+
+	// A range with a merge commit can't be cherry-picked.
+	repo.Git("checkout", "--quiet", "feature")
+	repo.Git("merge", "--quiet", "--no-edit", "main")
+	merged := repo.RevParse("HEAD")
+	repo.Push("feature")
+	_, _, _, err = nichegit.PushCherryPick(server.RepoURL(), &http.Client{}, nichegit.PushCherryPickArgs{
+		Ranges:         []nichegit.CommitRange{{Start: base, End: merged}},
+		CherryPickOnto: main,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/backport2"),
+	})
+	if err == nil || !strings.Contains(err.Error(), "merge commit") {
+		t.Errorf("expected an error for the merge commit, got %v", err)
+	}
+}
+
+func TestPushCherryPickConflict(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature := repo.CommitFile("a.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("a.txt", "main\n", "main")
+	repo.Push("main", "feature")
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	result, _, pushDebugInfo, err := nichegit.PushCherryPick(nichegittest.NewServer(t, repo).RepoURL(), &http.Client{}, nichegit.PushCherryPickArgs{
+		Ranges:          []nichegit.CommitRange{{Start: base, End: feature}},
+		CherryPickOnto:  main,
+		Committer:       sig,
+		Ref:             plumbing.ReferenceName("refs/heads/backport"),
+		AbortOnConflict: true,
+	})
+	var conflictErr *nichegit.ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("expected ConflictError, got %v", err)
+	}
+	if pushDebugInfo != nil {
+		t.Errorf("pushed despite the conflict")
+	}
+	if len(result.Commits) != 1 || len(result.Commits[0].ConflictOpenFiles) != 1 || result.Commits[0].ConflictOpenFiles[0] != "a.txt" {
+		t.Errorf("unexpected result: %+v", result.Commits)
+	}
+}
+
+func TestPushCherryPickTrailers(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("b.txt", "b\n", "Add b\n\nCo-authored-by: Foo <foo@example.com>")
+	feature := repo.CommitFile("c.txt", "c\n", "Add c")
+	repo.Git("checkout", "--quiet", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.PushCherryPickArgs{
+		Ranges:         []nichegit.CommitRange{{Start: base, End: feature}},
+		CherryPickOnto: base,
+		Committer:      sig,
+		Trailers: []nichegit.Trailer{
+			{Key: "Co-authored-by", Value: "Foo <foo@example.com>"},
+			{Key: "Backport-Of", Value: "#123"},
+		},
+		Ref: plumbing.ReferenceName("refs/heads/backport"),
+	}
+	if _, _, _, err := nichegit.PushCherryPick(server.RepoURL(), &http.Client{}, args); err != nil {
+		t.Fatal(err)
+	}
+	repo.Git("fetch", "--quiet", "origin", "backport")
+	// The existing trailer is not added again.
+	if got, want := repo.Git("show", "--no-patch", "--format=%B", "FETCH_HEAD~1"), "Add b\n\nCo-authored-by: Foo <foo@example.com>\nBackport-Of: #123"; got != want {
+		t.Errorf("commit message is %q, want %q", got, want)
+	}
+	if got, want := repo.Git("show", "--no-patch", "--format=%B", "FETCH_HEAD"), "Add c\n\nCo-authored-by: Foo <foo@example.com>\nBackport-Of: #123"; got != want {
+		t.Errorf("commit message is %q, want %q", got, want)
+	}
+
+	// A multiline value would not be parsed back as a trailer.
+	args.Trailers = []nichegit.Trailer{{Key: "Backport-Of", Value: "#123\n#124"}}
+	if _, _, _, err := nichegit.PushCherryPick(server.RepoURL(), &http.Client{}, args); err == nil {
+		t.Errorf("expected an error for the invalid trailer")
+	}
+}
+
+func commitLines(hashes []plumbing.Hash) string {
+	var lines []string
+	for _, hash := range hashes {
+		lines = append(lines, hash.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestPushCherryPickKeepsHeaders(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "base")
+	added := repo.CommitFile("b.txt", "b\n", "Add b")
+	repo.Git("reset", "--quiet", "--hard", base.String())
+	main := repo.CommitFile("c.txt", "c\n", "main")
+	feature := writeRawCommit(t, repo, "tree "+repo.Git("rev-parse", added.String()+"^{tree}")+"\n"+
+		"parent "+base.String()+"\n"+
+		"author Author <author@example.com> 1700000000 +0000\n"+
+		"committer Author <author@example.com> 1700000000 +0000\n"+
+		"encoding ISO-8859-1\n"+
+		"x-review-id 1234\n"+
+		" continued\n"+
+		"\n"+
+		"Add b\n")
+	repo.Git("branch", "feature", feature.String())
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	// The extra headers are signed along with the rest of the commit.
+	signer, pub := newSSHSigner(t)
+	result, _, _, err := nichegit.PushCherryPick(server.RepoURL(), &http.Client{}, nichegit.PushCherryPickArgs{
+		Commits:        []plumbing.Hash{feature},
+		CherryPickOnto: main,
+		Committer:      object.Signature{Name: "niche-git", Email: "niche-git@example.com"},
+		Ref:            plumbing.ReferenceName("refs/heads/backport"),
+		CurrentRefHash: &plumbing.ZeroHash,
+		Signer:         signer,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.Git("fetch", "--quiet", "origin", "backport")
+	raw := repo.Git("cat-file", "commit", result.CommitHash.String())
+	for _, want := range []string{"\nencoding ISO-8859-1\n", "\nx-review-id 1234\n continued\n"} {
+		if !strings.Contains(raw, want) {
+			t.Errorf("the cherry-picked commit doesn't have %q:\n%s", want, raw)
+		}
+	}
+	if files := repo.Git("ls-tree", "--name-only", "FETCH_HEAD"); !strings.Contains(files, "b.txt") {
+		t.Errorf("b.txt is not cherry-picked:\n%s", files)
+	}
+	verifyCommit(t, repo, pub, "FETCH_HEAD")
+}
+
+// writeRawCommit writes the commit object to the repository as is, e.g. with the headers that
+// git commit doesn't write.
+func writeRawCommit(t *testing.T, repo *nichegittest.TempRepo, content string) plumbing.Hash {
+	t.Helper()
+	pth := filepath.Join(t.TempDir(), "commit")
+	if err := os.WriteFile(pth, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return plumbing.NewHash(repo.Git("hash-object", "-t", "commit", "-w", "--literally", pth))
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetCommitGraph(t *testing.T) {
+	// base - a1 - a2 - m
+	//          \      /
+	//           b1 --
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("base.txt", "base\n", "base")
+	a1 := repo.CommitFile("a.txt", "1\n", "a1")
+	repo.Git("checkout", "--quiet", "-b", "b")
+	b1 := repo.CommitFile("b.txt", "1\n", "b1")
+	repo.Git("checkout", "--quiet", "main")
+	a2 := repo.CommitFile("a.txt", "2\n", "a2")
+	repo.Git("merge", "--quiet", "--no-ff", "--no-edit", "b")
+	m := repo.RevParse("HEAD")
+	repo.Push("main", "b")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	nodes, _, err := nichegit.GetCommitGraph(repoURL, &http.Client{}, nichegit.GetCommitGraphArgs{
+		WantCommitHashes: []plumbing.Hash{m},
+		HaveCommitHashes: []plumbing.Hash{base},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]int{}
+	index := map[string]int{}
+	for i, node := range nodes {
+		got[node.Hash] = node.Generation
+		index[node.Hash] = i
+		if node.AuthorTime.IsZero() {
+			t.Errorf("%s has no author time", node.Hash)
+		}
+	}
+	want := map[string]int{a1.String(): 1, a2.String(): 2, b1.String(): 2, m.String(): 3}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("generations diff (-want +got):\n%s", diff)
+	}
+	// The commits come before their parents.
+	for _, node := range nodes {
+		for _, parent := range node.ParentHashes {
+			if i, ok := index[parent]; ok && i < index[node.Hash] {
+				t.Errorf("%s comes before its child %s", parent, node.Hash)
+			}
+		}
+	}
+	if diff := cmp.Diff([]string{a2.String(), b1.String()}, nodes[0].ParentHashes); diff != "" {
+		t.Errorf("the parents of the merge diff (-want +got):\n%s", diff)
+	}
+}
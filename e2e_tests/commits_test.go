@@ -0,0 +1,192 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFetchCommitsResume(t *testing.T) {
+	const numCommits = 100
+	repo := nichegittest.NewTempRepo(t)
+	// The packfile needs to span multiple sideband packets (64 KiB each) as a partially
+	// received packet is discarded. Use incompressible commit messages to make it large.
+	var head plumbing.Hash
+	sum := sha256.Sum256(nil)
+	for i := 0; i < numCommits; i++ {
+		var body strings.Builder
+		for j := 0; j < 64; j++ {
+			sum = sha256.Sum256(sum[:])
+			body.WriteString(hex.EncodeToString(sum[:]) + "\n")
+		}
+		head = repo.CommitFile("file.txt", fmt.Sprintf("%d\n", i), fmt.Sprintf("commit %d\n\n%s", i, body.String()))
+	}
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	args := nichegit.FetchCommitsArgs{
+		WantCommitHashes: []plumbing.Hash{head},
+	}
+	_, debugInfo, err := nichegit.FetchCommits(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if debugInfo.ObjectStats.Commits != numCommits || debugInfo.ObjectStats.Trees != 0 || debugInfo.ObjectStats.Blobs != 0 {
+		t.Errorf("unexpected object stats: %+v", debugInfo.ObjectStats)
+	}
+	fullPackfileSize := debugInfo.PackfileSize
+
+	args.ResumeDir = t.TempDir()
+	server.InjectFault(nichegittest.Fault{
+		Match:         nichegittest.MatchUploadPack,
+		Times:         1,
+		TruncateAfter: fullPackfileSize / 2,
+	})
+	if _, _, err := nichegit.FetchCommits(server.RepoURL(), &http.Client{}, args); err == nil {
+		t.Fatal("expected the truncated fetch to fail")
+	}
+
+	commits, debugInfo, err := nichegit.FetchCommits(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != numCommits {
+		t.Errorf("got %d commits, want %d", len(commits), numCommits)
+	}
+	if debugInfo.ResumedPackfileSize == 0 {
+		t.Error("expected the fetch to resume from the saved packfile")
+	}
+	if debugInfo.PackfileSize == 0 || debugInfo.PackfileSize >= fullPackfileSize {
+		t.Errorf("expected only the rest of the commits to be fetched, got %d bytes out of %d", debugInfo.PackfileSize, fullPackfileSize)
+	}
+}
+
+func TestFetchCommitsOnlyNewCommits(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	var old plumbing.Hash
+	for i := 0; i < 5; i++ {
+		old = repo.CommitFile("file.txt", fmt.Sprintf("%d\n", i), fmt.Sprintf("old %d", i))
+	}
+	var head plumbing.Hash
+	for i := 0; i < 3; i++ {
+		head = repo.CommitFile("file.txt", fmt.Sprintf("new %d\n", i), fmt.Sprintf("new %d", i))
+	}
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	commits, _, err := nichegit.FetchCommits(server.RepoURL(), &http.Client{}, nichegit.FetchCommitsArgs{
+		WantCommitHashes: []plumbing.Hash{head},
+		HaveCommitHashes: []plumbing.Hash{old},
+		OnlyNewCommits:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != 3 {
+		t.Errorf("got %d commits, want 3", len(commits))
+	}
+	for _, c := range commits {
+		if !strings.HasPrefix(c.Message, "new ") {
+			t.Errorf("unexpected commit %s: %q", c.Hash, c.Message)
+		}
+	}
+
+	unknown := plumbing.NewHash("1111111111111111111111111111111111111111")
+	_, _, err = nichegit.FetchCommits(server.RepoURL(), &http.Client{}, nichegit.FetchCommitsArgs{
+		WantCommitHashes: []plumbing.Hash{head},
+		HaveCommitHashes: []plumbing.Hash{unknown},
+		OnlyNewCommits:   true,
+	})
+	if err == nil || !strings.Contains(err.Error(), unknown.String()) {
+		t.Errorf("expected an error for the unknown have, got %v", err)
+	}
+}
+
+func TestFetchCommitsBounded(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	commitAt := func(i int, pth, content string) plumbing.Hash {
+		t.Setenv("GIT_COMMITTER_DATE", start.Add(time.Duration(i)*time.Hour).Format(time.RFC3339))
+		return repo.CommitFile(pth, content, content)
+	}
+	for i := 0; i < 200; i++ {
+		commitAt(i, "main.txt", fmt.Sprintf("main %d", i))
+	}
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	var feature plumbing.Hash
+	for i := 0; i < 80; i++ {
+		feature = commitAt(200+i, "feature.txt", fmt.Sprintf("feature %d", i))
+	}
+	repo.Git("checkout", "--quiet", "main")
+	main := commitAt(300, "main.txt", "main 200")
+	repo.Git("merge", "--quiet", "--no-ff", "--no-edit", "feature")
+	merge := repo.RevParse("HEAD")
+	repo.Push("main", "feature")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	// The 50 newest commits on feature that are not on main.
+	commits, debugInfo, err := nichegit.FetchCommits(repoURL, &http.Client{}, nichegit.FetchCommitsArgs{
+		WantCommitHashes: []plumbing.Hash{feature},
+		StopAtHashes:     []plumbing.Hash{main},
+		MaxCount:         50,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(gitLogHashes(repo, "-n", "50", "feature", "^"+main.String()), commitHashes(commits)); diff != "" {
+		t.Errorf("MaxCount and StopAtHashes diff (-want +got):\n%s", diff)
+	}
+	if debugInfo.ObjectStats.Commits != 50 {
+		t.Errorf("fetched %d commits, want only 50", debugInfo.ObjectStats.Commits)
+	}
+
+	// The commits on feature that are not on main are fewer than MaxCount.
+	commits, _, err = nichegit.FetchCommits(repoURL, &http.Client{}, nichegit.FetchCommitsArgs{
+		WantCommitHashes: []plumbing.Hash{merge},
+		StopAtHashes:     []plumbing.Hash{repo.RevParse("feature~80")},
+		MaxCount:         200,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(gitLogHashes(repo, "-n", "200", "main", "^feature~80"), commitHashes(commits)); diff != "" {
+		t.Errorf("StopAtHashes diff (-want +got):\n%s", diff)
+	}
+
+	since, until := start.Add(100*time.Hour), start.Add(250*time.Hour)
+	commits, debugInfo, err = nichegit.FetchCommits(repoURL, &http.Client{}, nichegit.FetchCommitsArgs{
+		WantCommitHashes: []plumbing.Hash{merge},
+		Since:            since,
+		Until:            until,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(gitLogHashes(repo, "--since", since.Format(time.RFC3339), "--until", until.Format(time.RFC3339), "main"), commitHashes(commits)); diff != "" {
+		t.Errorf("Since and Until diff (-want +got):\n%s", diff)
+	}
+	if debugInfo.ObjectStats.Commits >= 280 {
+		t.Errorf("fetched %d commits, want only the ones since %s", debugInfo.ObjectStats.Commits, since)
+	}
+
+	if _, _, err := nichegit.FetchCommits(repoURL, &http.Client{}, nichegit.FetchCommitsArgs{
+		WantCommitHashes: []plumbing.Hash{merge},
+		MaxCount:         10,
+		ApplyReplaceRefs: true,
+	}); err == nil {
+		t.Error("expected an error for MaxCount with ApplyReplaceRefs")
+	}
+}
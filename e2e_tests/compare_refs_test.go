@@ -0,0 +1,70 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestCompareRefs(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("b.txt", "b\n", "f1")
+	repo.CommitFile("c.txt", "c\n", "f2")
+	repo.Git("checkout", "--quiet", "main")
+	m1 := repo.CommitFile("d.txt", "d\n", "m1")
+	m2 := repo.CommitFile("e.txt", "e\n", "m2")
+	repo.Git("checkout", "--quiet", "feature")
+	repo.Git("merge", "--quiet", "--no-edit", m1.String())
+	feature := repo.CommitFile("f.txt", "f\n", "f3")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	result, _, err := nichegit.CompareRefs(server.RepoURL(), &http.Client{}, nichegit.CompareRefsArgs{
+		RefA: "feature",
+		RefB: "refs/heads/main",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RefAHash != feature || result.RefBHash != m2 || result.MergeBase != m1 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if want := repo.Git("rev-list", "--count", "main..feature"); strconv.Itoa(result.Ahead) != want {
+		t.Errorf("ahead is %d, want %s", result.Ahead, want)
+	}
+	if want := repo.Git("rev-list", "--count", "feature..main"); strconv.Itoa(result.Behind) != want {
+		t.Errorf("behind is %d, want %s", result.Behind, want)
+	}
+	var ahead []plumbing.Hash
+	for _, c := range result.AheadCommits {
+		ahead = append(ahead, plumbing.NewHash(c.Hash))
+	}
+	if want := repo.Git("rev-list", "main..feature"); commitLines(ahead) != want {
+		t.Errorf("ahead commits are %v, want\n%s", ahead, want)
+	}
+	if len(result.BehindCommits) != 1 || result.BehindCommits[0].Hash != m2.String() {
+		t.Errorf("behind commits are %+v, want %s", result.BehindCommits, m2)
+	}
+
+	// The lists are limited, but the counts are not.
+	result, _, err = nichegit.CompareRefs(server.RepoURL(), &http.Client{}, nichegit.CompareRefsArgs{
+		RefA:       "main",
+		RefB:       feature.String(),
+		MaxCommits: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Ahead != 1 || result.Behind != 4 || len(result.BehindCommits) != 2 || result.BehindCommits[0].Hash != feature.String() {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
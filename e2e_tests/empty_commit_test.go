@@ -0,0 +1,84 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestPushEmptyCommit(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "base")
+	main := repo.CommitFile("b.txt", "b\n", "main")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.PushEmptyCommitArgs{
+		Ref:           plumbing.ReferenceName("refs/heads/main"),
+		CommitMessage: "Deploy to production",
+		Trailers:      []nichegit.Trailer{{Key: "Deployed-To", Value: "production"}, {Key: "Deploy-Id", Value: "42"}},
+		Author:        sig,
+		Committer:     sig,
+	}
+	result, _, _, err := nichegit.PushEmptyCommit(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Parent != main {
+		t.Errorf("the parent is %s, want %s", result.Parent, main)
+	}
+	if got := repo.RemoteRefHash("refs/heads/main"); got != result.CommitHash {
+		t.Errorf("main is %s, want %s", got, result.CommitHash)
+	}
+	repo.Git("fetch", "--quiet", "origin", "main")
+	if got, want := repo.Git("rev-parse", "FETCH_HEAD^{tree}"), repo.Git("rev-parse", main.String()+"^{tree}"); got != want {
+		t.Errorf("the tree is %s, want %s", got, want)
+	}
+	if got, want := repo.Git("show", "--no-patch", "--format=%B", "FETCH_HEAD"), "Deploy to production\n\nDeployed-To: production\nDeploy-Id: 42"; got != want {
+		t.Errorf("commit message is %q, want %q", got, want)
+	}
+
+	// The ref is not at the parent anymore.
+	args.Parent = main
+	if _, _, _, err := nichegit.PushEmptyCommit(server.RepoURL(), &http.Client{}, args); nichegit.ErrorCodeOf(err) != nichegit.ErrorCodeRefCASFailed {
+		t.Errorf("expected a compare-and-swap failure, got %v", err)
+	}
+}
+
+func TestPushEmptyCommit_Timezone(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "base")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	when := time.Date(2024, 1, 1, 9, 0, 0, 0, time.FixedZone("", -5*60*60))
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com", When: when}
+	tz, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, _, err = nichegit.PushEmptyCommit(server.RepoURL(), &http.Client{}, nichegit.PushEmptyCommitArgs{
+		Ref:           plumbing.ReferenceName("refs/heads/main"),
+		CommitMessage: "Deploy to production",
+		Author:        sig,
+		Committer:     sig,
+		Timezone:      tz,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.Git("fetch", "--quiet", "origin", "main")
+	// The same instant in +0900.
+	if got, want := repo.Git("show", "--no-patch", "--format=%ai %ci", "FETCH_HEAD"), "2024-01-01 23:00:00 +0900 2024-01-01 23:00:00 +0900"; got != want {
+		t.Errorf("the author and commit times are %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetFileHistory(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "1\n", "a 1")
+	repo.CommitFile("dir/b.txt", "1\n", "b 1")
+	repo.CommitFile("c.txt", "1\n", "c 1")
+	repo.CommitFile("a.txt", "2\n", "a 2")
+	repo.CommitFile("dir/sub/d.txt", "1\n", "d 1")
+	repo.CommitFile("c.txt", "2\n", "c 2")
+	repo.CommitFile("dir/b.txt", "2\n", "b 2")
+	repo.Git("rm", "--quiet", "a.txt")
+	repo.Git("commit", "--quiet", "--message", "a deleted")
+	head := repo.CommitFile("c.txt", "3\n", "c 3")
+	repo.Push("main")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	for _, paths := range [][]string{{"a.txt"}, {"dir"}, {"dir/sub/d.txt"}, {"a.txt", "dir/b.txt"}, {"missing.txt"}} {
+		result, _, err := nichegit.GetFileHistory(repoURL, &http.Client{}, nichegit.GetFileHistoryArgs{
+			CommitHash: head,
+			Paths:      paths,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := gitLogHashes(repo, append([]string{"main", "--"}, paths...)...)
+		if diff := cmp.Diff(want, commitHashes(result.Commits)); diff != "" {
+			t.Errorf("GetFileHistory(%v) diff (-want +got):\n%s", paths, diff)
+		}
+		if result.NextCursor != "" {
+			t.Errorf("GetFileHistory(%v) NextCursor = %q, want empty", paths, result.NextCursor)
+		}
+	}
+
+	// Take the history of a.txt and dir two commits at a time.
+	var got []string
+	cursor := ""
+	for page := 0; ; page++ {
+		if page > 5 {
+			t.Fatal("too many pages")
+		}
+		result, _, err := nichegit.GetFileHistory(repoURL, &http.Client{}, nichegit.GetFileHistoryArgs{
+			CommitHash: head,
+			Paths:      []string{"a.txt", "dir"},
+			PageSize:   2,
+			Cursor:     cursor,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, commitHashes(result.Commits)...)
+		if result.NextCursor == "" {
+			break
+		}
+		cursor = result.NextCursor
+	}
+	if diff := cmp.Diff(gitLogHashes(repo, "main", "--", "a.txt", "dir"), got); diff != "" {
+		t.Errorf("paged GetFileHistory diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetFileHistoryMerges(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "1\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature := repo.CommitFile("a.txt", "2\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	repo.CommitFile("b.txt", "1\n", "main")
+	// Takes a.txt from feature, so this merge doesn't modify a.txt.
+	repo.Git("merge", "--quiet", "--no-edit", "feature")
+	repo.Git("checkout", "--quiet", "-b", "other", base.String())
+	other := repo.CommitFile("a.txt", "3\n", "other")
+	repo.Git("checkout", "--quiet", "main")
+	// Resolves a.txt to a content different from both sides.
+	repo.Git("merge", "--quiet", "--no-commit", "--strategy=ours", "other")
+	merge := repo.CommitFile("a.txt", "4\n", "Merge other")
+	repo.Push("main")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	result, _, err := nichegit.GetFileHistory(repoURL, &http.Client{}, nichegit.GetFileHistoryArgs{
+		CommitHash: merge,
+		Paths:      []string{"a.txt"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]bool{}
+	for _, hash := range commitHashes(result.Commits) {
+		got[hash] = true
+	}
+	want := map[string]bool{
+		merge.String():   true,
+		other.String():   true,
+		feature.String(): true,
+		base.String():    true,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetFileHistory diff (-want +got):\n%s", diff)
+	}
+}
+
+func gitLogHashes(repo *nichegittest.TempRepo, args ...string) []string {
+	out := repo.Git(append([]string{"log", "--format=%H"}, args...)...)
+	if out == "" {
+		return nil
+	}
+	return strings.Split(out, "\n")
+}
+
+func commitHashes(commits []*nichegit.CommitInfo) []string {
+	var ret []string
+	for _, c := range commits {
+		ret = append(ret, c.Hash)
+	}
+	return ret
+}
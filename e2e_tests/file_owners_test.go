@@ -0,0 +1,71 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFetchFileOwners(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	setAuthor := func(name string) {
+		repo.Git("config", "user.name", name)
+		repo.Git("config", "user.email", name+"@example.com")
+	}
+	setAuthor("alice")
+	repo.CommitFile("a.txt", "1\n", "a 1")
+	repo.CommitFile("b.txt", "1\n", "b 1")
+	setAuthor("bob")
+	repo.CommitFile("a.txt", "2\n", "a 2")
+	repo.CommitFile("a.txt", "3\n", "a 3")
+	base := repo.CommitFile("c.txt", "1\n", "c 1")
+	setAuthor("carol")
+	repo.CommitFile("a.txt", "4\n", "a 4")
+	head := repo.CommitFile("b.txt", "2\n", "b 2")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	files, _, err := nichegit.FetchFileOwners(server.RepoURL(), &http.Client{}, nichegit.FetchFileOwnersArgs{
+		BaseCommitHash: base,
+		HeadCommitHash: head,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*nichegit.FileOwners{
+		{
+			Path: "a.txt",
+			Authors: []*nichegit.FileAuthor{
+				{Name: "bob", Email: "bob@example.com", Commits: 2},
+				{Name: "alice", Email: "alice@example.com", Commits: 1},
+			},
+		},
+		{
+			Path: "b.txt",
+			Authors: []*nichegit.FileAuthor{
+				{Name: "alice", Email: "alice@example.com", Commits: 1},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, files); diff != "" {
+		t.Errorf("FetchFileOwners diff (-want +got):\n%s", diff)
+	}
+
+	files, _, err = nichegit.FetchFileOwners(server.RepoURL(), &http.Client{}, nichegit.FetchFileOwnersArgs{
+		BaseCommitHash: base,
+		HeadCommitHash: head,
+		MaxCommits:     2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(files[0].Authors); got != 1 || files[0].Authors[0].Commits != 1 {
+		t.Errorf("expected only the last commit on a.txt within 2 commits, got %+v", files[0].Authors)
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPushSquashCherryPickProtectedPaths(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile(".github/workflows/ci.yml", "on: push\n", "ci")
+	base := repo.CommitFile("src/main.txt", "base\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile(".github/workflows/ci.yml", "on: pull_request\n", "change ci")
+	feature := repo.CommitFile("src/main.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("README.md", "main\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom: feature,
+		CherryPickTo:   main,
+		CherryPickBase: base,
+		CommitMessage:  "Squashed",
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/squashed"),
+		CurrentRefHash: &plumbing.ZeroHash,
+		ProtectedPaths: []string{".github/workflows/**"},
+	}
+	_, _, _, err := nichegit.PushSquashCherryPick(server.RepoURL(), &http.Client{}, args)
+	var protectedErr *nichegit.ProtectedPathError
+	if !errors.As(err, &protectedErr) {
+		t.Fatalf("expected ProtectedPathError, got %v", err)
+	}
+	if diff := cmp.Diff([]string{".github/workflows/ci.yml"}, protectedErr.Paths); diff != "" {
+		t.Errorf("ProtectedPathError.Paths diff (-want +got):\n%s", diff)
+	}
+	if got := repo.RemoteRefHash("refs/heads/squashed"); got != plumbing.ZeroHash {
+		t.Errorf("refs/heads/squashed is pushed as %s", got)
+	}
+
+	args.AllowProtectedPathChanges = true
+	result, _, _, err := nichegit.PushSquashCherryPick(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{".github/workflows/ci.yml"}, result.ProtectedFiles); diff != "" {
+		t.Errorf("ProtectedFiles diff (-want +got):\n%s", diff)
+	}
+	if got := repo.RemoteRefHash("refs/heads/squashed"); got != result.CommitHash {
+		t.Errorf("refs/heads/squashed is %s, want %s", got, result.CommitHash)
+	}
+}
+
+func TestPushSquashCherryPickSizeGuards(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("b.txt", strings.Repeat("b", 1000), "feature 1")
+	repo.CommitFile("c.txt", strings.Repeat("c", 2000), "feature 2")
+	feature := repo.CommitFile("a.txt", "feature\n", "feature 3")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("README.md", "main\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom: feature,
+		CherryPickTo:   main,
+		CherryPickBase: base,
+		CommitMessage:  "Squashed",
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/squashed"),
+		CurrentRefHash: &plumbing.ZeroHash,
+	}
+	tooLarge := func(args nichegit.PushSquashCherryPickArgs) *nichegit.ChangeTooLargeError {
+		t.Helper()
+		_, _, _, err := nichegit.PushSquashCherryPick(server.RepoURL(), &http.Client{}, args)
+		var tooLargeErr *nichegit.ChangeTooLargeError
+		if !errors.As(err, &tooLargeErr) {
+			t.Fatalf("expected ChangeTooLargeError, got %v", err)
+		}
+		if code := nichegit.ErrorCodeOf(err); code != nichegit.ErrorCodeTooLarge {
+			t.Errorf("the error code is %q, want %q", code, nichegit.ErrorCodeTooLarge)
+		}
+		if got := repo.RemoteRefHash("refs/heads/squashed"); got != plumbing.ZeroHash {
+			t.Fatalf("refs/heads/squashed is pushed to %s", got)
+		}
+		return tooLargeErr
+	}
+
+	maxFiles := args
+	maxFiles.MaxChangedFiles = 2
+	if err := tooLarge(maxFiles); err.ChangedFiles != 3 || err.MaxChangedFiles != 2 {
+		t.Errorf("unexpected error: %+v", err)
+	}
+
+	// The blobs of the files that the commit adds or modifies count, which exist on the server.
+	maxBytes := args
+	maxBytes.MaxNewBlobBytes = 2500
+	if err := tooLarge(maxBytes); err.NewBlobBytes != 3008 || err.MaxNewBlobBytes != 2500 {
+		t.Errorf("unexpected error: %+v", err)
+	}
+
+	args.MaxChangedFiles = 3
+	args.MaxNewBlobBytes = 3008
+	result, _, _, err := nichegit.PushSquashCherryPick(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/squashed"); got != result.CommitHash {
+		t.Errorf("refs/heads/squashed is %s, want %s", got, result.CommitHash)
+	}
+}
+
+func TestPushSquashCherryPickProtectedPathsConflictRef(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile(".github/workflows/ci.yml", "on: push\n", "ci")
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile(".github/workflows/ci.yml", "on: pull_request\n", "change ci")
+	feature := repo.CommitFile("file.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("file.txt", "main\n", "main")
+	repo.Push("main", "feature")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	_, _, _, err := nichegit.PushSquashCherryPick(repoURL, &http.Client{}, nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom:       feature,
+		CherryPickTo:         main,
+		CherryPickBase:       base,
+		CommitMessage:        "Squashed",
+		Author:               sig,
+		Committer:            sig,
+		Ref:                  plumbing.ReferenceName("refs/heads/squashed"),
+		AbortOnConflict:      true,
+		ConflictRefNamespace: nichegit.DefaultScratchRefNamespace,
+		ProtectedPaths:       []string{".github/workflows/**"},
+	})
+	// The conflict commit is pushed to the repository too, so the guard applies to it.
+	var protectedErr *nichegit.ProtectedPathError
+	if !errors.As(err, &protectedErr) {
+		t.Fatalf("expected ProtectedPathError, got %v", err)
+	}
+	refs, _, err := nichegit.ListScratchRefs(repoURL, &http.Client{}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refs) != 0 {
+		t.Errorf("the conflict ref is pushed: %+v", refs)
+	}
+}
+
+func TestProtectedPathsInvalidPattern(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	main := repo.CommitFile("a.txt", "a\n", "base")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	_, _, _, err := nichegit.PushCherryPick(server.RepoURL(), &http.Client{}, nichegit.PushCherryPickArgs{
+		Commits:        []plumbing.Hash{main},
+		CherryPickOnto: main,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/backport"),
+		CurrentRefHash: &plumbing.ZeroHash,
+		ProtectedPaths: []string{"src/[a-"},
+	})
+	if err == nil || !strings.Contains(err.Error(), `invalid path pattern "src/[a-"`) {
+		t.Errorf("expected an invalid path pattern error, got %v", err)
+	}
+}
+
+func TestMergeBranchesProtectedPaths(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile(".github/workflows/ci.yml", "on: push\n", "ci")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile(".github/workflows/ci.yml", "on: pull_request\n", "change ci")
+	repo.CommitFile("feature.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("main.txt", "main\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.MergeBranchesArgs{
+		Into:           "refs/heads/main",
+		From:           "refs/heads/feature",
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/main"),
+		CurrentRefHash: &main,
+		ProtectedPaths: []string{".github/workflows/**"},
+	}
+	_, _, _, err := nichegit.MergeBranches(server.RepoURL(), &http.Client{}, args)
+	var protectedErr *nichegit.ProtectedPathError
+	if !errors.As(err, &protectedErr) {
+		t.Fatalf("expected ProtectedPathError, got %v", err)
+	}
+	if diff := cmp.Diff([]string{".github/workflows/ci.yml"}, protectedErr.Paths); diff != "" {
+		t.Errorf("ProtectedPathError.Paths diff (-want +got):\n%s", diff)
+	}
+	if got := repo.RemoteRefHash("refs/heads/main"); got != main {
+		t.Errorf("refs/heads/main is moved to %s", got)
+	}
+
+	args.AllowProtectedPathChanges = true
+	result, _, _, err := nichegit.MergeBranches(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{".github/workflows/ci.yml"}, result.ProtectedFiles); diff != "" {
+		t.Errorf("ProtectedFiles diff (-want +got):\n%s", diff)
+	}
+	if got := repo.RemoteRefHash("refs/heads/main"); got != result.CommitHash {
+		t.Errorf("refs/heads/main is %s, want %s", got, result.CommitHash)
+	}
+}
+
+func TestPushCherryPickProtectedPaths(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile(".github/workflows/ci.yml", "on: push\n", "ci")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	fix := repo.CommitFile(".github/workflows/ci.yml", "on: pull_request\n", "change ci")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("main.txt", "main\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.PushCherryPickArgs{
+		Commits:        []plumbing.Hash{fix},
+		CherryPickOnto: main,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/backport"),
+		CurrentRefHash: &plumbing.ZeroHash,
+		ProtectedPaths: []string{".github/workflows/**"},
+	}
+	_, _, _, err := nichegit.PushCherryPick(server.RepoURL(), &http.Client{}, args)
+	var protectedErr *nichegit.ProtectedPathError
+	if !errors.As(err, &protectedErr) {
+		t.Fatalf("expected ProtectedPathError, got %v", err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/backport"); got != plumbing.ZeroHash {
+		t.Errorf("refs/heads/backport is pushed as %s", got)
+	}
+
+	args.AllowProtectedPathChanges = true
+	result, _, _, err := nichegit.PushCherryPick(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{".github/workflows/ci.yml"}, result.ProtectedFiles); diff != "" {
+		t.Errorf("ProtectedFiles diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestPushSquashCherryPickSizeGuardsConflictRef(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("b.txt", "b\n", "feature 1")
+	feature := repo.CommitFile("file.txt", "feature\n", "feature 2")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("file.txt", "main\n", "main")
+	repo.Push("main", "feature")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	_, _, _, err := nichegit.PushSquashCherryPick(repoURL, &http.Client{}, nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom:       feature,
+		CherryPickTo:         main,
+		CherryPickBase:       base,
+		CommitMessage:        "Squashed",
+		Author:               sig,
+		Committer:            sig,
+		Ref:                  plumbing.ReferenceName("refs/heads/squashed"),
+		AbortOnConflict:      true,
+		ConflictRefNamespace: nichegit.DefaultScratchRefNamespace,
+		MaxChangedFiles:      1,
+	})
+	var tooLargeErr *nichegit.ChangeTooLargeError
+	if !errors.As(err, &tooLargeErr) {
+		t.Fatalf("expected ChangeTooLargeError, got %v", err)
+	}
+	refs, _, err := nichegit.ListScratchRefs(repoURL, &http.Client{}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refs) != 0 {
+		t.Errorf("the conflict ref is pushed: %+v", refs)
+	}
+}
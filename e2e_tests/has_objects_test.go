@@ -0,0 +1,66 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHasObjects(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	head := repo.CommitFile("a.txt", "a\n", "base")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	blob := repo.RevParse("main:a.txt")
+	tree := repo.RevParse("main^{tree}")
+	missing := plumbing.NewHash("1111111111111111111111111111111111111111")
+	args := nichegit.HasObjectsArgs{Hashes: []plumbing.Hash{head, missing, blob, tree, head}}
+	want := &nichegit.HasObjectsResult{
+		Existing: []plumbing.Hash{head, blob, tree},
+		Missing:  []plumbing.Hash{missing},
+	}
+
+	got, debugInfo, err := nichegit.HasObjects(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("HasObjects diff (-want +got):\n%s", diff)
+	}
+	if debugInfo.PackfileSize != 0 || len(debugInfo.Warnings) != 0 {
+		t.Errorf("expected only object-info to be used: %+v", debugInfo)
+	}
+
+	// Without object-info, the objects are probed with fetches.
+	server.InjectFault(nichegittest.Fault{
+		Match: func(r *http.Request) bool {
+			if !nichegittest.MatchUploadPack(r) || r.Body == nil {
+				return false
+			}
+			body, _ := io.ReadAll(r.Body)
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			return bytes.Contains(body, []byte("command=object-info"))
+		},
+		StatusCode: http.StatusBadRequest,
+	})
+	got, debugInfo, err = nichegit.HasObjects(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("HasObjects with the probes diff (-want +got):\n%s", diff)
+	}
+	if len(debugInfo.Warnings) == 0 {
+		t.Error("expected a warning for the fallback to the probes")
+	}
+}
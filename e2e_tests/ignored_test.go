@@ -0,0 +1,69 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCheckIgnored(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile(".gitignore", "*.log\n/dist/\n", "root ignore")
+	repo.CommitFile("api/.gitignore", "!keep.log\ngenerated/\n", "api ignore")
+	// Commit an ignored file by mistake.
+	if err := os.MkdirAll(filepath.Join(repo.Dir, "api", "generated"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repo.Dir, "api", "generated", "client.go"), []byte("package generated\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	repo.Git("add", "--force", "api/generated/client.go")
+	repo.Git("commit", "--quiet", "--message", "generated client")
+	head := repo.RevParse("HEAD")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	paths := []string{"app.log", "api/keep.log", "dist/", "dist/app.js", "api/generated/client.go", "api/service.go"}
+	got, _, err := nichegit.CheckIgnored(server.RepoURL(), &http.Client{}, nichegit.CheckIgnoredArgs{
+		CommitHash: head,
+		Paths:      paths,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*nichegit.PathIgnored{
+		{Path: "app.log", Ignored: true, Source: ".gitignore", LineNumber: 1, Pattern: "*.log"},
+		{Path: "api/keep.log", Source: "api/.gitignore", LineNumber: 1, Pattern: "!keep.log"},
+		{Path: "dist/", Ignored: true, Source: ".gitignore", LineNumber: 2, Pattern: "/dist/"},
+		{Path: "dist/app.js", Ignored: true, Source: ".gitignore", LineNumber: 2, Pattern: "/dist/"},
+		{Path: "api/generated/client.go", Ignored: true, Tracked: true, Source: "api/.gitignore", LineNumber: 2, Pattern: "generated/"},
+		{Path: "api/service.go"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("CheckIgnored diff (-want +got):\n%s", diff)
+	}
+
+	// Cross-check with git check-ignore.
+	out := repo.Git(append([]string{"check-ignore", "--no-index", "--verbose", "--non-matching"}, paths...)...)
+	for i, line := range strings.Split(out, "\n") {
+		source, _, _ := strings.Cut(line, "\t")
+		pi := got[i]
+		rule := "::"
+		if pi.Pattern != "" {
+			rule = fmt.Sprintf("%s:%d:%s", pi.Source, pi.LineNumber, pi.Pattern)
+		}
+		if source != rule {
+			t.Errorf("%s: the rule is %q, git check-ignore says %q", pi.Path, rule, source)
+		}
+	}
+}
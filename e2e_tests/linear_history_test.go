@@ -0,0 +1,63 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestCheckLinearHistory(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "side")
+	repo.CommitFile("b.txt", "b\n", "side")
+	repo.Git("checkout", "--quiet", "main")
+	repo.CommitFile("c.txt", "c\n", "main")
+	repo.Git("merge", "--quiet", "--no-edit", "side")
+	merge := repo.RevParse("HEAD")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("d.txt", "d\n", "Add d")
+	feature := repo.CommitFile("e.txt", "e\n", "Add e")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	result, _, err := nichegit.CheckLinearHistory(server.RepoURL(), &http.Client{}, nichegit.CheckLinearHistoryArgs{
+		Ref: plumbing.ReferenceName("refs/heads/feature"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Linear || result.RefHash != feature || result.CommitCount != 6 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if len(result.MergeCommits) != 1 || result.MergeCommits[0].Hash != merge.String() {
+		t.Errorf("the merge commits are %+v, want %s", result.MergeCommits, merge)
+	}
+
+	// The commits after the merge are linear.
+	result, _, err = nichegit.CheckLinearHistory(server.RepoURL(), &http.Client{}, nichegit.CheckLinearHistoryArgs{
+		Ref:         plumbing.ReferenceName("refs/heads/feature"),
+		SinceCommit: merge,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Linear || result.CommitCount != 2 || len(result.MergeCommits) != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	// The since commit needs to be an ancestor.
+	_, _, err = nichegit.CheckLinearHistory(server.RepoURL(), &http.Client{}, nichegit.CheckLinearHistoryArgs{
+		Ref:         plumbing.ReferenceName("refs/heads/main"),
+		SinceCommit: feature,
+	})
+	if err == nil {
+		t.Errorf("expected an error for the since commit that is not an ancestor")
+	}
+}
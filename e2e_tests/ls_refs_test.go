@@ -0,0 +1,101 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestLsRefsCache(t *testing.T) {
+	nichegit.SetLsRefsCacheTTL(time.Minute)
+	t.Cleanup(func() { nichegit.SetLsRefsCacheTTL(0) })
+
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	feature := repo.CommitFile("file.txt", "feature\n", "feature")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	if _, debugInfo, err := nichegit.LsRefs(server.RepoURL(), &http.Client{}, []string{"refs/heads/"}); err != nil {
+		t.Fatal(err)
+	} else if debugInfo.Cached {
+		t.Error("the first call is cached")
+	}
+
+	// The server is down, but the cached result is used.
+	server.InjectFault(nichegittest.Fault{Match: nichegittest.MatchUploadPack, Times: 1, StatusCode: http.StatusServiceUnavailable})
+	refs, debugInfo, err := nichegit.LsRefs(server.RepoURL(), &http.Client{}, []string{"refs/heads/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !debugInfo.Cached || len(refs) != 1 {
+		t.Errorf("expected the cached result, got %d refs (cached %v)", len(refs), debugInfo.Cached)
+	}
+	server.ClearFaults()
+
+	// Pushing invalidates the cache.
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	if _, _, _, err := nichegit.PushSquashCherryPick(server.RepoURL(), &http.Client{}, nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom: feature,
+		CherryPickTo:   base,
+		CherryPickBase: base,
+		CommitMessage:  "Squashed",
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/squashed"),
+		CurrentRefHash: &plumbing.ZeroHash,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	refs, debugInfo, err = nichegit.LsRefs(server.RepoURL(), &http.Client{}, []string{"refs/heads/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if debugInfo.Cached || len(refs) != 2 {
+		t.Errorf("expected a fresh result after the push, got %d refs (cached %v)", len(refs), debugInfo.Cached)
+	}
+}
+
+func TestLsRefsCacheScope(t *testing.T) {
+	nichegit.SetLsRefsCacheTTL(time.Minute)
+	t.Cleanup(func() { nichegit.SetLsRefsCacheTTL(0) })
+
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("file.txt", "base\n", "base")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	lsRefs := func(scope string) bool {
+		t.Helper()
+		session := nichegit.NewSession(&http.Client{})
+		session.SetCacheScope(scope)
+		_, debugInfo, err := nichegit.LsRefs(server.RepoURL(), session.Client(), []string{"refs/heads/"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return debugInfo.Cached
+	}
+	if lsRefs("alice") {
+		t.Error("the first call is cached")
+	}
+	if !lsRefs("alice") {
+		t.Error("the call with the same scope is not cached")
+	}
+	// The result listed with other credentials is not used.
+	if lsRefs("bob") {
+		t.Error("the call with another scope is cached")
+	}
+	if _, debugInfo, err := nichegit.LsRefs(server.RepoURL(), &http.Client{}, []string{"refs/heads/"}); err != nil {
+		t.Fatal(err)
+	} else if debugInfo.Cached {
+		t.Error("the call without a session is cached")
+	}
+}
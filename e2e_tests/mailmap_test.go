@@ -0,0 +1,86 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMailmap(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	setAuthor := func(name, email string) {
+		repo.Git("config", "user.name", name)
+		repo.Git("config", "user.email", email)
+	}
+	setAuthor("alice", "alice@old.example.com")
+	base := repo.CommitFile("a.txt", "1\n", "a 1")
+	setAuthor("Alice Smith", "alice@example.com")
+	repo.CommitFile("a.txt", "1\n2\n", "a 2")
+	repo.CommitFile(".mailmap", "Alice Smith <alice@example.com> <alice@old.example.com>\n", "mailmap")
+	head := repo.CommitFile("a.txt", "1\n2\n3\n", "a 3")
+	repo.Push("main")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	commits, _, err := nichegit.FetchCommits(repoURL, &http.Client{}, nichegit.FetchCommitsArgs{
+		WantCommitHashes:  []plumbing.Hash{head},
+		MailmapCommitHash: head,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range commits {
+		if c.Author.Email != "alice@example.com" || c.Committer.Email != "alice@example.com" {
+			t.Errorf("commit %s has author %q and committer %q, want alice@example.com", c.Hash, c.Author.Email, c.Committer.Email)
+		}
+	}
+	// The .mailmap file of the base commit doesn't exist.
+	commits, _, err = nichegit.FetchCommits(repoURL, &http.Client{}, nichegit.FetchCommitsArgs{
+		WantCommitHashes:  []plumbing.Hash{base},
+		MailmapCommitHash: base,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != 1 || commits[0].Author.Email != "alice@old.example.com" {
+		t.Errorf("commits = %v, want the base commit by alice@old.example.com", commits)
+	}
+
+	lines, _, err := nichegit.GetBlame(repoURL, &http.Client{}, nichegit.GetBlameArgs{
+		CommitHash:   head,
+		Path:         "a.txt",
+		ApplyMailmap: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := lines[0].Author; got.Name != "Alice Smith" || got.Email != "alice@example.com" {
+		t.Errorf("author of line 1 = %s <%s>, want Alice Smith <alice@example.com>", got.Name, got.Email)
+	}
+
+	files, _, err := nichegit.FetchFileOwners(repoURL, &http.Client{}, nichegit.FetchFileOwnersArgs{
+		BaseCommitHash: repo.RevParse("HEAD~1"),
+		HeadCommitHash: head,
+		ApplyMailmap:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*nichegit.FileOwners{
+		{
+			Path: "a.txt",
+			Authors: []*nichegit.FileAuthor{
+				{Name: "Alice Smith", Email: "alice@example.com", Commits: 2},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, files); diff != "" {
+		t.Errorf("FetchFileOwners diff (-want +got):\n%s", diff)
+	}
+}
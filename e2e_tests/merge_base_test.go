@@ -0,0 +1,193 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetMergeBaseDeepHistory(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	commit := func(name string) plumbing.Hash {
+		now = now.Add(time.Minute)
+		t.Setenv("GIT_COMMITTER_DATE", now.Format(time.RFC3339))
+		return repo.CommitFile(name, name+"\n", name)
+	}
+	for i := 0; i < 10; i++ {
+		commit(fmt.Sprintf("old-%d.txt", i))
+	}
+	base := commit("base.txt")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	var feature plumbing.Hash
+	for i := 0; i < 5; i++ {
+		feature = commit(fmt.Sprintf("feature-%d.txt", i))
+	}
+	repo.Git("checkout", "--quiet", "main")
+	var main plumbing.Hash
+	for i := 0; i < 100; i++ {
+		main = commit(fmt.Sprintf("main-%d.txt", i))
+	}
+	repo.Push("main", "feature")
+
+	got, debugInfo, err := nichegit.GetMergeBase(nichegittest.NewServer(t, repo).RepoURL(), &http.Client{}, main, feature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != base {
+		t.Errorf("merge base is %s, want %s", got, base)
+	}
+	if len(debugInfo.Iterations) < 2 {
+		t.Errorf("expected multiple rounds: %+v", debugInfo.Iterations)
+	}
+	timeLimited := false
+	for _, it := range debugInfo.Iterations {
+		if it.Duplicates != 0 {
+			t.Errorf("round %+v refetched commits", it)
+		}
+		timeLimited = timeLimited || it.Since != ""
+	}
+	// The commits are a minute apart, so the round after the first one covers an hour.
+	if !timeLimited {
+		t.Errorf("expected the rounds after the first one to be limited by time: %+v", debugInfo.Iterations)
+	}
+}
+
+func TestGetMergeBaseTimeGap(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	t.Setenv("GIT_COMMITTER_DATE", "2020-01-01T00:00:00Z")
+	base := repo.CommitFile("base.txt", "base\n", "base")
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	commit := func(name string) plumbing.Hash {
+		now = now.Add(time.Minute)
+		t.Setenv("GIT_COMMITTER_DATE", now.Format(time.RFC3339))
+		return repo.CommitFile(name, name+"\n", name)
+	}
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	var feature plumbing.Hash
+	for i := 0; i < 40; i++ {
+		feature = commit(fmt.Sprintf("feature-%d.txt", i))
+	}
+	repo.Git("checkout", "--quiet", "main")
+	var main plumbing.Hash
+	for i := 0; i < 40; i++ {
+		main = commit(fmt.Sprintf("main-%d.txt", i))
+	}
+	repo.Push("main", "feature")
+
+	// The merge base is years older than the others. No commit is newer than the time of the last round, which the server refuses. The round is
+	// retried with the depth.
+	got, debugInfo, err := nichegit.GetMergeBase(nichegittest.NewServer(t, repo).RepoURL(), &http.Client{}, main, feature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != base {
+		t.Errorf("merge base is %s, want %s", got, base)
+	}
+	last := debugInfo.Iterations[len(debugInfo.Iterations)-1]
+	if last.Depth == 0 || last.Since != "" {
+		t.Errorf("expected the last round to be limited by depth: %+v", last)
+	}
+}
+
+func TestGetMergeBaseMultipleRoots(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tick := func() {
+		now = now.Add(time.Minute)
+		t.Setenv("GIT_COMMITTER_DATE", now.Format(time.RFC3339))
+	}
+	tick()
+	mainRoot := repo.CommitFile("main.txt", "main\n", "main root")
+	tick()
+	mainBase := repo.CommitFile("main.txt", "main 2\n", "main base")
+	// An imported history with its own root.
+	repo.Git("checkout", "--quiet", "--orphan", "import")
+	repo.Git("rm", "--quiet", "-r", "--cached", ".")
+	repo.Git("clean", "--quiet", "-fd")
+	tick()
+	importRoot := repo.CommitFile("import.txt", "import\n", "import root")
+	tick()
+	importBase := repo.CommitFile("import.txt", "import 2\n", "import base")
+	// The two sides merge the imported history separately, so each root has a merge base.
+	for _, branch := range []string{"side1", "side2"} {
+		repo.Git("checkout", "--quiet", "-b", branch, mainBase.String())
+		tick()
+		repo.Git("merge", "--quiet", "--allow-unrelated-histories", "-m", "merge import into "+branch, importBase.String())
+	}
+	side1, side2 := repo.RevParse("side1"), repo.RevParse("side2")
+	repo.Push("side1", "side2")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	got, _, err := nichegit.GetMergeBase(repoURL, &http.Client{}, side1, side2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != importBase {
+		t.Errorf("merge base is %s, want the newest one %s", got, importBase)
+	}
+
+	_, _, err = nichegit.GetMergeBaseWithOptions(repoURL, &http.Client{}, side1, side2, nichegit.MergeBaseOptions{})
+	var multipleErr *nichegit.MultipleMergeBasesError
+	if !errors.As(err, &multipleErr) {
+		t.Fatalf("expected MultipleMergeBasesError, got %v", err)
+	}
+	if diff := cmp.Diff([]plumbing.Hash{importBase, mainBase}, multipleErr.MergeBases); diff != "" {
+		t.Errorf("merge bases diff (-want +got):\n%s", diff)
+	}
+
+	result, _, err := nichegit.GetMergeBaseWithOptions(repoURL, &http.Client{}, side1, side2, nichegit.MergeBaseOptions{LatestAcrossRoots: true, FindRoots: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := []plumbing.Hash{mainRoot, importRoot}
+	if roots[1].String() < roots[0].String() {
+		roots[0], roots[1] = roots[1], roots[0]
+	}
+	want := &nichegit.MergeBaseResult{
+		MergeBase:  importBase,
+		MergeBases: []plumbing.Hash{importBase, mainBase},
+		Roots1:     roots,
+		Roots2:     roots,
+	}
+	if diff := cmp.Diff(want, result); diff != "" {
+		t.Errorf("result diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetMergeBaseNoCommonAncestor(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	mainRoot := repo.CommitFile("main.txt", "main\n", "main root")
+	main := repo.CommitFile("main.txt", "main 2\n", "main")
+	repo.Git("checkout", "--quiet", "--orphan", "other")
+	repo.Git("rm", "--quiet", "-r", "--cached", ".")
+	repo.Git("clean", "--quiet", "-fd")
+	otherRoot := repo.CommitFile("other.txt", "other\n", "other root")
+	other := repo.CommitFile("other.txt", "other 2\n", "other")
+	repo.Push("main", "other")
+
+	_, _, err := nichegit.GetMergeBase(nichegittest.NewServer(t, repo).RepoURL(), &http.Client{}, main, other)
+	var noAncestorErr *nichegit.NoCommonAncestorError
+	if !errors.As(err, &noAncestorErr) {
+		t.Fatalf("expected NoCommonAncestorError, got %v", err)
+	}
+	want := &nichegit.NoCommonAncestorError{
+		CommitHash1: main,
+		CommitHash2: other,
+		Roots1:      []plumbing.Hash{mainRoot},
+		Roots2:      []plumbing.Hash{otherRoot},
+	}
+	if diff := cmp.Diff(want, noAncestorErr); diff != "" {
+		t.Errorf("error diff (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"errors"
+	"net/http"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMergeBranches(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("shared.txt", "a\nb\nc\nd\ne\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("shared.txt", "a\nb\nc\nd\nE\n", "feature shared")
+	feature := repo.CommitFile("feature.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("shared.txt", "A\nb\nc\nd\ne\n", "main shared")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	mergeBase, _, err := nichegit.GetMergeBase(server.RepoURL(), &http.Client{}, main, feature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mergeBase != base {
+		t.Errorf("merge base is %s, want %s", mergeBase, base)
+	}
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	result, _, _, err := nichegit.MergeBranches(server.RepoURL(), &http.Client{}, nichegit.MergeBranchesArgs{
+		Into:            "main",
+		From:            "refs/heads/feature",
+		Author:          sig,
+		Committer:       sig,
+		Ref:             plumbing.ReferenceName("refs/heads/main"),
+		CurrentRefHash:  &main,
+		AbortOnConflict: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"feature.txt"}, result.MergedFiles); diff != "" {
+		t.Errorf("MergedFiles diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"shared.txt"}, result.ConflictResolvedFiles); diff != "" {
+		t.Errorf("ConflictResolvedFiles diff (-want +got):\n%s", diff)
+	}
+	repo.Git("fetch", "--quiet", "origin", "main")
+	if got := repo.Git("rev-parse", "FETCH_HEAD^1", "FETCH_HEAD^2"); got != main.String()+"\n"+feature.String() {
+		t.Errorf("the parents are %q, want main and feature", got)
+	}
+	if got := repo.Git("show", "FETCH_HEAD:shared.txt"); got != "A\nb\nc\nd\nE" {
+		t.Errorf("shared.txt is %q", got)
+	}
+
+	// Merging again is a no-op.
+	result, _, _, err = nichegit.MergeBranches(server.RepoURL(), &http.Client{}, nichegit.MergeBranchesArgs{
+		Into:      "main",
+		From:      "feature",
+		Author:    sig,
+		Committer: sig,
+		Ref:       plumbing.ReferenceName("refs/heads/main"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.UpToDate {
+		t.Errorf("expected the merge to be up to date, got %+v", result)
+	}
+}
+
+func TestMergeBranchesConflict(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("shared.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("shared.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	repo.CommitFile("shared.txt", "main\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.MergeBranchesArgs{
+		Into:            "main",
+		From:            "feature",
+		Author:          sig,
+		Committer:       sig,
+		Ref:             plumbing.ReferenceName("refs/heads/merged"),
+		CurrentRefHash:  &plumbing.ZeroHash,
+		AbortOnConflict: true,
+	}
+	_, _, _, err := nichegit.MergeBranches(server.RepoURL(), &http.Client{}, args)
+	var conflictErr *nichegit.ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("expected ConflictError, got %v", err)
+	}
+
+	args.AbortOnConflict = false
+	args.IncludeConflictHunks = true
+	result, _, _, err := nichegit.MergeBranches(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"shared.txt"}, result.ConflictOpenFiles); diff != "" {
+		t.Errorf("ConflictOpenFiles diff (-want +got):\n%s", diff)
+	}
+	wantContents := []*nichegit.ConflictContent{
+		{
+			Path:        "shared.txt",
+			OursLabel:   "main",
+			BaseLabel:   "merge base",
+			TheirsLabel: "feature",
+			Hunks: []*nichegit.ConflictHunk{
+				{
+					OursStartLine:   1,
+					BaseStartLine:   1,
+					TheirsStartLine: 1,
+					OursLines:       []string{"main"},
+					BaseLines:       []string{"a"},
+					TheirsLines:     []string{"feature"},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(wantContents, result.ConflictContents); diff != "" {
+		t.Errorf("ConflictContents diff (-want +got):\n%s", diff)
+	}
+	repo.Git("fetch", "--quiet", "origin", "merged")
+	want := "<<<<<<< main\nmain\n||||||| merge base\na\n=======\nfeature\n>>>>>>> feature"
+	if got := repo.Git("show", "FETCH_HEAD:shared.txt"); got != want {
+		t.Errorf("shared.txt is %q, want %q", got, want)
+	}
+}
+
+func TestMergeBranchesConflictStub(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	large := strings.Repeat("line\n", 1000)
+	base := repo.CommitFile("large.txt", large+"a\n", "base")
+	repo.CommitFile("small.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("large.txt", large+"feature\n", "feature")
+	repo.CommitFile("small.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	repo.CommitFile("large.txt", large+"main\n", "main")
+	repo.CommitFile("small.txt", "main\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	result, _, _, err := nichegit.MergeBranches(server.RepoURL(), &http.Client{}, nichegit.MergeBranchesArgs{
+		Into:                  "main",
+		From:                  "feature",
+		Author:                sig,
+		Committer:             sig,
+		Ref:                   plumbing.ReferenceName("refs/heads/merged"),
+		CurrentRefHash:        &plumbing.ZeroHash,
+		ConflictStubThreshold: 1000,
+		IncludeConflictHunks:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"large.txt"}, result.ConflictStubFiles); diff != "" {
+		t.Errorf("ConflictStubFiles diff (-want +got):\n%s", diff)
+	}
+	if len(result.ConflictContents) != 2 || len(result.ConflictContents[0].Hunks) != 1 {
+		t.Errorf("the hunks of the stubbed file are not reported: %+v", result.ConflictContents)
+	}
+	repo.Git("fetch", "--quiet", "origin", "merged")
+	want := "<<<<<<< main\n" +
+		"conflict stub: ours is blob " + repo.Git("rev-parse", "main:large.txt") + "\n" +
+		"||||||| merge base\n" +
+		"conflict stub: base is blob " + repo.Git("rev-parse", base.String()+":large.txt") + "\n" +
+		"=======\n" +
+		"conflict stub: theirs is blob " + repo.Git("rev-parse", "feature:large.txt") + "\n" +
+		">>>>>>> feature"
+	if got := repo.Git("show", "FETCH_HEAD:large.txt"); got != want {
+		t.Errorf("large.txt is %q, want %q", got, want)
+	}
+	if got := repo.Git("show", "FETCH_HEAD:small.txt"); !strings.HasPrefix(got, "<<<<<<< main\nmain\n") {
+		t.Errorf("small.txt is %q, want the conflict markers", got)
+	}
+}
+
+func TestMergeBranchesRenames(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("old.txt", "a\nb\nc\nd\ne\nf\ng\nh\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.Git("mv", "old.txt", "new.txt")
+	feature := repo.CommitFile("new.txt", "a\nb\nc\nd\ne\nf\ng\nH\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("old.txt", "A\nb\nc\nd\ne\nf\ng\nh\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	result, _, _, err := nichegit.MergeBranches(server.RepoURL(), &http.Client{}, nichegit.MergeBranchesArgs{
+		Into:            "main",
+		From:            "feature",
+		Author:          sig,
+		Committer:       sig,
+		Ref:             plumbing.ReferenceName("refs/heads/main"),
+		CurrentRefHash:  &main,
+		AbortOnConflict: true,
+		RenameThreshold: nichegit.DefaultRenameThreshold,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*nichegit.RenamedFile{{OldPath: "old.txt", NewPath: "new.txt", Similarity: 87}}, result.Renames); diff != "" {
+		t.Errorf("Renames diff (-want +got):\n%s", diff)
+	}
+
+	// Same as git merge.
+	repo.Git("merge", "--quiet", "--no-edit", feature.String())
+	repo.Git("fetch", "--quiet", "origin", "main")
+	if got, want := repo.Git("rev-parse", "FETCH_HEAD^{tree}"), repo.Git("rev-parse", "HEAD^{tree}"); got != want {
+		t.Errorf("the merged tree is %s, want %s", got, want)
+	}
+	if got := repo.Git("show", "FETCH_HEAD:new.txt"); got != "A\nb\nc\nd\ne\nf\ng\nH" {
+		t.Errorf("new.txt is %q", got)
+	}
+}
+
+func TestMergeBranchesSignedTag(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen is not available")
+	}
+	keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	if out, err := exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", "niche-git@example.com", "-f", keyFile).CombinedOutput(); err != nil {
+		t.Fatalf("ssh-keygen: %v: %s", err, out)
+	}
+
+	repo := nichegittest.NewTempRepo(t)
+	repo.Git("config", "gpg.format", "ssh")
+	repo.Git("config", "user.signingkey", keyFile)
+	repo.CommitFile("file.txt", "base\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "release")
+	release := repo.CommitFile("release.txt", "release\n", "release")
+	repo.Git("tag", "--sign", "--message", "Release v1.0", "v1.0")
+	repo.Git("tag", "--annotate", "--message", "Release v1.0 unsigned", "v1.0-unsigned")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("main.txt", "main\n", "main")
+	repo.Push("main", "release", "--tags")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	for _, tt := range []struct {
+		from            string
+		fromFirstParent bool
+		wantMergeTag    bool
+	}{
+		{"v1.0", false, true},
+		{"v1.0", true, true},
+		{"v1.0-unsigned", false, false},
+		{"release", false, false},
+	} {
+		ref := plumbing.ReferenceName("refs/heads/merged-" + tt.from)
+		if tt.fromFirstParent {
+			ref += "-swapped"
+		}
+		result, _, _, err := nichegit.MergeBranches(repoURL, &http.Client{}, nichegit.MergeBranchesArgs{
+			Into:            "main",
+			From:            tt.from,
+			FromFirstParent: tt.fromFirstParent,
+			EmbedMergeTag:   true,
+			Author:          sig,
+			Committer:       sig,
+			Ref:             ref,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		repo.Git("fetch", "--quiet", "origin", ref.String())
+		wantParents := main.String() + " " + release.String()
+		if tt.fromFirstParent {
+			wantParents = release.String() + " " + main.String()
+		}
+		if got := repo.Git("log", "-1", "--format=%P", "FETCH_HEAD"); got != wantParents {
+			t.Errorf("parents of the merge of %s = %q, want %q", tt.from, got, wantParents)
+		}
+
+		commit := repo.Git("cat-file", "commit", result.CommitHash.String())
+		var mergeTag []string
+		inMergeTag := false
+		for _, line := range strings.Split(commit, "\n") {
+			if strings.HasPrefix(line, "mergetag ") {
+				inMergeTag = true
+				mergeTag = append(mergeTag, strings.TrimPrefix(line, "mergetag "))
+			} else if inMergeTag && strings.HasPrefix(line, " ") {
+				mergeTag = append(mergeTag, strings.TrimPrefix(line, " "))
+			} else {
+				inMergeTag = false
+			}
+		}
+		if !tt.wantMergeTag {
+			if len(mergeTag) > 0 {
+				t.Errorf("the merge of %s has a mergetag header", tt.from)
+			}
+			continue
+		}
+		if got, want := strings.Join(mergeTag, "\n"), repo.Git("cat-file", "tag", tt.from); got != want {
+			t.Errorf("mergetag of the merge of %s = %q, want %q", tt.from, got, want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFetchModifiedFilesWithRenames(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("exact.txt", "exact\n", "exact")
+	repo.CommitFile("similar.txt", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n", "similar")
+	base := repo.CommitFile("deleted.txt", "a\nb\nc\n", "deleted")
+	repo.Git("mv", "exact.txt", "exact2.txt")
+	repo.Git("mv", "similar.txt", "similar2.txt")
+	repo.Git("rm", "--quiet", "deleted.txt")
+	repo.CommitFile("similar2.txt", "1\n2\n3\n4\n5\n6\n7\n8\n9\nten\n", "rename")
+	head := repo.CommitFile("added.txt", "x\ny\nz\n", "added")
+	repo.Push("main")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	result, _, err := nichegit.FetchModifiedFilesWithRenames(repoURL, &http.Client{}, nichegit.FetchModifiedFilesArgs{
+		CommitHash1:     base,
+		CommitHash2:     head,
+		RenameThreshold: nichegit.DefaultRenameThreshold,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(result.Files)
+	if diff := cmp.Diff([]string{"added.txt", "deleted.txt", "exact.txt", "exact2.txt", "similar.txt", "similar2.txt"}, result.Files); diff != "" {
+		t.Errorf("Files diff (-want +got):\n%s", diff)
+	}
+
+	// Same renames as git diff.
+	var want []*nichegit.RenamedFile
+	for _, line := range strings.Split(repo.Git("diff", "--name-status", "-M50%", base.String(), head.String()), "\n") {
+		fields := strings.Split(line, "\t")
+		if !strings.HasPrefix(fields[0], "R") {
+			continue
+		}
+		want = append(want, &nichegit.RenamedFile{OldPath: fields[1], NewPath: fields[2]})
+	}
+	var got []*nichegit.RenamedFile
+	for _, r := range result.Renames {
+		got = append(got, &nichegit.RenamedFile{OldPath: r.OldPath, NewPath: r.NewPath})
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Renames diff (-want +got):\n%s", diff)
+	}
+	if len(result.Renames) == 2 && result.Renames[0].Similarity != 100 {
+		t.Errorf("the exact rename has similarity %d", result.Renames[0].Similarity)
+	}
+}
+
+func TestFetchModifiedFilesSubmodules(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	// The gitlinks point to the commits of another repository, which the server doesn't have.
+	gitlink := func(digit string) string {
+		return strings.Repeat(digit, len(plumbing.ZeroHash.String()))
+	}
+	repo.Git("update-index", "--add", "--cacheinfo", "160000,"+gitlink("1")+",vendor/lib")
+	base := repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("update-index", "--cacheinfo", "160000,"+gitlink("2")+",vendor/lib")
+	repo.Git("update-index", "--add", "--cacheinfo", "160000,"+gitlink("3")+",tools")
+	head := repo.CommitFile("b.txt", "b\n", "move the submodule")
+	repo.Push("main")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	result, _, err := nichegit.FetchModifiedFilesWithRenames(repoURL, &http.Client{}, nichegit.FetchModifiedFilesArgs{
+		CommitHash1:     base,
+		CommitHash2:     head,
+		RenameThreshold: nichegit.DefaultRenameThreshold,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(result.Files)
+	if diff := cmp.Diff([]string{"b.txt", "tools", "vendor/lib"}, result.Files); diff != "" {
+		t.Errorf("Files diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"tools", "vendor/lib"}, result.Submodules); diff != "" {
+		t.Errorf("Submodules diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestFetchModifiedFilesMatchPattern(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("untouched.sql", "-- migration: 1\n", "untouched")
+	repo.CommitFile("many.txt", "id=1 id=2\nid=3\n", "many")
+	base := repo.CommitFile("schema.sql", "create table a;\n-- migration: 10\n", "schema")
+	repo.CommitFile("schema.sql", "create table a;\ncreate table b;\n-- migration: 11\n", "schema")
+	repo.CommitFile("many.txt", "id=1 id=2\nid=3\nid=4\n", "many")
+	head := repo.CommitFile("none.txt", "nothing\n", "none")
+	repo.Push("main")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	result, _, err := nichegit.FetchModifiedFilesWithRenames(repoURL, &http.Client{}, nichegit.FetchModifiedFilesArgs{
+		CommitHash1:  base,
+		CommitHash2:  head,
+		MatchPattern: regexp.MustCompile(`(?:migration: |id=)(?P<id>[0-9]+)`),
+		MaxMatches:   3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*nichegit.FileMatches{
+		{
+			Path: "many.txt",
+			Before: []*nichegit.PatternMatch{
+				{Line: 1, Text: "id=1", Groups: []string{"1"}, NamedGroups: map[string]string{"id": "1"}},
+				{Line: 1, Text: "id=2", Groups: []string{"2"}, NamedGroups: map[string]string{"id": "2"}},
+				{Line: 2, Text: "id=3", Groups: []string{"3"}, NamedGroups: map[string]string{"id": "3"}},
+			},
+			After: []*nichegit.PatternMatch{
+				{Line: 1, Text: "id=1", Groups: []string{"1"}, NamedGroups: map[string]string{"id": "1"}},
+				{Line: 1, Text: "id=2", Groups: []string{"2"}, NamedGroups: map[string]string{"id": "2"}},
+				{Line: 2, Text: "id=3", Groups: []string{"3"}, NamedGroups: map[string]string{"id": "3"}},
+			},
+			Truncated: true,
+		},
+		{
+			Path:   "schema.sql",
+			Before: []*nichegit.PatternMatch{{Line: 2, Text: "migration: 10", Groups: []string{"10"}, NamedGroups: map[string]string{"id": "10"}}},
+			After:  []*nichegit.PatternMatch{{Line: 3, Text: "migration: 11", Groups: []string{"11"}, NamedGroups: map[string]string{"id": "11"}}},
+		},
+	}
+	if diff := cmp.Diff(want, result.Matches); diff != "" {
+		t.Errorf("Matches diff (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestObjectCache(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("dir/a.txt", "a\n", "a")
+	head := repo.CommitFile("dir/b.txt", "b\n", "b")
+	repo.Push("main")
+	nichegit.SetObjectCacheSize(1 << 20)
+	t.Cleanup(func() { nichegit.SetObjectCacheSize(0) })
+
+	first, debugInfo, err := nichegit.FetchModifiedFiles(repo.FileURL(), &http.Client{}, base, head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if debugInfo.PackfileSize == 0 || debugInfo.CachedObjects != 0 {
+		t.Errorf("the first call is expected to fetch: %+v", debugInfo)
+	}
+	second, debugInfo, err := nichegit.FetchModifiedFiles(repo.FileURL(), &http.Client{}, base, head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2 commits, 2 root trees, and 2 trees of dir.
+	if debugInfo.PackfileSize != 0 || debugInfo.CachedObjects != 6 {
+		t.Errorf("the second call is expected to use the cache: %+v", debugInfo)
+	}
+	if diff := cmp.Diff(first, second); diff != "" {
+		t.Errorf("the results differ (-first +second):\n%s", diff)
+	}
+
+	// Another commit is partially cached. The cached commits are offered as the haves, so only
+	// the new commit and its root tree are fetched, and the tree of dir comes from the cache.
+	next := repo.CommitFile("c.txt", "c\n", "c")
+	repo.Push("main")
+	files, debugInfo, err := nichegit.FetchModifiedFiles(repo.FileURL(), &http.Client{}, head, next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"c.txt"}, files); diff != "" {
+		t.Errorf("modified files diff (-want +got):\n%s", diff)
+	}
+	if debugInfo.PackfileSize == 0 || debugInfo.NegotiationRounds == 0 || debugInfo.CommonHaves == 0 {
+		t.Errorf("the partially cached commits are expected to be fetched with the haves: %+v", debugInfo)
+	}
+	if got := debugInfo.ObjectStats.Trees; got != 1 {
+		t.Errorf("%d trees are fetched, want only the root tree of the new commit: %+v", got, debugInfo)
+	}
+
+	// The objects are evicted beyond the size.
+	nichegit.SetObjectCacheSize(1)
+	_, debugInfo, err = nichegit.FetchModifiedFiles(repo.FileURL(), &http.Client{}, base, head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if debugInfo.CachedObjects != 0 {
+		t.Errorf("the objects are expected to be evicted: %+v", debugInfo)
+	}
+}
+
+func TestObjectCache_WantsReachableFromHaves(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	first := repo.CommitFile("dir/a.txt", "a\n", "a")
+	second := repo.CommitFile("dir/b.txt", "b\n", "b")
+	third := repo.CommitFile("dir/c.txt", "c\n", "c")
+	repo.Push("main")
+	nichegit.SetObjectCacheSize(1 << 20)
+	t.Cleanup(func() { nichegit.SetObjectCacheSize(0) })
+
+	if _, _, err := nichegit.FetchModifiedFiles(repo.FileURL(), &http.Client{}, third, third); err != nil {
+		t.Fatal(err)
+	}
+	// The server leaves out the wants as they are reachable from the cached commit, so they are
+	// fetched again without the haves.
+	files, debugInfo, err := nichegit.FetchModifiedFiles(repo.FileURL(), &http.Client{}, first, second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"dir/b.txt"}, files); diff != "" {
+		t.Errorf("modified files diff (-want +got):\n%s", diff)
+	}
+	if len(debugInfo.Warnings) == 0 {
+		t.Errorf("no warning for the fetch without the haves: %+v", debugInfo)
+	}
+}
+
+func TestObjectCacheScope(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+	nichegit.SetObjectCacheSize(1 << 20)
+	t.Cleanup(func() { nichegit.SetObjectCacheSize(0) })
+
+	fetch := func(scope string) int {
+		t.Helper()
+		session := nichegit.NewSession(&http.Client{})
+		session.SetCacheScope(scope)
+		_, debugInfo, err := nichegit.FetchModifiedFiles(repo.FileURL(), session.Client(), base, head)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return debugInfo.CachedObjects
+	}
+	if n := fetch("alice"); n != 0 {
+		t.Errorf("the first call used %d cached objects", n)
+	}
+	if n := fetch("alice"); n == 0 {
+		t.Error("the call with the same scope didn't use the cache")
+	}
+	// The objects fetched with other credentials are not used.
+	if n := fetch("bob"); n != 0 {
+		t.Errorf("the call with another scope used %d cached objects", n)
+	}
+}
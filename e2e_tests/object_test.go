@@ -0,0 +1,47 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+)
+
+func TestGetObject(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("README.md", "readme\n", "readme")
+	repo.CommitFile("svc/main.go", "package main\n", "main")
+	repo.Git("tag", "--annotate", "--message", "release", "v1.0.0")
+	repo.Push("main", "v1.0.0")
+	server := nichegittest.NewServer(t, repo)
+
+	for _, rev := range []string{"HEAD", "HEAD^{tree}", "HEAD:svc", "HEAD:svc/main.go", "v1.0.0"} {
+		hash := repo.RevParse(rev)
+		wantType := repo.Git("cat-file", "-t", hash.String())
+		wantSize, _ := strconv.ParseInt(repo.Git("cat-file", "-s", hash.String()), 10, 64)
+		for _, pretty := range []bool{false, true} {
+			result, _, err := nichegit.GetObject(server.RepoURL(), &http.Client{}, nichegit.GetObjectArgs{Hash: hash, Pretty: pretty})
+			if err != nil {
+				t.Fatalf("%s: %v", rev, err)
+			}
+			if result.Type.String() != wantType || result.Size != wantSize {
+				t.Errorf("%s: the type and the size are %s and %d, want %s and %d", rev, result.Type, result.Size, wantType, wantSize)
+			}
+			if !pretty && wantType == "tree" {
+				// The raw trees are binary. The size is checked above.
+				continue
+			}
+			// Git trims the trailing newline.
+			want := repo.Git("cat-file", "-p", hash.String())
+			if got := strings.TrimRight(string(result.Content), "\n"); got != want {
+				t.Errorf("%s (pretty %t): the content is\n%s\nwant\n%s", rev, pretty, got, want)
+			}
+		}
+	}
+}
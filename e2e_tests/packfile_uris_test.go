@@ -0,0 +1,137 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestPackfileURIs(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("README.md", "readme\n", "readme")
+	repo.Push("main")
+	targetURL := nichegittest.NewServer(t, repo).RepoURL()
+	head := repo.CommitFile("large.bin", strings.Repeat("large\n", 1000), "large")
+	blob := repo.RevParse("HEAD:large.bin")
+	tree := repo.RevParse("HEAD^{tree}")
+
+	// The source repository offloads the blob to a packfile URI. git upload-pack doesn't send
+	// packfile URIs over git-http-backend, so the responses are made here.
+	uriPack := packObjects(t, repo, blob)
+	mainPack := packObjects(t, repo, head, tree)
+	checksum := hex.EncodeToString(uriPack[len(uriPack)-len(blob):])
+	var downloads atomic.Int32
+	client := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if !nichegit.IsPackfileURIRequest(req) {
+			req.Header.Set("Authorization", "Bearer secret")
+		}
+		switch req.URL.Host {
+		case "cdn.example.com":
+			downloads.Add(1)
+			if req.Header.Get("Authorization") != "" {
+				t.Errorf("the packfile URI request has the credentials")
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(uriPack))}, nil
+		case "source.example.com":
+			body, _ := io.ReadAll(req.Body)
+			if !bytes.Contains(body, []byte("packfile-uris https")) {
+				t.Errorf("the fetch request doesn't have packfile-uris: %q", body)
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(packfileURIsResponse(checksum+" https://cdn.example.com/large.pack", mainPack)))}, nil
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+	nichegit.SetPackfileURIProtocols([]string{"https"})
+	defer nichegit.SetPackfileURIProtocols(nil)
+
+	// The target refuses the new commit as "not our ref", so it's forwarded from the source. The
+	// objects in the packfile URI are forwarded with the ones in the response.
+	pushResult, _, _, err := nichegit.PushUpdateRefs(targetURL, client, nichegit.PushUpdateRefsArgs{
+		RefUpdates:    []nichegit.RefUpdate{{Name: "refs/heads/main", OldHash: &base, NewHash: head}},
+		SourceRepoURL: "https://source.example.com/repo.git",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pushResult.ForwardedObjects) != 1 || pushResult.ForwardedObjects[0] != head {
+		t.Errorf("ForwardedObjects is %v, want [%s]", pushResult.ForwardedObjects, head)
+	}
+	if got := repo.RemoteRefHash("refs/heads/main"); got != head {
+		t.Errorf("refs/heads/main is %s, want %s", got, head)
+	}
+	if got := repo.Git("--git-dir", repo.BareDir, "cat-file", "-t", blob.String()); got != "blob" {
+		t.Errorf("the blob is %q in the target, want blob", got)
+	}
+
+	// The objects in the packfile URI are parsed with the ones in the response.
+	result, debugInfo, err := nichegit.GetObject("https://source.example.com/repo.git", client, nichegit.GetObjectArgs{Hash: blob})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(result.Content) != strings.Repeat("large\n", 1000) {
+		t.Errorf("the blob content is %q", result.Content)
+	}
+	if debugInfo.PackfileURIs != 1 || debugInfo.PackfileSize != len(uriPack)+len(mainPack) {
+		t.Errorf("PackfileURIs and PackfileSize are %d and %d, want 1 and %d", debugInfo.PackfileURIs, debugInfo.PackfileSize, len(uriPack)+len(mainPack))
+	}
+	if downloads.Load() != 2 {
+		t.Errorf("the packfile URI is downloaded %d times, want 2", downloads.Load())
+	}
+
+	// A packfile that doesn't match the checksum is rejected.
+	checksum = strings.Repeat("0", len(checksum))
+	if _, _, err := nichegit.GetObject("https://source.example.com/repo.git", client, nichegit.GetObjectArgs{Hash: blob}); err == nil || !strings.Contains(err.Error(), "checksum") {
+		t.Errorf("expected a checksum error, got %v", err)
+	}
+}
+
+// packObjects returns a packfile of the objects.
+func packObjects(t *testing.T, repo *nichegittest.TempRepo, hashes ...plumbing.Hash) []byte {
+	t.Helper()
+	var input strings.Builder
+	for _, hash := range hashes {
+		fmt.Fprintln(&input, hash.String())
+	}
+	cmd := exec.Command("git", "pack-objects", "--stdout")
+	cmd.Dir = repo.Dir
+	cmd.Stdin = strings.NewReader(input.String())
+	pack, err := cmd.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pack
+}
+
+// packfileURIsResponse returns a protocol v2 fetch response with the packfile URI line and the
+// packfile.
+func packfileURIsResponse(uriLine string, pack []byte) []byte {
+	var buf bytes.Buffer
+	pktLine := func(bs []byte) {
+		fmt.Fprintf(&buf, "%04x", len(bs)+4)
+		buf.Write(bs)
+	}
+	pktLine([]byte("packfile-uris\n"))
+	pktLine([]byte(uriLine + "\n"))
+	buf.WriteString("0001")
+	pktLine([]byte("packfile\n"))
+	for len(pack) > 0 {
+		n := min(len(pack), 65515)
+		pktLine(append([]byte{1}, pack[:n]...))
+		pack = pack[n:]
+	}
+	buf.WriteString("0000")
+	return buf.Bytes()
+}
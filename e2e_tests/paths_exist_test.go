@@ -0,0 +1,47 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPathsExist(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("svc1/api/handler.go", "package api\n", "handler")
+	repo.CommitFile("svc1/api/handler_test.go", "package api\n", "handler test")
+	repo.CommitFile("svc2/main.go", "package main\n", "svc2")
+	repo.Git("update-index", "--add", "--cacheinfo", "160000,"+repo.RevParse("HEAD").String()+",vendor/lib")
+	repo.Git("commit", "--quiet", "--message", "submodule")
+	head := repo.RevParse("HEAD")
+	repo.Push("main")
+
+	paths := []string{"svc1/api/handler.go", "svc1/api/handler_test.go", "svc1/api", "svc1/api/missing.go", "svc1/api/handler.go/child", "svc3/main.go", "vendor/lib", "vendor/lib/README"}
+	got, debugInfo, err := nichegit.PathsExist(nichegittest.NewServer(t, repo).RepoURL(), &http.Client{}, head, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*nichegit.PathExistence{
+		{Path: "svc1/api/handler.go", Exists: true, Type: "blob", Mode: "100644", Hash: repo.RevParse("HEAD:svc1/api/handler.go").String()},
+		{Path: "svc1/api/handler_test.go", Exists: true, Type: "blob", Mode: "100644", Hash: repo.RevParse("HEAD:svc1/api/handler_test.go").String()},
+		{Path: "svc1/api", Exists: true, Type: "tree", Mode: "040000", Hash: repo.RevParse("HEAD:svc1/api").String()},
+		{Path: "svc1/api/missing.go"},
+		{Path: "svc1/api/handler.go/child"},
+		{Path: "svc3/main.go"},
+		{Path: "vendor/lib", Exists: true, Type: "commit", Mode: "160000", Hash: repo.RevParse("HEAD~").String()},
+		{Path: "vendor/lib/README"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PathsExist diff (-want +got):\n%s", diff)
+	}
+	// The root, svc1, svc1/api, and vendor trees, each once.
+	if debugInfo.ObjectStats.Trees != 4 || debugInfo.ObjectStats.Blobs != 0 || debugInfo.ObjectStats.Duplicates != 0 {
+		t.Errorf("unexpected objects are fetched: %+v", debugInfo.ObjectStats)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestFetchProgress(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	var mu sync.Mutex
+	var progresses []nichegit.FetchProgress
+	nichegit.SetFetchProgressFunc(func(p nichegit.FetchProgress) {
+		mu.Lock()
+		defer mu.Unlock()
+		progresses = append(progresses, p)
+	})
+	t.Cleanup(func() { nichegit.SetFetchProgressFunc(nil) })
+
+	_, debugInfo, err := nichegit.FetchCommits(server.RepoURL(), &http.Client{}, nichegit.FetchCommitsArgs{
+		WantCommitHashes: []plumbing.Hash{head},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var remote []string
+	var received int64
+	var indexed, total int
+	for _, p := range progresses {
+		switch p.Phase {
+		case nichegit.FetchPhaseRemote:
+			remote = append(remote, p.Message)
+		case nichegit.FetchPhaseReceiving:
+			received = p.ReceivedBytes
+		case nichegit.FetchPhaseIndexing:
+			indexed, total = p.IndexedObjects, p.TotalObjects
+		}
+	}
+	if !strings.Contains(strings.Join(remote, ""), "Total 2") {
+		t.Errorf("the server progress messages are %q", remote)
+	}
+	if received != int64(debugInfo.PackfileSize) {
+		t.Errorf("received %d bytes, want %d", received, debugInfo.PackfileSize)
+	}
+	// 2 commits.
+	if indexed != 2 || total != 2 {
+		t.Errorf("indexed %d/%d objects, want 2/2", indexed, total)
+	}
+}
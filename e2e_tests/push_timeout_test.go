@@ -0,0 +1,76 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestPushTimeouts(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	updateRef := func(ref string) error {
+		_, _, _, err := nichegit.PushUpdateRefs(server.RepoURL(), &http.Client{}, nichegit.PushUpdateRefsArgs{
+			RefUpdates: []nichegit.RefUpdate{{Name: plumbing.ReferenceName(ref), NewHash: base, OldHash: &plumbing.ZeroHash}},
+		})
+		return err
+	}
+	matchRefAdvertisement := func(r *http.Request) bool {
+		return r.Method == http.MethodGet && nichegittest.MatchReceivePack(r)
+	}
+	matchPush := func(r *http.Request) bool {
+		return r.Method == http.MethodPost && nichegittest.MatchReceivePack(r)
+	}
+	t.Cleanup(func() { nichegit.SetPushTimeouts(nichegit.PushTimeouts{}) })
+
+	nichegit.SetPushTimeouts(nichegit.PushTimeouts{RefAdvertisement: 100 * time.Millisecond})
+	server.InjectFault(nichegittest.Fault{Match: matchRefAdvertisement, Times: 1, Delay: 2 * time.Second})
+	start := time.Now()
+	err := updateRef("refs/heads/hung-ref-adv")
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("the push took %v with the hung ref advertisement", elapsed)
+	}
+	var timeoutErr *nichegit.PushTimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.Phase != "ref advertisement" {
+		t.Fatalf("the push with the hung ref advertisement returned %v, want a PushTimeoutError of the ref advertisement", err)
+	}
+	if code := nichegit.ErrorCodeOf(err); code != nichegit.ErrorCodeTimeout {
+		t.Errorf("the error code is %q, want %q", code, nichegit.ErrorCodeTimeout)
+	}
+	if got := repo.RemoteRefHash("refs/heads/hung-ref-adv"); !got.IsZero() {
+		t.Errorf("refs/heads/hung-ref-adv is %s, want it not pushed", got)
+	}
+	server.ClearFaults()
+
+	// The timed-out attempts are retried.
+	policy := nichegit.DefaultRetryPolicy
+	policy.InitialBackoff = 10 * time.Millisecond
+	nichegit.SetRetryPolicy(policy)
+	t.Cleanup(func() { nichegit.SetRetryPolicy(nichegit.RetryPolicy{}) })
+	server.InjectFault(nichegittest.Fault{Match: matchRefAdvertisement, Times: 1, Delay: 2 * time.Second})
+	if err := updateRef("refs/heads/retried"); err != nil {
+		t.Errorf("the push failed with the retries: %v", err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/retried"); got != base {
+		t.Errorf("refs/heads/retried is %s, want %s", got, base)
+	}
+	server.ClearFaults()
+	nichegit.SetRetryPolicy(nichegit.RetryPolicy{})
+
+	nichegit.SetPushTimeouts(nichegit.PushTimeouts{Push: 200 * time.Millisecond})
+	server.InjectFault(nichegittest.Fault{Match: matchPush, Times: 1, Delay: 2 * time.Second})
+	err = updateRef("refs/heads/hung-push")
+	if !errors.As(err, &timeoutErr) || timeoutErr.Phase != "push" {
+		t.Errorf("the hung push returned %v, want a PushTimeoutError of the push", err)
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestPushRebaseRefs(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "stack/user1/b")
+	repo.CommitFile("b.txt", "b\n", "Add b")
+	repo.Git("checkout", "--quiet", "-b", "stack/user1/a")
+	repo.CommitFile("c.txt", "c\n", "Add c")
+	oldA := repo.CommitFile("d.txt", "d\n", "Add d")
+	repo.Git("checkout", "--quiet", "main")
+	repo.Git("checkout", "--quiet", "-b", "stack/user1/c")
+	repo.CommitFile("e.txt", "e\n", "Add e")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("f.txt", "f\n", "main")
+	repo.Push("main", "stack/user1/a", "stack/user1/b", "stack/user1/c")
+	server := nichegittest.NewServer(t, repo)
+
+	args := nichegit.PushRebaseRefsArgs{
+		RefPrefix: "refs/heads/stack/user1/",
+		Onto:      main,
+		Committer: object.Signature{Name: "niche-git", Email: "niche-git@example.com"},
+		DryRun:    true,
+	}
+	plan, _, pushDebugInfo, err := nichegit.PushRebaseRefs(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pushDebugInfo != nil || repo.RemoteRefHash("refs/heads/stack/user1/a") != oldA {
+		t.Errorf("pushed in the dry-run mode")
+	}
+	// b is the base of a even though a comes first by name.
+	var got []string
+	for _, ref := range plan.Refs {
+		got = append(got, ref.Name.String()+"<"+ref.Base.String())
+	}
+	if want := []string{"refs/heads/stack/user1/b<", "refs/heads/stack/user1/c<", "refs/heads/stack/user1/a<refs/heads/stack/user1/b"}; !slices.Equal(got, want) {
+		t.Errorf("the refs are %v, want %v", got, want)
+	}
+
+	args.DryRun = false
+	result, _, _, err := nichegit.PushRebaseRefs(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range result.Refs {
+		if got := repo.RemoteRefHash(ref.Name.String()); got != ref.NewHash {
+			t.Errorf("%s is %s, want %s", ref.Name, got, ref.NewHash)
+		}
+	}
+	repo.Git("fetch", "--quiet", "origin", "stack/user1/a", "stack/user1/b", "stack/user1/c")
+	for _, tt := range []struct {
+		ref, commits string
+	}{
+		{"stack/user1/a", "Add d\nAdd c\nAdd b"},
+		{"stack/user1/b", "Add b"},
+		{"stack/user1/c", "Add e"},
+	} {
+		if got := repo.Git("log", "--format=%s", main.String()+"..origin/"+tt.ref); got != tt.commits {
+			t.Errorf("the commits of %s are %q, want %q", tt.ref, got, tt.commits)
+		}
+	}
+	if got, want := repo.Git("rev-parse", "origin/stack/user1/a~2"), repo.Git("rev-parse", "origin/stack/user1/b"); got != want {
+		t.Errorf("a is not stacked on b: %s, want %s", got, want)
+	}
+	// Nothing to rebase anymore.
+	result, _, pushDebugInfo, err = nichegit.PushRebaseRefs(server.RepoURL(), &http.Client{}, nichegit.PushRebaseRefsArgs{
+		RefPrefix: "refs/heads/stack/user1/b",
+		Onto:      repo.RemoteRefHash("refs/heads/stack/user1/b"),
+		Committer: args.Committer,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pushDebugInfo != nil || len(result.Refs) != 1 || result.Refs[0].NewHash != result.Refs[0].OldHash {
+		t.Errorf("rebased the ref already on the commit")
+	}
+}
+
+func TestPushRebaseRefsPushUnaffectedRefs(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "stack/user1/a")
+	oldA := repo.CommitFile("b.txt", "b\n", "Add b")
+	repo.Git("checkout", "--quiet", "-b", "stack/user1/b")
+	oldB := repo.CommitFile("c.txt", "c\n", "Add c")
+	repo.Git("checkout", "--quiet", "main")
+	repo.Git("checkout", "--quiet", "-b", "stack/user1/c")
+	repo.CommitFile("d.txt", "d\n", "Add d")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("e.txt", "e\n", "main")
+	repo.Push("main", "stack/user1/a", "stack/user1/b", "stack/user1/c")
+	server := nichegittest.NewServer(t, repo)
+
+	// movedBeforePush makes the branch updated by someone else right before the push.
+	movedBeforePush := func(branch string) {
+		repo.Git("checkout", "--quiet", branch)
+		repo.CommitFile("f.txt", "f\n", "Add f")
+		moved := false
+		server.ClearFaults()
+		server.InjectFault(nichegittest.Fault{
+			Match: func(r *http.Request) bool {
+				if r.Method == http.MethodPost && nichegittest.MatchReceivePack(r) && !moved {
+					moved = true
+					repo.Push(branch)
+				}
+				return false
+			},
+		})
+	}
+	args := nichegit.PushRebaseRefsArgs{
+		RefPrefix: "refs/heads/stack/user1/",
+		Onto:      main,
+		Committer: object.Signature{Name: "niche-git", Email: "niche-git@example.com"},
+	}
+	movedBeforePush("stack/user1/c")
+	if _, _, _, err := nichegit.PushRebaseRefs(server.RepoURL(), &http.Client{}, args); nichegit.ErrorCodeOf(err) != nichegit.ErrorCodeRefCASFailed {
+		t.Fatalf("expected a compare-and-swap failure, got %v", err)
+	}
+	if repo.RemoteRefHash("refs/heads/stack/user1/a") != oldA || repo.RemoteRefHash("refs/heads/stack/user1/b") != oldB {
+		t.Fatalf("the atomic push updated some refs")
+	}
+
+	movedBeforePush("stack/user1/a")
+	movedA := repo.RevParse("stack/user1/a")
+	args.PushUnaffectedRefs = true
+	result, _, _, err := nichegit.PushRebaseRefs(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var deferred []string
+	for _, ref := range result.Refs {
+		if ref.Deferred {
+			deferred = append(deferred, ref.Name.String())
+		} else if got := repo.RemoteRefHash(ref.Name.String()); got != ref.NewHash {
+			t.Errorf("%s is %s, want %s", ref.Name, got, ref.NewHash)
+		}
+	}
+	// b is stacked on a, so it's deferred too.
+	if want := []string{"refs/heads/stack/user1/a", "refs/heads/stack/user1/b"}; !slices.Equal(deferred, want) {
+		t.Errorf("the deferred refs are %v, want %v", deferred, want)
+	}
+	if got := repo.RemoteRefHash("refs/heads/stack/user1/a"); got != movedA {
+		t.Errorf("a is %s, want the other update %s", got, movedA)
+	}
+	if got := repo.RemoteRefHash("refs/heads/stack/user1/b"); got != oldB {
+		t.Errorf("the deferred b is updated to %s", got)
+	}
+}
+
+func TestPushRebaseRefsMergeCommits(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature1 := repo.CommitFile("feature.txt", "feature\n", "Add feature")
+	repo.Git("checkout", "--quiet", "main")
+	main1 := repo.CommitFile("main1.txt", "main 1\n", "main 1")
+	repo.Git("checkout", "--quiet", "feature")
+	repo.Git("merge", "--quiet", "--no-edit", "-m", "Merge main", "main")
+	feature2 := repo.CommitFile("feature2.txt", "feature 2\n", "Add feature 2")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("main2.txt", "main 2\n", "main 2")
+	repo.Push("main", "feature:refs/heads/stack/flatten/feature", "feature:refs/heads/stack/preserve/feature")
+	server := nichegittest.NewServer(t, repo)
+	args := func(prefix string, mode nichegit.MergeCommitMode) nichegit.PushRebaseRefsArgs {
+		return nichegit.PushRebaseRefsArgs{
+			RefPrefix:    prefix,
+			Onto:         main,
+			Committer:    object.Signature{Name: "niche-git", Email: "niche-git@example.com"},
+			MergeCommits: mode,
+		}
+	}
+
+	_, _, _, err := nichegit.PushRebaseRefs(server.RepoURL(), &http.Client{}, args("refs/heads/stack/flatten/", ""))
+	if err == nil || !strings.Contains(err.Error(), "merge commit") {
+		t.Errorf("expected an error for the merge commit by default, got %v", err)
+	}
+
+	// The merge from main is dropped.
+	result, _, _, err := nichegit.PushRebaseRefs(server.RepoURL(), &http.Client{}, args("refs/heads/stack/flatten/", nichegit.MergeCommitFlatten))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var picked []plumbing.Hash
+	for _, c := range result.Refs[0].Commits {
+		picked = append(picked, c.OriginalCommitHash)
+	}
+	if want := []plumbing.Hash{feature1, feature2}; !slices.Equal(picked, want) {
+		t.Errorf("the rebased commits are %v, want %v", picked, want)
+	}
+	repo.Git("fetch", "--quiet", "origin", "stack/flatten/feature", "stack/preserve/feature")
+	if got, want := repo.Git("log", "--format=%s", main.String()+"..origin/stack/flatten/feature"), "Add feature 2\nAdd feature"; got != want {
+		t.Errorf("the flattened commits are %q, want %q", got, want)
+	}
+
+	// The merge is recreated on the rebased feature commit, keeping the merged main commit.
+	if _, _, _, err := nichegit.PushRebaseRefs(server.RepoURL(), &http.Client{}, args("refs/heads/stack/preserve/", nichegit.MergeCommitPreserve)); err != nil {
+		t.Fatal(err)
+	}
+	repo.Git("fetch", "--quiet", "origin", "stack/preserve/feature")
+	ref := "origin/stack/preserve/feature"
+	if got, want := repo.Git("log", "--format=%s", main.String()+".."+ref), "Add feature 2\nMerge main\nAdd feature"; got != want {
+		t.Errorf("the preserved commits are %q, want %q", got, want)
+	}
+	if got := repo.RevParse(ref + "~1^2"); got != main1 {
+		t.Errorf("the second parent of the merge is %s, want %s", got, main1)
+	}
+	if got := repo.RevParse(ref + "~2^"); got != main {
+		t.Errorf("the rebased feature commit is on %s, want %s", got, main)
+	}
+	for _, pth := range []string{"feature.txt", "feature2.txt", "main1.txt", "main2.txt"} {
+		repo.Git("cat-file", "-e", ref+":"+pth)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestRefLocker(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	first := repo.CommitFile("file.txt", "first\n", "first")
+	second := repo.CommitFile("file.txt", "second\n", "second")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	// The pushes take the current value of the ref from the advertisement. Without the locks,
+	// both see the ref missing and the later one fails the compare-and-swap.
+	server.InjectFault(nichegittest.Fault{
+		Match: func(r *http.Request) bool {
+			return r.Method == http.MethodPost && nichegittest.MatchReceivePack(r)
+		},
+		Delay: 200 * time.Millisecond,
+	})
+
+	nichegit.SetRefLocker(nichegit.NewInProcessRefLocker())
+	defer nichegit.SetRefLocker(nil)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, hash := range []plumbing.Hash{first, second} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, _, errs[i] = nichegit.PushUpdateRefs(server.RepoURL(), &http.Client{}, nichegit.PushUpdateRefsArgs{
+				RefUpdates: []nichegit.RefUpdate{{Name: "refs/heads/target", NewHash: hash}},
+			})
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Errorf("the pushes are expected to be serialized: %v", err)
+		}
+	}
+	if got := repo.RemoteRefHash("refs/heads/target"); got != first && got != second {
+		t.Errorf("refs/heads/target is %s", got)
+	}
+}
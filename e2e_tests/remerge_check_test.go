@@ -0,0 +1,56 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCheckRemerge(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("svc1/file.txt", "base\n", "base")
+	enqueued := repo.CommitFile("svc2/file.txt", "base\n", "base 2")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature := repo.CommitFile("svc1/file.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	unrelated := repo.CommitFile("svc2/file.txt", "main\n", "unrelated")
+	related := repo.CommitFile("svc1/file.txt", "main\n", "related")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	result, _, err := nichegit.CheckRemerge(server.RepoURL(), &http.Client{}, nichegit.CheckRemergeArgs{
+		PRHead:       feature,
+		EnqueuedBase: enqueued,
+		CurrentTrunk: unrelated,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RemergeNeeded {
+		t.Errorf("expected no re-merge for an unrelated trunk change, got %+v", result)
+	}
+	if diff := cmp.Diff([]string{"svc2/file.txt"}, result.TrunkFiles); diff != "" {
+		t.Errorf("TrunkFiles diff (-want +got):\n%s", diff)
+	}
+
+	result, _, err = nichegit.CheckRemerge(server.RepoURL(), &http.Client{}, nichegit.CheckRemergeArgs{
+		PRHead:       feature,
+		EnqueuedBase: enqueued,
+		CurrentTrunk: related,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.RemergeNeeded {
+		t.Errorf("expected a re-merge for a trunk change on the PR files, got %+v", result)
+	}
+	if diff := cmp.Diff([]string{"svc1/file.txt"}, result.OverlappingFiles); diff != "" {
+		t.Errorf("OverlappingFiles diff (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFetchCommitsReplaceRefs(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	replaced := repo.CommitFile("file.txt", "main\n", "main")
+	repo.Git("checkout", "--quiet", "-b", "side", base.String())
+	replacement := repo.CommitFile("file.txt", "side\n", "side")
+	repo.Git("replace", replaced.String(), replacement.String())
+	repo.Push("main", "side", "refs/replace/*:refs/replace/*")
+	server := nichegittest.NewServer(t, repo)
+
+	fetchCommits := func(wants ...plumbing.Hash) map[string]*nichegit.CommitInfo {
+		t.Helper()
+		commits, _, err := nichegit.FetchCommits(server.RepoURL(), &http.Client{}, nichegit.FetchCommitsArgs{
+			WantCommitHashes: wants,
+			ApplyReplaceRefs: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ret := map[string]*nichegit.CommitInfo{}
+		for _, c := range commits {
+			ret[c.Hash] = c
+		}
+		return ret
+	}
+
+	// The replacement is reported under the replaced commit only.
+	commits := fetchCommits(replaced)
+	if len(commits) != 2 || commits[base.String()] == nil {
+		t.Fatalf("got %d commits, want the replaced commit and base: %v", len(commits), commits)
+	}
+	if c := commits[replaced.String()]; c == nil || c.ReplacedBy != replacement.String() || c.Message != "side\n" {
+		t.Errorf("the replaced commit is %+v, want the content of %s", c, replacement)
+	}
+
+	// The replacement reachable from the wants is reported in its own right too.
+	commits = fetchCommits(replaced, replacement)
+	if len(commits) != 3 {
+		t.Fatalf("got %d commits, want 3: %v", len(commits), commits)
+	}
+	if c := commits[replacement.String()]; c == nil || c.ReplacedBy != "" {
+		t.Errorf("the replacement is %+v, want it as is", c)
+	}
+	if c := commits[replaced.String()]; c == nil || c.ReplacedBy != replacement.String() {
+		t.Errorf("the replaced commit is %+v, want ReplacedBy %s", c, replacement)
+	}
+}
+
+func TestMergeBaseReplaceRefs(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	grafted := repo.CommitFile("main.txt", "main 1\n", "main 1")
+	main := repo.CommitFile("main.txt", "main 2\n", "main 2")
+	repo.Git("checkout", "--quiet", "-b", "side", base.String())
+	side1 := repo.CommitFile("side.txt", "side 1\n", "side 1")
+	side := repo.CommitFile("side.txt", "side 2\n", "side 2")
+	// The first main commit is grafted onto the side branch.
+	repo.Git("replace", "--graft", grafted.String(), side1.String())
+	replacement := repo.RevParse("refs/replace/" + grafted.String())
+	repo.Push("main", "side", "refs/replace/*:refs/replace/*")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	got, _, err := nichegit.GetMergeBase(repoURL, &http.Client{}, main, side)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != base {
+		t.Errorf("merge base is %s, want %s without the replace refs", got, base)
+	}
+	result, _, err := nichegit.GetMergeBaseWithOptions(repoURL, &http.Client{}, main, side, nichegit.MergeBaseOptions{ApplyReplaceRefs: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&nichegit.MergeBaseResult{
+		MergeBase:       side1,
+		MergeBases:      []plumbing.Hash{side1},
+		ReplacedCommits: []plumbing.Hash{grafted},
+	}, result); diff != "" {
+		t.Errorf("result diff (-want +got):\n%s", diff)
+	}
+
+	compared, _, err := nichegit.CompareRefs(repoURL, &http.Client{}, nichegit.CompareRefsArgs{
+		RefA:             "main",
+		RefB:             "side",
+		ApplyReplaceRefs: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if compared.MergeBase != side1 || compared.Ahead != 2 || compared.Behind != 1 {
+		t.Errorf("got merge base %s, ahead %d, behind %d, want %s, 2, 1", compared.MergeBase, compared.Ahead, compared.Behind, side1)
+	}
+	if diff := cmp.Diff([]plumbing.Hash{grafted}, compared.ReplacedCommits); diff != "" {
+		t.Errorf("replaced commits diff (-want +got):\n%s", diff)
+	}
+	if c := compared.AheadCommits[1]; c.Hash != grafted.String() || c.ReplacedBy != replacement.String() {
+		t.Errorf("the grafted commit is %+v, want ReplacedBy %s", c, replacement)
+	}
+}
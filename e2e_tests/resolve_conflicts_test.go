@@ -0,0 +1,91 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestResolveConflicts(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("dir/file.txt", "base\n", "base")
+	base := repo.CommitFile("other.txt", "base\n", "other")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("dir/file.txt", "feature\n", "feature")
+	feature := repo.CommitFile("other.txt", "feature\n", "feature other")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("dir/file.txt", "main\n", "main")
+	repo.Push("main", "feature")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	cpResult, _, _, err := nichegit.PushSquashCherryPick(repoURL, &http.Client{}, nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom:       feature,
+		CherryPickTo:         main,
+		CherryPickBase:       base,
+		CommitMessage:        "Squashed",
+		Author:               sig,
+		Committer:            sig,
+		Ref:                  plumbing.ReferenceName("refs/heads/squashed"),
+		AbortOnConflict:      true,
+		ConflictRefNamespace: nichegit.DefaultScratchRefNamespace,
+	})
+	var conflictErr *nichegit.ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("expected ConflictError, got %v", err)
+	}
+
+	args := nichegit.ResolveConflictsArgs{
+		ConflictCommit: cpResult.CommitHash,
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/squashed"),
+		CurrentRefHash: &plumbing.ZeroHash,
+		ConflictRef:    cpResult.ConflictRef,
+	}
+	result, _, _, err := nichegit.ResolveConflicts(repoURL, &http.Client{}, args)
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("expected ConflictError, got %v", err)
+	}
+	if want := []string{"dir/file.txt"}; !reflect.DeepEqual(result.UnresolvedFiles, want) {
+		t.Errorf("UnresolvedFiles = %v, want %v", result.UnresolvedFiles, want)
+	}
+	if got := repo.RemoteRefHash("refs/heads/squashed"); got != plumbing.ZeroHash {
+		t.Errorf("refs/heads/squashed is pushed with the unresolved conflicts")
+	}
+
+	args.Resolutions = map[string][]byte{"dir/file.txt": []byte("resolved\n")}
+	result, _, _, err = nichegit.ResolveConflicts(repoURL, &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/squashed"); got != result.CommitHash {
+		t.Errorf("refs/heads/squashed is %s, want %s", got, result.CommitHash)
+	}
+	if got := repo.RemoteRefHash(cpResult.ConflictRef.String()); got != plumbing.ZeroHash {
+		t.Errorf("%s is not deleted", cpResult.ConflictRef)
+	}
+
+	repo.Git("fetch", "--quiet", "origin", "refs/heads/squashed")
+	if got, want := repo.Git("ls-tree", "-r", "--name-only", "FETCH_HEAD"), "dir/file.txt\nother.txt"; got != want {
+		t.Errorf("files = %q, want %q", got, want)
+	}
+	if got := repo.Git("show", "FETCH_HEAD:dir/file.txt"); got != "resolved" {
+		t.Errorf("dir/file.txt = %q, want %q", got, "resolved")
+	}
+	if got := repo.Git("show", "FETCH_HEAD:other.txt"); got != "feature" {
+		t.Errorf("other.txt = %q, want %q", got, "feature")
+	}
+	if got := repo.Git("log", "-1", "--format=%P %s", "FETCH_HEAD"); got != main.String()+" Squashed" {
+		t.Errorf("parent and subject = %q, want %q", got, main.String()+" Squashed")
+	}
+}
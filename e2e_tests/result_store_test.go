@@ -0,0 +1,105 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResultStore(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("svc/b.txt", "b\nTODO\n", "b")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	store := &memoryResultStore{results: map[string][]byte{}}
+	newClient := func(scope string) *http.Client {
+		session := nichegit.NewSessionWithOptions(context.Background(), nil, nichegit.SessionOptions{ResultStore: store})
+		session.SetCacheScope(scope)
+		return session.Client()
+	}
+	client := newClient("")
+
+	// Each operation returns the same result from the store without reaching the server.
+	calls := map[string]func(client *http.Client) (any, debug.FetchDebugInfo, error){
+		"FetchCommits": func(client *http.Client) (any, debug.FetchDebugInfo, error) {
+			return nichegit.FetchCommits(server.RepoURL(), client, nichegit.FetchCommitsArgs{WantCommitHashes: []plumbing.Hash{head}})
+		},
+		"FetchModifiedFilesWithRenames": func(client *http.Client) (any, debug.FetchDebugInfo, error) {
+			return nichegit.FetchModifiedFilesWithRenames(server.RepoURL(), client, nichegit.FetchModifiedFilesArgs{
+				CommitHash1:  base,
+				CommitHash2:  head,
+				MatchPattern: regexp.MustCompile("TODO"),
+			})
+		},
+		"GetBlame": func(client *http.Client) (any, debug.FetchDebugInfo, error) {
+			return nichegit.GetBlame(server.RepoURL(), client, nichegit.GetBlameArgs{CommitHash: head, Path: "svc/b.txt"})
+		},
+		"GetObject": func(client *http.Client) (any, debug.FetchDebugInfo, error) {
+			return nichegit.GetObject(server.RepoURL(), client, nichegit.GetObjectArgs{Hash: head})
+		},
+		"GetTree": func(client *http.Client) (any, debug.FetchDebugInfo, error) {
+			return nichegit.GetTree(server.RepoURL(), client, nichegit.GetTreeArgs{CommitHash: head})
+		},
+		"PathsExist": func(client *http.Client) (any, debug.FetchDebugInfo, error) {
+			return nichegit.PathsExist(server.RepoURL(), client, head, []string{"a.txt", "c.txt"})
+		},
+	}
+	want := map[string]any{}
+	for name, call := range calls {
+		result, debugInfo, err := call(client)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if debugInfo.StoredResult {
+			t.Errorf("%s: the first call returned a stored result", name)
+		}
+		want[name] = result
+	}
+	if len(store.results) != len(calls) {
+		t.Errorf("stored %d results, want %d", len(store.results), len(calls))
+	}
+
+	server.InjectFault(nichegittest.Fault{StatusCode: http.StatusInternalServerError})
+	for name, call := range calls {
+		result, debugInfo, err := call(client)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !debugInfo.StoredResult {
+			t.Errorf("%s: the second call didn't return the stored result", name)
+		}
+		if diff := cmp.Diff(want[name], result); diff != "" {
+			t.Errorf("%s: the stored result differs (-want +got):\n%s", name, diff)
+		}
+	}
+
+	// The other credentials don't share the results.
+	if _, _, err := calls["GetTree"](newClient("other")); err == nil {
+		t.Error("the stored result is returned to a different cache scope")
+	}
+}
+
+type memoryResultStore struct {
+	results map[string][]byte
+}
+
+func (s *memoryResultStore) Get(key string) ([]byte, bool, error) {
+	result, ok := s.results[key]
+	return result, ok, nil
+}
+
+func (s *memoryResultStore) Put(key string, result []byte) error {
+	s.results[key] = result
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	head := repo.CommitFile("file.txt", "head\n", "head")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	fetchCommits := func() error {
+		_, _, err := nichegit.FetchCommits(server.RepoURL(), &http.Client{}, nichegit.FetchCommitsArgs{
+			WantCommitHashes: []plumbing.Hash{head},
+		})
+		return err
+	}
+	updateRef := func(ref string) error {
+		_, _, _, err := nichegit.PushUpdateRefs(server.RepoURL(), &http.Client{}, nichegit.PushUpdateRefsArgs{
+			RefUpdates: []nichegit.RefUpdate{{Name: plumbing.ReferenceName(ref), NewHash: base, OldHash: &plumbing.ZeroHash}},
+		})
+		return err
+	}
+
+	// Not retried by default.
+	server.InjectFault(nichegittest.Fault{Match: nichegittest.MatchUploadPack, Times: 1, StatusCode: http.StatusServiceUnavailable})
+	if err := fetchCommits(); err == nil {
+		t.Error("the fetch succeeded without the retries, want the 503")
+	}
+	server.ClearFaults()
+
+	policy := nichegit.DefaultRetryPolicy
+	policy.InitialBackoff = 10 * time.Millisecond
+	nichegit.SetRetryPolicy(policy)
+	t.Cleanup(func() { nichegit.SetRetryPolicy(nichegit.RetryPolicy{}) })
+
+	server.InjectFault(nichegittest.Fault{Match: nichegittest.MatchUploadPack, Times: 2, StatusCode: http.StatusBadGateway})
+	if err := fetchCommits(); err != nil {
+		t.Errorf("the fetch failed with the retries: %v", err)
+	}
+	server.ClearFaults()
+
+	server.InjectFault(nichegittest.Fault{Match: nichegittest.MatchReceivePack, Times: 1, StatusCode: http.StatusServiceUnavailable})
+	if err := updateRef("refs/heads/retried"); err != nil {
+		t.Errorf("the push failed with the retries: %v", err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/retried"); got != base {
+		t.Errorf("refs/heads/retried is %s, want %s", got, base)
+	}
+	server.ClearFaults()
+
+	// The server applies the push but fails to respond. The retry fails the compare-and-swap,
+	// but the ref is already at the new value.
+	server.InjectFault(nichegittest.Fault{
+		Match:              func(r *http.Request) bool { return r.Method == http.MethodPost && nichegittest.MatchReceivePack(r) },
+		Times:              1,
+		StatusCode:         http.StatusBadGateway,
+		StatusAfterServing: true,
+	})
+	if err := updateRef("refs/heads/applied"); err != nil {
+		t.Errorf("the push failed after the server applied it: %v", err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/applied"); got != base {
+		t.Errorf("refs/heads/applied is %s, want %s", got, base)
+	}
+	server.ClearFaults()
+
+	// Client errors are not retried.
+	server.InjectFault(nichegittest.Fault{Match: nichegittest.MatchReceivePack, Times: 1, StatusCode: http.StatusBadRequest})
+	if err := updateRef("refs/heads/bad-request"); err == nil {
+		t.Error("the push succeeded after a 400, want no retry")
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPushRevertMerge(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("lib/lib.txt", "lib\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature := repo.CommitFile("feature.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	repo.CommitFile("main.txt", "main\n", "main")
+	repo.Git("merge", "--quiet", "--no-ff", "-m", "Merge feature", "feature")
+	merge := repo.RevParse("HEAD")
+	main := repo.CommitFile("README.md", "main\n", "main")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.PushRevertMergeArgs{
+		MergeCommit:    merge,
+		RevertOnto:     main,
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/main"),
+		CurrentRefHash: &main,
+	}
+	for _, mainline := range []int{0, 3} {
+		args.Mainline = mainline
+		_, _, _, err := nichegit.PushRevertMerge(server.RepoURL(), &http.Client{}, args)
+		if err == nil || !strings.Contains(err.Error(), "out of range") {
+			t.Errorf("mainline %d: expected the out of range error, got %v", mainline, err)
+		}
+	}
+	nonMerge := args
+	nonMerge.MergeCommit = main
+	nonMerge.Mainline = 1
+	if _, _, _, err := nichegit.PushRevertMerge(server.RepoURL(), &http.Client{}, nonMerge); err == nil || !strings.Contains(err.Error(), "not a merge commit") {
+		t.Errorf("expected the error for a non-merge commit, got %v", err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/main"); got != main {
+		t.Fatalf("refs/heads/main is updated to %s after the errors", got)
+	}
+
+	// With the feature branch as the mainline, the changes of main are reverted.
+	args.Mainline = 2
+	result, fetchDebugInfo, _, err := nichegit.PushRevertMerge(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"main.txt"}, result.RevertedFiles); diff != "" {
+		t.Errorf("RevertedFiles diff (-want +got):\n%s", diff)
+	}
+	// The lib tree is fetched with both the merge commit and its parent.
+	if fetchDebugInfo.ObjectStats.Duplicates == 0 || len(fetchDebugInfo.Warnings) == 0 {
+		t.Errorf("expected the duplicate fetch to be reported: %+v", fetchDebugInfo)
+	}
+	repo.Git("fetch", "--quiet", "origin", "main")
+	if got := repo.Git("ls-tree", "--name-only", "FETCH_HEAD"); got != "README.md\nfeature.txt\nlib" {
+		t.Errorf("the root tree has %q, want README.md, feature.txt, and lib", got)
+	}
+	want := "Revert \"Merge feature\"\n\nThis reverts commit " + merge.String() + ", reversing\nchanges made to " + feature.String() + "."
+	if got := repo.Git("show", "--no-patch", "--format=%B", "FETCH_HEAD"); got != want {
+		t.Errorf("commit message is %q, want %q", got, want)
+	}
+}
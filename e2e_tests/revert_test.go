@@ -0,0 +1,138 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPushRevert(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("config.txt", "timeout=10\n", "base")
+	broken := repo.CommitFile("config.txt", "timeout=0\n", "Break the timeout")
+	main := repo.CommitFile("README.md", "main\n", "main")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.PushRevertArgs{
+		Commit:         broken,
+		Mainline:       1,
+		RevertOnto:     main,
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/main"),
+		CurrentRefHash: &main,
+	}
+	if _, _, _, err := nichegit.PushRevert(server.RepoURL(), &http.Client{}, args); err == nil {
+		t.Fatal("expected an error for the mainline of a non-merge commit")
+	}
+
+	args.Mainline = 0
+	result, _, _, err := nichegit.PushRevert(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"config.txt"}, result.RevertedFiles); diff != "" {
+		t.Errorf("RevertedFiles diff (-want +got):\n%s", diff)
+	}
+	repo.Git("fetch", "--quiet", "origin", "main")
+	if got := repo.Git("show", "FETCH_HEAD:config.txt"); got != "timeout=10" {
+		t.Errorf("config.txt is %q", got)
+	}
+	if got := repo.Git("show", "--no-patch", "--format=%P", "FETCH_HEAD"); got != main.String() {
+		t.Errorf("the parent is %s, want %s", got, main)
+	}
+	want := "Revert \"Break the timeout\"\n\nThis reverts commit " + broken.String() + "."
+	if got := repo.Git("show", "--no-patch", "--format=%B", "FETCH_HEAD"); got != want {
+		t.Errorf("commit message is %q, want %q", got, want)
+	}
+}
+
+func TestPushRevertMergeCommit(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("lib.txt", "lib\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature := repo.CommitFile("feature.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	repo.CommitFile("main.txt", "main\n", "main")
+	repo.Git("merge", "--quiet", "--no-ff", "-m", "Merge feature", "feature")
+	merge := repo.RevParse("HEAD")
+	main := repo.CommitFile("README.md", "main\n", "main")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.PushRevertArgs{
+		Commit:         merge,
+		RevertOnto:     main,
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/main"),
+		CurrentRefHash: &main,
+	}
+	for _, mainline := range []int{0, 3} {
+		args.Mainline = mainline
+		_, _, _, err := nichegit.PushRevert(server.RepoURL(), &http.Client{}, args)
+		if err == nil || !strings.Contains(err.Error(), "out of range") {
+			t.Errorf("mainline %d: expected the out of range error, got %v", mainline, err)
+		}
+	}
+	if got := repo.RemoteRefHash("refs/heads/main"); got != main {
+		t.Fatalf("refs/heads/main is updated to %s after the errors", got)
+	}
+
+	// With the feature branch as the mainline, the changes of main are reverted.
+	args.Mainline = 2
+	result, _, _, err := nichegit.PushRevert(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"main.txt"}, result.RevertedFiles); diff != "" {
+		t.Errorf("RevertedFiles diff (-want +got):\n%s", diff)
+	}
+	repo.Git("fetch", "--quiet", "origin", "main")
+	if got := repo.Git("ls-tree", "--name-only", "FETCH_HEAD"); got != "README.md\nfeature.txt\nlib.txt" {
+		t.Errorf("the root tree has %q, want README.md, feature.txt, and lib.txt", got)
+	}
+	if got := repo.Git("show", "--no-patch", "--format=%P", "FETCH_HEAD"); got != main.String() {
+		t.Errorf("the parent is %s, want %s", got, main)
+	}
+	want := "Revert \"Merge feature\"\n\nThis reverts commit " + merge.String() + ", reversing\nchanges made to " + feature.String() + "."
+	if got := repo.Git("show", "--no-patch", "--format=%B", "FETCH_HEAD"); got != want {
+		t.Errorf("commit message is %q, want %q", got, want)
+	}
+}
+
+func TestPushRevertRootCommit(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	root := repo.CommitFile("README.md", "root\n", "root")
+	main := repo.CommitFile("README.md", "main\n", "main")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	_, _, _, err := nichegit.PushRevert(server.RepoURL(), &http.Client{}, nichegit.PushRevertArgs{
+		Commit:         root,
+		RevertOnto:     main,
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/main"),
+		CurrentRefHash: &main,
+	})
+	if err == nil || !strings.Contains(err.Error(), "root commit") {
+		t.Errorf("expected the root commit error, got %v", err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/main"); got != main {
+		t.Errorf("refs/heads/main is updated to %s", got)
+	}
+}
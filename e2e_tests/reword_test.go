@@ -0,0 +1,134 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestPushRewordCommits(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "base")
+	repo.CommitFile("b.txt", "b\n", "Add b\n\nThe body of b.")
+	oldTip := repo.CommitFile("c.txt", "c\n", "Add c\n\nTicket: PROJ-1")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	args := nichegit.PushRewordCommitsArgs{
+		Ref:             plumbing.ReferenceName("refs/heads/main"),
+		Count:           2,
+		MessageTemplate: `{{appendTrailer .Message "Ticket" "PROJ-1"}}`,
+		Author:          &object.Signature{Name: "bot", Email: "bot@example.com"},
+		Committer:       object.Signature{Name: "niche-git", Email: "niche-git@example.com"},
+		DryRun:          true,
+	}
+	plan, _, pushDebugInfo, err := nichegit.PushRewordCommits(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pushDebugInfo != nil || repo.RemoteRefHash("refs/heads/main") != oldTip {
+		t.Errorf("pushed in the dry-run mode")
+	}
+	if len(plan.Commits) != 2 || plan.Commits[1].OriginalCommitHash != oldTip {
+		t.Fatalf("the rewritten commits are %v", plan.Commits)
+	}
+
+	args.DryRun = false
+	result, _, _, err := nichegit.PushRewordCommits(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/main"); got != result.CommitHash {
+		t.Errorf("main is %s, want %s", got, result.CommitHash)
+	}
+	repo.Git("fetch", "--quiet", "origin", "main")
+	// The trailer is not added twice to the commit that already has it.
+	want := "Add c\n\nTicket: PROJ-1\n---\nAdd b\n\nThe body of b.\n\nTicket: PROJ-1\n---\nbase\n---"
+	if got := repo.Git("log", "--format=%B---", "FETCH_HEAD"); got != want {
+		t.Errorf("the messages are %q, want %q", got, want)
+	}
+	if got := repo.Git("log", "-2", "--format=%an <%ae>", "FETCH_HEAD"); got != "bot <bot@example.com>\nbot <bot@example.com>" {
+		t.Errorf("the authors are %q", got)
+	}
+	if got := repo.Git("rev-parse", "FETCH_HEAD~2"); got != base.String() {
+		t.Errorf("the base is %s, want %s", got, base)
+	}
+	if got, want := repo.Git("rev-parse", "FETCH_HEAD^{tree}"), repo.Git("rev-parse", oldTip.String()+"^{tree}"); got != want {
+		t.Errorf("the tree is %s, want %s", got, want)
+	}
+
+	args.Count = 5
+	if _, _, _, err := nichegit.PushRewordCommits(server.RepoURL(), &http.Client{}, args); err == nil {
+		t.Errorf("expected an error for more commits than the branch has")
+	}
+}
+
+func TestPushRewordCommitsKeepsHeaders(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "base")
+	feature := repo.CommitFile("b.txt", "b\n", "feature")
+	repo.Git("reset", "--quiet", "--hard", base.String())
+	main := repo.CommitFile("c.txt", "c\n", "main")
+	repo.Git("merge", "--quiet", "--no-edit", feature.String())
+	mergeTag := "object " + feature.String() + "\n" +
+		"type commit\n" +
+		"tag v1.0.0\n" +
+		"tagger Tagger <tagger@example.com> 1700000000 +0000\n" +
+		"\n" +
+		"Release v1.0.0\n"
+	merge := writeRawCommit(t, repo, "tree "+repo.Git("rev-parse", "HEAD^{tree}")+"\n"+
+		"parent "+main.String()+"\n"+
+		"parent "+feature.String()+"\n"+
+		"author Author <author@example.com> 1700000000 +0000\n"+
+		"committer Author <author@example.com> 1700000000 +0000\n"+
+		"encoding ISO-8859-1\n"+
+		"mergetag "+strings.ReplaceAll(strings.TrimSuffix(mergeTag, "\n"), "\n", "\n ")+"\n"+
+		"x-review-id 1234\n"+
+		"\n"+
+		"Merge tag 'v1.0.0'\n")
+	repo.Git("reset", "--quiet", "--hard", merge.String())
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	result, _, _, err := nichegit.PushRewordCommits(server.RepoURL(), &http.Client{}, nichegit.PushRewordCommitsArgs{
+		Ref:       plumbing.ReferenceName("refs/heads/main"),
+		Count:     1,
+		Trailers:  []nichegit.Trailer{{Key: "Ticket", Value: "PROJ-1"}},
+		Committer: object.Signature{Name: "niche-git", Email: "niche-git@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.Git("fetch", "--quiet", "origin", "main")
+	rewritten, err := object.DecodeCommit(nil, rawCommitObject(t, repo.Git("cat-file", "commit", result.CommitHash.String())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rewritten.Encoding != "ISO-8859-1" {
+		t.Errorf("the encoding is %q, want ISO-8859-1", rewritten.Encoding)
+	}
+	if rewritten.MergeTag != mergeTag {
+		t.Errorf("the merge tag is %q, want %q", rewritten.MergeTag, mergeTag)
+	}
+	if raw := repo.Git("cat-file", "commit", result.CommitHash.String()); !strings.Contains(raw, "\nx-review-id 1234\n") {
+		t.Errorf("the reworded commit doesn't have the extra header:\n%s", raw)
+	}
+}
+
+func rawCommitObject(t *testing.T, content string) plumbing.EncodedObject {
+	t.Helper()
+	obj := &plumbing.MemoryObject{}
+	obj.SetType(plumbing.CommitObject)
+	if _, err := obj.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	return obj
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestCleanupScratchRefs(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	commit := repo.CommitFile("file.txt", "base\n", "base")
+	repo.Push("main")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	now := time.Now()
+	createScratchRef := func(createdAt time.Time) plumbing.ReferenceName {
+		t.Helper()
+		ref, _, _, err := nichegit.CreateScratchRef(repoURL, &http.Client{}, nichegit.CreateScratchRefArgs{
+			Purpose: "conflict",
+			Hash:    commit,
+			Now:     createdAt,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := repo.RemoteRefHash(ref.Name.String()); got != commit {
+			t.Fatalf("%s is %s, want %s", ref.Name, got, commit)
+		}
+		return ref.Name
+	}
+	oldRef := createScratchRef(now.Add(-48 * time.Hour))
+	newRef := createScratchRef(now)
+	if _, _, _, err := nichegit.PushUpdateRefs(repoURL, &http.Client{}, nichegit.PushUpdateRefsArgs{
+		RefUpdates: []nichegit.RefUpdate{
+			{Name: nichegit.DefaultScratchRefNamespace + "unknown", NewHash: commit},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	refs, _, err := nichegit.ListScratchRefs(repoURL, &http.Client{}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refs) != 2 || refs[0].Purpose != "conflict" {
+		t.Errorf("unexpected scratch refs: %+v", refs)
+	}
+
+	if _, _, err := nichegit.CleanupScratchRefs(repoURL, &http.Client{}, nichegit.CleanupScratchRefsArgs{}); err == nil {
+		t.Error("expected an error for the zero MaxAge")
+	}
+
+	result, _, err := nichegit.CleanupScratchRefs(repoURL, &http.Client{}, nichegit.CleanupScratchRefsArgs{
+		MaxAge: 24 * time.Hour,
+		Now:    now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.DeletedRefs) != 1 || result.DeletedRefs[0].Name != oldRef {
+		t.Errorf("unexpected deleted refs: %+v", result.DeletedRefs)
+	}
+	if got := repo.RemoteRefHash(oldRef.String()); got != plumbing.ZeroHash {
+		t.Errorf("%s is not deleted", oldRef)
+	}
+	if got := repo.RemoteRefHash(newRef.String()); got != commit {
+		t.Errorf("%s is %s, want %s", newRef, got, commit)
+	}
+}
+
+func TestPushSquashCherryPickConflictRef(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	feature := repo.CommitFile("file.txt", "feature\n", "feature")
+	repo.Git("reset", "--quiet", "--hard", base.String())
+	main := repo.CommitFile("file.txt", "main\n", "main")
+	repo.Git("branch", "feature", feature.String())
+	repo.Push("main", "feature")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	result, _, _, err := nichegit.PushSquashCherryPick(repoURL, &http.Client{}, nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom:       feature,
+		CherryPickTo:         main,
+		CherryPickBase:       base,
+		CommitMessage:        "Squashed",
+		Author:               sig,
+		Committer:            sig,
+		Ref:                  plumbing.ReferenceName("refs/heads/squashed"),
+		AbortOnConflict:      true,
+		ConflictRefNamespace: nichegit.DefaultScratchRefNamespace,
+	})
+	var conflictErr *nichegit.ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("expected ConflictError, got %v", err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/squashed"); got != plumbing.ZeroHash {
+		t.Errorf("refs/heads/squashed is pushed")
+	}
+	if got := repo.RemoteRefHash(result.ConflictRef.String()); got != result.CommitHash {
+		t.Errorf("%s is %s, want %s", result.ConflictRef, got, result.CommitHash)
+	}
+
+	refs, _, err := nichegit.ListScratchRefs(repoURL, &http.Client{}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refs) != 1 || refs[0].Name != result.ConflictRef || refs[0].Purpose != "squash-cherry-pick" {
+		t.Errorf("unexpected scratch refs: %+v", refs)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestSemverTags(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	v1 := repo.CommitFile("file.txt", "1\n", "1")
+	repo.Git("tag", "v1.2.0", v1.String())
+	v2 := repo.CommitFile("file.txt", "2\n", "2")
+	repo.Git("tag", "--annotate", "--message", "release", "v1.10.0", v2.String())
+	rc := repo.CommitFile("file.txt", "3\n", "3")
+	repo.Git("tag", "v1.11.0-rc.1", rc.String())
+	repo.Git("tag", "other-v9.0.0", rc.String())
+	head := repo.CommitFile("file.txt", "4\n", "4")
+	repo.Push("main", "--tags")
+	server := nichegittest.NewServer(t, repo)
+
+	args := nichegit.FindLatestSemverTagArgs{TagPrefix: "v"}
+	tag, _, err := nichegit.FindLatestSemverTag(server.RepoURL(), &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag == nil || tag.Name != "v1.10.0" || tag.CommitHash != v2.String() || tag.Hash == v2.String() {
+		t.Errorf("unexpected latest tag: %+v", tag)
+	}
+
+	tag, commits, _, err := nichegit.FetchCommitsSinceSemverTag(server.RepoURL(), &http.Client{}, nichegit.FetchCommitsSinceSemverTagArgs{
+		FindLatestSemverTagArgs: args,
+		HeadCommitHash:          head,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag == nil || tag.Name != "v1.10.0" {
+		t.Errorf("unexpected latest tag: %+v", tag)
+	}
+	if len(commits) != 2 {
+		t.Errorf("got %d commits since the tag, want 2", len(commits))
+	}
+
+	result, _, _, err := nichegit.PushNextSemverTag(server.RepoURL(), &http.Client{}, nichegit.PushNextSemverTagArgs{
+		FindLatestSemverTagArgs: nichegit.FindLatestSemverTagArgs{TagPrefix: "v", IncludePrereleases: true},
+		Bump:                    "minor",
+		CommitHash:              head,
+		Message:                 "Release v1.11.0",
+		Tagger:                  object.Signature{Name: "niche-git", Email: "niche-git@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.PreviousTag.Name != "v1.11.0-rc.1" || result.Tag.Name != "v1.11.0" {
+		t.Errorf("unexpected tags: previous %+v, new %+v", result.PreviousTag, result.Tag)
+	}
+	if got := repo.Git("--git-dir", repo.BareDir, "rev-parse", "v1.11.0^{commit}"); got != head.String() {
+		t.Errorf("v1.11.0 points to %s, want %s", got, head)
+	}
+	if got := repo.Git("--git-dir", repo.BareDir, "cat-file", "-t", "v1.11.0"); got != "tag" {
+		t.Errorf("v1.11.0 is a %s, want an annotated tag", got)
+	}
+
+	// Without a message, a lightweight tag is created.
+	_, _, _, err = nichegit.PushNextSemverTag(server.RepoURL(), &http.Client{}, nichegit.PushNextSemverTagArgs{
+		FindLatestSemverTagArgs: nichegit.FindLatestSemverTagArgs{TagPrefix: "v"},
+		Bump:                    "patch",
+		CommitHash:              head,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := repo.RemoteRefHash("refs/tags/v1.11.1"); got != head {
+		t.Errorf("refs/tags/v1.11.1 is %s, want %s", got, head)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFetchImpactedServices(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("README.md", "base\n", "base")
+	repo.CommitFile("services/api/main.go", "package main\n", "api")
+	repo.CommitFile("libs/common/util.go", "package common\n", "common")
+	head := repo.CommitFile("docs/index.md", "docs\n", "docs")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	result, _, err := nichegit.FetchImpactedServices(server.RepoURL(), &http.Client{}, nichegit.FetchImpactedServicesArgs{
+		CommitHash1: base,
+		CommitHash2: head,
+		Services: []nichegit.ServicePatterns{
+			{Name: "api", Patterns: []string{"services/api/**", "libs/common/**"}},
+			{Name: "worker", Patterns: []string{"services/worker/**", "libs/common/**"}},
+			{Name: "web", Patterns: []string{"services/web/**"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &nichegit.FetchImpactedServicesResult{
+		Services: []*nichegit.ImpactedService{
+			{Name: "api", Files: []string{"libs/common/util.go", "services/api/main.go"}},
+			{Name: "worker", Files: []string{"libs/common/util.go"}},
+		},
+		UnmatchedFiles: []string{"docs/index.md"},
+	}
+	if diff := cmp.Diff(want, result); diff != "" {
+		t.Errorf("FetchImpactedServices diff (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestSession(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "base")
+	main := repo.CommitFile("b.txt", "b\n", "main")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	var dials, uploadPacks atomic.Int32
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			dials.Add(1)
+			return (&net.Dialer{}).DialContext(ctx, network, addr)
+		},
+	}
+	t.Cleanup(transport.CloseIdleConnections)
+	session := nichegit.NewSession(&http.Client{Transport: countingTransport(transport, &uploadPacks)})
+	client := session.Client()
+
+	for i := 0; i < 2; i++ {
+		refs, debugInfo, err := nichegit.LsRefs(server.RepoURL(), client, []string{"refs/heads/"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(refs) != 1 || refs[0].Hash != main.String() {
+			t.Errorf("unexpected refs: %+v", refs)
+		}
+		if debugInfo.Cached != (i == 1) {
+			t.Errorf("the call %d is cached: %t", i, debugInfo.Cached)
+		}
+	}
+	if _, _, err := nichegit.FetchCommits(server.RepoURL(), client, nichegit.FetchCommitsArgs{WantCommitHashes: []plumbing.Hash{main}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := uploadPacks.Load(); got != 2 {
+		t.Errorf("sent %d upload-pack requests, want 2", got)
+	}
+	if got := dials.Load(); got != 1 {
+		t.Errorf("made %d connections, want 1", got)
+	}
+
+	// A push through the session drops the ls-refs results.
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	result, _, _, err := nichegit.PushEmptyCommit(server.RepoURL(), client, nichegit.PushEmptyCommitArgs{
+		Ref:           plumbing.ReferenceName("refs/heads/main"),
+		Parent:        main,
+		CommitMessage: "marker",
+		Author:        sig,
+		Committer:     sig,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	refs, debugInfo, err := nichegit.LsRefs(server.RepoURL(), client, []string{"refs/heads/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if debugInfo.Cached || len(refs) != 1 || refs[0].Hash != result.CommitHash.String() {
+		t.Errorf("unexpected refs after the push: %+v", refs)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// countingTransport counts the upload-pack requests.
+func countingTransport(inner http.RoundTripper, uploadPacks *atomic.Int32) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if strings.HasSuffix(req.URL.Path, "/git-upload-pack") {
+			uploadPacks.Add(1)
+		}
+		return inner.RoundTrip(req)
+	})
+}
+
+func TestSessionWithContext(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	server.InjectFault(nichegittest.Fault{Match: nichegittest.MatchUploadPack, Delay: 2 * time.Second})
+	nichegit.SetRetryPolicy(nichegit.RetryPolicy{MaxRetries: 3, InitialBackoff: time.Second})
+	defer nichegit.SetRetryPolicy(nichegit.RetryPolicy{})
+
+	// The deadline stops the request, and the retry that cannot finish its wait before it.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, _, err := nichegit.FetchModifiedFiles(server.RepoURL(), nichegit.NewSessionWithContext(ctx, nil).Client(), base, head)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("the operation took %v beyond the deadline", elapsed)
+	}
+
+	// git upload-pack of the file:// repositories stops too.
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := nichegit.FetchModifiedFiles(repo.FileURL(), nichegit.NewSessionWithContext(canceled, nil).Client(), base, head); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the cancellation error, got %v", err)
+	}
+}
+
+func TestSessionWithOptions(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "a")
+	head := repo.CommitFile("b.txt", "b\n", "b")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	fetchCommits := func(client *http.Client) (bool, error) {
+		_, debugInfo, err := nichegit.FetchCommits(server.RepoURL(), client, nichegit.FetchCommitsArgs{
+			WantCommitHashes: []plumbing.Hash{head},
+		})
+		return debugInfo.Spooled, err
+	}
+
+	// The options of a session don't affect the others, which use the process-wide defaults.
+	policy := nichegit.DefaultRetryPolicy
+	policy.InitialBackoff = 10 * time.Millisecond
+	threshold := int64(1)
+	var indexed atomic.Int32
+	retrying := nichegit.NewSessionWithOptions(context.Background(), nil, nichegit.SessionOptions{
+		RetryPolicy:            &policy,
+		PackfileSpoolThreshold: &threshold,
+		FetchProgressFunc: func(p nichegit.FetchProgress) {
+			if p.Phase == nichegit.FetchPhaseIndexing {
+				indexed.Add(1)
+			}
+		},
+	}).Client()
+	plain := nichegit.NewSession(nil).Client()
+
+	server.InjectFault(nichegittest.Fault{Match: nichegittest.MatchUploadPack, Times: 1, StatusCode: http.StatusServiceUnavailable})
+	spooled, err := fetchCommits(retrying)
+	if err != nil {
+		t.Errorf("the fetch failed with the retries of the session: %v", err)
+	}
+	if !spooled {
+		t.Error("the packfile is not spooled with the threshold of the session")
+	}
+	if indexed.Load() == 0 {
+		t.Error("the progress function of the session is not called")
+	}
+	server.ClearFaults()
+
+	indexed.Store(0)
+	server.InjectFault(nichegittest.Fault{Match: nichegittest.MatchUploadPack, Times: 1, StatusCode: http.StatusServiceUnavailable})
+	if _, err := fetchCommits(plain); err == nil {
+		t.Error("the fetch of the other session succeeded, want the 503 without the retries")
+	}
+	server.ClearFaults()
+	spooled, err = fetchCommits(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spooled || indexed.Load() != 0 {
+		t.Errorf("the other session used the options: spooled %t, progress %d", spooled, indexed.Load())
+	}
+}
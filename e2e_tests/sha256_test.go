@@ -0,0 +1,66 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+//go:build sha256
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// Run with `go test -tags sha256 ./...`, which runs the other tests with SHA-256 repositories as
+// well.
+func TestSHA256Repository(t *testing.T) {
+	repo := nichegittest.NewTempRepoWithObjectFormat(t, "sha256")
+	base := repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature := repo.CommitFile("b.txt", "b\n", "Add b")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("c.txt", "c\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+	if len(main.String()) != 64 {
+		t.Fatalf("%s is not a SHA-256 hash", main)
+	}
+
+	refs, _, err := nichegit.LsRefs(server.RepoURL(), &http.Client{}, []string{"refs/heads/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refs) != 2 || refs[0].Hash != feature.String() {
+		t.Errorf("unexpected refs: %v", refs)
+	}
+	modified, _, err := nichegit.FetchModifiedFiles(server.RepoURL(), &http.Client{}, base, feature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(modified) != 1 || modified[0] != "b.txt" {
+		t.Errorf("modified files are %v", modified)
+	}
+
+	result, _, _, err := nichegit.PushCherryPick(server.RepoURL(), &http.Client{}, nichegit.PushCherryPickArgs{
+		Commits:        []plumbing.Hash{feature},
+		CherryPickOnto: main,
+		Committer:      object.Signature{Name: "niche-git", Email: "niche-git@example.com"},
+		Ref:            plumbing.ReferenceName("refs/heads/picked"),
+		CurrentRefHash: &plumbing.ZeroHash,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/picked"); got != result.CommitHash {
+		t.Errorf("picked is %s, want %s", got, result.CommitHash)
+	}
+	// git verifies the pushed objects with the SHA-256 hashes.
+	repo.Git("fetch", "--quiet", "origin", "picked")
+	if got := repo.Git("show", "FETCH_HEAD:b.txt"); got != "b" {
+		t.Errorf("b.txt is %q", got)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestPushSquashCherryPickSignOff(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature := repo.CommitFile("a.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("README.md", "main\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	author := object.Signature{Name: "Author", Email: "author@example.com"}
+	committer := object.Signature{Name: "Committer", Email: "committer@example.com"}
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{
+			name:    "added",
+			message: "Squashed",
+			want:    "Squashed\n\nSigned-off-by: Committer <committer@example.com>",
+		},
+		{
+			name:    "already present",
+			message: "Squashed\n\nSigned-off-by: Committer <committer@example.com>\n",
+			want:    "Squashed\n\nSigned-off-by: Committer <committer@example.com>",
+		},
+		{
+			name:    "another sign-off",
+			message: "Squashed\n\nSigned-off-by: Author <author@example.com>\n",
+			want:    "Squashed\n\nSigned-off-by: Author <author@example.com>\nSigned-off-by: Committer <committer@example.com>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, _, _, err := nichegit.PushSquashCherryPick(server.RepoURL(), &http.Client{}, nichegit.PushSquashCherryPickArgs{
+				CherryPickFrom: feature,
+				CherryPickTo:   main,
+				CherryPickBase: base,
+				CommitMessage:  tt.message,
+				Author:         author,
+				Committer:      committer,
+				Ref:            plumbing.ReferenceName("refs/heads/squashed"),
+				SignOff:        true,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			repo.Git("fetch", "--quiet", "origin", "squashed")
+			if got := repo.Git("rev-parse", "FETCH_HEAD"); got != result.CommitHash.String() {
+				t.Fatalf("refs/heads/squashed is %s, want %s", got, result.CommitHash)
+			}
+			if got := repo.Git("show", "--no-patch", "--format=%B", "FETCH_HEAD"); got != tt.want {
+				t.Errorf("commit message is %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignOffOperations(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	first := repo.CommitFile("b.txt", "b\n", "Add b")
+	second := repo.CommitFile("c.txt", "c\n", "Add c\n\nSigned-off-by: Committer <committer@example.com>")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("d.txt", "d\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	committer := object.Signature{Name: "Committer", Email: "committer@example.com"}
+	message := func(hash plumbing.Hash) string {
+		t.Helper()
+		repo.Git("fetch", "--quiet", "origin", hash.String())
+		return repo.Git("show", "--no-patch", "--format=%B", hash.String())
+	}
+
+	cherryPicked, _, _, err := nichegit.PushCherryPick(server.RepoURL(), &http.Client{}, nichegit.PushCherryPickArgs{
+		Commits:        []plumbing.Hash{first, second},
+		CherryPickOnto: main,
+		Committer:      committer,
+		Ref:            plumbing.ReferenceName("refs/heads/backport"),
+		CurrentRefHash: &plumbing.ZeroHash,
+		SignOff:        true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The second commit is already signed off by the committer.
+	for i, want := range []string{
+		"Add b\n\nSigned-off-by: Committer <committer@example.com>",
+		"Add c\n\nSigned-off-by: Committer <committer@example.com>",
+	} {
+		if got := message(cherryPicked.Commits[i].CommitHash); got != want {
+			t.Errorf("commit %d message is %q, want %q", i, got, want)
+		}
+	}
+
+	merged, _, _, err := nichegit.MergeBranches(server.RepoURL(), &http.Client{}, nichegit.MergeBranchesArgs{
+		Into:           "refs/heads/main",
+		From:           "refs/heads/feature",
+		CommitMessage:  "Merge feature",
+		Author:         committer,
+		Committer:      committer,
+		Ref:            plumbing.ReferenceName("refs/heads/main"),
+		CurrentRefHash: &main,
+		SignOff:        true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := message(merged.CommitHash), "Merge feature\n\nSigned-off-by: Committer <committer@example.com>"; got != want {
+		t.Errorf("merge commit message is %q, want %q", got, want)
+	}
+
+	empty, _, _, err := nichegit.PushEmptyCommit(server.RepoURL(), &http.Client{}, nichegit.PushEmptyCommitArgs{
+		Ref:           plumbing.ReferenceName("refs/heads/main"),
+		CommitMessage: "Deploy\n\nSigned-off-by: Committer <committer@example.com>\n",
+		Author:        committer,
+		Committer:     committer,
+		SignOff:       true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := message(empty.CommitHash), "Deploy\n\nSigned-off-by: Committer <committer@example.com>"; got != want {
+		t.Errorf("empty commit message is %q, want %q", got, want)
+	}
+
+	reworded, _, _, err := nichegit.PushRewordCommits(server.RepoURL(), &http.Client{}, nichegit.PushRewordCommitsArgs{
+		Ref:       plumbing.ReferenceName("refs/heads/main"),
+		Count:     1,
+		Committer: committer,
+		SignOff:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := message(reworded.Commits[0].CommitHash), "Deploy\n\nSigned-off-by: Committer <committer@example.com>"; got != want {
+		t.Errorf("reworded commit message is %q, want %q", got, want)
+	}
+}
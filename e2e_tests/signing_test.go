@@ -0,0 +1,86 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestPushSquashCherryPickSigned(t *testing.T) {
+	signer, pub := newSSHSigner(t)
+
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature := repo.CommitFile("file.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("README.md", "main\n", "main")
+	repo.Push("main", "feature")
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	_, _, _, err := nichegit.PushSquashCherryPick(nichegittest.NewServer(t, repo).RepoURL(), &http.Client{}, nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom: feature,
+		CherryPickTo:   main,
+		CherryPickBase: base,
+		CommitMessage:  "Squashed",
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/squashed"),
+		CurrentRefHash: &plumbing.ZeroHash,
+		Signer:         signer,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo.Git("fetch", "--quiet", "origin", "squashed")
+	verifyCommit(t, repo, pub, "FETCH_HEAD")
+}
+
+// newSSHSigner returns a signer with a new SSH key, and its public key.
+func newSSHSigner(t *testing.T) (signing.Signer, []byte) {
+	t.Helper()
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen is not available")
+	}
+	keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	if out, err := exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", "niche-git@example.com", "-f", keyFile).CombinedOutput(); err != nil {
+		t.Fatalf("ssh-keygen: %v: %s", err, out)
+	}
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := os.ReadFile(keyFile + ".pub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := signing.NewSigner(signing.FormatSSH, key, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer, pub
+}
+
+// verifyCommit fails the test if the signature of the commit doesn't verify with the public key.
+func verifyCommit(t *testing.T, repo *nichegittest.TempRepo, pub []byte, rev string) {
+	t.Helper()
+	allowedSigners := filepath.Join(t.TempDir(), "allowed_signers")
+	if err := os.WriteFile(allowedSigners, append([]byte("niche-git@example.com "), pub...), 0644); err != nil {
+		t.Fatal(err)
+	}
+	repo.Git("config", "gpg.format", "ssh")
+	repo.Git("config", "gpg.ssh.allowedSignersFile", allowedSigners)
+	repo.Git("verify-commit", rev)
+}
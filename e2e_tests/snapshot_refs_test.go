@@ -0,0 +1,54 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSnapshotAndRestoreRefs(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	repo.Git("branch", "keep")
+	repo.Git("branch", "removed")
+	repo.Push("main", "keep", "removed")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	snapshot, _, err := nichegit.SnapshotRefs(repoURL, &http.Client{}, []string{"refs/heads/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A bad automation run.
+	repo.CommitFile("file.txt", "bad\n", "bad")
+	repo.Git("branch", "added")
+	repo.Push("main", "added", ":removed")
+
+	result, _, _, err := nichegit.RestoreRefs(repoURL, &http.Client{}, snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&nichegit.RestoreRefsResult{
+		UpdatedRefs: []string{"refs/heads/main", "refs/heads/removed"},
+		DeletedRefs: []string{"refs/heads/added"},
+	}, result); diff != "" {
+		t.Errorf("result diff (-want +got):\n%s", diff)
+	}
+
+	restored, _, err := nichegit.SnapshotRefs(repoURL, &http.Client{}, []string{"refs/heads/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(snapshot, restored); diff != "" {
+		t.Errorf("refs diff (-want +got):\n%s", diff)
+	}
+	if got := repo.RemoteRefHash("refs/heads/main"); got != base {
+		t.Errorf("refs/heads/main is %s, want %s", got, base)
+	}
+}
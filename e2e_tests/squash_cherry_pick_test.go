@@ -0,0 +1,285 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPushSquashCherryPick(t *testing.T) {
+	t.Run("http", func(t *testing.T) {
+		testPushSquashCherryPick(t, func(repo *nichegittest.TempRepo) string {
+			return nichegittest.NewServer(t, repo).RepoURL()
+		})
+	})
+	t.Run("file", func(t *testing.T) {
+		if nichegittest.ObjectFormat() != "sha1" {
+			t.Skip("pushing to a file URL is not supported with the SHA-256 object format")
+		}
+		testPushSquashCherryPick(t, func(repo *nichegittest.TempRepo) string {
+			return repo.FileURL()
+		})
+	})
+}
+
+func testPushSquashCherryPick(t *testing.T, repoURL func(*nichegittest.TempRepo) string) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("svc1/file.txt", "base\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("svc1/file.txt", "feature\n", "feature 1")
+	feature := repo.CommitFile("svc2/file.txt", "feature\n", "feature 2")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("README.md", "main\n", "main")
+	repo.Push("main", "feature")
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	result, _, _, err := nichegit.PushSquashCherryPick(repoURL(repo), &http.Client{}, nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom: feature,
+		CherryPickTo:   main,
+		CherryPickBase: base,
+		CommitMessage:  "Squashed",
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/squashed"),
+		CurrentRefHash: &plumbing.ZeroHash,
+		PathScope:      []string{"svc1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/squashed"); got != result.CommitHash {
+		t.Errorf("refs/heads/squashed is %s, want %s", got, result.CommitHash)
+	}
+	if diff := cmp.Diff([]string{"svc2/file.txt"}, result.SkippedFiles); diff != "" {
+		t.Errorf("SkippedFiles diff (-want +got):\n%s", diff)
+	}
+
+	repo.Git("fetch", "--quiet", "origin", "squashed")
+	if got := repo.Git("show", "FETCH_HEAD:svc1/file.txt"); got != "feature" {
+		t.Errorf("svc1/file.txt is %q, want %q", got, "feature")
+	}
+	if got := repo.Git("ls-tree", "--name-only", "FETCH_HEAD"); got != "README.md\nsvc1" {
+		t.Errorf("the root tree has %q, want README.md and svc1", got)
+	}
+}
+
+// TestPushSquashCherryPickScopedTrees checks that the trees restricted to the path scope, which
+// the result doesn't reference, are not pushed.
+func TestPushSquashCherryPickScopedTrees(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("svc1/file.txt", "base\n", "base svc1")
+	base := repo.CommitFile("svc2/file.txt", "base\n", "base svc2")
+	// The restricted tree of a commit that changes both services is not in the repository.
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	for _, pth := range []string{"svc1/file.txt", "svc2/file.txt"} {
+		if err := os.WriteFile(filepath.Join(repo.Dir, pth), []byte("feature\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	repo.Git("commit", "--quiet", "--all", "--message", "feature")
+	feature := repo.RevParse("HEAD")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("README.md", "main\n", "main")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	_, _, _, err := nichegit.PushSquashCherryPick(server.RepoURL(), &http.Client{}, nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom: feature,
+		CherryPickTo:   main,
+		CherryPickBase: base,
+		CommitMessage:  "Squashed",
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/squashed"),
+		CurrentRefHash: &plumbing.ZeroHash,
+		PathScope:      []string{"svc1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := repo.Git("--git-dir", repo.BareDir, "fsck", "--unreachable", "--no-reflogs"); got != "" {
+		t.Errorf("unreferenced objects are pushed:\n%s", got)
+	}
+}
+
+func TestPushSquashCherryPickConflictHunks(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("file.txt", "a\nb\nc\nd\n", "base")
+	base := repo.CommitFile("image.bin", "\x00base", "binary")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("file.txt", "a\nfeature\nc\nd\n", "feature")
+	feature := repo.CommitFile("image.bin", "\x00feature", "feature binary")
+	repo.Git("checkout", "--quiet", "main")
+	repo.CommitFile("file.txt", "x\na\nmain\nc\nd\n", "main")
+	main := repo.CommitFile("image.bin", "\x00main", "main binary")
+	repo.Push("main", "feature")
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	result, _, _, err := nichegit.PushSquashCherryPick(nichegittest.NewServer(t, repo).RepoURL(), &http.Client{}, nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom:       feature,
+		CherryPickTo:         main,
+		CherryPickBase:       base,
+		CommitMessage:        "Squashed",
+		Author:               sig,
+		Committer:            sig,
+		Ref:                  plumbing.ReferenceName("refs/heads/squashed"),
+		CurrentRefHash:       &plumbing.ZeroHash,
+		AbortOnConflict:      true,
+		IncludeConflictHunks: true,
+	})
+	var conflictErr *nichegit.ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("expected ConflictError, got %v", err)
+	}
+	want := []*nichegit.ConflictContent{
+		{
+			Path:        "file.txt",
+			OursLabel:   main.String(),
+			BaseLabel:   base.String(),
+			TheirsLabel: feature.String(),
+			Hunks: []*nichegit.ConflictHunk{
+				{
+					OursStartLine:   3,
+					BaseStartLine:   2,
+					TheirsStartLine: 2,
+					OursLines:       []string{"main"},
+					BaseLines:       []string{"b"},
+					TheirsLines:     []string{"feature"},
+				},
+			},
+		},
+		{
+			Path:        "image.bin",
+			OursLabel:   main.String(),
+			BaseLabel:   base.String(),
+			TheirsLabel: feature.String(),
+			Unmergeable: true,
+			Hunks:       []*nichegit.ConflictHunk{},
+		},
+	}
+	if diff := cmp.Diff(want, result.ConflictContents); diff != "" {
+		t.Errorf("ConflictContents diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestPushSquashCherryPickPackOptions(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	// A large tree, which the new tree of the cherry-pick is a small delta to.
+	for i := 0; i < 2000; i++ {
+		if err := os.WriteFile(filepath.Join(repo.Dir, fmt.Sprintf("file%04d.txt", i)), []byte(fmt.Sprintf("%d\n", i)), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	repo.Git("add", ".")
+	repo.Git("commit", "--quiet", "--message", "base")
+	base := repo.RevParse("HEAD")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature := repo.CommitFile("file0001.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("file0002.txt", "main\n", "main")
+	repo.Push("main", "feature")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	push := func(ref string, opts nichegit.PackOptions) (int, error) {
+		_, _, pushDebugInfo, err := nichegit.PushSquashCherryPick(repoURL, &http.Client{}, nichegit.PushSquashCherryPickArgs{
+			CherryPickFrom: feature,
+			CherryPickTo:   main,
+			CherryPickBase: base,
+			CommitMessage:  "Squashed",
+			Author:         sig,
+			Committer:      sig,
+			Ref:            plumbing.ReferenceName(ref),
+			CurrentRefHash: &plumbing.ZeroHash,
+			PackOptions:    opts,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return pushDebugInfo.PackfileSize, nil
+	}
+	plain, err := push("refs/heads/plain", nichegit.PackOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	compressed, err := push("refs/heads/compressed", nichegit.PackOptions{DeltaCompression: true, CompressionLevel: 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if compressed*10 > plain {
+		t.Errorf("the packfile with the delta compression is %d bytes, want less than 1/10 of %d bytes", compressed, plain)
+	}
+	repo.Git("fetch", "--quiet", "origin", "plain", "compressed")
+	if got, want := repo.Git("rev-parse", "origin/compressed^{tree}"), repo.Git("rev-parse", "origin/plain^{tree}"); got != want {
+		t.Errorf("the tree of compressed is %s, want %s", got, want)
+	}
+	repo.Git("-C", repo.BareDir, "fsck", "--no-progress")
+
+	if _, err := push("refs/heads/invalid", nichegit.PackOptions{CompressionLevel: 10}); err == nil {
+		t.Error("the push with the compression level 10 succeeded, want an error")
+	}
+}
+
+func TestPushSquashCherryPickAdditionalRefUpdates(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("a.txt", "a\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	feature := repo.CommitFile("b.txt", "b\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("c.txt", "c\n", "main")
+	repo.Git("branch", "tmp", base.String())
+	repo.Push("main", "feature", "tmp")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	args := nichegit.PushSquashCherryPickArgs{
+		CherryPickFrom: feature,
+		CherryPickTo:   main,
+		CherryPickBase: base,
+		CommitMessage:  "Squashed",
+		Author:         sig,
+		Committer:      sig,
+		Ref:            plumbing.ReferenceName("refs/heads/squashed"),
+		CurrentRefHash: &plumbing.ZeroHash,
+		AdditionalRefUpdates: []nichegit.RefUpdate{
+			{Name: "refs/heads/tracking", OldHash: &plumbing.ZeroHash, ToCreatedCommit: true},
+			// The stale old value fails the whole push.
+			{Name: "refs/heads/tmp", OldHash: &main, NewHash: plumbing.ZeroHash},
+		},
+	}
+	if _, _, _, err := nichegit.PushSquashCherryPick(repoURL, &http.Client{}, args); err == nil {
+		t.Fatal("expected a compare-and-swap failure of refs/heads/tmp")
+	}
+	for _, ref := range []string{"refs/heads/squashed", "refs/heads/tracking"} {
+		if got := repo.RemoteRefHash(ref); !got.IsZero() {
+			t.Errorf("%s is updated to %s despite the failure", ref, got)
+		}
+	}
+
+	args.AdditionalRefUpdates[1].OldHash = &base
+	result, _, _, err := nichegit.PushSquashCherryPick(repoURL, &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range []string{"refs/heads/squashed", "refs/heads/tracking"} {
+		if got := repo.RemoteRefHash(ref); got != result.CommitHash {
+			t.Errorf("%s is %s, want %s", ref, got, result.CommitHash)
+		}
+	}
+	if got := repo.RemoteRefHash("refs/heads/tmp"); !got.IsZero() {
+		t.Errorf("refs/heads/tmp is %s, want deleted", got)
+	}
+}
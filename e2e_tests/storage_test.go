@@ -0,0 +1,192 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/google/go-cmp/cmp"
+)
+
+// countingStorageProvider counts the storages created by the provider and the ones not closed.
+type countingStorageProvider struct {
+	provider nichegit.StorageProvider
+	created  int
+	open     int
+}
+
+func (p *countingStorageProvider) NewStorage() (storer.EncodedObjectStorer, error) {
+	storage, err := p.provider.NewStorage()
+	if err != nil {
+		return nil, err
+	}
+	p.created++
+	p.open++
+	return &countingStorage{EncodedObjectStorer: storage, provider: p}, nil
+}
+
+type countingStorage struct {
+	storer.EncodedObjectStorer
+	provider *countingStorageProvider
+}
+
+func (s *countingStorage) Close() error {
+	s.provider.open--
+	return s.EncodedObjectStorer.(interface{ Close() error }).Close()
+}
+
+func TestDiskStorageProvider(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("shared.txt", "a\nb\nc\nd\ne\n", "base")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("shared.txt", "a\nb\nc\nd\nE\n", "feature shared")
+	feature := repo.CommitFile("feature.txt", "feature\n", "feature")
+	repo.Git("checkout", "--quiet", "main")
+	main := repo.CommitFile("shared.txt", "A\nb\nc\nd\ne\n", "main shared")
+	repo.Push("main", "feature")
+	server := nichegittest.NewServer(t, repo)
+
+	dir := t.TempDir()
+	provider := &countingStorageProvider{provider: nichegit.NewDiskStorageProvider(dir)}
+	nichegit.SetStorageProvider(provider)
+	defer nichegit.SetStorageProvider(nil)
+
+	files, _, err := nichegit.FetchModifiedFiles(server.RepoURL(), &http.Client{}, base, feature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(files)
+	if diff := cmp.Diff([]string{"feature.txt", "shared.txt"}, files); diff != "" {
+		t.Errorf("FetchModifiedFiles diff (-want +got):\n%s", diff)
+	}
+
+	// The merge fetches the blobs into the storage and pushes the created objects from it.
+	sig := object.Signature{Name: "niche-git", Email: "niche-git@example.com"}
+	result, _, _, err := nichegit.MergeBranches(server.RepoURL(), &http.Client{}, nichegit.MergeBranchesArgs{
+		Into:            "main",
+		From:            "refs/heads/feature",
+		Author:          sig,
+		Committer:       sig,
+		Ref:             plumbing.ReferenceName("refs/heads/main"),
+		CurrentRefHash:  &main,
+		AbortOnConflict: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"shared.txt"}, result.ConflictResolvedFiles); diff != "" {
+		t.Errorf("ConflictResolvedFiles diff (-want +got):\n%s", diff)
+	}
+	repo.Git("fetch", "--quiet", "origin", "main")
+	if got := repo.Git("show", "FETCH_HEAD:shared.txt"); got != "A\nb\nc\nd\nE" {
+		t.Errorf("shared.txt is %q", got)
+	}
+
+	if provider.created == 0 {
+		t.Error("the storage provider is not used")
+	}
+	if provider.open != 0 {
+		t.Errorf("%d storages are not closed", provider.open)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("the object storage directories are left: %v", entries)
+	}
+}
+
+// serialCheckingStorage records the calls made while another call is in progress.
+type serialCheckingStorage struct {
+	storer.EncodedObjectStorer
+	inFlight   atomic.Int32
+	concurrent atomic.Int32
+}
+
+func (s *serialCheckingStorage) EncodedObject(typ plumbing.ObjectType, hash plumbing.Hash) (plumbing.EncodedObject, error) {
+	if s.inFlight.Add(1) > 1 {
+		s.concurrent.Add(1)
+	}
+	defer s.inFlight.Add(-1)
+	return s.EncodedObjectStorer.EncodedObject(typ, hash)
+}
+
+func (s *serialCheckingStorage) Close() error {
+	return s.EncodedObjectStorer.(interface{ Close() error }).Close()
+}
+
+type serialCheckingStorageProvider struct {
+	provider nichegit.StorageProvider
+	storages []*serialCheckingStorage
+}
+
+func (p *serialCheckingStorageProvider) NewStorage() (storer.EncodedObjectStorer, error) {
+	storage, err := p.provider.NewStorage()
+	if err != nil {
+		return nil, err
+	}
+	s := &serialCheckingStorage{EncodedObjectStorer: storage}
+	p.storages = append(p.storages, s)
+	return s, nil
+}
+
+// TestStorageProviderConcurrentReads checks that the storage of the provider is not read
+// concurrently by the parallel tree diff. Run it with -race too.
+func TestStorageProviderConcurrentReads(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	writeFiles := func(content string) {
+		for i := 0; i < 32; i++ {
+			fpath := filepath.Join(repo.Dir, fmt.Sprintf("dir%d", i), "sub", "file.txt")
+			if err := os.MkdirAll(filepath.Dir(fpath), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(fpath, []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		repo.Git("add", ".")
+		repo.Git("commit", "--quiet", "--message", content)
+	}
+	writeFiles("base\n")
+	base := repo.RevParse("HEAD")
+	writeFiles("head\n")
+	head := repo.RevParse("HEAD")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	// The subtrees are compared in parallel up to GOMAXPROCS.
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(8))
+	provider := &serialCheckingStorageProvider{provider: nichegit.NewDiskStorageProvider(t.TempDir())}
+	nichegit.SetStorageProvider(provider)
+	defer nichegit.SetStorageProvider(nil)
+
+	files, _, err := nichegit.FetchModifiedFiles(server.RepoURL(), &http.Client{}, base, head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 32 {
+		t.Errorf("got %d modified files, want 32", len(files))
+	}
+	if len(provider.storages) == 0 {
+		t.Fatal("the storage provider is not used")
+	}
+	for _, s := range provider.storages {
+		if n := s.concurrent.Load(); n > 0 {
+			t.Errorf("the storage is read concurrently %d times", n)
+		}
+	}
+}
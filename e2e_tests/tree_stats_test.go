@@ -0,0 +1,70 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetTreeStats(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("README.md", "readme\n", "readme")
+	repo.CommitFile("svc/api/big.bin", strings.Repeat("x", 1000), "big")
+	repo.CommitFile("svc/api/copy.bin", strings.Repeat("x", 1000), "copy")
+	head := repo.CommitFile("svc/main.go", "package main\n", "main")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	got, debugInfo, err := nichegit.GetTreeStats(server.RepoURL(), &http.Client{}, nichegit.GetTreeStatsArgs{
+		CommitHash:   head,
+		LargestFiles: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &nichegit.TreeStats{
+		Files:          4,
+		Directories:    2,
+		MaxDepth:       3,
+		TotalFileBytes: 1000 + 1000 + 7 + 13,
+		LargestFiles: []*nichegit.FileSize{
+			{Path: "svc/api/big.bin", Size: 1000},
+			{Path: "svc/api/copy.bin", Size: 1000},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetTreeStats diff (-want +got):\n%s", diff)
+	}
+	if debugInfo.ObjectStats.Blobs != 0 {
+		t.Errorf("blobs are fetched: %+v", debugInfo.ObjectStats)
+	}
+
+	// Without object-info, the sizes are not available.
+	server.InjectFault(nichegittest.Fault{
+		Match: func(r *http.Request) bool {
+			if !nichegittest.MatchUploadPack(r) || r.Body == nil {
+				return false
+			}
+			body, _ := io.ReadAll(r.Body)
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			return bytes.Contains(body, []byte("command=object-info"))
+		},
+		StatusCode: http.StatusBadRequest,
+	})
+	got, debugInfo, err = nichegit.GetTreeStats(server.RepoURL(), &http.Client{}, nichegit.GetTreeStatsArgs{CommitHash: head})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Files != 4 || got.TotalFileBytes != 0 || len(got.LargestFiles) != 0 || len(debugInfo.Warnings) == 0 {
+		t.Errorf("expected the sizes to be skipped with a warning: %+v, %v", got, debugInfo.Warnings)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+)
+
+func TestGetTree(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	repo.CommitFile("README.md", "readme\n", "readme")
+	repo.CommitFile("svc/api/big.bin", strings.Repeat("x", 1000), "big")
+	head := repo.CommitFile("svc/main.go", "package main\n", "main")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+
+	format := func(entries []*nichegit.TreeEntry) string {
+		var lines []string
+		for _, e := range entries {
+			lines = append(lines, fmt.Sprintf("%s %s %s %d\t%s", e.Mode, e.Type, e.Hash, e.Size, e.Path))
+		}
+		return strings.Join(lines, "\n")
+	}
+	// lsTree runs git ls-tree --long, which pads the sizes and writes "-" for the trees, in the
+	// format above.
+	lsTree := func(args ...string) string {
+		var lines []string
+		for _, line := range strings.Split(repo.Git(append([]string{"ls-tree", "--long"}, args...)...), "\n") {
+			meta, pth, _ := strings.Cut(line, "\t")
+			fields := strings.Fields(meta)
+			if fields[3] == "-" {
+				fields[3] = "0"
+			}
+			lines = append(lines, strings.Join(fields, " ")+"\t"+pth)
+		}
+		return strings.Join(lines, "\n")
+	}
+	for _, tt := range []struct {
+		path  string
+		depth int
+		want  string
+	}{
+		{"", 0, lsTree("-r", "-t", head.String())},
+		// Without the line of svc itself.
+		{"svc", 0, strings.SplitN(lsTree("-r", "-t", head.String(), "svc/"), "\n", 2)[1]},
+		{"/svc/", 1, lsTree(head.String(), "svc/")},
+	} {
+		entries, debugInfo, err := nichegit.GetTree(server.RepoURL(), &http.Client{}, nichegit.GetTreeArgs{
+			CommitHash: head,
+			Path:       tt.path,
+			Depth:      tt.depth,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := format(entries); got != tt.want {
+			t.Errorf("GetTree(%q, %d) =\n%s\nwant\n%s", tt.path, tt.depth, got, tt.want)
+		}
+		if debugInfo.ObjectStats.Blobs != 0 {
+			t.Errorf("blobs are fetched: %+v", debugInfo.ObjectStats)
+		}
+	}
+
+	if _, _, err := nichegit.GetTree(server.RepoURL(), &http.Client{}, nichegit.GetTreeArgs{CommitHash: head, Path: "README.md"}); err == nil {
+		t.Errorf("listed a file")
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package e2etests
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPushUpdateRefs(t *testing.T) {
+	target := nichegittest.NewTempRepo(t)
+	base := target.CommitFile("file.txt", "base\n", "base")
+	target.Push("main")
+	targetURL := nichegittest.NewServer(t, target).RepoURL()
+
+	source := nichegittest.NewTempRepo(t)
+	source.Git("pull", "--quiet", target.BareDir, "main")
+	feature := source.CommitFile("file.txt", "feature\n", "feature")
+	source.Push("main")
+
+	// The ref can be updated to an existing object.
+	if _, _, _, err := nichegit.PushUpdateRefs(targetURL, &http.Client{}, nichegit.PushUpdateRefsArgs{
+		RefUpdates: []nichegit.RefUpdate{{Name: "refs/heads/release", OldHash: &plumbing.ZeroHash, NewHash: base}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The objects are probed one by one after the server refuses them at once, so only the missing
+	// one is reported.
+	args := nichegit.PushUpdateRefsArgs{
+		RefUpdates: []nichegit.RefUpdate{
+			{Name: "refs/heads/release", OldHash: &base, NewHash: feature},
+			{Name: "refs/heads/stable", OldHash: &plumbing.ZeroHash, NewHash: base},
+		},
+	}
+	_, _, _, err := nichegit.PushUpdateRefs(targetURL, &http.Client{}, args)
+	var missingObjectErr *nichegit.MissingObjectError
+	if !errors.As(err, &missingObjectErr) {
+		t.Fatalf("expected MissingObjectError, got %v", err)
+	}
+	if diff := cmp.Diff([]plumbing.Hash{feature}, missingObjectErr.Hashes); diff != "" {
+		t.Errorf("missing objects diff (-want +got):\n%s", diff)
+	}
+
+	args.SourceRepoURL = source.FileURL()
+	result, _, _, err := nichegit.PushUpdateRefs(targetURL, &http.Client{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]plumbing.Hash{feature}, result.ForwardedObjects); diff != "" {
+		t.Errorf("ForwardedObjects diff (-want +got):\n%s", diff)
+	}
+	if got := target.RemoteRefHash("refs/heads/release"); got != feature {
+		t.Errorf("refs/heads/release is %s, want %s", got, feature)
+	}
+	if got := target.RemoteRefHash("refs/heads/stable"); got != base {
+		t.Errorf("refs/heads/stable is %s, want %s", got, base)
+	}
+}
+
+func TestPushUpdateRefsInvalidRefName(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	repo.Push("main")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	for _, name := range []string{
+		"",
+		"main",
+		"refs/heads/my branch",
+		"refs/heads/a..b",
+		"refs/heads/-feature",
+		"refs/heads/feature.lock",
+		"refs/heads/.hidden",
+		"refs/heads/a//b",
+		"refs/heads/feature/",
+		"refs/heads/feature.",
+		"refs/heads/a@{1}",
+		"refs/heads/a:b",
+		"refs/heads/a\x01b",
+	} {
+		_, _, _, err := nichegit.PushUpdateRefs(repoURL, &http.Client{}, nichegit.PushUpdateRefsArgs{
+			RefUpdates: []nichegit.RefUpdate{
+				{Name: "refs/heads/valid", OldHash: &plumbing.ZeroHash, NewHash: base},
+				{Name: plumbing.ReferenceName(name), OldHash: &plumbing.ZeroHash, NewHash: base},
+			},
+		})
+		var invalidErr *nichegit.InvalidRefNameError
+		if !errors.As(err, &invalidErr) {
+			t.Errorf("PushUpdateRefs(%q) = %v, want InvalidRefNameError", name, err)
+			continue
+		}
+		if invalidErr.Name != name {
+			t.Errorf("InvalidRefNameError.Name = %q, want %q", invalidErr.Name, name)
+		}
+	}
+	if got := repo.RemoteRefHash("refs/heads/valid"); got != plumbing.ZeroHash {
+		t.Errorf("refs/heads/valid is pushed along with the invalid ref")
+	}
+
+	if _, _, _, err := nichegit.PushUpdateRefs(repoURL, &http.Client{}, nichegit.PushUpdateRefsArgs{
+		RefUpdates: []nichegit.RefUpdate{{Name: "refs/heads/feature/a-b_c.d", OldHash: &plumbing.ZeroHash, NewHash: base}},
+	}); err != nil {
+		t.Errorf("PushUpdateRefs with a valid name: %v", err)
+	}
+}
+
+func TestPushWithLease(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	base := repo.CommitFile("file.txt", "base\n", "base")
+	repo.Push("main")
+	head := repo.CommitFile("file.txt", "head\n", "head")
+	repoURL := nichegittest.NewServer(t, repo).RepoURL()
+
+	// The session caches main at base. The lease is checked against the server, not the cache.
+	client := nichegit.NewSession(&http.Client{}).Client()
+	if _, _, err := nichegit.LsRefs(repoURL, client, []string{"refs/heads/main"}); err != nil {
+		t.Fatal(err)
+	}
+	repo.Push("main")
+
+	_, _, _, err := nichegit.PushWithLease(repoURL, client, nichegit.PushUpdateRefsArgs{
+		RefUpdates: []nichegit.RefUpdate{
+			{Name: "refs/heads/main", OldHash: &base, NewHash: base},
+			{Name: "refs/heads/release", OldHash: &plumbing.ZeroHash, NewHash: base},
+		},
+	})
+	var leaseBrokenErr *nichegit.LeaseBrokenError
+	if !errors.As(err, &leaseBrokenErr) {
+		t.Fatalf("expected LeaseBrokenError, got %v", err)
+	}
+	want := []*nichegit.BrokenLease{{Name: "refs/heads/main", Expected: base, Actual: head}}
+	if diff := cmp.Diff(want, leaseBrokenErr.Refs); diff != "" {
+		t.Errorf("broken leases diff (-want +got):\n%s", diff)
+	}
+	if code := nichegit.ErrorCodeOf(err); code != nichegit.ErrorCodeLeaseBroken {
+		t.Errorf("error code is %q, want %q", code, nichegit.ErrorCodeLeaseBroken)
+	}
+	if got := repo.RemoteRefHash("refs/heads/release"); !got.IsZero() {
+		t.Errorf("refs/heads/release is %s, want it not to be created", got)
+	}
+
+	if _, _, _, err := nichegit.PushWithLease(repoURL, client, nichegit.PushUpdateRefsArgs{
+		RefUpdates: []nichegit.RefUpdate{
+			{Name: "refs/heads/main", OldHash: &head, NewHash: base},
+			{Name: "refs/heads/release", OldHash: &plumbing.ZeroHash, NewHash: head},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if got := repo.RemoteRefHash("refs/heads/main"); got != base {
+		t.Errorf("refs/heads/main is %s, want %s", got, base)
+	}
+	if got := repo.RemoteRefHash("refs/heads/release"); got != head {
+		t.Errorf("refs/heads/release is %s, want %s", got, head)
+	}
+
+	_, _, _, err = nichegit.PushWithLease(repoURL, client, nichegit.PushUpdateRefsArgs{
+		RefUpdates: []nichegit.RefUpdate{{Name: "refs/heads/main", NewHash: head}},
+	})
+	if err == nil {
+		t.Error("expected an error for a ref update without OldHash")
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/pathmatch"
+	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/aviator-co/niche-git/internal/trailer"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// Trailer is a "Key: Value" line at the end of a commit message.
+type Trailer = trailer.Trailer
+
+type PushEmptyCommitArgs struct {
+	// Ref is the ref to push the created commit to.
+	Ref plumbing.ReferenceName
+	// Parent, if set, is the commit where the empty commit is created on top of, and the
+	// expected current value of Ref. If not set, the current value of Ref is read with ls-refs.
+	// Either way, Ref is pushed with compare-and-swap against it.
+	Parent plumbing.Hash
+
+	// CommitMessage is the message of the created commit.
+	CommitMessage string
+	// SignOff appends a Signed-off-by trailer for the committer to the commit message if it's
+	// not already there.
+	SignOff bool
+	// Trailers are appended to CommitMessage, e.g. "Deployed-To: production". A trailer that
+	// already exists in the message is not added again.
+	Trailers  []Trailer
+	Author    object.Signature
+	Committer object.Signature
+	// Timezone, if set, is the timezone of the Author and Committer times. By default, they
+	// keep their own timezones.
+	Timezone *time.Location
+
+	// AdditionalRefUpdates are the ref updates pushed atomically with the update of Ref, e.g. to
+	// move a tracking ref to the created commit with ToCreatedCommit or to delete a temporary
+	// ref.
+	AdditionalRefUpdates []RefUpdate
+
+	// ProtectedPaths are the path patterns that the operation must not modify. The empty commit
+	// doesn't modify any file, so the patterns are only validated. This lets the callers pass
+	// the same guards to every operation.
+	ProtectedPaths []string
+
+	// Signer, if set, signs the created commit.
+	Signer signing.Signer
+	// PackOptions are the options of the pushed packfile.
+	PackOptions PackOptions
+}
+
+type PushEmptyCommitResult struct {
+	CommitHash plumbing.Hash
+	// Parent is the parent of the created commit, which was the value of Ref.
+	Parent plumbing.Hash
+	// CommitMessage is the message of the created commit, including the trailers.
+	CommitMessage string
+}
+
+// PushEmptyCommit creates a commit that has the same tree as its parent, like `git commit
+// --allow-empty`, and pushes it to the ref. This is for the markers such as the deployments.
+// Only the parent commit is fetched, without its tree.
+func PushEmptyCommit(repoURL string, client *http.Client, args PushEmptyCommitArgs) (*PushEmptyCommitResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	args.Author = signatureIn(args.Author, args.Timezone)
+	args.Committer = signatureIn(args.Committer, args.Timezone)
+	refNames := withAdditionalRefNames(args.Ref, args.AdditionalRefUpdates)
+	if err := validateRefNames(refNames...); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := trailer.Validate(args.Trailers); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := pathmatch.Validate(args.ProtectedPaths); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	message := trailer.Append(args.CommitMessage, trailer.WithSignOff(args.Trailers, args.SignOff, args.Committer.Name, args.Committer.Email))
+	if message == "" {
+		return nil, debug.FetchDebugInfo{}, nil, errors.New("the commit message is empty")
+	}
+	unlock, err := lockRefs(repoURL, refNames...)
+	defer unlock()
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+
+	parent := args.Parent
+	if parent.IsZero() {
+		refInfos, _, err := LsRefs(repoURL, client, []string{args.Ref.String()})
+		if err != nil {
+			return nil, debug.FetchDebugInfo{}, nil, err
+		}
+		for _, info := range refInfos {
+			if info.Name == args.Ref.String() && plumbing.IsHash(info.Hash) {
+				parent = plumbing.NewHash(info.Hash)
+			}
+		}
+		if parent.IsZero() {
+			return nil, debug.FetchDebugInfo{}, nil, fmt.Errorf("%s does not exist", args.Ref)
+		}
+	}
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	defer storage.Close()
+	f := &historyFetcher{
+		repoURL: repoURL,
+		client:  client,
+		storage: storage,
+		opts:    fetch.FetchOptions{Depth: 1},
+	}
+	if err := f.fetchCommits([]plumbing.Hash{parent}); err != nil {
+		return nil, f.debugInfo, nil, err
+	}
+	fetchDebugInfo := f.debugInfo
+	parentCommit, err := object.GetCommit(f.storage, parent)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", parent.String(), err)
+	}
+
+	commitHash, err := storeCommit(f.storage, &object.Commit{
+		Message:      message,
+		Author:       args.Author,
+		Committer:    args.Committer,
+		TreeHash:     parentCommit.TreeHash,
+		ParentHashes: []plumbing.Hash{parent},
+	}, args.Signer)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	result := &PushEmptyCommitResult{CommitHash: commitHash, Parent: parent, CommitMessage: message}
+	buf, err := encodePushPackfile(f.storage, []plumbing.Hash{commitHash}, args.PackOptions)
+	if err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+	pushDebugInfo, err := pushCreatedCommit(repoURL, client, buf, push.RefUpdate{
+		Name:    args.Ref,
+		OldHash: &parent,
+		NewHash: commitHash,
+	}, args.AdditionalRefUpdates)
+	if err != nil {
+		return result, fetchDebugInfo, &pushDebugInfo, err
+	}
+	return result, fetchDebugInfo, &pushDebugInfo, nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/go-git/go-git/v5/plumbing"
+	gogittransport "github.com/go-git/go-git/v5/plumbing/transport"
+	gogithttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+// ErrorCode is the category of an error, so that the callers can branch on it without matching
+// the messages.
+type ErrorCode string
+
+const (
+	// ErrorCodeConflict means the operation was aborted due to merge conflicts. The error is
+	// ConflictError.
+	ErrorCodeConflict ErrorCode = "CONFLICT"
+	// ErrorCodeRefCASFailed means the server rejected a ref update, usually because the ref
+	// didn't have the expected old value. The error is RefUpdateError.
+	ErrorCodeRefCASFailed ErrorCode = "REF_CAS_FAILED"
+	// ErrorCodeLeaseBroken means the refs were not at the values that the caller expected when
+	// pushing with PushWithLease. The error is LeaseBrokenError.
+	ErrorCodeLeaseBroken ErrorCode = "LEASE_BROKEN"
+	// ErrorCodeObjectNotFound means the objects to update the refs to don't exist in the
+	// repository. The error is MissingObjectError.
+	ErrorCodeObjectNotFound ErrorCode = "OBJECT_NOT_FOUND"
+	// ErrorCodePreconditionFailed means the change is not allowed by the protected paths or the
+	// Git LFS file locks. The error is ProtectedPathError or LFSLockError.
+	ErrorCodePreconditionFailed ErrorCode = "PRECONDITION_FAILED"
+	// ErrorCodeTooLarge means the change exceeds the change size limits. The error is
+	// ChangeTooLargeError.
+	ErrorCodeTooLarge ErrorCode = "TOO_LARGE"
+	// ErrorCodeInvalidRefName means a ref name to push is invalid. The error is
+	// InvalidRefNameError.
+	ErrorCodeInvalidRefName ErrorCode = "INVALID_REF_NAME"
+	// ErrorCodeAuthFailed means the server rejected the credentials.
+	ErrorCodeAuthFailed ErrorCode = "AUTH_FAILED"
+	// ErrorCodeNetwork means the server couldn't be reached or responded with a server-side
+	// error (5xx, 429).
+	ErrorCodeNetwork ErrorCode = "NETWORK"
+	// ErrorCodeTimeout means the operation didn't finish within its time limit. The CLI sets it
+	// for --timeout. The error of a push that exceeds PushTimeouts is PushTimeoutError.
+	ErrorCodeTimeout ErrorCode = "TIMEOUT"
+	// ErrorCodeUnknown means the error is not in the categories above.
+	ErrorCodeUnknown ErrorCode = "UNKNOWN"
+)
+
+// ErrorCodeOf returns the category of the error returned by the operations. Returns an empty
+// code for nil.
+func ErrorCodeOf(err error) ErrorCode {
+	if err == nil {
+		return ""
+	}
+	var conflictErr *ConflictError
+	var leaseBrokenErr *LeaseBrokenError
+	var refUpdateErr *RefUpdateError
+	var missingObjectErr *MissingObjectError
+	var protectedPathErr *ProtectedPathError
+	var tooLargeErr *ChangeTooLargeError
+	var lfsLockErr *LFSLockError
+	var invalidRefNameErr *InvalidRefNameError
+	var pushTimeoutErr *PushTimeoutError
+	switch {
+	case errors.As(err, &conflictErr):
+		return ErrorCodeConflict
+	case errors.As(err, &leaseBrokenErr):
+		return ErrorCodeLeaseBroken
+	case errors.As(err, &refUpdateErr):
+		return ErrorCodeRefCASFailed
+	case errors.As(err, &missingObjectErr):
+		return ErrorCodeObjectNotFound
+	case errors.As(err, &protectedPathErr) || errors.As(err, &lfsLockErr):
+		return ErrorCodePreconditionFailed
+	case errors.As(err, &tooLargeErr):
+		return ErrorCodeTooLarge
+	case errors.As(err, &invalidRefNameErr):
+		return ErrorCodeInvalidRefName
+	case errors.As(err, &pushTimeoutErr):
+		return ErrorCodeTimeout
+	case errors.Is(err, gogittransport.ErrAuthenticationRequired) || errors.Is(err, gogittransport.ErrAuthorizationFailed):
+		return ErrorCodeAuthFailed
+	}
+	if statusCode, ok := httpStatusCode(err); ok {
+		switch {
+		case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+			return ErrorCodeAuthFailed
+		case statusCode == http.StatusTooManyRequests || statusCode >= 500:
+			return ErrorCodeNetwork
+		}
+		return ErrorCodeUnknown
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ErrorCodeNetwork
+	}
+	return ErrorCodeUnknown
+}
+
+func httpStatusCode(err error) (int, bool) {
+	var statusErr *fetch.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode, true
+	}
+	// go-git wraps the HTTP errors in UnexpectedError, which doesn't support unwrapping.
+	var unexpectedErr *plumbing.UnexpectedError
+	if errors.As(err, &unexpectedErr) {
+		var httpErr *gogithttp.Err
+		if errors.As(unexpectedErr.Err, &httpErr) {
+			return httpErr.StatusCode(), true
+		}
+	}
+	return 0, false
+}
+
+// RefUpdateError is returned when the server rejects a ref update. This happens when the
+// expected old value doesn't match (compare-and-swap failure) or the update is not allowed.
+type RefUpdateError = push.RefUpdateError
+
+// ConflictError is returned when the operation is aborted due to merge conflicts.
+type ConflictError struct {
+	// Files are the conflicting files.
+	Files []string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("conflict detected: %s", strings.Join(e.Files, ", "))
+}
+
+// ProtectedPathError is returned when a change touches protected paths.
+type ProtectedPathError struct {
+	// Paths are the protected paths that are modified by the change.
+	Paths []string
+}
+
+func (e *ProtectedPathError) Error() string {
+	return fmt.Sprintf("the change modifies protected paths: %s", strings.Join(e.Paths, ", "))
+}
+
+// ChangeTooLargeError is returned when a change exceeds the configured size limits.
+type ChangeTooLargeError struct {
+	// ChangedFiles is the number of the modified files. Set when MaxChangedFiles is exceeded.
+	ChangedFiles    int
+	MaxChangedFiles int
+
+	// NewBlobBytes is the total size of the blobs of the added and modified files. Set when
+	// MaxNewBlobBytes is exceeded.
+	NewBlobBytes    int64
+	MaxNewBlobBytes int64
+}
+
+func (e *ChangeTooLargeError) Error() string {
+	if e.MaxChangedFiles > 0 {
+		return fmt.Sprintf("the change is too large: %d files are modified (max %d)", e.ChangedFiles, e.MaxChangedFiles)
+	}
+	return fmt.Sprintf("the change is too large: %d bytes of new blobs (max %d)", e.NewBlobBytes, e.MaxNewBlobBytes)
+}
+
+// LFSLockError is returned when a change modifies files that are locked by others with Git LFS
+// file locking.
+type LFSLockError struct {
+	// Paths are the locked paths that are modified by the change.
+	Paths []string
+}
+
+func (e *LFSLockError) Error() string {
+	return fmt.Sprintf("the change modifies files locked by others: %s", strings.Join(e.Paths, ", "))
+}
+
+// MissingObjectError is returned when the objects to update the refs to don't exist in the
+// repository.
+type MissingObjectError struct {
+	Hashes []plumbing.Hash
+}
+
+func (e *MissingObjectError) Error() string {
+	var hashes []string
+	for _, hash := range e.Hashes {
+		hashes = append(hashes, hash.String())
+	}
+	return fmt.Sprintf("the objects don't exist in the repository: %s", strings.Join(hashes, ", "))
+}
+
+// InvalidRefNameError is returned when a ref name to push doesn't follow the git
+// check-ref-format rules. The ref is not sent to the server.
+type InvalidRefNameError struct {
+	Name string
+	// Reason is the rule that the name breaks.
+	Reason string
+}
+
+func (e *InvalidRefNameError) Error() string {
+	return fmt.Sprintf("invalid ref name %q: %s", e.Name, e.Reason)
+}
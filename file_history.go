@@ -0,0 +1,304 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// DefaultFileHistoryPageSize is the default number of commits that GetFileHistory returns.
+const DefaultFileHistoryPageSize = 50
+
+// DefaultFileHistoryMaxCommits is the default number of commits that GetFileHistory looks into
+// for a page.
+const DefaultFileHistoryMaxCommits = 1000
+
+// fileHistoryFetchDepth is the depth of the history fetched in each round of GetFileHistory.
+const fileHistoryFetchDepth = 100
+
+type GetFileHistoryArgs struct {
+	// CommitHash is the commit to start the history from.
+	CommitHash plumbing.Hash
+	// Paths are the files or the directories to take the history of. A commit is in the history
+	// if it modifies any of them.
+	Paths []string
+	// PageSize is the maximum number of the commits to return. Defaults to
+	// DefaultFileHistoryPageSize.
+	PageSize int
+	// MaxCommits is the maximum number of the commits to look into for the page. Defaults to
+	// DefaultFileHistoryMaxCommits. If reached, the page has fewer commits than PageSize and
+	// NextCursor is set.
+	MaxCommits int
+	// Cursor is the NextCursor of the previous page. If set, the history continues from there
+	// instead of CommitHash.
+	Cursor string
+}
+
+type GetFileHistoryResult struct {
+	// Commits are the commits that modify the paths, newest first.
+	Commits []*CommitInfo
+	// NextCursor is set if the history can have more commits. Pass it as Cursor to get the next
+	// page.
+	NextCursor string
+}
+
+// GetFileHistory returns the commits that modify the paths in the history of the commit, newest
+// first by the committer time like git log. The history of all the parents of a merge commit is
+// walked like `git log --full-history`, but the merge commit itself is included only if it
+// differs from all of its parents in the paths. Renames are not followed.
+//
+// The history is fetched without the trees, and only the trees on the way to the paths are
+// fetched for the commits looked into. The unchanged trees are compared by their hashes without
+// fetching their contents.
+func GetFileHistory(repoURL string, client *http.Client, args GetFileHistoryArgs) (*GetFileHistoryResult, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "GetFileHistory", args, func() (*GetFileHistoryResult, debug.FetchDebugInfo, error) {
+		return getFileHistory(repoURL, client, args)
+	})
+}
+
+func getFileHistory(repoURL string, client *http.Client, args GetFileHistoryArgs) (*GetFileHistoryResult, debug.FetchDebugInfo, error) {
+	pageSize := args.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultFileHistoryPageSize
+	}
+	maxCommits := args.MaxCommits
+	if maxCommits <= 0 {
+		maxCommits = DefaultFileHistoryMaxCommits
+	}
+	start := []plumbing.Hash{args.CommitHash}
+	if args.Cursor != "" {
+		var err error
+		start, err = parseFileHistoryCursor(args.Cursor)
+		if err != nil {
+			return nil, debug.FetchDebugInfo{}, err
+		}
+	}
+	var segments [][]string
+	for _, pth := range args.Paths {
+		cleaned := strings.Trim(path.Clean("/"+pth), "/")
+		if cleaned == "" {
+			return nil, debug.FetchDebugInfo{}, fmt.Errorf("invalid path %q", pth)
+		}
+		segments = append(segments, strings.Split(cleaned, "/"))
+	}
+
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, err
+	}
+	defer storage.Close()
+	h := &fileHistoryWalk{
+		historyFetcher: historyFetcher{
+			repoURL: repoURL,
+			client:  client,
+			storage: storage,
+			opts:    fetch.FetchOptions{Depth: fileHistoryFetchDepth},
+		},
+		segments: segments,
+		entries:  map[plumbing.Hash][]plumbing.Hash{},
+	}
+	if err := h.fetchCommits(start); err != nil {
+		return nil, h.debugInfo, err
+	}
+	var queue []*object.Commit
+	seen := map[plumbing.Hash]bool{}
+	push := func(hash plumbing.Hash) error {
+		if seen[hash] {
+			return nil
+		}
+		seen[hash] = true
+		commit, err := object.GetCommit(h.storage, hash)
+		if err != nil {
+			return fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+		}
+		queue = insertByCommitterTime(queue, commit)
+		return nil
+	}
+	for _, hash := range start {
+		if err := push(hash); err != nil {
+			return nil, h.debugInfo, err
+		}
+	}
+
+	result := &GetFileHistoryResult{}
+	looked := 0
+	for len(queue) > 0 && len(result.Commits) < pageSize && looked < maxCommits {
+		// Take the next commits in order, and then compare their paths with their parents at
+		// once so that the trees are fetched together.
+		var batch []*object.Commit
+		for len(queue) > 0 && len(batch) < pageSize && looked < maxCommits {
+			commit := queue[0]
+			queue = queue[1:]
+			looked++
+			batch = append(batch, commit)
+			if err := h.fetchCommits(commit.ParentHashes); err != nil {
+				return nil, h.debugInfo, err
+			}
+			for _, parent := range commit.ParentHashes {
+				if err := push(parent); err != nil {
+					return nil, h.debugInfo, err
+				}
+			}
+		}
+		var trees []plumbing.Hash
+		for _, commit := range batch {
+			trees = append(trees, commit.TreeHash)
+			for _, parent := range commit.ParentHashes {
+				parentCommit, err := object.GetCommit(h.storage, parent)
+				if err != nil {
+					return nil, h.debugInfo, fmt.Errorf("cannot find %q in the fetched packfile: %w", parent.String(), err)
+				}
+				trees = append(trees, parentCommit.TreeHash)
+			}
+		}
+		if err := h.resolveEntries(trees); err != nil {
+			return nil, h.debugInfo, err
+		}
+		for i, commit := range batch {
+			modifies, err := h.modifies(commit)
+			if err != nil {
+				return nil, h.debugInfo, err
+			}
+			if !modifies {
+				continue
+			}
+			result.Commits = append(result.Commits, convertCommitInfo(commit))
+			if len(result.Commits) == pageSize {
+				// The rest of the batch is looked into again in the next page.
+				queue = append(batch[i+1:], queue...)
+				break
+			}
+		}
+	}
+	if len(queue) > 0 {
+		var hashes []string
+		for _, commit := range queue {
+			hashes = append(hashes, commit.Hash.String())
+		}
+		result.NextCursor = strings.Join(hashes, ",")
+	}
+	return result, h.debugInfo, nil
+}
+
+func parseFileHistoryCursor(cursor string) ([]plumbing.Hash, error) {
+	var ret []plumbing.Hash
+	for _, s := range strings.Split(cursor, ",") {
+		if !plumbing.IsHash(s) {
+			return nil, fmt.Errorf("invalid cursor %q", cursor)
+		}
+		ret = append(ret, plumbing.NewHash(s))
+	}
+	return ret, nil
+}
+
+type fileHistoryWalk struct {
+	historyFetcher
+	segments [][]string
+
+	// entries are the hashes of the entries at the paths in the root trees. ZeroHash if the
+	// path doesn't exist.
+	entries map[plumbing.Hash][]plumbing.Hash
+}
+
+// resolveEntries finds the entries at the paths in the root trees. The trees on the way to the
+// paths are fetched level by level, and the trees already fetched are not fetched again.
+func (h *fileHistoryWalk) resolveEntries(rootTrees []plumbing.Hash) error {
+	type cursor struct {
+		root  plumbing.Hash
+		path  int
+		depth int
+		tree  plumbing.Hash
+	}
+	var cursors []*cursor
+	seen := map[plumbing.Hash]bool{}
+	for _, root := range rootTrees {
+		if _, ok := h.entries[root]; ok || seen[root] {
+			continue
+		}
+		seen[root] = true
+		h.entries[root] = make([]plumbing.Hash, len(h.segments))
+		for i := range h.segments {
+			cursors = append(cursors, &cursor{root: root, path: i, tree: root})
+		}
+	}
+	for len(cursors) > 0 {
+		var missing []plumbing.Hash
+		fetching := map[plumbing.Hash]bool{}
+		for _, c := range cursors {
+			if !h.storage.has(plumbing.TreeObject, c.tree) && !fetching[c.tree] {
+				fetching[c.tree] = true
+				missing = append(missing, c.tree)
+			}
+		}
+		if len(missing) > 0 {
+			var err error
+			h.debugInfo, err = fetchTreeOnly(h.repoURL, h.client, h.storage, missing, h.debugInfo)
+			if err != nil {
+				return err
+			}
+		}
+		var next []*cursor
+		for _, c := range cursors {
+			tree, err := object.GetTree(h.storage, c.tree)
+			if err != nil {
+				return fmt.Errorf("cannot find the tree %q: %w", c.tree.String(), err)
+			}
+			entry, err := tree.FindEntry(h.segments[c.path][c.depth])
+			if err != nil {
+				continue
+			}
+			if c.depth == len(h.segments[c.path])-1 {
+				h.entries[c.root][c.path] = entry.Hash
+				continue
+			}
+			if entry.Mode != filemode.Dir {
+				continue
+			}
+			c.depth++
+			c.tree = entry.Hash
+			next = append(next, c)
+		}
+		cursors = next
+	}
+	return nil
+}
+
+// modifies returns true if the commit differs from all of its parents in the paths.
+func (h *fileHistoryWalk) modifies(commit *object.Commit) (bool, error) {
+	entries := h.entries[commit.TreeHash]
+	if len(commit.ParentHashes) == 0 {
+		for _, hash := range entries {
+			if !hash.IsZero() {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	for _, parent := range commit.ParentHashes {
+		parentCommit, err := object.GetCommit(h.storage, parent)
+		if err != nil {
+			return false, fmt.Errorf("cannot find %q in the fetched packfile: %w", parent.String(), err)
+		}
+		same := true
+		for i, hash := range h.entries[parentCommit.TreeHash] {
+			if hash != entries[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/diff"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/mailmap"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// DefaultFileOwnersMaxCommits is the default number of commits that FetchFileOwners looks into.
+const DefaultFileOwnersMaxCommits = 100
+
+type FetchFileOwnersArgs struct {
+	// BaseCommitHash is the commit that the change is made on. The owners are taken from its
+	// history.
+	BaseCommitHash plumbing.Hash
+	// HeadCommitHash is the commit with the change.
+	HeadCommitHash plumbing.Hash
+	// MaxCommits is the number of the commits in the history of BaseCommitHash to look into.
+	// Defaults to DefaultFileOwnersMaxCommits.
+	MaxCommits int
+	// ApplyMailmap makes the authors canonical with the .mailmap file of BaseCommitHash. The
+	// commits of the aliases are counted for the canonical author.
+	ApplyMailmap bool
+}
+
+type FileOwners struct {
+	// Path is the path of the modified file.
+	Path string `json:"path"`
+	// Authors are the distinct authors of the recent commits that modified the file, ordered by
+	// the number of the commits.
+	Authors []*FileAuthor `json:"authors"`
+}
+
+type FileAuthor struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// Commits is the number of the commits by the author that modified the file.
+	Commits int `json:"commits"`
+}
+
+// FetchFileOwners returns the recent authors of each file modified between the two commits. The
+// authors are taken from the commits in the bounded history of the base commit that changed the
+// file relative to their first parent. Only the commits and the trees are fetched, plus the
+// .mailmap file with ApplyMailmap.
+func FetchFileOwners(repoURL string, client *http.Client, args FetchFileOwnersArgs) ([]*FileOwners, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "FetchFileOwners", args, func() ([]*FileOwners, debug.FetchDebugInfo, error) {
+		return fetchFileOwners(repoURL, client, args)
+	})
+}
+
+func fetchFileOwners(repoURL string, client *http.Client, args FetchFileOwnersArgs) ([]*FileOwners, debug.FetchDebugInfo, error) {
+	maxCommits := args.MaxCommits
+	if maxCommits <= 0 {
+		maxCommits = DefaultFileOwnersMaxCommits
+	}
+	// One more commit is needed to take the diff of the last one.
+	pack, debugInfo, err := fetch.FetchBlobNoneHistoryPackfile(repoURL, client, []plumbing.Hash{args.BaseCommitHash, args.HeadCommitHash}, fetch.FetchOptions{Depth: maxCommits + 1})
+	defer pack.Close()
+	if err != nil {
+		return nil, debugInfo, err
+	}
+
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	defer storage.Close()
+	if err := parseFetchedPackfile(storage, pack, &debugInfo); err != nil {
+		return nil, debugInfo, err
+	}
+
+	baseTree, err := getTreeFromCommit(storage, args.BaseCommitHash)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	headTree, err := getTreeFromCommit(storage, args.HeadCommitHash)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	modified, err := diff.DiffTree(storage, baseTree, headTree)
+	if err != nil {
+		return nil, debugInfo, fmt.Errorf("failed to take file diffs: %w", err)
+	}
+
+	var m *mailmap.Mailmap
+	if args.ApplyMailmap {
+		if m, err = loadMailmap(repoURL, client, storage, baseTree, &debugInfo); err != nil {
+			return nil, debugInfo, err
+		}
+	}
+
+	authorsByPath := map[string]map[string]*FileAuthor{}
+	for pth := range modified {
+		authorsByPath[pth] = map[string]*FileAuthor{}
+	}
+	hash := args.BaseCommitHash
+	for i := 0; i < maxCommits; i++ {
+		commit, err := object.GetCommit(storage, hash)
+		if err != nil {
+			// Reached the shallow boundary.
+			break
+		}
+		var parent *object.Commit
+		if len(commit.ParentHashes) > 0 {
+			parent, err = object.GetCommit(storage, commit.ParentHashes[0])
+			if err != nil {
+				// The parent is beyond the shallow boundary. The changes of this
+				// commit are unknown.
+				break
+			}
+		}
+		tree, err := commit.Tree()
+		if err != nil {
+			return nil, debugInfo, fmt.Errorf("cannot find the tree of %q in the fetched packfile: %w", commit.Hash, err)
+		}
+		var parentTree *object.Tree
+		if parent != nil {
+			parentTree, err = parent.Tree()
+			if err != nil {
+				return nil, debugInfo, fmt.Errorf("cannot find the tree of %q in the fetched packfile: %w", parent.Hash, err)
+			}
+		}
+		for pth, authors := range authorsByPath {
+			if entryHash(tree, pth) == entryHash(parentTree, pth) {
+				continue
+			}
+			name, email := m.Map(commit.Author.Name, commit.Author.Email)
+			author, ok := authors[email]
+			if !ok {
+				author = &FileAuthor{Name: name, Email: email}
+				authors[email] = author
+			}
+			author.Commits++
+		}
+		if parent == nil {
+			break
+		}
+		hash = parent.Hash
+	}
+
+	var ret []*FileOwners
+	for pth, authors := range authorsByPath {
+		owners := &FileOwners{Path: pth, Authors: []*FileAuthor{}}
+		for _, author := range authors {
+			owners.Authors = append(owners.Authors, author)
+		}
+		sort.Slice(owners.Authors, func(i, j int) bool {
+			if owners.Authors[i].Commits != owners.Authors[j].Commits {
+				return owners.Authors[i].Commits > owners.Authors[j].Commits
+			}
+			return owners.Authors[i].Email < owners.Authors[j].Email
+		})
+		ret = append(ret, owners)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Path < ret[j].Path })
+	return ret, debugInfo, nil
+}
+
+// entryHash returns the hash of the file at the path in the tree, or ZeroHash if it doesn't exist.
+func entryHash(tree *object.Tree, pth string) plumbing.Hash {
+	if tree == nil {
+		return plumbing.ZeroHash
+	}
+	entry, err := tree.FindEntry(pth)
+	if err != nil {
+		return plumbing.ZeroHash
+	}
+	return entry.Hash
+}
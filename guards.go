@@ -0,0 +1,140 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/diff"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/lfs"
+	"github.com/aviator-co/niche-git/internal/pathmatch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// diffFromTarget returns the files modified from the old tree to the new tree.
+func diffFromTarget(storage storer.EncodedObjectStorer, oldTree *object.Tree, newTreeHash plumbing.Hash) (map[string]diff.BlobHashes, error) {
+	newTree, err := object.GetTree(storage, newTreeHash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get the merged tree: %w", err)
+	}
+	modified, err := diff.DiffTree(storage, oldTree, newTree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take file diffs: %w", err)
+	}
+	return modified, nil
+}
+
+// sortedPaths returns the paths of the diff in order.
+func sortedPaths(modified map[string]diff.BlobHashes) []string {
+	var ret []string
+	for pth := range modified {
+		ret = append(ret, pth)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// findProtectedFiles returns the files that match the protected path patterns.
+func findProtectedFiles(files []string, protectedPaths []string) []string {
+	var ret []string
+	for _, pth := range files {
+		if pathmatch.MatchAny(protectedPaths, pth) {
+			ret = append(ret, pth)
+		}
+	}
+	return ret
+}
+
+// checkProtectedPaths returns the files that match the protected path patterns among the files
+// modified from the old tree to the new tree, and fails with ProtectedPathError if there are any
+// and allow is false. A nil old tree is the empty tree.
+func checkProtectedPaths(storage storer.EncodedObjectStorer, oldTree *object.Tree, newTreeHash plumbing.Hash, protectedPaths []string, allow bool) ([]string, error) {
+	if len(protectedPaths) == 0 {
+		return nil, nil
+	}
+	if oldTree == nil {
+		oldTree = &object.Tree{}
+	}
+	modified, err := diffFromTarget(storage, oldTree, newTreeHash)
+	if err != nil {
+		return nil, err
+	}
+	protectedFiles := findProtectedFiles(sortedPaths(modified), protectedPaths)
+	if len(protectedFiles) > 0 && !allow {
+		return protectedFiles, &ProtectedPathError{Paths: protectedFiles}
+	}
+	return protectedFiles, nil
+}
+
+// findLFSLockedFiles returns the files that are locked by others with Git LFS file locking.
+func findLFSLockedFiles(repoURL string, client *http.Client, ref plumbing.ReferenceName, files []string) ([]string, error) {
+	locks, err := lfs.VerifyLocks(repoURL, client, ref)
+	if err != nil {
+		return nil, err
+	}
+	locked := map[string]bool{}
+	for _, lock := range locks {
+		locked[lock.Path] = true
+	}
+	var ret []string
+	for _, pth := range files {
+		if locked[pth] {
+			ret = append(ret, pth)
+		}
+	}
+	return ret, nil
+}
+
+// newBlobBytes returns the total size of the blobs on the new side of the diff, i.e. the contents
+// of the files that the change adds or modifies. The sizes of the blobs that are not in the storage
+// are looked up with object-info, or the blobs are fetched if the server doesn't support it.
+func newBlobBytes(repoURL string, client *http.Client, storage *objectStorage, modified map[string]diff.BlobHashes, debugInfo *debug.FetchDebugInfo) (int64, error) {
+	seen := map[plumbing.Hash]bool{}
+	var stored, remote []plumbing.Hash
+	for _, hashes := range modified {
+		hash := hashes.BlobHash2
+		if hash.IsZero() || hashes.Submodule2 || seen[hash] {
+			continue
+		}
+		seen[hash] = true
+		if storage.HasEncodedObject(hash) == nil {
+			stored = append(stored, hash)
+		} else {
+			remote = append(remote, hash)
+		}
+	}
+	var ret int64
+	if len(remote) > 0 {
+		sizes, _, err := fetch.ObjectSizes(repoURL, client, remote)
+		if err == nil {
+			for _, hash := range remote {
+				size, ok := sizes[hash]
+				if !ok {
+					return 0, fmt.Errorf("cannot find the size of %q on the server", hash.String())
+				}
+				ret += size
+			}
+		} else {
+			debugInfo.Warnings = append(debugInfo.Warnings, fmt.Sprintf("cannot look up the blob sizes with object-info, fetching the blobs: %v", err))
+			if err := fetchBlobs(repoURL, client, storage, remote, debugInfo); err != nil {
+				return 0, err
+			}
+			stored = append(stored, remote...)
+		}
+	}
+	for _, hash := range stored {
+		size, err := storage.EncodedObjectSize(hash)
+		if err != nil {
+			return 0, fmt.Errorf("cannot find %q in the storage: %w", hash.String(), err)
+		}
+		ret += size
+	}
+	return ret, nil
+}
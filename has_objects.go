@@ -0,0 +1,81 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+type HasObjectsArgs struct {
+	// Hashes are the objects to look up. They can be any type of objects.
+	Hashes []plumbing.Hash
+}
+
+type HasObjectsResult struct {
+	// Existing are the objects that the remote repository has, in the order of Hashes.
+	Existing []plumbing.Hash
+	// Missing are the objects that the remote repository doesn't have, in the order of Hashes.
+	Missing []plumbing.Hash
+}
+
+// HasObjects reports which of the objects exist in the remote repository without fetching
+// their contents, e.g. to decide whether a push needs to be retried or whether a cached result
+// is still valid.
+//
+// The objects are looked up with the object-info command. If the server doesn't support it, they
+// are probed with minimal fetches instead, which is recorded in the warnings of the debug info.
+// The probes find only the objects that the server serves, so an object that is not reachable
+// from the refs can be reported as missing on the servers that don't allow it in a want.
+func HasObjects(repoURL string, client *http.Client, args HasObjectsArgs) (*HasObjectsResult, debug.FetchDebugInfo, error) {
+	var hashes []plumbing.Hash
+	seen := map[plumbing.Hash]bool{}
+	for _, hash := range args.Hashes {
+		if !seen[hash] {
+			seen[hash] = true
+			hashes = append(hashes, hash)
+		}
+	}
+	var debugInfo debug.FetchDebugInfo
+	if len(hashes) == 0 {
+		return &HasObjectsResult{}, debugInfo, nil
+	}
+
+	exists := map[plumbing.Hash]bool{}
+	sizes, headers, err := fetch.ObjectSizes(repoURL, client, hashes)
+	debugInfo.ResponseHeaders = headers
+	if err == nil {
+		for hash := range sizes {
+			exists[hash] = true
+		}
+	} else {
+		debugInfo.Warnings = append(debugInfo.Warnings, fmt.Sprintf("cannot look up the objects with object-info, probing them with fetches: %v", err))
+		missing, probeDebugInfo, err := fetch.MissingObjects(repoURL, client, hashes)
+		probeDebugInfo.Warnings = append(debugInfo.Warnings, probeDebugInfo.Warnings...)
+		debugInfo = probeDebugInfo
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		for _, hash := range hashes {
+			exists[hash] = true
+		}
+		for _, hash := range missing {
+			exists[hash] = false
+		}
+	}
+
+	result := &HasObjectsResult{}
+	for _, hash := range hashes {
+		if exists[hash] {
+			result.Existing = append(result.Existing, hash)
+		} else {
+			result.Missing = append(result.Missing, hash)
+		}
+	}
+	return result, debugInfo, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/gitignore"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+type CheckIgnoredArgs struct {
+	CommitHash plumbing.Hash
+	// Paths are the paths to check. They don't need to exist in the commit. A path with a
+	// trailing slash is checked as a directory.
+	Paths []string
+}
+
+type PathIgnored struct {
+	Path    string `json:"path"`
+	Ignored bool   `json:"ignored"`
+	// Tracked is true if the path exists in the commit. Git doesn't apply the ignore rules to
+	// the tracked files, so a tracked and ignored path is usually committed by mistake.
+	Tracked bool `json:"tracked"`
+	// Source, LineNumber, and Pattern are the .gitignore line that decided the result, same as
+	// git check-ignore --verbose. They are empty if no line matches.
+	Source     string `json:"source,omitempty"`
+	LineNumber int    `json:"lineNumber,omitempty"`
+	Pattern    string `json:"pattern,omitempty"`
+}
+
+// CheckIgnored evaluates the .gitignore files of the commit for the paths, like git check-ignore
+// --no-index. Only the trees and the .gitignore files are fetched. The exclude files outside of
+// the tree, such as .git/info/exclude and core.excludesFile, are not considered.
+func CheckIgnored(repoURL string, client *http.Client, args CheckIgnoredArgs) ([]*PathIgnored, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "CheckIgnored", args, func() ([]*PathIgnored, debug.FetchDebugInfo, error) {
+		return checkIgnored(repoURL, client, args)
+	})
+}
+
+func checkIgnored(repoURL string, client *http.Client, args CheckIgnoredArgs) ([]*PathIgnored, debug.FetchDebugInfo, error) {
+	var debugInfo debug.FetchDebugInfo
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	defer storage.Close()
+	if err := fetchBlobNone(repoURL, client, storage, []plumbing.Hash{args.CommitHash}, &debugInfo); err != nil {
+		return nil, debugInfo, err
+	}
+	tree, err := getTreeFromCommit(storage, args.CommitHash)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+
+	files, err := gitignore.Files(storage, tree, args.Paths)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	var blobHashes []plumbing.Hash
+	for _, hash := range files {
+		blobHashes = append(blobHashes, hash)
+	}
+	if len(blobHashes) > 0 {
+		blobPack, blobDebugInfo, err := fetch.FetchFullPackfile(repoURL, client, blobHashes, nil)
+		defer blobPack.Close()
+		debugInfo.PackfileSize += blobDebugInfo.PackfileSize
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		if err := parseFetchedPackfile(storage, blobPack, &debugInfo); err != nil {
+			return nil, debugInfo, err
+		}
+	}
+
+	matcher, err := gitignore.NewMatcher(storage, files)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	var ret []*PathIgnored
+	for _, pth := range args.Paths {
+		isDir := strings.HasSuffix(pth, "/")
+		tracked := false
+		if entry, err := tree.FindEntry(strings.TrimSuffix(pth, "/")); err == nil {
+			tracked = true
+			isDir = !entry.Mode.IsFile()
+		}
+		ignored, rule := matcher.Match(pth, isDir)
+		result := &PathIgnored{Path: pth, Ignored: ignored, Tracked: tracked}
+		if rule != nil {
+			result.Source = rule.Source
+			result.LineNumber = rule.LineNumber
+			result.Pattern = rule.Pattern
+		}
+		ret = append(ret, result)
+	}
+	return ret, debugInfo, nil
+}
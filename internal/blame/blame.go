@@ -0,0 +1,168 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Package blame attributes the lines of a file to the commits that introduced them.
+package blame
+
+import (
+	"fmt"
+
+	"github.com/aviator-co/niche-git/internal/diff"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// Commit is a commit in the history of the file.
+type Commit struct {
+	Hash plumbing.Hash
+	// Parents are the parents that have the file. The others are omitted.
+	Parents []plumbing.Hash
+	// Blob is the blob hash of the file in the commit.
+	Blob plumbing.Hash
+	// Boundary is true if the parents of the commit are not known, e.g. beyond the fetched
+	// history. The lines are not passed beyond it.
+	Boundary bool
+}
+
+// Line is the attribution of a line.
+type Line struct {
+	// Commit is the commit that introduced the line.
+	Commit plumbing.Hash
+	// OriginalLineNumber is the 0-based line number in the file of Commit.
+	OriginalLineNumber int
+	// Boundary is true if Commit is a boundary commit, i.e. the line might be older.
+	Boundary bool
+}
+
+// lineRef is a line of the final file that is still to be attributed, with its line number in
+// the commit that the line is passed to.
+type lineRef struct {
+	final int
+	line  int
+}
+
+// Blame attributes the lines of the file in the start commit. commits needs to have the start
+// commit and its ancestors that have the file, and blobs the contents of their files.
+//
+// Same as git, the lines are passed from a commit to the parent that has the same file if any.
+// Otherwise, the lines matched in the diff with each parent in order are passed to the parent,
+// and the rest are attributed to the commit.
+func Blame(commits map[plumbing.Hash]*Commit, blobs map[plumbing.Hash][]byte, start plumbing.Hash) ([]*Line, error) {
+	order, err := topoOrder(commits, start)
+	if err != nil {
+		return nil, err
+	}
+	linesOf := map[plumbing.Hash][]string{}
+	getLines := func(blob plumbing.Hash) ([]string, error) {
+		if lines, ok := linesOf[blob]; ok {
+			return lines, nil
+		}
+		content, ok := blobs[blob]
+		if !ok {
+			return nil, fmt.Errorf("the blob %q is missing", blob.String())
+		}
+		lines := diff.SplitLines(content)
+		linesOf[blob] = lines
+		return lines, nil
+	}
+
+	startLines, err := getLines(commits[start].Blob)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Line, len(startLines))
+	pending := map[plumbing.Hash][]lineRef{}
+	for i := range startLines {
+		pending[start] = append(pending[start], lineRef{final: i, line: i})
+	}
+	for _, hash := range order {
+		refs := pending[hash]
+		delete(pending, hash)
+		if len(refs) == 0 {
+			continue
+		}
+		commit := commits[hash]
+		if !commit.Boundary {
+			refs, err = passToParents(commits, commit, refs, pending, getLines)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, ref := range refs {
+			ret[ref.final] = &Line{Commit: hash, OriginalLineNumber: ref.line, Boundary: commit.Boundary}
+		}
+	}
+	return ret, nil
+}
+
+// passToParents passes the lines of the commit that exist in the parents to them, and returns
+// the rest.
+func passToParents(commits map[plumbing.Hash]*Commit, commit *Commit, refs []lineRef, pending map[plumbing.Hash][]lineRef, getLines func(plumbing.Hash) ([]string, error)) ([]lineRef, error) {
+	for _, parent := range commit.Parents {
+		if commits[parent].Blob == commit.Blob {
+			pending[parent] = append(pending[parent], refs...)
+			return nil, nil
+		}
+	}
+	lines, err := getLines(commit.Blob)
+	if err != nil {
+		return nil, err
+	}
+	for _, parent := range commit.Parents {
+		if len(refs) == 0 {
+			break
+		}
+		parentLines, err := getLines(commits[parent].Blob)
+		if err != nil {
+			return nil, err
+		}
+		matches := diff.MatchLines(lines, parentLines)
+		var rest []lineRef
+		for _, ref := range refs {
+			if m := matches[ref.line]; m >= 0 {
+				pending[parent] = append(pending[parent], lineRef{final: ref.final, line: m})
+			} else {
+				rest = append(rest, ref)
+			}
+		}
+		refs = rest
+	}
+	return refs, nil
+}
+
+// topoOrder returns the commits reachable from the start commit, each after all of its
+// children.
+func topoOrder(commits map[plumbing.Hash]*Commit, start plumbing.Hash) ([]plumbing.Hash, error) {
+	children := map[plumbing.Hash]int{}
+	visited := map[plumbing.Hash]bool{}
+	stack := []plumbing.Hash{start}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[hash] {
+			continue
+		}
+		visited[hash] = true
+		commit, ok := commits[hash]
+		if !ok {
+			return nil, fmt.Errorf("the commit %q is missing", hash.String())
+		}
+		for _, parent := range commit.Parents {
+			children[parent]++
+			stack = append(stack, parent)
+		}
+	}
+	var ret []plumbing.Hash
+	ready := []plumbing.Hash{start}
+	for len(ready) > 0 {
+		hash := ready[0]
+		ready = ready[1:]
+		ret = append(ret, hash)
+		for _, parent := range commits[hash].Parents {
+			children[parent]--
+			if children[parent] == 0 {
+				ready = append(ready, parent)
+			}
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package blame
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func hashOf(s string) plumbing.Hash {
+	return plumbing.ComputeHash(plumbing.BlobObject, []byte(s))
+}
+
+func TestBlame(t *testing.T) {
+	a, b, c, m := hashOf("a"), hashOf("b"), hashOf("c"), hashOf("m")
+	blobs := map[plumbing.Hash][]byte{
+		hashOf("1"): []byte("1\n2\n3\n"),
+		hashOf("2"): []byte("1\nb\n3\n"),
+		hashOf("3"): []byte("1\n2\n3\nc\n"),
+		hashOf("4"): []byte("1\nb\n3\nc\nm\n"),
+	}
+	commits := map[plumbing.Hash]*Commit{
+		a: {Hash: a, Blob: hashOf("1")},
+		b: {Hash: b, Blob: hashOf("2"), Parents: []plumbing.Hash{a}},
+		c: {Hash: c, Blob: hashOf("3"), Parents: []plumbing.Hash{a}},
+		m: {Hash: m, Blob: hashOf("4"), Parents: []plumbing.Hash{b, c}},
+	}
+	lines, err := Blame(commits, blobs, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Line{
+		{Commit: a, OriginalLineNumber: 0},
+		{Commit: b, OriginalLineNumber: 1},
+		{Commit: a, OriginalLineNumber: 2},
+		{Commit: c, OriginalLineNumber: 3},
+		{Commit: m, OriginalLineNumber: 4},
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(want))
+	}
+	for i, line := range lines {
+		if *line != want[i] {
+			t.Errorf("line %d: got %+v, want %+v", i, *line, want[i])
+		}
+	}
+}
+
+func TestBlame_Boundary(t *testing.T) {
+	a, b := hashOf("a"), hashOf("b")
+	blobs := map[plumbing.Hash][]byte{
+		hashOf("1"): []byte("1\n2\n"),
+		hashOf("2"): []byte("1\n2\n3\n"),
+	}
+	commits := map[plumbing.Hash]*Commit{
+		a: {Hash: a, Blob: hashOf("1"), Boundary: true},
+		b: {Hash: b, Blob: hashOf("2"), Parents: []plumbing.Hash{a}},
+	}
+	lines, err := Blame(commits, blobs, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Line{
+		{Commit: a, OriginalLineNumber: 0, Boundary: true},
+		{Commit: a, OriginalLineNumber: 1, Boundary: true},
+		{Commit: b, OriginalLineNumber: 2},
+	}
+	for i, line := range lines {
+		if *line != want[i] {
+			t.Errorf("line %d: got %+v, want %+v", i, *line, want[i])
+		}
+	}
+}
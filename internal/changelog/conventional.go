@@ -0,0 +1,71 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Package changelog parses commit messages for changelogs.
+package changelog
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/aviator-co/niche-git/internal/trailer"
+)
+
+// Commit is a commit message parsed as a conventional commit. See
+// https://www.conventionalcommits.org/.
+type Commit struct {
+	// Type is the lower-cased type (e.g. "feat"). Empty if the subject is not a conventional
+	// commit.
+	Type string
+	// Scope is the optional scope in the parentheses.
+	Scope string
+	// Subject is the description after the type, or the whole subject line if the subject is
+	// not a conventional commit.
+	Subject string
+	// Breaking is true if the commit has "!" after the type or a BREAKING CHANGE trailer.
+	Breaking bool
+	// References are the PR and issue references (e.g. "#123" or "owner/repo#123") in the
+	// subject and the trailers, in the order of appearance.
+	References []string
+}
+
+var (
+	conventionalRE = regexp.MustCompile(`^([A-Za-z]+)(?:\(([^()]*)\))?(!)?:\s+(.*)$`)
+	referenceRE    = regexp.MustCompile(`(?:[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+)?#[0-9]+`)
+)
+
+// Parse parses the commit message.
+func Parse(message string) Commit {
+	subject, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	subject = strings.TrimSpace(subject)
+	c := Commit{Subject: subject}
+	if m := conventionalRE.FindStringSubmatch(subject); m != nil {
+		c.Type = strings.ToLower(m[1])
+		c.Scope = m[2]
+		c.Breaking = m[3] != ""
+		c.Subject = m[4]
+	}
+
+	seen := map[string]bool{}
+	addReferences := func(s string) {
+		for _, ref := range referenceRE.FindAllString(s, -1) {
+			if !seen[ref] {
+				seen[ref] = true
+				c.References = append(c.References, ref)
+			}
+		}
+	}
+	addReferences(subject)
+	for _, t := range trailer.Parse(message) {
+		if strings.EqualFold(t.Key, "BREAKING-CHANGE") {
+			c.Breaking = true
+			continue
+		}
+		addReferences(t.Value)
+	}
+	// "BREAKING CHANGE" has a space, which the trailer syntax doesn't allow.
+	if strings.Contains(message, "\nBREAKING CHANGE:") {
+		c.Breaking = true
+	}
+	return c
+}
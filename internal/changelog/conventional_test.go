@@ -0,0 +1,39 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		message string
+		want    Commit
+	}{
+		{
+			message: "feat(api): add the endpoint (#12)\n",
+			want:    Commit{Type: "feat", Scope: "api", Subject: "add the endpoint (#12)", References: []string{"#12"}},
+		},
+		{
+			message: "Fix!: drop the option\n\nBody with #99.\n\nFixes: aviator-co/niche-git#3\nRefs: #4, #3\n",
+			want:    Commit{Type: "fix", Subject: "drop the option", Breaking: true, References: []string{"aviator-co/niche-git#3", "#4", "#3"}},
+		},
+		{
+			message: "refactor: move the code\n\nBREAKING CHANGE: the API is changed\n",
+			want:    Commit{Type: "refactor", Subject: "move the code", Breaking: true},
+		},
+		{
+			message: "Update the README",
+			want:    Commit{Subject: "Update the README"},
+		},
+	}
+	for _, tt := range tests {
+		if diff := cmp.Diff(tt.want, Parse(tt.message)); diff != "" {
+			t.Errorf("Parse(%q) diff (-want +got):\n%s", tt.message, diff)
+		}
+	}
+}
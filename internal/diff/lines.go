@@ -0,0 +1,106 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import "strings"
+
+// SplitLines splits the content into lines, keeping the newlines.
+func SplitLines(content []byte) []string {
+	var ret []string
+	s := string(content)
+	for s != "" {
+		i := strings.IndexByte(s, '\n')
+		if i < 0 {
+			ret = append(ret, s)
+			break
+		}
+		ret = append(ret, s[:i+1])
+		s = s[i+1:]
+	}
+	return ret
+}
+
+// MatchLines returns the index of the matching line of b for each line of a in their longest
+// common subsequence, or -1 if the line is not in it.
+func MatchLines(a, b []string) []int {
+	ret := make([]int, len(a))
+	for i := range ret {
+		ret[i] = -1
+	}
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		ret[prefix] = prefix
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		ret[len(a)-1-suffix] = len(b) - 1 - suffix
+		suffix++
+	}
+	for _, m := range myersMatches(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]) {
+		ret[prefix+m[0]] = prefix + m[1]
+	}
+	return ret
+}
+
+// myersMatches returns the pairs of the matching line indices with the Myers diff algorithm.
+func myersMatches(a, b []string) [][2]int {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return nil
+	}
+	maxD := n + m
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+	// trace[d] is v[-d-1:d+2] before the step d.
+	var trace [][]int
+	var d, k int
+search:
+	for d = 0; d <= maxD; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k = -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	var ret [][2]int
+	x, y := n, m
+	for ; d >= 0; d-- {
+		tv := trace[d]
+		at := func(k int) int { return tv[k+d+1] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ret = append(ret, [2]int{x, y})
+		}
+		x, y = prevX, prevY
+	}
+	for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+		ret[i], ret[j] = ret[j], ret[i]
+	}
+	return ret
+}
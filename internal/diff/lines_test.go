@@ -0,0 +1,55 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMatchLines_LCS(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	randomLines := func() []string {
+		ret := make([]string, rnd.Intn(20))
+		for i := range ret {
+			ret[i] = string(rune('a' + rnd.Intn(4)))
+		}
+		return ret
+	}
+	for i := 0; i < 500; i++ {
+		a, b := randomLines(), randomLines()
+		matches := MatchLines(a, b)
+		count, last := 0, -1
+		for i, j := range matches {
+			if j < 0 {
+				continue
+			}
+			if j <= last || a[i] != b[j] {
+				t.Fatalf("invalid match %d->%d for %q and %q", i, j, a, b)
+			}
+			last = j
+			count++
+		}
+		if want := lcsLength(a, b); count != want {
+			t.Fatalf("matched %d lines of %q and %q, want %d", count, a, b, want)
+		}
+	}
+}
+
+func lcsLength(a, b []string) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else {
+				dp[i][j] = max(dp[i+1][j], dp[i][j+1])
+			}
+		}
+	}
+	return dp[0][0]
+}
@@ -0,0 +1,170 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// DefaultRenameThreshold is the similarity in percent that a deleted file and an added file
+// need to be a rename, same as git's default.
+const DefaultRenameThreshold = 50
+
+// MaxRenamePairs is the number of the deleted and added file pairs that DetectRenames compares
+// the contents of. Beyond that, only the exact renames are detected, like git's rename limit.
+const MaxRenamePairs = 1000 * 1000
+
+// Rename is a file deleted from the first tree and added to the second tree with a similar
+// content.
+type Rename struct {
+	OldPath string
+	NewPath string
+	// Similarity is the percentage of the content kept. 100 for an exact rename.
+	Similarity int
+}
+
+// RenameCandidates returns the blobs that DetectRenames needs to compare the contents. The blobs
+// of the exact renames are not included as they are found by the hashes.
+func RenameCandidates(modified map[string]BlobHashes) []plumbing.Hash {
+	deleted, added := map[plumbing.Hash]bool{}, map[plumbing.Hash]bool{}
+	for _, hashes := range modified {
+		if hashes.IsSubmodule() {
+			continue
+		}
+		if hashes.BlobHash2.IsZero() {
+			deleted[hashes.BlobHash1] = true
+		} else if hashes.BlobHash1.IsZero() {
+			added[hashes.BlobHash2] = true
+		}
+	}
+	var ret []plumbing.Hash
+	for hash := range deleted {
+		if !added[hash] {
+			ret = append(ret, hash)
+		}
+	}
+	for hash := range added {
+		if !deleted[hash] {
+			ret = append(ret, hash)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].String() < ret[j].String() })
+	return ret
+}
+
+// DetectRenames pairs the deleted files and the added files of the diff whose contents are at
+// least threshold percent similar. The exact renames are found by the hashes, and the other pairs
+// compare the contents that read returns. If read is nil, only the exact renames are detected.
+// The renames are sorted by the new paths.
+func DetectRenames(modified map[string]BlobHashes, threshold int, read func(plumbing.Hash) ([]byte, error)) ([]Rename, error) {
+	var deleted, added []string
+	for pth, hashes := range modified {
+		if hashes.IsSubmodule() {
+			// The gitlinks are not renamed by their commits.
+			continue
+		}
+		if hashes.BlobHash2.IsZero() {
+			deleted = append(deleted, pth)
+		} else if hashes.BlobHash1.IsZero() {
+			added = append(added, pth)
+		}
+	}
+	sort.Strings(deleted)
+	sort.Strings(added)
+
+	var ret []Rename
+	deletedByHash := map[plumbing.Hash][]string{}
+	for _, pth := range deleted {
+		hash := modified[pth].BlobHash1
+		deletedByHash[hash] = append(deletedByHash[hash], pth)
+	}
+	renamed := map[string]bool{}
+	var restAdded []string
+	for _, pth := range added {
+		hash := modified[pth].BlobHash2
+		if candidates := deletedByHash[hash]; len(candidates) > 0 {
+			ret = append(ret, Rename{OldPath: candidates[0], NewPath: pth, Similarity: 100})
+			renamed[candidates[0]] = true
+			deletedByHash[hash] = candidates[1:]
+			continue
+		}
+		restAdded = append(restAdded, pth)
+	}
+	var restDeleted []string
+	for _, pth := range deleted {
+		if !renamed[pth] {
+			restDeleted = append(restDeleted, pth)
+		}
+	}
+
+	if read != nil && len(restDeleted) > 0 && len(restAdded) > 0 && len(restDeleted)*len(restAdded) <= MaxRenamePairs {
+		contents := map[plumbing.Hash][]byte{}
+		readCached := func(hash plumbing.Hash) ([]byte, error) {
+			if content, ok := contents[hash]; ok {
+				return content, nil
+			}
+			content, err := read(hash)
+			if err != nil {
+				return nil, err
+			}
+			contents[hash] = content
+			return content, nil
+		}
+		var pairs []Rename
+		for _, oldPath := range restDeleted {
+			oldContent, err := readCached(modified[oldPath].BlobHash1)
+			if err != nil {
+				return nil, err
+			}
+			for _, newPath := range restAdded {
+				newContent, err := readCached(modified[newPath].BlobHash2)
+				if err != nil {
+					return nil, err
+				}
+				if score := Similarity(oldContent, newContent); score >= threshold {
+					pairs = append(pairs, Rename{OldPath: oldPath, NewPath: newPath, Similarity: score})
+				}
+			}
+		}
+		// The most similar pairs take the files first.
+		sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].Similarity > pairs[j].Similarity })
+		used := map[string]bool{}
+		for _, pair := range pairs {
+			if used[pair.OldPath] || used[pair.NewPath] {
+				continue
+			}
+			used[pair.OldPath] = true
+			used[pair.NewPath] = true
+			ret = append(ret, pair)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].NewPath < ret[j].NewPath })
+	return ret, nil
+}
+
+// Similarity returns the percentage of the content kept between the two versions. Like git's
+// rename score, this is the size of the lines of a that are also in b over the larger size.
+func Similarity(a, b []byte) int {
+	size := len(a)
+	if len(b) > size {
+		size = len(b)
+	}
+	if size == 0 {
+		return 100
+	}
+	counts := map[string]int{}
+	for _, line := range SplitLines(a) {
+		counts[line]++
+	}
+	common := 0
+	for _, line := range SplitLines(b) {
+		if counts[line] > 0 {
+			counts[line]--
+			common += len(line)
+		}
+	}
+	return common * 100 / size
+}
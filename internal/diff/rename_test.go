@@ -0,0 +1,86 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDetectRenames(t *testing.T) {
+	contents := map[plumbing.Hash][]byte{}
+	blob := func(content string) plumbing.Hash {
+		hash := plumbing.ComputeHash(plumbing.BlobObject, []byte(content))
+		contents[hash] = []byte(content)
+		return hash
+	}
+	modified := map[string]BlobHashes{
+		// Exact rename.
+		"a.txt": {BlobHash1: blob("a\n")},
+		"b.txt": {BlobHash2: blob("a\n")},
+		// Similar rename. 3 of the 4 lines are kept.
+		"c.txt": {BlobHash1: blob("1\n2\n3\n4\n")},
+		"d.txt": {BlobHash2: blob("1\n2\n3\n5\n")},
+		// Not similar.
+		"e.txt": {BlobHash1: blob("x\ny\n")},
+		"f.txt": {BlobHash2: blob("z\nw\n")},
+		// Modified.
+		"g.txt": {BlobHash1: blob("g\n"), BlobHash2: blob("G\n")},
+		// Submodules at the same commit are not renames.
+		"sub1": {BlobHash1: plumbing.ComputeHash(plumbing.CommitObject, nil), Submodule1: true},
+		"sub2": {BlobHash2: plumbing.ComputeHash(plumbing.CommitObject, nil), Submodule2: true},
+	}
+	read := func(hash plumbing.Hash) ([]byte, error) {
+		content, ok := contents[hash]
+		if !ok {
+			return nil, fmt.Errorf("unexpected read of %s", hash)
+		}
+		return content, nil
+	}
+
+	got, err := DetectRenames(modified, 50, read)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Rename{
+		{OldPath: "a.txt", NewPath: "b.txt", Similarity: 100},
+		{OldPath: "c.txt", NewPath: "d.txt", Similarity: 75},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("DetectRenames diff (-want +got):\n%s", diff)
+	}
+
+	// Without the contents, only the exact renames are detected.
+	got, err = DetectRenames(modified, 50, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want[:1], got); diff != "" {
+		t.Errorf("DetectRenames diff (-want +got):\n%s", diff)
+	}
+
+	if got := len(RenameCandidates(modified)); got != 4 {
+		t.Errorf("RenameCandidates returned %d blobs, want 4", got)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 100},
+		{"a\n", "", 0},
+		{"a\nb\n", "a\nb\n", 100},
+		{"a\nb\n", "a\nc\n", 50},
+		{"a\nb\n", "a\nb\nc\nd\n", 50},
+	} {
+		if got := Similarity([]byte(tc.a), []byte(tc.b)); got != tc.want {
+			t.Errorf("Similarity(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
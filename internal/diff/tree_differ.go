@@ -5,8 +5,11 @@ package diff
 
 import (
 	"path"
+	"runtime"
+	"sync"
 
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/storer"
 )
@@ -15,23 +18,125 @@ import (
 type BlobHashes struct {
 	BlobHash1 plumbing.Hash
 	BlobHash2 plumbing.Hash
+	// Submodule1 and Submodule2 are true if the path is a submodule on the side. The hash is the
+	// commit that the gitlink points to rather than a blob.
+	Submodule1 bool
+	Submodule2 bool
 }
 
-// DiffTree returns the diff of two trees.
+// IsSubmodule returns true if the path is a submodule on either side.
+func (h BlobHashes) IsSubmodule() bool {
+	return h.Submodule1 || h.Submodule2
+}
+
+// leafHashes returns the BlobHashes of the entries that are not directories. A nil entry is
+// the side where the path doesn't exist.
+func leafHashes(entry1, entry2 *object.TreeEntry) BlobHashes {
+	var ret BlobHashes
+	if entry1 != nil {
+		ret.BlobHash1 = entry1.Hash
+		ret.Submodule1 = entry1.Mode == filemode.Submodule
+	}
+	if entry2 != nil {
+		ret.BlobHash2 = entry2.Hash
+		ret.Submodule2 = entry2.Mode == filemode.Submodule
+	}
+	return ret
+}
+
+// isLeaf returns true if the entry is not a directory. A submodule is a leaf, as its commit is
+// in another repository.
+func isLeaf(entry *object.TreeEntry) bool {
+	return entry.Mode != filemode.Dir
+}
+
+// DiffTree returns the diff of two trees. The subtrees are compared in parallel up to
+// GOMAXPROCS. The storage needs to be safe for concurrent reads.
+//
+// A submodule is compared by the commit that the gitlink points to, and reported with
+// Submodule1 or Submodule2 set instead of being walked into.
 func DiffTree(storage storer.EncodedObjectStorer, tree1, tree2 *object.Tree) (map[string]BlobHashes, error) {
+	return diffTree(storage, tree1, tree2, runtime.GOMAXPROCS(0))
+}
+
+// diffTree returns the diff of two trees, comparing the subtrees with up to the number of the
+// workers.
+func diffTree(storage storer.EncodedObjectStorer, tree1, tree2 *object.Tree, workers int) (map[string]BlobHashes, error) {
 	td := &treeDiffer{
 		storage:  storage,
 		modified: map[string]BlobHashes{},
+		// The calling goroutine is one of the workers.
+		slots: make(chan struct{}, max(workers-1, 0)),
 	}
-	if err := td.Diff("", tree1, tree2); err != nil {
-		return nil, err
+	td.setErr(td.Diff("", tree1, tree2))
+	td.wg.Wait()
+	if td.err != nil {
+		return nil, td.err
 	}
 	return td.modified, nil
 }
 
 type treeDiffer struct {
-	storage  storer.EncodedObjectStorer
+	storage storer.EncodedObjectStorer
+	// slots bounds the goroutines comparing the subtrees.
+	slots chan struct{}
+	wg    sync.WaitGroup
+
+	mu       sync.Mutex
 	modified map[string]BlobHashes
+	err      error
+}
+
+func (td *treeDiffer) add(pth string, hashes BlobHashes) {
+	td.mu.Lock()
+	defer td.mu.Unlock()
+	td.modified[pth] = hashes
+}
+
+func (td *treeDiffer) setErr(err error) {
+	td.mu.Lock()
+	defer td.mu.Unlock()
+	if td.err == nil {
+		td.err = err
+	}
+}
+
+func (td *treeDiffer) failed() bool {
+	td.mu.Lock()
+	defer td.mu.Unlock()
+	return td.err != nil
+}
+
+// diffSubtrees compares the subtrees in a new goroutine if a slot is available, or in the
+// calling goroutine otherwise.
+func (td *treeDiffer) diffSubtrees(pth string, hash1, hash2 plumbing.Hash) error {
+	select {
+	case td.slots <- struct{}{}:
+		td.wg.Add(1)
+		go func() {
+			defer td.wg.Done()
+			defer func() { <-td.slots }()
+			td.setErr(td.diffHashes(pth, hash1, hash2))
+		}()
+		return nil
+	default:
+		return td.diffHashes(pth, hash1, hash2)
+	}
+}
+
+func (td *treeDiffer) diffHashes(pth string, hash1, hash2 plumbing.Hash) error {
+	if td.failed() {
+		return nil
+	}
+	subtree1, err := object.GetTree(td.storage, hash1)
+	if err != nil {
+		return err
+	}
+	subtree2, err := object.GetTree(td.storage, hash2)
+	if err != nil {
+		return err
+	}
+	return td.Diff(pth, subtree1, subtree2)
 }
 
 func (td *treeDiffer) Diff(pth string, tree1, tree2 *object.Tree) error {
@@ -63,31 +168,23 @@ func (td *treeDiffer) Diff(pth string, tree1, tree2 *object.Tree) error {
 			// contents are the same.
 			continue
 		}
-		if entry1.Mode.IsFile() && entry2.Mode.IsFile() {
-			// Simply the files are different.
-			td.modified[path.Join(pth, name)] = BlobHashes{entry1.Hash, entry2.Hash}
+		if isLeaf(entry1) && isLeaf(entry2) {
+			// Simply the files are different, or the submodule points to another commit.
+			td.add(path.Join(pth, name), leafHashes(entry1, entry2))
 			continue
 		}
-		if !entry1.Mode.IsFile() && entry2.Mode.IsFile() {
-			td.modified[path.Join(pth, name)] = BlobHashes{plumbing.ZeroHash, entry2.Hash}
+		if !isLeaf(entry1) && isLeaf(entry2) {
+			td.add(path.Join(pth, name), leafHashes(nil, entry2))
 			td.handleExistOnlyInOneSide(pth, entry1, true)
 			continue
 		}
-		if entry1.Mode.IsFile() && !entry2.Mode.IsFile() {
-			td.modified[path.Join(pth, name)] = BlobHashes{entry1.Hash, plumbing.ZeroHash}
+		if isLeaf(entry1) && !isLeaf(entry2) {
+			td.add(path.Join(pth, name), leafHashes(entry1, nil))
 			td.handleExistOnlyInOneSide(pth, entry2, false)
 			continue
 		}
 		// Both are directories.
-		subtree1, err := object.GetTree(td.storage, entry1.Hash)
-		if err != nil {
-			return err
-		}
-		subtree2, err := object.GetTree(td.storage, entry2.Hash)
-		if err != nil {
-			return err
-		}
-		if err := td.Diff(path.Join(pth, name), subtree1, subtree2); err != nil {
+		if err := td.diffSubtrees(path.Join(pth, name), entry1.Hash, entry2.Hash); err != nil {
 			return err
 		}
 	}
@@ -95,11 +192,11 @@ func (td *treeDiffer) Diff(pth string, tree1, tree2 *object.Tree) error {
 }
 
 func (td *treeDiffer) handleExistOnlyInOneSide(pth string, entry *object.TreeEntry, isTree1 bool) error {
-	if entry.Mode.IsFile() {
+	if isLeaf(entry) {
 		if isTree1 {
-			td.modified[path.Join(pth, entry.Name)] = BlobHashes{entry.Hash, plumbing.ZeroHash}
+			td.add(path.Join(pth, entry.Name), leafHashes(entry, nil))
 		} else {
-			td.modified[path.Join(pth, entry.Name)] = BlobHashes{plumbing.ZeroHash, entry.Hash}
+			td.add(path.Join(pth, entry.Name), leafHashes(nil, entry))
 		}
 		return nil
 	}
@@ -0,0 +1,200 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffTree(t *testing.T) {
+	storage := memory.NewStorage()
+	tree1 := createTree(t, storage, map[string]string{
+		"a.txt":         "a",
+		"dir/b.txt":     "b",
+		"dir/sub/c.txt": "c",
+		"dir/d.txt":     "d",
+		"file-or-dir":   "file",
+		"gone/e.txt":    "e",
+	})
+	tree2 := createTree(t, storage, map[string]string{
+		"a.txt":               "a",
+		"dir/b.txt":           "b2",
+		"dir/sub/c.txt":       "c",
+		"file-or-dir/f.txt":   "f",
+		"new/g.txt":           "g",
+		"new/deeper/h.txt":    "h",
+		"dir/sub/added.txt":   "added",
+		"dir/sub/another.txt": "another",
+	})
+	hash := func(content string) plumbing.Hash {
+		return plumbing.ComputeHash(plumbing.BlobObject, []byte(content))
+	}
+	want := map[string]BlobHashes{
+		"dir/b.txt":           {BlobHash1: hash("b"), BlobHash2: hash("b2")},
+		"dir/d.txt":           {BlobHash1: hash("d")},
+		"dir/sub/added.txt":   {BlobHash2: hash("added")},
+		"dir/sub/another.txt": {BlobHash2: hash("another")},
+		"file-or-dir":         {BlobHash1: hash("file")},
+		"file-or-dir/f.txt":   {BlobHash2: hash("f")},
+		"gone/e.txt":          {BlobHash1: hash("e")},
+		"new/g.txt":           {BlobHash2: hash("g")},
+		"new/deeper/h.txt":    {BlobHash2: hash("h")},
+	}
+	for _, workers := range []int{1, 2, 8} {
+		got, err := diffTree(storage, tree1, tree2, workers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("diffTree with %d workers diff (-want +got):\n%s", workers, diff)
+		}
+	}
+}
+
+func TestDiffTree_MissingSubtree(t *testing.T) {
+	storage := memory.NewStorage()
+	tree1 := createTree(t, storage, map[string]string{"dir/a.txt": "a"})
+	tree2 := &object.Tree{Entries: []object.TreeEntry{
+		{Name: "dir", Mode: filemode.Dir, Hash: plumbing.ComputeHash(plumbing.TreeObject, []byte("missing"))},
+	}}
+	for _, workers := range []int{1, 8} {
+		if _, err := diffTree(storage, tree1, tree2, workers); err == nil {
+			t.Errorf("diffTree with %d workers succeeded, want an error for the missing subtree", workers)
+		}
+	}
+}
+
+func TestDiffTree_Submodules(t *testing.T) {
+	storage := memory.NewStorage()
+	commit := func(s string) plumbing.Hash {
+		// The commits of the submodules are not in the storage.
+		return plumbing.ComputeHash(plumbing.CommitObject, []byte(s))
+	}
+	dir := createTree(t, storage, map[string]string{"a.txt": "a"})
+	file := plumbing.ComputeHash(plumbing.BlobObject, []byte("file"))
+	tree1 := &object.Tree{Entries: []object.TreeEntry{
+		{Name: "dir-to-sub", Mode: filemode.Dir, Hash: dir.Hash},
+		{Name: "file-to-sub", Mode: filemode.Regular, Hash: file},
+		{Name: "moved", Mode: filemode.Submodule, Hash: commit("1")},
+		{Name: "removed", Mode: filemode.Submodule, Hash: commit("1")},
+		{Name: "unchanged", Mode: filemode.Submodule, Hash: commit("1")},
+	}}
+	tree2 := &object.Tree{Entries: []object.TreeEntry{
+		{Name: "added", Mode: filemode.Submodule, Hash: commit("2")},
+		{Name: "dir-to-sub", Mode: filemode.Submodule, Hash: commit("2")},
+		{Name: "file-to-sub", Mode: filemode.Submodule, Hash: commit("2")},
+		{Name: "moved", Mode: filemode.Submodule, Hash: commit("2")},
+		{Name: "unchanged", Mode: filemode.Submodule, Hash: commit("1")},
+	}}
+	want := map[string]BlobHashes{
+		"added":            {BlobHash2: commit("2"), Submodule2: true},
+		"dir-to-sub":       {BlobHash2: commit("2"), Submodule2: true},
+		"dir-to-sub/a.txt": {BlobHash1: plumbing.ComputeHash(plumbing.BlobObject, []byte("a"))},
+		"file-to-sub":      {BlobHash1: file, BlobHash2: commit("2"), Submodule2: true},
+		"moved":            {BlobHash1: commit("1"), BlobHash2: commit("2"), Submodule1: true, Submodule2: true},
+		"removed":          {BlobHash1: commit("1"), Submodule1: true},
+	}
+	got, err := DiffTree(storage, tree1, tree2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("DiffTree diff (-want +got):\n%s", diff)
+	}
+}
+
+// benchmarkTrees returns two wide trees where every file of the dirs differs.
+func benchmarkTrees(b *testing.B) (*memory.Storage, *object.Tree, *object.Tree) {
+	storage := memory.NewStorage()
+	files1, files2 := map[string]string{}, map[string]string{}
+	for i := 0; i < 200; i++ {
+		for j := 0; j < 10; j++ {
+			for k := 0; k < 20; k++ {
+				pth := fmt.Sprintf("dir%03d/sub%02d/file%02d.txt", i, j, k)
+				files1[pth] = pth
+				files2[pth] = pth + " modified"
+			}
+		}
+	}
+	return storage, createTree(b, storage, files1), createTree(b, storage, files2)
+}
+
+func BenchmarkDiffTree_Serial(b *testing.B) {
+	storage, tree1, tree2 := benchmarkTrees(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := diffTree(storage, tree1, tree2, 1); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDiffTree_Parallel(b *testing.B) {
+	storage, tree1, tree2 := benchmarkTrees(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := DiffTree(storage, tree1, tree2); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// createTree stores the files keyed by the slash-separated paths as a tree.
+func createTree(tb testing.TB, storage *memory.Storage, files map[string]string) *object.Tree {
+	tb.Helper()
+	dirs := map[string]map[string]string{}
+	var entries []object.TreeEntry
+	for pth, content := range files {
+		dir, rest, ok := strings.Cut(pth, "/")
+		if ok {
+			if dirs[dir] == nil {
+				dirs[dir] = map[string]string{}
+			}
+			dirs[dir][rest] = content
+			continue
+		}
+		obj := storage.NewEncodedObject()
+		obj.SetType(plumbing.BlobObject)
+		w, err := obj.Writer()
+		if err != nil {
+			tb.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			tb.Fatal(err)
+		}
+		w.Close()
+		hash, err := storage.SetEncodedObject(obj)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		entries = append(entries, object.TreeEntry{Name: pth, Mode: filemode.Regular, Hash: hash})
+	}
+	for dir, subFiles := range dirs {
+		entries = append(entries, object.TreeEntry{Name: dir, Mode: filemode.Dir, Hash: createTree(tb, storage, subFiles).Hash})
+	}
+	sort.Sort(object.TreeEntrySorter(entries))
+	tree := &object.Tree{Entries: entries}
+	obj := storage.NewEncodedObject()
+	if err := tree.Encode(obj); err != nil {
+		tb.Fatal(err)
+	}
+	hash, err := storage.SetEncodedObject(obj)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tree, err = object.GetTree(storage, hash)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return tree
+}
@@ -13,37 +13,85 @@ import (
 )
 
 // FetchBlobNonePackfile fetches a packfile from a remote repository without blobs.
-func FetchBlobNonePackfile(repoURL string, client *http.Client, oids []plumbing.Hash) ([]byte, debug.FetchDebugInfo, error) {
-	return fetchPackfile(repoURL, client, createBlobNoneFetchRequest(oids))
+func FetchBlobNonePackfile(repoURL string, client *http.Client, oids []plumbing.Hash) (*Packfile, debug.FetchDebugInfo, error) {
+	return fetchPackfileWithFallback(repoURL, client, oids, func(wants []string) *bytes.Buffer {
+		return createBlobNoneFetchRequest(client, wants)
+	})
 }
 
-func createBlobNoneFetchRequest(oids []plumbing.Hash) *bytes.Buffer {
-	chunks := []*gitprotocolio.ProtocolV2RequestChunk{
-		{
-			Command: "fetch",
-		},
-		{
-			EndCapability: true,
-		},
+// FetchBlobNoneHistoryPackfile is FetchBlobNonePackfile that fetches the commits and the trees of
+// the history limited by the options.
+func FetchBlobNoneHistoryPackfile(repoURL string, client *http.Client, oids []plumbing.Hash, opts FetchOptions) (*Packfile, debug.FetchDebugInfo, error) {
+	if err := opts.validate(); err != nil {
+		return nil, debug.FetchDebugInfo{}, err
+	}
+	return fetchPackfileWithFallback(repoURL, client, oids, func(wants []string) *bytes.Buffer {
+		return createBlobNoneFetchRequestWithOptions(client, wants, nil, opts, true)
+	})
+}
+
+// NegotiateBlobNonePackfile is FetchBlobNonePackfile that negotiates the haves with the server,
+// so that the commits and the trees reachable from the haves that the server has are not sent.
+// It also returns the acknowledged haves. The packfile is thin: it can have deltas against the
+// trees of the acknowledged haves, so they need to be in the storage before it's parsed.
+func NegotiateBlobNonePackfile(repoURL string, client *http.Client, wantOids, haveOids []plumbing.Hash) (*Packfile, []plumbing.Hash, debug.FetchDebugInfo, error) {
+	if len(haveOids) == 0 {
+		pack, debugInfo, err := FetchBlobNonePackfile(repoURL, client, wantOids)
+		return pack, nil, debugInfo, err
+	}
+	var common []plumbing.Hash
+	pack, debugInfo, err := withWantRefFallback(repoURL, client, wantOids, func(wants []string) (*Packfile, debug.FetchDebugInfo, error) {
+		var pack *Packfile
+		var debugInfo debug.FetchDebugInfo
+		var err error
+		pack, common, debugInfo, err = negotiate(repoURL, client, haveOids, func(haveOids []plumbing.Hash, done bool) *bytes.Buffer {
+			return createBlobNoneFetchRequestWithOptions(client, wants, haveOids, FetchOptions{Depth: 1}, done)
+		})
+		return pack, debugInfo, err
+	})
+	return pack, common, debugInfo, err
+}
+
+func createBlobNoneFetchRequest(client *http.Client, wants []string) *bytes.Buffer {
+	return createBlobNoneFetchRequestWithOptions(client, wants, nil, FetchOptions{Depth: 1}, true)
+}
+
+func createBlobNoneFetchRequestWithOptions(client *http.Client, wants []string, haveOids []plumbing.Hash, opts FetchOptions, done bool) *bytes.Buffer {
+	chunks := commandRequestChunks("fetch")
+	for _, want := range wants {
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte(want),
+		})
+	}
+	for _, oid := range haveOids {
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("have " + oid.String()),
+		})
+	}
+	if len(haveOids) > 0 {
+		// Without a thin pack, the server sends the trees shared with the haves anyway.
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("thin-pack"),
+		})
 	}
-	for _, oid := range oids {
+	chunks = append(chunks, progressChunks(client)...)
+	chunks = append(chunks, packfileURIChunks(client)...)
+	for _, arg := range opts.arguments() {
 		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
-			Argument: []byte("want " + oid.String()),
+			Argument: []byte(arg),
 		})
 	}
 	chunks = append(chunks,
-		&gitprotocolio.ProtocolV2RequestChunk{
-			Argument: []byte("no-progress"),
-		},
-		&gitprotocolio.ProtocolV2RequestChunk{
-			Argument: []byte("deepen 1"),
-		},
 		&gitprotocolio.ProtocolV2RequestChunk{
 			Argument: []byte("filter blob:none"),
 		},
-		&gitprotocolio.ProtocolV2RequestChunk{
+	)
+	if done {
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
 			Argument: []byte("done"),
-		},
+		})
+	}
+	chunks = append(chunks,
 		&gitprotocolio.ProtocolV2RequestChunk{
 			EndArgument: true,
 		},
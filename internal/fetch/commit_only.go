@@ -13,22 +13,50 @@ import (
 )
 
 // FetchCommitOnlyPackfile fetches a packfile from a remote repository with only commit objects.
-func FetchCommitOnlyPackfile(repoURL string, client *http.Client, wantOids, haveOids []plumbing.Hash) ([]byte, debug.FetchDebugInfo, error) {
-	return fetchPackfile(repoURL, client, createCommitOnlyFetchRequest(wantOids, haveOids))
+func FetchCommitOnlyPackfile(repoURL string, client *http.Client, wantOids, haveOids []plumbing.Hash) (*Packfile, debug.FetchDebugInfo, error) {
+	return fetchPackfileWithFallback(repoURL, client, wantOids, func(wants []string) *bytes.Buffer {
+		return createCommitOnlyFetchRequest(client, wants, haveOids, nil, FetchOptions{}, true)
+	})
 }
 
-func createCommitOnlyFetchRequest(wantOids, haveOids []plumbing.Hash) *bytes.Buffer {
-	chunks := []*gitprotocolio.ProtocolV2RequestChunk{
-		{
-			Command: "fetch",
-		},
-		{
-			EndCapability: true,
-		},
+// FetchCommitOnlyHistoryPackfile is FetchCommitOnlyPackfile that fetches only the history limited
+// by the options. shallowOids are the commits whose parents the client doesn't have, so that the
+// server doesn't take the history beyond them as the client's.
+func FetchCommitOnlyHistoryPackfile(repoURL string, client *http.Client, wantOids, haveOids, shallowOids []plumbing.Hash, opts FetchOptions) (*Packfile, debug.FetchDebugInfo, error) {
+	if err := opts.validate(); err != nil {
+		return nil, debug.FetchDebugInfo{}, err
 	}
+	return fetchPackfileWithFallback(repoURL, client, wantOids, func(wants []string) *bytes.Buffer {
+		return createCommitOnlyFetchRequest(client, wants, haveOids, shallowOids, opts, true)
+	})
+}
+
+// NegotiateCommitOnlyPackfile is FetchCommitOnlyPackfile that negotiates the haves with the
+// server first. It also returns the haves that the server acknowledged, which are the haves the
+// server has.
+func NegotiateCommitOnlyPackfile(repoURL string, client *http.Client, wantOids, haveOids []plumbing.Hash) (*Packfile, []plumbing.Hash, debug.FetchDebugInfo, error) {
+	var wants []string
 	for _, oid := range wantOids {
+		wants = append(wants, "want "+oid.String())
+	}
+	pack, acks, debugInfo, err := fetchPackfileWithAcks(repoURL, client, createCommitOnlyFetchRequest(client, wants, haveOids, nil, FetchOptions{}, false))
+	if err != nil || acks.ready {
+		// The server sent the packfile after "ready".
+		return pack, acks.common, debugInfo, err
+	}
+	pack.Close()
+	// The server needs "done" to send the packfile. Only the acknowledged haves matter.
+	pack, debugInfo, err = fetchPackfile(repoURL, client, createCommitOnlyFetchRequest(client, wants, acks.common, nil, FetchOptions{}, true))
+	return pack, acks.common, debugInfo, err
+}
+
+// createCommitOnlyFetchRequest creates a commit-only fetch request. The history is limited by the
+// options.
+func createCommitOnlyFetchRequest(client *http.Client, wants []string, haveOids, shallowOids []plumbing.Hash, opts FetchOptions, done bool) *bytes.Buffer {
+	chunks := commandRequestChunks("fetch")
+	for _, want := range wants {
 		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
-			Argument: []byte("want " + oid.String()),
+			Argument: []byte(want),
 		})
 	}
 	for _, oid := range haveOids {
@@ -36,16 +64,29 @@ func createCommitOnlyFetchRequest(wantOids, haveOids []plumbing.Hash) *bytes.Buf
 			Argument: []byte("have " + oid.String()),
 		})
 	}
+	chunks = append(chunks, progressChunks(client)...)
+	chunks = append(chunks, packfileURIChunks(client)...)
 	chunks = append(chunks,
-		&gitprotocolio.ProtocolV2RequestChunk{
-			Argument: []byte("no-progress"),
-		},
 		&gitprotocolio.ProtocolV2RequestChunk{
 			Argument: []byte("filter tree:0"),
 		},
-		&gitprotocolio.ProtocolV2RequestChunk{
+	)
+	for _, oid := range shallowOids {
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("shallow " + oid.String()),
+		})
+	}
+	for _, arg := range opts.arguments() {
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte(arg),
+		})
+	}
+	if done {
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
 			Argument: []byte("done"),
-		},
+		})
+	}
+	chunks = append(chunks,
 		&gitprotocolio.ProtocolV2RequestChunk{
 			EndArgument: true,
 		},
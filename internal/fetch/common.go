@@ -5,6 +5,7 @@ package fetch
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -15,34 +16,68 @@ import (
 	"strings"
 
 	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fileurl"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/google/gitprotocolio"
 )
 
-func fetchPackfile(repoURL string, client *http.Client, body *bytes.Buffer) ([]byte, debug.FetchDebugInfo, error) {
+func fetchPackfile(repoURL string, client *http.Client, body *bytes.Buffer) (*Packfile, debug.FetchDebugInfo, error) {
+	packfile, _, debugInfo, err := fetchPackfileWithAcks(repoURL, client, body)
+	return packfile, debugInfo, err
+}
+
+// acknowledgments is the acknowledgments section of a fetch response. The section is sent only
+// if the request doesn't have "done".
+type acknowledgments struct {
+	// common are the haves that the server has ("ACK <oid>").
+	common []plumbing.Hash
+	// ready is true if the server sent "ready", which means that the packfile follows in the
+	// same response. Otherwise, the response ends after the section and the packfile is empty.
+	ready bool
+}
+
+// fetchPackfileWithAcks is fetchPackfile that also returns the acknowledgments section.
+func fetchPackfileWithAcks(repoURL string, client *http.Client, body *bytes.Buffer) (*Packfile, acknowledgments, debug.FetchDebugInfo, error) {
+	var acks acknowledgments
 	rd, headers, err := callProtocolV2(repoURL, client, body)
 	debugInfo := debug.FetchDebugInfo{ResponseHeaders: headers}
 	if err != nil {
-		return nil, debugInfo, err
+		return nil, acks, debugInfo, err
 	}
 	defer rd.Close()
 	v2Resp := gitprotocolio.NewProtocolV2Response(rd)
 	isPackfile := false
-	packfile := bytes.NewBuffer(nil)
+	isPackfileURIs := false
+	packfile := newPackfile(Context(client))
+	var uriLines []string
 	for v2Resp.Scan() {
 		chunk := v2Resp.Chunk()
 		if chunk.EndResponse {
 			break
 		}
 		if chunk.Delimiter {
+			isPackfileURIs = false
+			continue
+		}
+		if isPackfileURIs {
+			uriLines = append(uriLines, string(chunk.Response))
 			continue
 		}
 		if isPackfile {
 			sideband := gitprotocolio.ParseSideBandPacket(chunk.Response)
 			if sideband == nil {
-				return nil, debugInfo, errors.New("unexpected non-sideband packet")
+				packfile.Close()
+				return nil, acks, debugInfo, errors.New("unexpected non-sideband packet")
 			}
-			if pkt, ok := sideband.(gitprotocolio.SideBandMainPacket); ok {
-				packfile.Write(pkt.Bytes())
+			switch pkt := sideband.(type) {
+			case gitprotocolio.SideBandMainPacket:
+				if _, err := packfile.Write(pkt.Bytes()); err != nil {
+					packfile.Close()
+					return nil, acks, debugInfo, err
+				}
+				reportProgress(client, "", packfile.size)
+			case gitprotocolio.SideBandReportPacket:
+				reportProgress(client, string(pkt.Bytes()), packfile.size)
 			}
 			continue
 		}
@@ -54,23 +89,54 @@ func fetchPackfile(repoURL string, client *http.Client, body *bytes.Buffer) ([]b
 			// No use. Skipping.
 			continue
 		}
+		if hash, ok := bytes.CutPrefix(chunk.Response, []byte("ACK ")); ok {
+			acks.common = append(acks.common, plumbing.NewHash(strings.TrimSpace(string(hash))))
+			continue
+		}
+		if bytes.Equal(chunk.Response, []byte("ready\n")) {
+			acks.ready = true
+			continue
+		}
+		if bytes.Equal(chunk.Response, []byte("packfile-uris\n")) {
+			isPackfileURIs = true
+			continue
+		}
 		if bytes.Equal(chunk.Response, []byte("packfile\n")) {
 			isPackfile = true
 			continue
 		}
 	}
+	if serverErr := asServerError(v2Resp.Err()); serverErr != nil {
+		packfile.Close()
+		return nil, acks, debugInfo, serverErr
+	}
 	if err := v2Resp.Err(); err != nil {
-		return nil, debugInfo, fmt.Errorf("failed to parse the protov2 resposne: %v", err)
+		// Return the partially received packfile so that the caller can resume from it.
+		debugInfo.PackfileSize = packfile.Len()
+		debugInfo.Spooled = packfile.Spooled()
+		return packfile, acks, debugInfo, fmt.Errorf("failed to parse the protov2 response: %w", err)
 	}
 	debugInfo.PackfileSize = packfile.Len()
-	return packfile.Bytes(), debugInfo, nil
+	debugInfo.Spooled = packfile.Spooled()
+	// The objects in the packfile URIs are excluded from the packfile, so they are needed too.
+	for _, line := range uriLines {
+		uriPack, err := downloadPackfileURI(client, line)
+		if err != nil {
+			packfile.Close()
+			return nil, acks, debugInfo, err
+		}
+		packfile.uriPacks = append(packfile.uriPacks, uriPack)
+		debugInfo.PackfileSize += uriPack.Len()
+		debugInfo.Spooled = debugInfo.Spooled || uriPack.Spooled()
+	}
+	return packfile, acks, debugInfo, nil
 }
 
 func callProtocolV2(repoURL string, client *http.Client, body *bytes.Buffer) (io.ReadCloser, http.Header, error) {
 	if strings.HasPrefix(repoURL, "http") {
 		return callProtocolV2HTTP(repoURL, client, body)
-	} else if strings.HasPrefix(repoURL, "file") {
-		rd, err := callProtocolV2File(repoURL, body)
+	} else if fileurl.IsFileURL(repoURL) {
+		rd, err := callProtocolV2File(Context(client), repoURL, body)
 		return rd, http.Header{}, err
 	}
 	return nil, nil, errors.New("unsupported protocol")
@@ -81,38 +147,84 @@ func callProtocolV2HTTP(repoURL string, client *http.Client, body *bytes.Buffer)
 	if err != nil {
 		return nil, nil, err
 	}
-	req, err := http.NewRequest("POST", upURL, body)
-	if err != nil {
-		return nil, nil, err
-	}
-	req.Header.Set("Git-Protocol", "version=2")
 	if client == nil {
 		client = http.DefaultClient
 	}
-	resp, err := client.Do(req)
+	// The request is sent again on the retries, so the body is kept.
+	reqBody := body.Bytes()
+	ctx := Context(client)
+	var resp *http.Response
+	err = WithRetries(ctx, func() error {
+		req, err := http.NewRequestWithContext(ctx, "POST", upURL, bytes.NewReader(reqBody))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/x-git-upload-pack-request")
+		req.Header.Set("Accept", "application/x-git-upload-pack-result")
+		req.Header.Set("Git-Protocol", "version=2")
+		resp, err = client.Do(req)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return &HTTPStatusError{StatusCode: resp.StatusCode}
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, nil, err
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, resp.Header, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		var headers http.Header
+		if resp != nil {
+			headers = resp.Header
+		}
+		return nil, headers, err
 	}
 	return resp.Body, resp.Header, nil
 }
 
-func callProtocolV2File(repoURL string, body *bytes.Buffer) (io.ReadCloser, error) {
-	fpath := strings.TrimPrefix(repoURL, "file://")
-	cmd := exec.Command("git", "-c", "uploadpack.allowFilter=1", "upload-pack", "--stateless-rpc", fpath)
+// callProtocolV2File runs git upload-pack for the file:// repository. The process is killed when ctx
+// is done.
+func callProtocolV2File(ctx context.Context, repoURL string, body *bytes.Buffer) (io.ReadCloser, error) {
+	fpath, err := fileurl.ToLocalPath(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.CommandContext(ctx, "git", "-c", "uploadpack.allowFilter=1", "upload-pack", "--stateless-rpc", fpath)
 	cmd.Stdin = body
 	cmd.Stderr = os.Stderr
 	stdout := bytes.NewBuffer(nil)
 	cmd.Stdout = stdout
 	cmd.Env = append(cmd.Env, "GIT_PROTOCOL=version=2")
 	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("git upload-pack is stopped: %w", ctx.Err())
+		}
+		if serverErr := findServerError(stdout.Bytes()); serverErr != nil {
+			return nil, serverErr
+		}
 		return nil, err
 	}
 	return io.NopCloser(stdout), nil
 }
 
+// findServerError returns the error that upload-pack sent as an ERR packet before exiting.
+func findServerError(bs []byte) *ServerError {
+	v2Resp := gitprotocolio.NewProtocolV2Response(bytes.NewReader(bs))
+	for v2Resp.Scan() {
+	}
+	return asServerError(v2Resp.Err())
+}
+
+// asServerError returns the ServerError of the ERR packet if the error is one. gitprotocolio stops
+// scanning at an ERR packet and returns it as an ErrorPacket error rather than as a chunk.
+func asServerError(err error) *ServerError {
+	var pkt gitprotocolio.ErrorPacket
+	if !errors.As(err, &pkt) {
+		return nil
+	}
+	return &ServerError{Message: strings.TrimSpace(string(pkt))}
+}
+
 func buildUploadPackURL(repoURL string) (string, error) {
 	u, err := url.Parse(repoURL)
 	if err != nil {
@@ -121,3 +233,28 @@ func buildUploadPackURL(repoURL string) (string, error) {
 	u = u.JoinPath("git-upload-pack")
 	return u.String(), nil
 }
+
+// HTTPStatusError is returned when the server responds with a non-200 status code.
+type HTTPStatusError struct {
+	StatusCode int
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+}
+
+// ServerError is returned when the server sends an ERR packet.
+type ServerError struct {
+	Message string
+}
+
+func (e *ServerError) Error() string {
+	return fmt.Sprintf("server error: %s", e.Message)
+}
+
+// IsNotOurRef returns true if the server refused a want because the object is not advertised.
+// This happens when the object is reachable only from the refs hidden by transfer.hideRefs or
+// uploadpack.hideRefs.
+func (e *ServerError) IsNotOurRef() bool {
+	return strings.Contains(e.Message, "not our ref")
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"context"
+)
+
+// Options are the settings of the fetches and the pushes with a context. They override the
+// process-wide defaults, which the unset fields fall back to.
+type Options struct {
+	// RetryPolicy, if set, overrides the policy of SetRetryPolicy. The requests with the context
+	// share their own retry budget.
+	RetryPolicy *RetryPolicy
+	// SpoolThreshold, if set, overrides SpoolThreshold.
+	SpoolThreshold *int64
+	// PackfileURIProtocols, if not nil, overrides PackfileURIProtocols. Empty disables the
+	// packfile URIs.
+	PackfileURIProtocols []string
+	// Progress, if set, overrides ProgressFunc.
+	Progress func(message string, receivedBytes int64)
+}
+
+type optionsKey struct{}
+
+// contextOptions are the Options of a context with their retry budget.
+type contextOptions struct {
+	Options
+	retrier *retryState
+}
+
+// WithOptions returns a context that has the options. The Session's context carries them to the
+// operations with its client.
+func WithOptions(ctx context.Context, opts Options) context.Context {
+	o := &contextOptions{Options: opts}
+	if opts.RetryPolicy != nil {
+		o.retrier = &retryState{policy: *opts.RetryPolicy, tokens: opts.RetryPolicy.Budget}
+	}
+	return context.WithValue(ctx, optionsKey{}, o)
+}
+
+// optionsFrom returns the options of the context. The fields are unset if it has none.
+func optionsFrom(ctx context.Context) *contextOptions {
+	if o, ok := ctx.Value(optionsKey{}).(*contextOptions); ok {
+		return o
+	}
+	return &contextOptions{}
+}
+
+// retrierFrom returns the retry state of the context, or the process-wide one.
+func retrierFrom(ctx context.Context) *retryState {
+	if o := optionsFrom(ctx); o.retrier != nil {
+		return o.retrier
+	}
+	return retrier
+}
+
+// spoolThresholdFrom returns the spool threshold of the context, or SpoolThreshold.
+func spoolThresholdFrom(ctx context.Context) int64 {
+	if o := optionsFrom(ctx); o.SpoolThreshold != nil {
+		return *o.SpoolThreshold
+	}
+	return SpoolThreshold
+}
+
+// packfileURIProtocolsFrom returns the packfile URI protocols of the context, or
+// PackfileURIProtocols.
+func packfileURIProtocolsFrom(ctx context.Context) []string {
+	if o := optionsFrom(ctx); o.PackfileURIProtocols != nil {
+		return o.PackfileURIProtocols
+	}
+	return PackfileURIProtocols
+}
+
+// progressFrom returns the progress function of the context, or ProgressFunc.
+func progressFrom(ctx context.Context) func(message string, receivedBytes int64) {
+	if o := optionsFrom(ctx); o.Progress != nil {
+		return o.Progress
+	}
+	return ProgressFunc
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// FallbackWantRef is recorded in the debug info when the objects are fetched with want-ref
+// because the server refused to serve them by object ID.
+const FallbackWantRef = "want-ref"
+
+// fetchPackfileWithFallback fetches the objects by their IDs. If the server refuses it with "not
+// our ref", which happens on servers that hide refs, this retries with want-ref for the refs
+// that point to the objects.
+func fetchPackfileWithFallback(repoURL string, client *http.Client, oids []plumbing.Hash, createRequest func(wants []string) *bytes.Buffer) (*Packfile, debug.FetchDebugInfo, error) {
+	return withWantRefFallback(repoURL, client, oids, func(wants []string) (*Packfile, debug.FetchDebugInfo, error) {
+		return fetchPackfile(repoURL, client, createRequest(wants))
+	})
+}
+
+// withWantRefFallback calls fetch with the want arguments of the objects, and with the want-ref
+// arguments if the server refuses them with "not our ref".
+func withWantRefFallback(repoURL string, client *http.Client, oids []plumbing.Hash, fetch func(wants []string) (*Packfile, debug.FetchDebugInfo, error)) (*Packfile, debug.FetchDebugInfo, error) {
+	var wants []string
+	for _, oid := range oids {
+		wants = append(wants, "want "+oid.String())
+	}
+	packfile, debugInfo, err := fetch(wants)
+	var serverErr *ServerError
+	if err == nil || !errors.As(err, &serverErr) || !serverErr.IsNotOurRef() {
+		return packfile, debugInfo, err
+	}
+
+	refData, _, _, lsRefsErr := CachedLsRefs(repoURL, client, []string{"refs/"})
+	if lsRefsErr != nil {
+		return nil, debugInfo, fmt.Errorf("%v; cannot list refs for want-ref fallback: %w", err, lsRefsErr)
+	}
+	wantRefs, missing := resolveWantRefs(oids, refData)
+	if len(missing) > 0 {
+		return nil, debugInfo, fmt.Errorf("%v; no advertised ref points to %s", err, strings.Join(missing, ", "))
+	}
+	packfile, debugInfo, err = fetch(wantRefs)
+	debugInfo.Fallback = FallbackWantRef
+	return packfile, debugInfo, err
+}
+
+// resolveWantRefs returns want-ref arguments for the objects from the ls-refs output. It also
+// returns the object IDs that no ref points to.
+func resolveWantRefs(oids []plumbing.Hash, refData []string) ([]string, []string) {
+	refByOid := map[string]string{}
+	for _, line := range refData {
+		parts := strings.Split(strings.TrimSpace(line), " ")
+		if len(parts) < 2 {
+			continue
+		}
+		if _, ok := refByOid[parts[0]]; !ok {
+			refByOid[parts[0]] = parts[1]
+		}
+		for _, p := range parts[2:] {
+			if peeled, ok := strings.CutPrefix(p, "peeled:"); ok {
+				if _, ok := refByOid[peeled]; !ok {
+					refByOid[peeled] = parts[1]
+				}
+			}
+		}
+	}
+	var wants, missing []string
+	seen := map[string]bool{}
+	for _, oid := range oids {
+		ref, ok := refByOid[oid.String()]
+		if !ok {
+			missing = append(missing, oid.String())
+			continue
+		}
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		wants = append(wants, "want-ref "+ref)
+	}
+	return wants, missing
+}
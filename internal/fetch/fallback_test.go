@@ -0,0 +1,42 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestResolveWantRefs(t *testing.T) {
+	branch := plumbing.NewHash("1111111111111111111111111111111111111111")
+	tag := plumbing.NewHash("2222222222222222222222222222222222222222")
+	tagged := plumbing.NewHash("3333333333333333333333333333333333333333")
+	unknown := plumbing.NewHash("4444444444444444444444444444444444444444")
+	refData := []string{
+		branch.String() + " refs/heads/main\n",
+		branch.String() + " refs/heads/copy\n",
+		tag.String() + " refs/tags/v1 peeled:" + tagged.String() + "\n",
+	}
+
+	wants, missing := resolveWantRefs([]plumbing.Hash{branch, tagged, unknown}, refData)
+	if want := []string{"want-ref refs/heads/main", "want-ref refs/tags/v1"}; !reflect.DeepEqual(wants, want) {
+		t.Errorf("wants = %v, want %v", wants, want)
+	}
+	if want := []string{unknown.String()}; !reflect.DeepEqual(missing, want) {
+		t.Errorf("missing = %v, want %v", missing, want)
+	}
+}
+
+func TestFindServerError(t *testing.T) {
+	bs := []byte("004aERR upload-pack: not our ref 1111111111111111111111111111111111111111\n")
+	err := findServerError(bs)
+	if err == nil {
+		t.Fatal("expected a server error")
+	}
+	if !err.IsNotOurRef() {
+		t.Errorf("expected not our ref, got %q", err.Message)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/gitprotocolio"
+)
+
+// FetchFullPackfile fetches a packfile from a remote repository with all objects reachable from
+// the wants but not from the haves.
+func FetchFullPackfile(repoURL string, client *http.Client, wantOids, haveOids []plumbing.Hash) (*Packfile, debug.FetchDebugInfo, error) {
+	return fetchPackfileWithFallback(repoURL, client, wantOids, func(wants []string) *bytes.Buffer {
+		return createFullFetchRequest(client, wants, haveOids)
+	})
+}
+
+func createFullFetchRequest(client *http.Client, wants []string, haveOids []plumbing.Hash) *bytes.Buffer {
+	chunks := commandRequestChunks("fetch")
+	for _, want := range wants {
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte(want),
+		})
+	}
+	for _, oid := range haveOids {
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("have " + oid.String()),
+		})
+	}
+	chunks = append(chunks, progressChunks(client)...)
+	chunks = append(chunks, packfileURIChunks(client)...)
+	chunks = append(chunks,
+		&gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("done"),
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			EndArgument: true,
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			EndRequest: true,
+		},
+	)
+	bs := bytes.NewBuffer(nil)
+	for _, chunk := range chunks {
+		// Not possible to fail.
+		bs.Write(chunk.EncodeToPktLine())
+	}
+	return bs
+}
@@ -42,14 +42,7 @@ func LsRefs(repoURL string, client *http.Client, refPrefixes []string) ([]string
 }
 
 func createLsRefsRequest(refPrefixes []string) *bytes.Buffer {
-	chunks := []*gitprotocolio.ProtocolV2RequestChunk{
-		{
-			Command: "ls-refs",
-		},
-		{
-			EndCapability: true,
-		},
-	}
+	chunks := commandRequestChunks("ls-refs")
 	for _, refPrefix := range refPrefixes {
 		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
 			Argument: []byte("ref-prefix " + refPrefix),
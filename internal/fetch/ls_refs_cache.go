@@ -0,0 +1,89 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// lsRefsCache is a process-wide snapshot of the ls-refs results keyed by the repository URL, and
+// then by the CacheScope of the client and the ref prefixes.
+var lsRefsCache = &refsCache{entries: map[string]map[string]*refsCacheEntry{}}
+
+type refsCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]map[string]*refsCacheEntry
+}
+
+type refsCacheEntry struct {
+	refData []string
+	headers http.Header
+	expires time.Time
+}
+
+// SetLsRefsCacheTTL enables caching the ls-refs results for the duration. Zero disables the
+// cache and drops the cached results.
+func SetLsRefsCacheTTL(ttl time.Duration) {
+	lsRefsCache.mu.Lock()
+	defer lsRefsCache.mu.Unlock()
+	lsRefsCache.ttl = ttl
+	if ttl <= 0 {
+		lsRefsCache.entries = map[string]map[string]*refsCacheEntry{}
+	}
+}
+
+// InvalidateLsRefsCache drops the cached ls-refs results of the repository, including the ones
+// of the Session of the client. This is called after pushing to the repository.
+func InvalidateLsRefsCache(repoURL string, client *http.Client) {
+	if session := sessionOf(client); session != nil {
+		session.invalidateLsRefs(repoURL)
+	}
+	lsRefsCache.mu.Lock()
+	defer lsRefsCache.mu.Unlock()
+	delete(lsRefsCache.entries, repoURL)
+}
+
+// CachedLsRefs is LsRefs that uses the cached result if the client has a Session or the cache is
+// enabled with SetLsRefsCacheTTL. The returned bool is true if the result is from the cache.
+func CachedLsRefs(repoURL string, client *http.Client, refPrefixes []string) ([]string, http.Header, bool, error) {
+	key := strings.Join(refPrefixes, "\x00")
+	session := sessionOf(client)
+	if session != nil {
+		if entry := session.cachedLsRefs(repoURL, key); entry != nil {
+			return append([]string(nil), entry.refData...), entry.headers.Clone(), true, nil
+		}
+	}
+	// The session is of one client, but the process-wide cache is shared by the clients.
+	scopedKey := CacheScope(client) + "\x00" + key
+	lsRefsCache.mu.Lock()
+	ttl := lsRefsCache.ttl
+	if entry, ok := lsRefsCache.entries[repoURL][scopedKey]; ok && time.Now().Before(entry.expires) {
+		lsRefsCache.mu.Unlock()
+		return append([]string(nil), entry.refData...), entry.headers.Clone(), true, nil
+	}
+	lsRefsCache.mu.Unlock()
+
+	refData, headers, err := LsRefs(repoURL, client, refPrefixes)
+	if err == nil && session != nil {
+		session.storeLsRefs(repoURL, key, refData, headers)
+	}
+	if err != nil || ttl <= 0 {
+		return refData, headers, false, err
+	}
+	lsRefsCache.mu.Lock()
+	defer lsRefsCache.mu.Unlock()
+	if lsRefsCache.entries[repoURL] == nil {
+		lsRefsCache.entries[repoURL] = map[string]*refsCacheEntry{}
+	}
+	lsRefsCache.entries[repoURL][scopedKey] = &refsCacheEntry{
+		refData: append([]string(nil), refData...),
+		headers: headers.Clone(),
+		expires: time.Now().Add(ttl),
+	}
+	return refData, headers, false, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+const (
+	// negotiationRoundSize is the number of the new haves sent in a negotiation round.
+	negotiationRoundSize = 32
+	// MaxNegotiationHaves is the number of the haves sent before giving up the negotiation.
+	// Same as the haves that git sends without a new ACK before giving up (MAX_IN_VAIN).
+	MaxNegotiationHaves = 256
+)
+
+// negotiate sends the haves to the server in rounds until the server is ready to send the
+// packfile or the haves run out, and then fetches the packfile with "done". The haves should be
+// ordered by the preference, usually the newest first. The requests over HTTP are stateless, so
+// each round sends the acknowledged haves again. It returns the acknowledged haves.
+func negotiate(repoURL string, client *http.Client, haveOids []plumbing.Hash, createRequest func(haveOids []plumbing.Hash, done bool) *bytes.Buffer) (*Packfile, []plumbing.Hash, debug.FetchDebugInfo, error) {
+	if len(haveOids) > MaxNegotiationHaves {
+		haveOids = haveOids[:MaxNegotiationHaves]
+	}
+	var common []plumbing.Hash
+	isCommon := map[plumbing.Hash]bool{}
+	rounds := 0
+	var debugInfo debug.FetchDebugInfo
+	for len(haveOids) > 0 {
+		n := min(len(haveOids), negotiationRoundSize)
+		round := append(append([]plumbing.Hash{}, common...), haveOids[:n]...)
+		haveOids = haveOids[n:]
+		pack, acks, roundDebugInfo, err := fetchPackfileWithAcks(repoURL, client, createRequest(round, false))
+		rounds++
+		roundDebugInfo.NegotiationRounds = rounds
+		debugInfo = roundDebugInfo
+		if err != nil {
+			return pack, common, debugInfo, err
+		}
+		for _, oid := range acks.common {
+			if !isCommon[oid] {
+				isCommon[oid] = true
+				common = append(common, oid)
+			}
+		}
+		debugInfo.CommonHaves = len(common)
+		if acks.ready {
+			return pack, common, debugInfo, nil
+		}
+		pack.Close()
+	}
+	pack, packDebugInfo, err := fetchPackfile(repoURL, client, createRequest(common, true))
+	packDebugInfo.NegotiationRounds = rounds
+	packDebugInfo.CommonHaves = len(common)
+	return pack, common, packDebugInfo, err
+}
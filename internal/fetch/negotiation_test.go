@@ -0,0 +1,147 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// negotiationServer is an upload-pack server that has some of the haves, and is ready to send the
+// packfile when it sees the ready one.
+type negotiationServer struct {
+	common map[plumbing.Hash]bool
+	ready  plumbing.Hash
+	// requests are the haves of the requests, and dones are whether they have "done".
+	requests [][]plumbing.Hash
+	dones    []bool
+}
+
+func (s *negotiationServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	var haves []plumbing.Hash
+	done := false
+	for len(body) >= 4 {
+		n, err := strconv.ParseUint(string(body[:4]), 16, 16)
+		if err != nil {
+			break
+		}
+		if n < 4 {
+			// Flush and delimiter.
+			body = body[4:]
+			continue
+		}
+		arg := strings.TrimSpace(string(body[4:n]))
+		body = body[n:]
+		if hash, ok := strings.CutPrefix(arg, "have "); ok {
+			haves = append(haves, plumbing.NewHash(hash))
+		}
+		done = done || arg == "done"
+	}
+	s.requests = append(s.requests, haves)
+	s.dones = append(s.dones, done)
+
+	pkt := func(line string) {
+		fmt.Fprintf(w, "%04x%s", len(line)+4, line)
+	}
+	packfile := func() {
+		pkt("packfile\n")
+		pkt("\x01PACK")
+		fmt.Fprint(w, "0000")
+	}
+	if done {
+		packfile()
+		return
+	}
+	pkt("acknowledgments\n")
+	ready := false
+	acked := false
+	for _, have := range haves {
+		if s.common[have] {
+			pkt("ACK " + have.String() + "\n")
+			acked = true
+			ready = ready || have == s.ready
+		}
+	}
+	if !acked {
+		pkt("NAK\n")
+	}
+	if !ready {
+		fmt.Fprint(w, "0000")
+		return
+	}
+	pkt("ready\n")
+	fmt.Fprint(w, "0001")
+	packfile()
+}
+
+func TestNegotiate(t *testing.T) {
+	var haves []plumbing.Hash
+	for i := 0; i < 70; i++ {
+		haves = append(haves, plumbing.ComputeHash(plumbing.CommitObject, []byte(fmt.Sprint(i))))
+	}
+	createRequest := func(haveOids []plumbing.Hash, done bool) *bytes.Buffer {
+		return createBlobNoneFetchRequestWithOptions(http.DefaultClient, []string{"want " + haves[0].String()}, haveOids, FetchOptions{Depth: 1}, done)
+	}
+
+	t.Run("ready", func(t *testing.T) {
+		s := &negotiationServer{common: map[plumbing.Hash]bool{haves[5]: true, haves[40]: true}, ready: haves[40]}
+		server := httptest.NewServer(s)
+		defer server.Close()
+		pack, common, debugInfo, err := negotiate(server.URL, nil, haves, createRequest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer pack.Close()
+		if pack.Len() == 0 {
+			t.Error("the packfile after ready is empty")
+		}
+		if want := []plumbing.Hash{haves[5], haves[40]}; !reflect.DeepEqual(common, want) {
+			t.Errorf("common = %v, want %v", common, want)
+		}
+		if debugInfo.NegotiationRounds != 2 || debugInfo.CommonHaves != 2 {
+			t.Errorf("unexpected debug info: %+v", debugInfo)
+		}
+		// The second round sends the acknowledged have again with the next haves.
+		if want := append([]plumbing.Hash{haves[5]}, haves[32:64]...); !reflect.DeepEqual(s.requests[1], want) {
+			t.Errorf("the second round sent %v, want %v", s.requests[1], want)
+		}
+		if want := []bool{false, false}; !reflect.DeepEqual(s.dones, want) {
+			t.Errorf("dones = %v, want %v", s.dones, want)
+		}
+	})
+
+	t.Run("done", func(t *testing.T) {
+		s := &negotiationServer{common: map[plumbing.Hash]bool{haves[5]: true}}
+		server := httptest.NewServer(s)
+		defer server.Close()
+		pack, common, debugInfo, err := negotiate(server.URL, nil, haves, createRequest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer pack.Close()
+		if pack.Len() == 0 {
+			t.Error("the packfile after done is empty")
+		}
+		if want := []plumbing.Hash{haves[5]}; !reflect.DeepEqual(common, want) {
+			t.Errorf("common = %v, want %v", common, want)
+		}
+		if debugInfo.NegotiationRounds != 3 {
+			t.Errorf("%d rounds, want 3", debugInfo.NegotiationRounds)
+		}
+		// Only the acknowledged haves are sent with done.
+		if last := len(s.requests) - 1; !s.dones[last] || !reflect.DeepEqual(s.requests[last], []plumbing.Hash{haves[5]}) {
+			t.Errorf("the last request sent %v with done %t, want only the acknowledged have with done", s.requests[last], s.dones[last])
+		}
+	})
+}
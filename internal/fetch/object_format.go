@@ -0,0 +1,43 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"crypto"
+
+	formatcfg "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/go-git/go-git/v5/plumbing/hash"
+	"github.com/google/gitprotocolio"
+)
+
+// ObjectFormat is the object format of the repositories that this build works with. go-git fixes
+// the hash algorithm at build time, and SHA-256 is enabled with the sha256 build tag.
+var ObjectFormat = objectFormat()
+
+func objectFormat() formatcfg.ObjectFormat {
+	if hash.CryptoType == crypto.SHA256 {
+		return formatcfg.SHA256
+	}
+	return formatcfg.SHA1
+}
+
+// commandRequestChunks returns the chunks of a protocol v2 command up to the end of the
+// capabilities.
+func commandRequestChunks(command string) []*gitprotocolio.ProtocolV2RequestChunk {
+	chunks := []*gitprotocolio.ProtocolV2RequestChunk{
+		{
+			Command: command,
+		},
+	}
+	if ObjectFormat != formatcfg.SHA1 {
+		// The server assumes SHA-1 without this, and rejects the request for a SHA-256
+		// repository.
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+			Capability: "object-format=" + string(ObjectFormat),
+		})
+	}
+	return append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+		EndCapability: true,
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/gitprotocolio"
+)
+
+// objectInfoBatchSize is the number of objects asked in one object-info request.
+const objectInfoBatchSize = 1000
+
+// ObjectSizes returns the sizes of the objects with the object-info command, without fetching
+// them. The objects that the server doesn't have are omitted. This fails if the server doesn't
+// support the command.
+func ObjectSizes(repoURL string, client *http.Client, oids []plumbing.Hash) (map[plumbing.Hash]int64, http.Header, error) {
+	ret := map[plumbing.Hash]int64{}
+	var headers http.Header
+	for start := 0; start < len(oids); start += objectInfoBatchSize {
+		end := min(start+objectInfoBatchSize, len(oids))
+		var err error
+		headers, err = objectSizes(repoURL, client, oids[start:end], ret)
+		if err != nil {
+			return nil, headers, err
+		}
+	}
+	return ret, headers, nil
+}
+
+func objectSizes(repoURL string, client *http.Client, oids []plumbing.Hash, sizes map[plumbing.Hash]int64) (http.Header, error) {
+	rd, headers, err := callProtocolV2(repoURL, client, createObjectInfoRequest(oids))
+	if err != nil {
+		return headers, err
+	}
+	defer rd.Close()
+	v2Resp := gitprotocolio.NewProtocolV2Response(rd)
+	isServerInfo := false
+	for v2Resp.Scan() {
+		chunk := v2Resp.Chunk()
+		if chunk.EndResponse {
+			if isServerInfo {
+				isServerInfo = false
+				continue
+			}
+			break
+		}
+		if bytes.Equal(chunk.Response, []byte("version 2\n")) {
+			isServerInfo = true
+			continue
+		}
+		if isServerInfo {
+			continue
+		}
+		line := strings.TrimSuffix(string(chunk.Response), "\n")
+		if line == "size" {
+			// The echo of the requested attribute.
+			continue
+		}
+		oid, size, _ := strings.Cut(line, " ")
+		if size == "" {
+			// The server doesn't have the object.
+			continue
+		}
+		n, err := strconv.ParseInt(size, 10, 64)
+		if err != nil {
+			return headers, fmt.Errorf("unexpected object-info response %q", line)
+		}
+		sizes[plumbing.NewHash(oid)] = n
+	}
+	if serverErr := asServerError(v2Resp.Err()); serverErr != nil {
+		return headers, serverErr
+	}
+	if err := v2Resp.Err(); err != nil {
+		return headers, fmt.Errorf("failed to parse the protov2 response: %w", err)
+	}
+	return headers, nil
+}
+
+func createObjectInfoRequest(oids []plumbing.Hash) *bytes.Buffer {
+	chunks := append(commandRequestChunks("object-info"), &gitprotocolio.ProtocolV2RequestChunk{
+		Argument: []byte("size"),
+	})
+	for _, oid := range oids {
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("oid " + oid.String()),
+		})
+	}
+	chunks = append(chunks,
+		&gitprotocolio.ProtocolV2RequestChunk{
+			EndArgument: true,
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			EndRequest: true,
+		},
+	)
+	bs := bytes.NewBuffer(nil)
+	for _, chunk := range chunks {
+		// Not possible to fail.
+		bs.Write(chunk.EncodeToPktLine())
+	}
+	return bs
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestObjectSizesServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("0023ERR object-info is not allowed\n"))
+	}))
+	defer server.Close()
+
+	_, _, err := ObjectSizes(server.URL+"/repo.git", &http.Client{}, []plumbing.Hash{plumbing.NewHash("1111111111111111111111111111111111111111")})
+	var serverErr *ServerError
+	if !errors.As(err, &serverErr) {
+		t.Fatalf("expected a ServerError, got %v", err)
+	}
+	if serverErr.Message != "object-info is not allowed" {
+		t.Errorf("Message is %q", serverErr.Message)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FetchOptions limits the history fetched from the wants. Depth cannot be combined with the
+// others, same as git.
+type FetchOptions struct {
+	// Depth fetches the last Depth commits of the history ("deepen").
+	Depth int
+	// Since fetches the commits whose committer timestamps are not older than it
+	// ("deepen-since").
+	Since time.Time
+	// Not fetches the commits that are not reachable from the refs ("deepen-not"). These need
+	// to be ref names, e.g. refs/heads/main, as the servers don't accept object IDs.
+	Not []string
+}
+
+func (o FetchOptions) validate() error {
+	if o.Depth > 0 && (!o.Since.IsZero() || len(o.Not) > 0) {
+		return errors.New("the depth cannot be combined with the time or the ref limit")
+	}
+	return nil
+}
+
+// arguments returns the fetch arguments for the options.
+func (o FetchOptions) arguments() []string {
+	var ret []string
+	if o.Depth > 0 {
+		ret = append(ret, fmt.Sprintf("deepen %d", o.Depth))
+	}
+	if !o.Since.IsZero() {
+		ret = append(ret, fmt.Sprintf("deepen-since %d", o.Since.Unix()))
+	}
+	for _, ref := range o.Not {
+		ret = append(ret, "deepen-not "+ref)
+	}
+	return ret
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/gitprotocolio"
+)
+
+// PackfileURIProtocols are the URI protocols (e.g. "https") of the packfiles that the server can
+// offload to the packfile URIs instead of sending them in the response ("packfile-uris"). Empty
+// disables it, which is the default. The servers that don't advertise packfile-uris reject the
+// argument, so this is only for the servers that do.
+var PackfileURIProtocols []string
+
+// packfileURIChunks returns the "packfile-uris" argument if the packfile URI protocols of the
+// client are set.
+func packfileURIChunks(client *http.Client) []*gitprotocolio.ProtocolV2RequestChunk {
+	protocols := packfileURIProtocolsFrom(Context(client))
+	if len(protocols) == 0 {
+		return nil
+	}
+	return []*gitprotocolio.ProtocolV2RequestChunk{{Argument: []byte("packfile-uris " + strings.Join(protocols, ","))}}
+}
+
+type packfileURIKey struct{}
+
+// IsPackfileURIRequest returns true if the request downloads a packfile URI. These usually point
+// to a CDN, which doesn't need the credentials of the repository.
+func IsPackfileURIRequest(req *http.Request) bool {
+	v, _ := req.Context().Value(packfileURIKey{}).(bool)
+	return v
+}
+
+// downloadPackfileURI downloads a packfile from a line of the packfile-uris section, which is the
+// checksum of the packfile and the URI.
+func downloadPackfileURI(client *http.Client, line string) (*Packfile, error) {
+	checksum, uri, ok := strings.Cut(strings.TrimSpace(line), " ")
+	want, err := hex.DecodeString(checksum)
+	if !ok || err != nil {
+		return nil, fmt.Errorf("malformed packfile URI line %q", line)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx := Context(client)
+	pack := newPackfile(ctx)
+	err = WithRetries(ctx, func() error {
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, packfileURIKey{}, true), "GET", uri, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return &HTTPStatusError{StatusCode: resp.StatusCode}
+		}
+		pack.Close()
+		pack = newPackfile(ctx)
+		_, err = io.Copy(pack, resp.Body)
+		return err
+	})
+	if err != nil {
+		pack.Close()
+		return nil, fmt.Errorf("cannot download the packfile URI %s: %w", uri, err)
+	}
+	got, err := pack.tail(len(want))
+	if err != nil || !bytes.Equal(got, want) {
+		pack.Close()
+		return nil, fmt.Errorf("the packfile from %s doesn't have the checksum %s", uri, checksum)
+	}
+	return pack, nil
+}
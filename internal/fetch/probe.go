@@ -0,0 +1,88 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/gitprotocolio"
+)
+
+// MissingObjects returns the objects that the remote repository doesn't have.
+//
+// This probes the objects with a minimal fetch. All objects are probed at once first, and if the
+// server refuses it, each object is probed one by one to find the missing ones.
+func MissingObjects(repoURL string, client *http.Client, oids []plumbing.Hash) ([]plumbing.Hash, debug.FetchDebugInfo, error) {
+	exists, debugInfo, err := probeObjects(repoURL, client, oids)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	if exists {
+		return nil, debugInfo, nil
+	}
+	if len(oids) == 1 {
+		return oids, debugInfo, nil
+	}
+	var missing []plumbing.Hash
+	for _, oid := range oids {
+		exists, oidDebugInfo, err := probeObjects(repoURL, client, []plumbing.Hash{oid})
+		debugInfo.PackfileSize += oidDebugInfo.PackfileSize
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		if !exists {
+			missing = append(missing, oid)
+		}
+	}
+	return missing, debugInfo, nil
+}
+
+func probeObjects(repoURL string, client *http.Client, oids []plumbing.Hash) (bool, debug.FetchDebugInfo, error) {
+	pack, debugInfo, err := fetchPackfile(repoURL, client, createProbeRequest(oids))
+	pack.Close()
+	var serverErr *ServerError
+	if errors.As(err, &serverErr) && serverErr.IsNotOurRef() {
+		return false, debugInfo, nil
+	}
+	return err == nil, debugInfo, err
+}
+
+func createProbeRequest(oids []plumbing.Hash) *bytes.Buffer {
+	chunks := commandRequestChunks("fetch")
+	for _, oid := range oids {
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("want " + oid.String()),
+		})
+	}
+	chunks = append(chunks,
+		&gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("no-progress"),
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("deepen 1"),
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("filter tree:0"),
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("done"),
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			EndArgument: true,
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			EndRequest: true,
+		},
+	)
+	bs := bytes.NewBuffer(nil)
+	for _, chunk := range chunks {
+		// Not possible to fail.
+		bs.Write(chunk.EncodeToPktLine())
+	}
+	return bs
+}
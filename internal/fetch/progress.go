@@ -0,0 +1,31 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"net/http"
+
+	"github.com/google/gitprotocolio"
+)
+
+// ProgressFunc, if set, is called with the progress of the packfile transfers: the messages that
+// the server sends on the sideband progress channel, and the size of the packfile received so
+// far with an empty message. If set, the fetch requests don't send "no-progress" so that the
+// server sends the messages. It can be called from multiple goroutines.
+var ProgressFunc func(message string, receivedBytes int64)
+
+// progressChunks returns the "no-progress" argument unless the progress function of the client
+// is set.
+func progressChunks(client *http.Client) []*gitprotocolio.ProtocolV2RequestChunk {
+	if progressFrom(Context(client)) != nil {
+		return nil
+	}
+	return []*gitprotocolio.ProtocolV2RequestChunk{{Argument: []byte("no-progress")}}
+}
+
+func reportProgress(client *http.Client, message string, receivedBytes int64) {
+	if f := progressFrom(Context(client)); f != nil {
+		f(message, receivedBytes)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	gogithttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+// RetryPolicy is how the requests that fail transiently are retried. See nichegit.RetryPolicy for
+// the fields.
+type RetryPolicy struct {
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         float64
+	Budget         float64
+	BudgetRatio    float64
+}
+
+// backoff returns the wait before the retry, counted from zero.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	d := float64(p.InitialBackoff) * math.Pow(multiplier, float64(retry))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		d *= 1 + p.Jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(d)
+}
+
+// retrier is the process-wide retry policy and its budget.
+var retrier = &retryState{}
+
+type retryState struct {
+	mu     sync.Mutex
+	policy RetryPolicy
+	tokens float64
+	// sleep is replaced in the tests.
+	sleep func(time.Duration)
+}
+
+// SetRetryPolicy sets the retry policy of the fetches and the pushes in the process. The retry
+// budget is refilled only if the policy changes, so that it's kept across the operations. The zero
+// policy disables retrying, which is the default.
+func SetRetryPolicy(p RetryPolicy) {
+	retrier.mu.Lock()
+	defer retrier.mu.Unlock()
+	if retrier.policy == p {
+		return
+	}
+	retrier.policy = p
+	retrier.tokens = p.Budget
+}
+
+// takeRetry returns the wait before the retry, or false if the policy or the budget doesn't
+// allow it.
+func (s *retryState) takeRetry(retry int) (time.Duration, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if retry >= s.policy.MaxRetries {
+		return 0, false
+	}
+	if s.policy.Budget > 0 {
+		// Stop retrying while the server keeps failing, like the gRPC retry throttling.
+		if s.tokens <= s.policy.Budget/2 {
+			return 0, false
+		}
+		s.tokens--
+	}
+	return s.policy.backoff(retry), true
+}
+
+func (s *retryState) succeeded() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.policy.Budget > 0 {
+		s.tokens = min(s.tokens+s.policy.BudgetRatio, s.policy.Budget)
+	}
+}
+
+// WithRetries calls fn, and calls it again while it fails with a retryable error and the retry
+// policy of ctx allows. Returns the error of the last call. It doesn't retry once ctx is done, or if the
+// wait before the retry doesn't end before the deadline of ctx.
+func WithRetries(ctx context.Context, fn func() error) error {
+	r := retrierFrom(ctx)
+	for retry := 0; ; retry++ {
+		err := fn()
+		if err == nil {
+			r.succeeded()
+			return nil
+		}
+		if !IsRetryable(err) {
+			return err
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+		wait, ok := r.takeRetry(retry)
+		if !ok {
+			return err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= wait {
+			return err
+		}
+		// The tests replace the sleep of the process-wide state.
+		retrier.mu.Lock()
+		sleep := retrier.sleep
+		retrier.mu.Unlock()
+		if sleep != nil {
+			sleep(wait)
+			continue
+		}
+		// The wait ends early if ctx is canceled, without retrying.
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// IsRetryable returns true if the error is transient: a 5xx, 408, or 429 response, a timeout,
+// or a refused or reset connection. The deadline and the cancellation of the request context
+// are not retryable.
+func IsRetryable(err error) bool {
+	// go-git wraps the HTTP errors in UnexpectedError, which doesn't support unwrapping.
+	var unexpectedErr *plumbing.UnexpectedError
+	if errors.As(err, &unexpectedErr) {
+		err = unexpectedErr.Err
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	statusCode := 0
+	var statusErr *HTTPStatusError
+	var httpErr *gogithttp.Err
+	if errors.As(err, &statusErr) {
+		statusCode = statusErr.StatusCode
+	} else if errors.As(err, &httpErr) {
+		statusCode = httpErr.StatusCode()
+	}
+	if statusCode != 0 {
+		return statusCode >= 500 || statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED)
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{&HTTPStatusError{StatusCode: 503}, true},
+		{&HTTPStatusError{StatusCode: 429}, true},
+		{&HTTPStatusError{StatusCode: 404}, false},
+		{fmt.Errorf("wrapped: %w", &HTTPStatusError{StatusCode: 500}), true},
+		{&url.Error{Op: "Post", URL: "https://example.com", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}, true},
+		{&net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, true},
+		{&url.Error{Op: "Post", URL: "https://example.com", Err: timeoutError{}}, true},
+		{&url.Error{Op: "Post", URL: "https://example.com", Err: context.DeadlineExceeded}, false},
+		{plumbing.NewUnexpectedError(context.Canceled), false},
+		{&ServerError{Message: "not our ref"}, false},
+		{errors.New("unexpected EOF"), false},
+	}
+	for _, tt := range tests {
+		if got := IsRetryable(tt.err); got != tt.want {
+			t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+	for retry, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		if got := p.backoff(retry); got != want {
+			t.Errorf("backoff(%d) = %v, want %v", retry, got, want)
+		}
+	}
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := p.backoff(1); got < 100*time.Millisecond || got > 300*time.Millisecond {
+			t.Fatalf("backoff(1) with the jitter = %v, want 100ms to 300ms", got)
+		}
+	}
+}
+
+func TestWithRetries(t *testing.T) {
+	var waits []time.Duration
+	retrier.sleep = func(d time.Duration) { waits = append(waits, d) }
+	t.Cleanup(func() {
+		retrier.sleep = nil
+		SetRetryPolicy(RetryPolicy{})
+	})
+	unavailable := &HTTPStatusError{StatusCode: 503}
+	// failing returns a function that fails n times and then succeeds.
+	failing := func(n int) (func() error, *int) {
+		calls := 0
+		return func() error {
+			calls++
+			if calls <= n {
+				return unavailable
+			}
+			return nil
+		}, &calls
+	}
+
+	ctx := context.Background()
+
+	// Disabled by default.
+	fn, calls := failing(1)
+	if err := WithRetries(ctx, fn); err != unavailable || *calls != 1 {
+		t.Errorf("WithRetries without a policy = %v after %d calls, want the error after 1 call", err, *calls)
+	}
+
+	SetRetryPolicy(RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond, Multiplier: 2})
+	fn, calls = failing(2)
+	if err := WithRetries(ctx, fn); err != nil || *calls != 3 {
+		t.Errorf("WithRetries = %v after %d calls, want success after 3 calls", err, *calls)
+	}
+	if want := []time.Duration{time.Millisecond, 2 * time.Millisecond}; fmt.Sprint(waits) != fmt.Sprint(want) {
+		t.Errorf("waits = %v, want %v", waits, want)
+	}
+	fn, calls = failing(10)
+	if err := WithRetries(ctx, fn); err != unavailable || *calls != 4 {
+		t.Errorf("WithRetries = %v after %d calls, want the error after 4 calls", err, *calls)
+	}
+	notFound := &HTTPStatusError{StatusCode: 404}
+	calls404 := 0
+	if err := WithRetries(ctx, func() error { calls404++; return notFound }); err != notFound || calls404 != 1 {
+		t.Errorf("WithRetries of a 404 = %v after %d calls, want no retry", err, calls404)
+	}
+
+	// The budget of 4 tokens allows 2 retries in total while the server keeps failing.
+	SetRetryPolicy(RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond, Budget: 4, BudgetRatio: 1})
+	fn, calls = failing(10)
+	if err := WithRetries(ctx, fn); err != unavailable || *calls != 3 {
+		t.Errorf("WithRetries with the budget = %v after %d calls, want the error after 3 calls", err, *calls)
+	}
+	fn, calls = failing(10)
+	if err := WithRetries(ctx, fn); err != unavailable || *calls != 1 {
+		t.Errorf("WithRetries with the exhausted budget = %v after %d calls, want the error after 1 call", err, *calls)
+	}
+	// A success returns a token, which allows a retry again.
+	if err := WithRetries(ctx, func() error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	fn, calls = failing(1)
+	if err := WithRetries(ctx, fn); err != nil || *calls != 2 {
+		t.Errorf("WithRetries with the refilled budget = %v after %d calls, want success after 2 calls", err, *calls)
+	}
+
+	// No retry if the wait doesn't end before the deadline, or after the cancellation.
+	SetRetryPolicy(RetryPolicy{MaxRetries: 3, InitialBackoff: time.Minute})
+	deadlineCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	waits = nil
+	fn, calls = failing(1)
+	if err := WithRetries(deadlineCtx, fn); err != unavailable || *calls != 1 || len(waits) != 0 {
+		t.Errorf("WithRetries near the deadline = %v after %d calls and %v waits, want the error after 1 call", err, *calls, waits)
+	}
+	SetRetryPolicy(RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond})
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	fn, calls = failing(1)
+	if err := WithRetries(canceledCtx, fn); err != unavailable || *calls != 1 {
+		t.Errorf("WithRetries after the cancellation = %v after %d calls, want the error after 1 call", err, *calls)
+	}
+}
+
+func TestWithRetriesCanceledWhileWaiting(t *testing.T) {
+	SetRetryPolicy(RetryPolicy{MaxRetries: 3, InitialBackoff: time.Hour})
+	t.Cleanup(func() { SetRetryPolicy(RetryPolicy{}) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	unavailable := &HTTPStatusError{StatusCode: 503}
+	calls := 0
+	done := make(chan error, 1)
+	go func() {
+		done <- WithRetries(ctx, func() error { calls++; return unavailable })
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		if err != unavailable || calls != 1 {
+			t.Errorf("WithRetries = %v after %d calls, want the error after 1 call", err, calls)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("WithRetries kept waiting after the cancellation")
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// sessionDrainLimit is the maximum number of the unread bytes of a response body that Session
+// reads before closing it. The rest, if any, is discarded with the connection.
+const sessionDrainLimit = 64 << 10
+
+// Session is an http.RoundTripper that shares the state of the requests through it. The ls-refs
+// results are cached until a push to the repository through the same client. The response bodies
+// are drained on Close so that the connections are reused even if the caller stops reading a
+// response early.
+type Session struct {
+	inner http.RoundTripper
+	// ctx is the context of the operations through the session. See Context.
+	ctx context.Context
+	// cacheScope is the identity of the credentials of the session. See CacheScope.
+	cacheScope string
+
+	mu     sync.Mutex
+	lsRefs map[string]map[string]*refsCacheEntry
+}
+
+// NewSession returns a Session that sends the requests with the inner RoundTripper.
+func NewSession(inner http.RoundTripper) *Session {
+	if inner == nil {
+		inner = http.DefaultTransport
+	}
+	return &Session{inner: inner, ctx: context.Background(), lsRefs: map[string]map[string]*refsCacheEntry{}}
+}
+
+// NewSessionWithContext is NewSession whose operations are bound to the context.
+func NewSessionWithContext(ctx context.Context, inner http.RoundTripper) *Session {
+	s := NewSession(inner)
+	if ctx != nil {
+		s.ctx = ctx
+	}
+	return s
+}
+
+// SetCacheScope sets the identity of the credentials of the session. This needs to be called
+// before the session is used.
+func (s *Session) SetCacheScope(scope string) {
+	s.cacheScope = scope
+}
+
+func (s *Session) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := s.inner.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	resp.Body = &drainingReadCloser{ReadCloser: resp.Body}
+	return resp, nil
+}
+
+// sessionOf returns the Session of the client, or nil if the client doesn't have one.
+func sessionOf(client *http.Client) *Session {
+	if client == nil {
+		return nil
+	}
+	s, _ := client.Transport.(*Session)
+	return s
+}
+
+// CacheScope returns the identity of the credentials of the client, which is a part of the keys
+// of the process-wide caches so that the results fetched with some credentials are not returned
+// to the others. It's empty if the client doesn't have a Session.
+func CacheScope(client *http.Client) string {
+	if s := sessionOf(client); s != nil {
+		return s.cacheScope
+	}
+	return ""
+}
+
+// Context returns the context of the operations with the client. The requests, the waits before
+// the retries, and the git commands of the file:// repositories stop when it's done. It's
+// context.Background() if the client doesn't have a Session.
+func Context(client *http.Client) context.Context {
+	if s := sessionOf(client); s != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+func (s *Session) cachedLsRefs(repoURL, key string) *refsCacheEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lsRefs[repoURL][key]
+}
+
+func (s *Session) storeLsRefs(repoURL, key string, refData []string, headers http.Header) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lsRefs[repoURL] == nil {
+		s.lsRefs[repoURL] = map[string]*refsCacheEntry{}
+	}
+	s.lsRefs[repoURL][key] = &refsCacheEntry{
+		refData: append([]string(nil), refData...),
+		headers: headers.Clone(),
+	}
+}
+
+func (s *Session) invalidateLsRefs(repoURL string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.lsRefs, repoURL)
+}
+
+// drainingReadCloser reads the rest of the body up to sessionDrainLimit on Close.
+type drainingReadCloser struct {
+	io.ReadCloser
+}
+
+func (r *drainingReadCloser) Close() error {
+	_, _ = io.CopyN(io.Discard, r.ReadCloser, sessionDrainLimit)
+	return r.ReadCloser.Close()
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+)
+
+// SpoolThreshold is the packfile size in bytes beyond which a fetched packfile is spooled to a
+// temporary file instead of being kept in memory. Zero or negative disables spooling.
+var SpoolThreshold int64
+
+// Packfile is a fetched packfile. It's kept in memory until it exceeds the spool threshold, and
+// then it's moved to a temporary file. Close must be called to remove the temporary file.
+type Packfile struct {
+	buf  bytes.Buffer
+	file *os.File
+	size int64
+	// threshold is the spool threshold. Zero or negative disables spooling.
+	threshold int64
+	// uriPacks are the packfiles downloaded from the packfile URIs of the response.
+	uriPacks []*Packfile
+}
+
+// newPackfile returns an empty packfile with the spool threshold of the context.
+func newPackfile(ctx context.Context) *Packfile {
+	return &Packfile{threshold: spoolThresholdFrom(ctx)}
+}
+
+func (p *Packfile) Write(bs []byte) (int, error) {
+	if p.file == nil && p.threshold > 0 && p.size+int64(len(bs)) > p.threshold {
+		if err := p.spool(); err != nil {
+			return 0, err
+		}
+	}
+	var n int
+	var err error
+	if p.file != nil {
+		n, err = p.file.Write(bs)
+	} else {
+		n, err = p.buf.Write(bs)
+	}
+	p.size += int64(n)
+	return n, err
+}
+
+func (p *Packfile) spool() error {
+	file, err := os.CreateTemp("", "niche-git-*.pack")
+	if err != nil {
+		return fmt.Errorf("cannot create a temporary file for the packfile: %w", err)
+	}
+	if _, err := file.Write(p.buf.Bytes()); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return fmt.Errorf("cannot write the packfile to a temporary file: %w", err)
+	}
+	p.file = file
+	p.buf = bytes.Buffer{}
+	return nil
+}
+
+// URIPacks returns the packfiles that the server offloaded to the packfile URIs. Their objects
+// are not in this packfile, and need to be parsed before it.
+func (p *Packfile) URIPacks() []*Packfile {
+	if p == nil {
+		return nil
+	}
+	return p.uriPacks
+}
+
+// tail returns the last n bytes of the packfile.
+func (p *Packfile) tail(n int) ([]byte, error) {
+	if int64(n) > p.size {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if p.file == nil {
+		return p.buf.Bytes()[p.size-int64(n):], nil
+	}
+	bs := make([]byte, n)
+	if _, err := p.file.ReadAt(bs, p.size-int64(n)); err != nil {
+		return nil, err
+	}
+	return bs, nil
+}
+
+// Len returns the size of the packfile in bytes.
+func (p *Packfile) Len() int {
+	if p == nil {
+		return 0
+	}
+	return int(p.size)
+}
+
+// Spooled returns true if the packfile is in a temporary file.
+func (p *Packfile) Spooled() bool {
+	return p != nil && p.file != nil
+}
+
+// Reader returns a reader of the packfile content from the beginning.
+func (p *Packfile) Reader() io.Reader {
+	if p == nil {
+		return bytes.NewReader(nil)
+	}
+	if p.file != nil {
+		return io.NewSectionReader(p.file, 0, p.size)
+	}
+	return bytes.NewReader(p.buf.Bytes())
+}
+
+// Close removes the temporary files if the packfile or the packfiles of the URIs are spooled.
+func (p *Packfile) Close() error {
+	if p == nil {
+		return nil
+	}
+	var err error
+	for _, uriPack := range p.uriPacks {
+		if closeErr := uriPack.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	p.uriPacks = nil
+	if p.file == nil {
+		return err
+	}
+	if closeErr := p.file.Close(); err == nil {
+		err = closeErr
+	}
+	if rmErr := os.Remove(p.file.Name()); err == nil {
+		err = rmErr
+	}
+	p.file = nil
+	return err
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestPackfileSpool(t *testing.T) {
+	orig := SpoolThreshold
+	t.Cleanup(func() { SpoolThreshold = orig })
+	SpoolThreshold = 8
+
+	p := newPackfile(context.Background())
+	if _, err := p.Write([]byte("PACK")); err != nil {
+		t.Fatal(err)
+	}
+	if p.Spooled() {
+		t.Fatal("the packfile is spooled before exceeding the threshold")
+	}
+	if _, err := p.Write([]byte("0123456789")); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Spooled() {
+		t.Fatal("the packfile is not spooled after exceeding the threshold")
+	}
+	name := p.file.Name()
+
+	bs, err := io.ReadAll(p.Reader())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != "PACK0123456789" || p.Len() != len(bs) {
+		t.Errorf("unexpected content %q (len %d)", bs, p.Len())
+	}
+
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(name); !os.IsNotExist(err) {
+		t.Errorf("the temporary file is not removed: %v", err)
+	}
+}
+
+func TestPackfileSpoolThresholdOfContext(t *testing.T) {
+	orig := SpoolThreshold
+	t.Cleanup(func() { SpoolThreshold = orig })
+	SpoolThreshold = 8
+
+	// The threshold of the context overrides the process-wide one.
+	threshold := int64(0)
+	p := newPackfile(WithOptions(context.Background(), Options{SpoolThreshold: &threshold}))
+	defer p.Close()
+	if _, err := p.Write([]byte("PACK0123456789")); err != nil {
+		t.Fatal(err)
+	}
+	if p.Spooled() {
+		t.Error("the packfile is spooled though the context disables spooling")
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fetch
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/gitprotocolio"
+)
+
+// FetchTreeOnlyPackfile fetches a packfile from a remote repository with the wanted commits and
+// trees, and without their subtrees and blobs. For a commit, its root tree is included.
+func FetchTreeOnlyPackfile(repoURL string, client *http.Client, oids []plumbing.Hash) (*Packfile, debug.FetchDebugInfo, error) {
+	return fetchPackfileWithFallback(repoURL, client, oids, func(wants []string) *bytes.Buffer {
+		return createTreeOnlyFetchRequest(client, wants)
+	})
+}
+
+func createTreeOnlyFetchRequest(client *http.Client, wants []string) *bytes.Buffer {
+	chunks := commandRequestChunks("fetch")
+	for _, want := range wants {
+		chunks = append(chunks, &gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte(want),
+		})
+	}
+	chunks = append(chunks, progressChunks(client)...)
+	chunks = append(chunks, packfileURIChunks(client)...)
+	chunks = append(chunks,
+		&gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("deepen 1"),
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			// The trees at depth 1 or deeper from the wanted tree or the root tree are omitted.
+			Argument: []byte("filter tree:1"),
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			Argument: []byte("done"),
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			EndArgument: true,
+		},
+		&gitprotocolio.ProtocolV2RequestChunk{
+			EndRequest: true,
+		},
+	)
+	bs := bytes.NewBuffer(nil)
+	for _, chunk := range chunks {
+		// Not possible to fail.
+		bs.Write(chunk.EncodeToPktLine())
+	}
+	return bs
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fileurl
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var driveLetterRE = regexp.MustCompile(`^/?[A-Za-z]:(/|$)`)
+
+// IsFileURL returns true if the repository URL is a file:// URL.
+func IsFileURL(repoURL string) bool {
+	return strings.HasPrefix(strings.ToLower(repoURL), "file:")
+}
+
+// ToPath converts a file:// URL to a slash-separated local path.
+//
+// In addition to the RFC 8089 forms (file:///path, file://localhost/path), this accepts the
+// forms that are common on Windows: drive letters (file:///C:/repo, file://C:/repo), UNC
+// paths (file://server/share/repo), and backslashes as separators (file://C:\repo).
+func ToPath(repoURL string) (string, error) {
+	if !IsFileURL(repoURL) {
+		return "", fmt.Errorf("not a file URL: %q", repoURL)
+	}
+	s := strings.ReplaceAll(repoURL[len("file:"):], `\`, "/")
+	if !strings.HasPrefix(s, "//") {
+		// file:/path or file:C:/path
+		return unescape(s, repoURL)
+	}
+	s = s[len("//"):]
+	if driveLetterRE.MatchString(s) {
+		// file://C:/path. The drive letter is parsed as the host by net/url, so handle it here.
+		return unescape(s, repoURL)
+	}
+	u, err := url.Parse("file://" + s)
+	if err != nil {
+		return "", fmt.Errorf("invalid file URL %q: %w", repoURL, err)
+	}
+	pth := u.Path
+	if driveLetterRE.MatchString(pth) {
+		// file:///C:/path
+		pth = strings.TrimPrefix(pth, "/")
+	}
+	if u.Host != "" && u.Host != "localhost" {
+		// UNC path.
+		return "//" + u.Host + pth, nil
+	}
+	return pth, nil
+}
+
+// ToLocalPath converts a file:// URL to a local path with the OS-specific separators.
+func ToLocalPath(repoURL string) (string, error) {
+	pth, err := ToPath(repoURL)
+	if err != nil {
+		return "", err
+	}
+	return filepath.FromSlash(pth), nil
+}
+
+func unescape(pth, repoURL string) (string, error) {
+	ret, err := url.PathUnescape(pth)
+	if err != nil {
+		return "", fmt.Errorf("invalid file URL %q: %w", repoURL, err)
+	}
+	if driveLetterRE.MatchString(ret) {
+		ret = strings.TrimPrefix(ret, "/")
+	}
+	return ret, nil
+}
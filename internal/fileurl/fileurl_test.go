@@ -0,0 +1,34 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package fileurl
+
+import "testing"
+
+func TestToPath(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"file:///home/user/repo.git", "/home/user/repo.git"},
+		{"file://localhost/home/user/repo.git", "/home/user/repo.git"},
+		{"file:/home/user/repo.git", "/home/user/repo.git"},
+		{"file:///home/user/my%20repo", "/home/user/my repo"},
+		{"file:///C:/Users/user/repo", "C:/Users/user/repo"},
+		{"file://C:/Users/user/repo", "C:/Users/user/repo"},
+		{`file://C:\Users\user\repo`, "C:/Users/user/repo"},
+		{`file:///c:\repo`, "c:/repo"},
+		{"file://server/share/repo", "//server/share/repo"},
+		{"FILE:///tmp/repo", "/tmp/repo"},
+	}
+	for _, tt := range tests {
+		got, err := ToPath(tt.url)
+		if err != nil {
+			t.Errorf("ToPath(%q) failed: %v", tt.url, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ToPath(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
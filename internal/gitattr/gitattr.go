@@ -0,0 +1,190 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Package gitattr evaluates the .gitattributes files in a tree.
+package gitattr
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/gitattributes"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+const fileName = ".gitattributes"
+
+// Values of the attributes in addition to the ones set with "attr=value". These are the same as
+// the output of git check-attr.
+const (
+	ValueSet         = "set"
+	ValueUnset       = "unset"
+	ValueUnspecified = "unspecified"
+)
+
+// Files returns the blob hashes of the .gitattributes files that apply to the paths, keyed by
+// their directory. The root directory is "".
+//
+// Only the trees need to be in the storage.
+func Files(storage storer.EncodedObjectStorer, tree *object.Tree, paths []string) (map[string]plumbing.Hash, error) {
+	ret := map[string]plumbing.Hash{}
+	visited := map[string]bool{}
+	for _, pth := range paths {
+		dir := ""
+		current := tree
+		segments := strings.Split(path.Dir(path.Clean(pth)), "/")
+		if segments[0] == "." {
+			segments = nil
+		}
+		for i := 0; ; i++ {
+			if !visited[dir] {
+				visited[dir] = true
+				if entry, err := current.FindEntry(fileName); err == nil && entry.Mode.IsFile() {
+					ret[dir] = entry.Hash
+				}
+			}
+			if i == len(segments) {
+				break
+			}
+			entry, err := current.FindEntry(segments[i])
+			if err != nil || entry.Mode.IsFile() {
+				// The rest of the directories don't exist.
+				break
+			}
+			current, err = object.GetTree(storage, entry.Hash)
+			if err != nil {
+				return nil, fmt.Errorf("cannot find the tree of %q: %w", path.Join(dir, segments[i]), err)
+			}
+			dir = path.Join(dir, segments[i])
+		}
+	}
+	return ret, nil
+}
+
+// Matcher evaluates the attributes of the paths.
+type Matcher struct {
+	// stack is the lines of the .gitattributes files in the order of increasing precedence.
+	stack  []gitattributes.MatchAttribute
+	macros map[string]gitattributes.MatchAttribute
+}
+
+// NewMatcher creates a matcher from the .gitattributes files returned by Files. The blobs need
+// to be in the storage.
+func NewMatcher(storage storer.EncodedObjectStorer, files map[string]plumbing.Hash) (*Matcher, error) {
+	var dirs []string
+	for dir := range files {
+		dirs = append(dirs, dir)
+	}
+	// The files in the deeper directories take precedence.
+	sort.Slice(dirs, func(i, j int) bool {
+		if di, dj := depth(dirs[i]), depth(dirs[j]); di != dj {
+			return di < dj
+		}
+		return dirs[i] < dirs[j]
+	})
+	// Git defines the binary macro implicitly.
+	binary, err := gitattributes.ParseAttributesLine("[attr]binary -diff -merge -text", nil, true)
+	if err != nil {
+		return nil, err
+	}
+	stack := []gitattributes.MatchAttribute{binary}
+	for _, dir := range dirs {
+		blob, err := object.GetBlob(storage, files[dir])
+		if err != nil {
+			return nil, fmt.Errorf("cannot find %q: %w", path.Join(dir, fileName), err)
+		}
+		rd, err := blob.Reader()
+		if err != nil {
+			return nil, err
+		}
+		var domain []string
+		if dir != "" {
+			domain = strings.Split(dir, "/")
+		}
+		// Macros can be defined only in the root .gitattributes.
+		attrs, err := gitattributes.ReadAttributes(rd, domain, dir == "")
+		rd.Close()
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %q: %w", path.Join(dir, fileName), err)
+		}
+		stack = append(stack, attrs...)
+	}
+	m := &Matcher{stack: stack, macros: map[string]gitattributes.MatchAttribute{}}
+	for _, attr := range stack {
+		if attr.Pattern == nil {
+			m.macros[attr.Name] = attr
+		}
+	}
+	return m, nil
+}
+
+// match returns the attributes of the path. The later lines take precedence per attribute.
+//
+// This doesn't use gitattributes.Matcher as it lets the earlier lines override the later ones.
+func (m *Matcher) match(pth []string) map[string]gitattributes.Attribute {
+	results := map[string]gitattributes.Attribute{}
+	for i := len(m.stack) - 1; i >= 0; i-- {
+		line := m.stack[i]
+		if line.Pattern == nil || !line.Pattern.Match(pth) {
+			continue
+		}
+		// The later attributes in the same line take precedence as well.
+		for j := len(line.Attributes) - 1; j >= 0; j-- {
+			attr := line.Attributes[j]
+			if _, ok := results[attr.Name()]; ok {
+				continue
+			}
+			results[attr.Name()] = attr
+			if macro, ok := m.macros[attr.Name()]; ok && attr.IsSet() {
+				for _, macroAttr := range macro.Attributes {
+					if _, ok := results[macroAttr.Name()]; !ok {
+						results[macroAttr.Name()] = macroAttr
+					}
+				}
+			}
+		}
+	}
+	return results
+}
+
+// Lookup returns the attributes of the path. If names is empty, all the specified attributes are
+// returned. Otherwise, the named attributes are returned, with ValueUnspecified for the ones
+// that are not specified.
+func (m *Matcher) Lookup(pth string, names []string) map[string]string {
+	results := m.match(strings.Split(path.Clean(pth), "/"))
+	ret := map[string]string{}
+	for _, name := range names {
+		ret[name] = ValueUnspecified
+	}
+	for name, attr := range results {
+		if _, ok := ret[name]; !ok && len(names) > 0 {
+			continue
+		}
+		switch {
+		case attr.IsSet():
+			ret[name] = ValueSet
+		case attr.IsUnset():
+			ret[name] = ValueUnset
+		case attr.IsValueSet():
+			ret[name] = attr.Value()
+		default:
+			if len(names) > 0 {
+				ret[name] = ValueUnspecified
+			} else {
+				delete(ret, name)
+			}
+		}
+	}
+	return ret
+}
+
+func depth(dir string) int {
+	if dir == "" {
+		return 0
+	}
+	return strings.Count(dir, "/") + 1
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package gitattr
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLookup(t *testing.T) {
+	storage := memory.NewStorage()
+	tree := writeTree(t, storage, map[string]string{
+		".gitattributes":             "*.pb.go linguist-generated\n*.png binary\n*.txt eol=lf text\n[attr]vendored linguist-vendored -diff\n",
+		"api/.gitattributes":         "*.pb.go -linguist-generated\nlegacy/** merge=ours\n",
+		"api/legacy/x.txt":           "x",
+		"api/v1/service.pb.go":       "package v1",
+		"third_party/.gitattributes": "* vendored\n",
+		"third_party/lib.go":         "package lib",
+	})
+
+	files, err := Files(storage, tree, []string{"api/legacy/x.txt", "api/v1/service.pb.go", "other/service.pb.go", "third_party/lib.go"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dirs []string
+	for dir := range files {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	if diff := cmp.Diff([]string{"", "api", "third_party"}, dirs); diff != "" {
+		t.Errorf("Files diff (-want +got):\n%s", diff)
+	}
+
+	matcher, err := NewMatcher(storage, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		pth   string
+		names []string
+		want  map[string]string
+	}{
+		{"other/service.pb.go", []string{"linguist-generated", "merge"}, map[string]string{"linguist-generated": ValueSet, "merge": ValueUnspecified}},
+		{"api/v1/service.pb.go", []string{"linguist-generated"}, map[string]string{"linguist-generated": ValueUnset}},
+		{"api/legacy/x.txt", nil, map[string]string{"merge": "ours", "eol": "lf", "text": ValueSet}},
+		{"image.png", nil, map[string]string{"binary": ValueSet, "diff": ValueUnset, "merge": ValueUnset, "text": ValueUnset}},
+		{"third_party/lib.go", nil, map[string]string{"vendored": ValueSet, "linguist-vendored": ValueSet, "diff": ValueUnset}},
+	}
+	for _, tt := range tests {
+		if diff := cmp.Diff(tt.want, matcher.Lookup(tt.pth, tt.names)); diff != "" {
+			t.Errorf("Lookup(%q, %q) diff (-want +got):\n%s", tt.pth, tt.names, diff)
+		}
+	}
+}
+
+// writeTree writes the files to the storage and returns the root tree.
+func writeTree(t *testing.T, storage *memory.Storage, files map[string]string) *object.Tree {
+	t.Helper()
+	type dir struct {
+		files map[string]string
+		dirs  map[string]*dir
+	}
+	root := &dir{files: map[string]string{}, dirs: map[string]*dir{}}
+	for pth, content := range files {
+		d := root
+		segments := strings.Split(pth, "/")
+		for _, s := range segments[:len(segments)-1] {
+			if _, ok := d.dirs[s]; !ok {
+				d.dirs[s] = &dir{files: map[string]string{}, dirs: map[string]*dir{}}
+			}
+			d = d.dirs[s]
+		}
+		d.files[segments[len(segments)-1]] = content
+	}
+	var write func(d *dir) plumbing.Hash
+	write = func(d *dir) plumbing.Hash {
+		tree := &object.Tree{}
+		for name, content := range d.files {
+			obj := storage.NewEncodedObject()
+			obj.SetType(plumbing.BlobObject)
+			w, _ := obj.Writer()
+			_, _ = w.Write([]byte(content))
+			_ = w.Close()
+			hash, err := storage.SetEncodedObject(obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tree.Entries = append(tree.Entries, object.TreeEntry{Name: name, Mode: filemode.Regular, Hash: hash})
+		}
+		for name, sub := range d.dirs {
+			tree.Entries = append(tree.Entries, object.TreeEntry{Name: name, Mode: filemode.Dir, Hash: write(sub)})
+		}
+		sort.Slice(tree.Entries, func(i, j int) bool { return tree.Entries[i].Name < tree.Entries[j].Name })
+		obj := storage.NewEncodedObject()
+		if err := tree.Encode(obj); err != nil {
+			t.Fatal(err)
+		}
+		hash, err := storage.SetEncodedObject(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+	tree, err := object.GetTree(storage, write(root))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tree
+}
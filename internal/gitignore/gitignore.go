@@ -0,0 +1,243 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Package gitignore evaluates the .gitignore files in a tree.
+package gitignore
+
+import (
+	"bufio"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+const fileName = ".gitignore"
+
+// Files returns the blob hashes of the .gitignore files that apply to the paths, keyed by their
+// directory. The root directory is "".
+//
+// Only the trees need to be in the storage.
+func Files(storage storer.EncodedObjectStorer, tree *object.Tree, paths []string) (map[string]plumbing.Hash, error) {
+	ret := map[string]plumbing.Hash{}
+	visited := map[string]bool{}
+	for _, pth := range paths {
+		dir := ""
+		current := tree
+		segments := strings.Split(path.Dir(path.Clean(pth)), "/")
+		if segments[0] == "." {
+			segments = nil
+		}
+		for i := 0; ; i++ {
+			if !visited[dir] {
+				visited[dir] = true
+				if entry, err := current.FindEntry(fileName); err == nil && entry.Mode.IsFile() {
+					ret[dir] = entry.Hash
+				}
+			}
+			if i == len(segments) {
+				break
+			}
+			entry, err := current.FindEntry(segments[i])
+			if err != nil || entry.Mode.IsFile() {
+				// The rest of the directories don't exist.
+				break
+			}
+			current, err = object.GetTree(storage, entry.Hash)
+			if err != nil {
+				return nil, fmt.Errorf("cannot find the tree of %q: %w", path.Join(dir, segments[i]), err)
+			}
+			dir = path.Join(dir, segments[i])
+		}
+	}
+	return ret, nil
+}
+
+// Rule is a line of a .gitignore file.
+type Rule struct {
+	// Source is the path of the .gitignore file.
+	Source string
+	// LineNumber is the 1-based line number in the source.
+	LineNumber int
+	// Pattern is the line as written, including the "!" prefix.
+	Pattern string
+
+	dir      []string
+	negated  bool
+	dirOnly  bool
+	basename bool
+	segments []string
+}
+
+// Matcher evaluates whether the paths are ignored.
+type Matcher struct {
+	// rules is the lines of the .gitignore files in the order of increasing precedence.
+	rules []*Rule
+}
+
+// NewMatcher creates a matcher from the .gitignore files returned by Files. The blobs need to be
+// in the storage.
+func NewMatcher(storage storer.EncodedObjectStorer, files map[string]plumbing.Hash) (*Matcher, error) {
+	var dirs []string
+	for dir := range files {
+		dirs = append(dirs, dir)
+	}
+	// The files in the deeper directories take precedence.
+	sort.Slice(dirs, func(i, j int) bool {
+		if di, dj := depth(dirs[i]), depth(dirs[j]); di != dj {
+			return di < dj
+		}
+		return dirs[i] < dirs[j]
+	})
+	m := &Matcher{}
+	for _, dir := range dirs {
+		source := path.Join(dir, fileName)
+		blob, err := object.GetBlob(storage, files[dir])
+		if err != nil {
+			return nil, fmt.Errorf("cannot find %q: %w", source, err)
+		}
+		rd, err := blob.Reader()
+		if err != nil {
+			return nil, err
+		}
+		var domain []string
+		if dir != "" {
+			domain = strings.Split(dir, "/")
+		}
+		scanner := bufio.NewScanner(rd)
+		lineNumber := 0
+		for scanner.Scan() {
+			lineNumber++
+			if rule := parseRule(scanner.Text(), domain); rule != nil {
+				rule.Source = source
+				rule.LineNumber = lineNumber
+				m.rules = append(m.rules, rule)
+			}
+		}
+		rd.Close()
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("cannot read %q: %w", source, err)
+		}
+	}
+	return m, nil
+}
+
+// parseRule parses a line of a .gitignore file. It returns nil for blank lines and comments.
+func parseRule(line string, dir []string) *Rule {
+	rule := &Rule{Pattern: strings.TrimSuffix(line, "\r"), dir: dir}
+	p := rule.Pattern
+	if p == "" || strings.HasPrefix(p, "#") {
+		return nil
+	}
+	// Trailing spaces are ignored unless they are escaped.
+	for strings.HasSuffix(p, " ") && !strings.HasSuffix(p, "\\ ") {
+		p = p[:len(p)-1]
+	}
+	rule.Pattern = p
+	if strings.HasPrefix(p, "!") {
+		rule.negated = true
+		p = p[1:]
+	} else if strings.HasPrefix(p, "\\!") || strings.HasPrefix(p, "\\#") {
+		p = p[1:]
+	}
+	if strings.HasSuffix(p, "/") {
+		rule.dirOnly = true
+		p = strings.TrimRight(p, "/")
+	}
+	if p == "" {
+		return nil
+	}
+	// A pattern without a slash matches the basename at any depth. Otherwise, it's relative to
+	// the directory of the .gitignore file.
+	if !strings.Contains(p, "/") {
+		rule.basename = true
+	}
+	rule.segments = strings.Split(strings.TrimPrefix(p, "/"), "/")
+	return rule
+}
+
+// match returns true if the rule matches the path. This doesn't look at the parent directories.
+func (r *Rule) match(pth []string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+	if len(pth) <= len(r.dir) {
+		return false
+	}
+	for i, seg := range r.dir {
+		if pth[i] != seg {
+			return false
+		}
+	}
+	pth = pth[len(r.dir):]
+	if r.basename {
+		ok, _ := path.Match(r.segments[0], pth[len(pth)-1])
+		return ok
+	}
+	return matchSegments(r.segments, pth)
+}
+
+// matchSegments matches the path segments with the pattern segments, where "**" matches zero or
+// more directories. A trailing "**" matches everything inside, but not the directory itself.
+func matchSegments(pattern, pth []string) bool {
+	if len(pattern) == 0 {
+		return len(pth) == 0
+	}
+	if pattern[0] == "**" {
+		if len(pattern) == 1 {
+			return len(pth) > 0
+		}
+		for i := 0; i <= len(pth); i++ {
+			if matchSegments(pattern[1:], pth[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(pth) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pattern[0], pth[0]); !ok {
+		return false
+	}
+	return matchSegments(pattern[1:], pth[1:])
+}
+
+// lastMatch returns the rule with the highest precedence that matches the path.
+func (m *Matcher) lastMatch(pth []string, isDir bool) *Rule {
+	for i := len(m.rules) - 1; i >= 0; i-- {
+		if m.rules[i].match(pth, isDir) {
+			return m.rules[i]
+		}
+	}
+	return nil
+}
+
+// Match returns whether the path is ignored, and the rule that decided it. The rule is nil if no
+// rule matches. It's a negated rule if the path is re-included.
+//
+// Same as git, a path in an ignored directory is ignored and cannot be re-included.
+func (m *Matcher) Match(pth string, isDir bool) (bool, *Rule) {
+	segments := strings.Split(path.Clean(pth), "/")
+	for i := 1; i < len(segments); i++ {
+		if rule := m.lastMatch(segments[:i], true); rule != nil && !rule.negated {
+			return true, rule
+		}
+	}
+	rule := m.lastMatch(segments, isDir)
+	if rule == nil {
+		return false, nil
+	}
+	return !rule.negated, rule
+}
+
+func depth(dir string) int {
+	if dir == "" {
+		return 0
+	}
+	return strings.Count(dir, "/") + 1
+}
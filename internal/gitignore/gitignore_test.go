@@ -0,0 +1,93 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package gitignore
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	m := newTestMatcher(map[string]string{
+		"":    "# build outputs\n*.log\n/dist/\nbuild/\n!important.log\ndocs/**/*.html\ncache/**\n",
+		"api": "generated/\n!debug.log\n",
+	})
+	tests := []struct {
+		pth     string
+		isDir   bool
+		ignored bool
+		pattern string
+	}{
+		{"app.log", false, true, "*.log"},
+		{"src/app.log", false, true, "*.log"},
+		{"important.log", false, false, "!important.log"},
+		{"api/debug.log", false, false, "!debug.log"},
+		{"dist", true, true, "/dist/"},
+		{"dist", false, false, ""},
+		{"dist/app.js", false, true, "/dist/"},
+		{"src/dist/app.js", false, false, ""},
+		{"src/build/out.o", false, true, "build/"},
+		// A path in an ignored directory cannot be re-included.
+		{"build/important.log", false, true, "build/"},
+		{"docs/index.html", false, true, "docs/**/*.html"},
+		{"docs/a/b/index.html", false, true, "docs/**/*.html"},
+		{"cache", true, false, ""},
+		{"cache/a/b", false, true, "cache/**"},
+		{"api/generated/x.go", false, true, "generated/"},
+		{"generated/x.go", false, false, ""},
+		{"src/main.go", false, false, ""},
+	}
+	for _, tt := range tests {
+		ignored, rule := m.Match(tt.pth, tt.isDir)
+		pattern := ""
+		if rule != nil {
+			pattern = rule.Pattern
+		}
+		if ignored != tt.ignored || pattern != tt.pattern {
+			t.Errorf("Match(%q, %v) = %v, %q; want %v, %q", tt.pth, tt.isDir, ignored, pattern, tt.ignored, tt.pattern)
+		}
+	}
+}
+
+func TestParseRule(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"", ""},
+		{"# comment", ""},
+		{"\\#file", "\\#file"},
+		{"trailing   ", "trailing"},
+		{"escaped\\ ", "escaped\\ "},
+		{"!", ""},
+	}
+	for _, tt := range tests {
+		rule := parseRule(tt.line, nil)
+		got := ""
+		if rule != nil {
+			got = rule.Pattern
+		}
+		if got != tt.want {
+			t.Errorf("parseRule(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
+
+// newTestMatcher creates a matcher from the .gitignore contents keyed by their directory.
+func newTestMatcher(files map[string]string) *Matcher {
+	m := &Matcher{}
+	for _, dir := range []string{"", "api"} {
+		var domain []string
+		if dir != "" {
+			domain = strings.Split(dir, "/")
+		}
+		for i, line := range strings.Split(files[dir], "\n") {
+			if rule := parseRule(line, domain); rule != nil {
+				rule.LineNumber = i + 1
+				m.rules = append(m.rules, rule)
+			}
+		}
+	}
+	return m
+}
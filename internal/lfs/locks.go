@@ -0,0 +1,111 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package lfs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/fileurl"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+const mediaType = "application/vnd.git-lfs+json"
+
+// Lock is a file lock. See https://github.com/git-lfs/git-lfs/blob/main/docs/api/locking.md.
+type Lock struct {
+	ID    string `json:"id"`
+	Path  string `json:"path"`
+	Owner struct {
+		Name string `json:"name"`
+	} `json:"owner"`
+}
+
+type verifyRequest struct {
+	Ref    verifyRef `json:"ref"`
+	Cursor string    `json:"cursor,omitempty"`
+}
+
+type verifyRef struct {
+	Name string `json:"name"`
+}
+
+type verifyResponse struct {
+	Ours       []Lock `json:"ours"`
+	Theirs     []Lock `json:"theirs"`
+	NextCursor string `json:"next_cursor"`
+	Message    string `json:"message"`
+}
+
+// VerifyLocks calls the lock verification endpoint for pushing to the ref and returns the locks
+// owned by others.
+//
+// If the server doesn't support LFS locking, this returns no locks. The same goes for the file://
+// repositories, which don't have the LFS API.
+func VerifyLocks(repoURL string, client *http.Client, ref plumbing.ReferenceName) ([]Lock, error) {
+	if fileurl.IsFileURL(repoURL) {
+		return nil, nil
+	}
+	verifyURL, err := buildLFSURL(repoURL, "locks/verify")
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	var theirs []Lock
+	cursor := ""
+	for {
+		bs, err := json.Marshal(verifyRequest{Ref: verifyRef{Name: ref.String()}, Cursor: cursor})
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequestWithContext(fetch.Context(client), "POST", verifyURL, bytes.NewReader(bs))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", mediaType)
+		req.Header.Set("Accept", mediaType)
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		var body verifyResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNotImplemented {
+			// Locking is not supported.
+			return nil, nil
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("LFS lock verification failed with status code %d: %s", resp.StatusCode, body.Message)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the LFS lock verification response: %w", err)
+		}
+		theirs = append(theirs, body.Theirs...)
+		if body.NextCursor == "" {
+			return theirs, nil
+		}
+		cursor = body.NextCursor
+	}
+}
+
+// buildLFSURL returns the URL of the LFS API endpoint. This follows the default of the git-lfs
+// client, which appends ".git/info/lfs" or "/info/lfs" to the repository URL.
+func buildLFSURL(repoURL, endpoint string) (string, error) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasSuffix(u.Path, ".git") {
+		u.Path = strings.TrimSuffix(u.Path, "/") + ".git"
+	}
+	return u.JoinPath("info", "lfs", endpoint).String(), nil
+}
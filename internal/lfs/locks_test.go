@@ -0,0 +1,63 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package lfs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestVerifyLocks(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repo.git/info/lfs/locks/verify" {
+			http.NotFound(w, r)
+			return
+		}
+		var req verifyRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("cannot parse the request: %v", err)
+		}
+		if req.Ref.Name != "refs/heads/main" {
+			t.Errorf("unexpected ref %q", req.Ref.Name)
+		}
+		resp := verifyResponse{}
+		if req.Cursor == "" {
+			resp.Theirs = []Lock{{ID: "1", Path: "a.bin"}}
+			resp.NextCursor = "next"
+		} else {
+			resp.Theirs = []Lock{{ID: "2", Path: "b.bin"}}
+		}
+		w.Header().Set("Content-Type", mediaType)
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	locks, err := VerifyLocks(srv.URL+"/repo", srv.Client(), plumbing.NewBranchReferenceName("main"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(locks) != 2 || locks[0].Path != "a.bin" || locks[1].Path != "b.bin" {
+		t.Errorf("unexpected locks: %+v", locks)
+	}
+
+	locks, err = VerifyLocks(srv.URL+"/unsupported.git", srv.Client(), plumbing.NewBranchReferenceName("main"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(locks) != 0 {
+		t.Errorf("expected no locks for a server without locking, got %+v", locks)
+	}
+
+	locks, err = VerifyLocks("file:///tmp/repo.git", nil, plumbing.NewBranchReferenceName("main"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(locks) != 0 {
+		t.Errorf("expected no locks for a file:// repository, got %+v", locks)
+	}
+}
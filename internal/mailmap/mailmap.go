@@ -0,0 +1,124 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Package mailmap maps the author and committer identities with a .mailmap file, like git's
+// %aN and %aE.
+package mailmap
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+)
+
+// FileName is the name of the mailmap file at the root of the tree.
+const FileName = ".mailmap"
+
+// Mailmap is a parsed .mailmap file. A nil Mailmap maps nothing.
+type Mailmap struct {
+	// entries are keyed by the lower-cased commit email.
+	entries map[string]*entry
+}
+
+type entry struct {
+	identity
+	// names are the identities for the specific commit names, keyed by the lower-cased name.
+	names map[string]*identity
+}
+
+type identity struct {
+	// name and email are the canonical ones. Empty if not replaced.
+	name  string
+	email string
+}
+
+// Parse parses the content of a .mailmap file. The lines that cannot be parsed are ignored, same
+// as git. The supported forms are:
+//
+//	Proper Name <commit@email>
+//	<proper@email> <commit@email>
+//	Proper Name <proper@email> <commit@email>
+//	Proper Name <proper@email> Commit Name <commit@email>
+func Parse(content []byte) *Mailmap {
+	m := &Mailmap{entries: map[string]*entry{}}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		name1, email1, rest, ok := parseNameAndEmail(line)
+		if !ok {
+			continue
+		}
+		if name2, email2, _, ok := parseNameAndEmail(rest); ok {
+			m.add(name1, email1, name2, email2)
+		} else {
+			m.add(name1, "", "", email1)
+		}
+	}
+	return m
+}
+
+// parseNameAndEmail parses "Name <email>" at the start of the line. The name can be empty.
+func parseNameAndEmail(line string) (string, string, string, bool) {
+	left := strings.IndexByte(line, '<')
+	if left < 0 {
+		return "", "", "", false
+	}
+	right := strings.IndexByte(line[left+1:], '>')
+	if right < 0 {
+		return "", "", "", false
+	}
+	name := strings.TrimSpace(line[:left])
+	email := line[left+1 : left+1+right]
+	return name, email, line[left+1+right+1:], true
+}
+
+func (m *Mailmap) add(newName, newEmail, oldName, oldEmail string) {
+	key := strings.ToLower(oldEmail)
+	e, ok := m.entries[key]
+	if !ok {
+		e = &entry{names: map[string]*identity{}}
+		m.entries[key] = e
+	}
+	id := &e.identity
+	if oldName != "" {
+		nameKey := strings.ToLower(oldName)
+		id, ok = e.names[nameKey]
+		if !ok {
+			id = &identity{}
+			e.names[nameKey] = id
+		}
+	}
+	// A later line replaces only the fields it has.
+	if newName != "" {
+		id.name = newName
+	}
+	if newEmail != "" {
+		id.email = newEmail
+	}
+}
+
+// Map returns the canonical name and email of the identity. The emails and the names are matched
+// case-insensitively. The identity is returned as is if no line matches.
+func (m *Mailmap) Map(name, email string) (string, string) {
+	if m == nil {
+		return name, email
+	}
+	e, ok := m.entries[strings.ToLower(email)]
+	if !ok {
+		return name, email
+	}
+	id := &e.identity
+	if named, ok := e.names[strings.ToLower(name)]; ok {
+		id = named
+	}
+	if id.name != "" {
+		name = id.name
+	}
+	if id.email != "" {
+		email = id.email
+	}
+	return name, email
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package mailmap
+
+import "testing"
+
+func TestMap(t *testing.T) {
+	m := Parse([]byte(`# Canonical identities
+Alice Smith <alice@example.com>
+<bob@example.com> <bob@old.example.com>
+Carol Jones <carol@example.com> <carol@personal.example.com>
+Dave <dave@example.com> dave <build@example.com>
+Erin <erin@example.com> Erin Old <build@example.com>
+broken line <without-end
+`))
+	tests := []struct {
+		name, email         string
+		wantName, wantEmail string
+	}{
+		{"alice", "alice@example.com", "Alice Smith", "alice@example.com"},
+		{"alice", "ALICE@example.com", "Alice Smith", "ALICE@example.com"},
+		{"Bob", "bob@old.example.com", "Bob", "bob@example.com"},
+		{"carol", "carol@personal.example.com", "Carol Jones", "carol@example.com"},
+		{"Dave", "build@example.com", "Dave", "dave@example.com"},
+		{"erin old", "build@example.com", "Erin", "erin@example.com"},
+		// No line for the name of the shared email.
+		{"bot", "build@example.com", "bot", "build@example.com"},
+		{"frank", "frank@example.com", "frank", "frank@example.com"},
+	}
+	for _, tt := range tests {
+		name, email := m.Map(tt.name, tt.email)
+		if name != tt.wantName || email != tt.wantEmail {
+			t.Errorf("Map(%q, %q) = %q, %q; want %q, %q", tt.name, tt.email, name, email, tt.wantName, tt.wantEmail)
+		}
+	}
+}
+
+func TestMapNil(t *testing.T) {
+	var m *Mailmap
+	if name, email := m.Map("alice", "alice@example.com"); name != "alice" || email != "alice@example.com" {
+		t.Errorf("Map = %q, %q; want the identity as is", name, email)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// CorpusLabels are the labels of the conflict markers in the expected files of a corpus case.
+var CorpusLabels = Labels{Ours: "ours", Base: "base", Theirs: "theirs"}
+
+// CorpusCaseResult is the result of a corpus case.
+type CorpusCaseResult struct {
+	// Name is the directory name of the case.
+	Name string
+	// FilesConflict are the files that MergeTree reported as conflicts.
+	FilesConflict []string
+	// Mismatches are the files whose merged content differs from the expected one.
+	Mismatches []*CorpusMismatch
+}
+
+// CorpusMismatch is a file in the merge result that differs from the expected one.
+type CorpusMismatch struct {
+	Path string
+	// Expected and Actual are the contents. Nil if the file doesn't exist on the side.
+	Expected []byte
+	Actual   []byte
+}
+
+// RunCorpus runs the cases in the subdirectories of the directory in the name order. See
+// RunCorpusCase.
+func RunCorpus(dir string) ([]*CorpusCaseResult, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*CorpusCaseResult
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		result, err := RunCorpusCase(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("case %s: %w", entry.Name(), err)
+		}
+		ret = append(ret, result)
+	}
+	return ret, nil
+}
+
+// RunCorpusCase merges the "ours" and "theirs" directories of the case directory on the "base"
+// directory with MergeTree and Diff3Resolver, and compares the result with the "expected"
+// directory. A missing directory is an empty tree. The conflict markers use CorpusLabels.
+func RunCorpusCase(dir string) (*CorpusCaseResult, error) {
+	storage := memory.NewStorage()
+	var trees []*object.Tree
+	for _, name := range []string{"ours", "theirs", "base"} {
+		hash, err := storeDir(storage, filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		tree, err := object.GetTree(storage, hash)
+		if err != nil {
+			return nil, err
+		}
+		trees = append(trees, tree)
+	}
+	resolver := NewDiff3Resolver(storage, CorpusLabels)
+	mergeResult, err := MergeTree(storage, trees[0], trees[1], trees[2], resolver.Resolve)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge the trees: %w", err)
+	}
+	mergedTree, err := object.GetTree(storage, mergeResult.TreeHash)
+	if err != nil {
+		return nil, err
+	}
+	actual, err := treeFiles(mergedTree)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := dirFiles(filepath.Join(dir, "expected"))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CorpusCaseResult{Name: filepath.Base(dir), FilesConflict: resolver.FilesConflict}
+	var paths []string
+	for pth := range expected {
+		paths = append(paths, pth)
+	}
+	for pth := range actual {
+		if _, ok := expected[pth]; !ok {
+			paths = append(paths, pth)
+		}
+	}
+	sort.Strings(paths)
+	for _, pth := range paths {
+		e, eok := expected[pth]
+		a, aok := actual[pth]
+		if eok && aok && bytes.Equal(e, a) {
+			continue
+		}
+		result.Mismatches = append(result.Mismatches, &CorpusMismatch{Path: pth, Expected: e, Actual: a})
+	}
+	return result, nil
+}
+
+// storeDir stores the files in the directory as a tree, and returns its hash. A missing
+// directory is an empty tree.
+func storeDir(storage *memory.Storage, dir string) (plumbing.Hash, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return plumbing.ZeroHash, err
+	}
+	tree := &object.Tree{}
+	for _, entry := range entries {
+		pth := filepath.Join(dir, entry.Name())
+		if entry.IsDir() {
+			hash, err := storeDir(storage, pth)
+			if err != nil {
+				return plumbing.ZeroHash, err
+			}
+			tree.Entries = append(tree.Entries, object.TreeEntry{Name: entry.Name(), Mode: filemode.Dir, Hash: hash})
+			continue
+		}
+		content, err := os.ReadFile(pth)
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		mode := filemode.Regular
+		if info.Mode()&0100 != 0 {
+			mode = filemode.Executable
+		}
+		obj := storage.NewEncodedObject()
+		obj.SetType(plumbing.BlobObject)
+		w, err := obj.Writer()
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return plumbing.ZeroHash, err
+		}
+		if err := w.Close(); err != nil {
+			return plumbing.ZeroHash, err
+		}
+		hash, err := storage.SetEncodedObject(obj)
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		tree.Entries = append(tree.Entries, object.TreeEntry{Name: entry.Name(), Mode: mode, Hash: hash})
+	}
+	SortTreeEntries(tree.Entries)
+	obj := storage.NewEncodedObject()
+	if err := tree.Encode(obj); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return storage.SetEncodedObject(obj)
+}
+
+// treeFiles returns the contents of the files in the tree by their paths.
+func treeFiles(tree *object.Tree) (map[string][]byte, error) {
+	ret := map[string][]byte{}
+	err := tree.Files().ForEach(func(f *object.File) error {
+		rd, err := f.Reader()
+		if err != nil {
+			return err
+		}
+		defer rd.Close()
+		content, err := io.ReadAll(rd)
+		if err != nil {
+			return err
+		}
+		ret[f.Name] = content
+		return nil
+	})
+	return ret, err
+}
+
+// dirFiles returns the contents of the files in the directory by their slash-separated paths. A
+// missing directory has no files.
+func dirFiles(dir string) (map[string][]byte, error) {
+	ret := map[string][]byte{}
+	err := filepath.WalkDir(dir, func(pth string, d os.DirEntry, err error) error {
+		if err != nil {
+			if pth == dir && os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, pth)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(pth)
+		if err != nil {
+			return err
+		}
+		ret[path.Clean(filepath.ToSlash(rel))] = content
+		return nil
+	})
+	return ret, err
+}
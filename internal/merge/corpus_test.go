@@ -0,0 +1,67 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCorpus(t *testing.T) {
+	results, err := RunCorpus("testdata/corpus")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) == 0 {
+		t.Fatal("no cases")
+	}
+	for _, result := range results {
+		for _, m := range result.Mismatches {
+			t.Errorf("%s: %s is %q, want %q", result.Name, m.Path, m.Actual, m.Expected)
+		}
+	}
+}
+
+func TestRunCorpusCase_Mismatch(t *testing.T) {
+	dir := t.TempDir()
+	write := func(pth, content string) {
+		pth = filepath.Join(dir, pth)
+		if err := os.MkdirAll(filepath.Dir(pth), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(pth, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("base/file.txt", "a\n")
+	write("ours/file.txt", "b\n")
+	write("theirs/file.txt", "c\n")
+	write("expected/file.txt", "b\n")
+	write("expected/missing.txt", "missing\n")
+
+	result, err := RunCorpusCase(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"file.txt"}, result.FilesConflict); diff != "" {
+		t.Errorf("FilesConflict mismatch (-want +got):\n%s", diff)
+	}
+	want := []*CorpusMismatch{
+		{
+			Path:     "file.txt",
+			Expected: []byte("b\n"),
+			Actual:   []byte("<<<<<<< ours\nb\n||||||| base\na\n=======\nc\n>>>>>>> theirs\n"),
+		},
+		{
+			Path:     "missing.txt",
+			Expected: []byte("missing\n"),
+		},
+	}
+	if diff := cmp.Diff(want, result.Mismatches); diff != "" {
+		t.Errorf("Mismatches mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,391 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/aviator-co/niche-git/internal/diff"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// Labels are the names shown in the conflict markers.
+type Labels struct {
+	Ours   string
+	Base   string
+	Theirs string
+}
+
+// Merge3 merges the changes from base to ours and from base to theirs line by line, like
+// git merge-file --diff3. It returns the merged content and whether there are conflicts. The
+// conflicting hunks are written with the conflict markers including the base lines.
+func Merge3(base, ours, theirs []byte, labels Labels) ([]byte, bool) {
+	var out []string
+	conflict := false
+	walk3(base, ours, theirs, func(h *hunk3) {
+		if h.stable {
+			out = append(out, h.base...)
+			return
+		}
+		lines, c := mergeHunk(h.base, h.ours, h.theirs, labels)
+		out = append(out, lines...)
+		conflict = conflict || c
+	})
+	return []byte(strings.Join(out, "")), conflict
+}
+
+// ConflictHunk is a hunk that Merge3 writes with the conflict markers. The lines have the
+// newlines.
+type ConflictHunk struct {
+	// OursStart, BaseStart, and TheirsStart are the 0-based line numbers of the hunk in each
+	// side.
+	OursStart   int
+	BaseStart   int
+	TheirsStart int
+	Ours        []string
+	Base        []string
+	Theirs      []string
+}
+
+// Conflicts returns the hunks that Merge3 cannot merge.
+func Conflicts(base, ours, theirs []byte) []*ConflictHunk {
+	var ret []*ConflictHunk
+	walk3(base, ours, theirs, func(h *hunk3) {
+		if h.stable || !hunkConflicts(h.base, h.ours, h.theirs) {
+			return
+		}
+		ret = append(ret, &ConflictHunk{
+			OursStart:   h.oursStart,
+			BaseStart:   h.baseStart,
+			TheirsStart: h.theirsStart,
+			Ours:        h.ours,
+			Base:        h.base,
+			Theirs:      h.theirs,
+		})
+	})
+	return ret
+}
+
+// hunk3 is a part of the three-way diff. The lines of a stable hunk are kept in both sides.
+type hunk3 struct {
+	stable      bool
+	baseStart   int
+	oursStart   int
+	theirsStart int
+	base        []string
+	ours        []string
+	theirs      []string
+}
+
+// walk3 calls fn with the stable and the changed hunks of the three-way diff in order.
+func walk3(base, ours, theirs []byte, fn func(h *hunk3)) {
+	baseLines := diff.SplitLines(base)
+	oursLines := diff.SplitLines(ours)
+	theirsLines := diff.SplitLines(theirs)
+	matchOurs := diff.MatchLines(baseLines, oursLines)
+	matchTheirs := diff.MatchLines(baseLines, theirsLines)
+
+	ib, io, it := 0, 0, 0
+	for {
+		// Find the next base line that is kept in both sides.
+		i := ib
+		for i < len(baseLines) && (matchOurs[i] < 0 || matchTheirs[i] < 0) {
+			i++
+		}
+		jo, jt := len(oursLines), len(theirsLines)
+		if i < len(baseLines) {
+			jo, jt = matchOurs[i], matchTheirs[i]
+		}
+		if ib < i || io < jo || it < jt {
+			fn(&hunk3{
+				baseStart:   ib,
+				oursStart:   io,
+				theirsStart: it,
+				base:        baseLines[ib:i],
+				ours:        oursLines[io:jo],
+				theirs:      theirsLines[it:jt],
+			})
+		}
+		if i == len(baseLines) {
+			break
+		}
+		// Collect the lines that are kept in both sides.
+		stable := &hunk3{stable: true, baseStart: i, oursStart: jo, theirsStart: jt}
+		for i < len(baseLines) && matchOurs[i] == jo && matchTheirs[i] == jt {
+			i++
+			jo++
+			jt++
+		}
+		stable.base = baseLines[stable.baseStart:i]
+		stable.ours = oursLines[stable.oursStart:jo]
+		stable.theirs = theirsLines[stable.theirsStart:jt]
+		fn(stable)
+		ib, io, it = i, jo, jt
+	}
+}
+
+// Diff3Resolver is a conflict resolver for MergeTree that merges the contents of the files
+// changed on both sides with Merge3. The files that cannot be merged line by line, such as
+// binary files and modify/delete conflicts, are left with the first side's entry.
+//
+// A file whose content is changed on one side and whose mode (e.g. regular to executable) is
+// changed on the other side is merged without reading the blobs. The same applies to symlinks,
+// whose targets are compared by the blob hashes, so a symlink is a conflict only if both sides
+// point it to different targets.
+//
+// The blobs of the conflicting files need to be in the storage. See ConflictBlobs.
+type Diff3Resolver struct {
+	storage storer.EncodedObjectStorer
+	labels  Labels
+
+	// NewHashes are the blobs created by the resolver.
+	NewHashes []plumbing.Hash
+	// FilesResolved are the files merged without conflicts.
+	FilesResolved []string
+	// FilesConflict are the files with conflicts. The merged text files have the conflict
+	// markers.
+	FilesConflict []string
+
+	// StubThreshold, if positive, makes the resolver write a conflicted file whose merged
+	// content is larger than this many bytes as a ConflictStub instead, so that the large
+	// content is not duplicated in a new blob.
+	StubThreshold int64
+	// FilesStubbed are the files in FilesConflict written as conflict stubs.
+	FilesStubbed []string
+}
+
+func NewDiff3Resolver(storage storer.EncodedObjectStorer, labels Labels) *Diff3Resolver {
+	return &Diff3Resolver{storage: storage, labels: labels}
+}
+
+// Resolve is the resolver function to pass to MergeTree.
+func (r *Diff3Resolver) Resolve(parentPath string, entry1, entry2, entryBase *object.TreeEntry) ([]object.TreeEntry, error) {
+	if entry, ok := mergeBlobEntries(entry1, entry2, entryBase); ok {
+		r.FilesResolved = append(r.FilesResolved, path.Join(parentPath, entry1.Name))
+		return []object.TreeEntry{entry}, nil
+	}
+	if !contentMergeable(entry1, entry2, entryBase) {
+		if entry1 != nil {
+			r.FilesConflict = append(r.FilesConflict, path.Join(parentPath, entry1.Name))
+			return []object.TreeEntry{*entry1}, nil
+		}
+		r.FilesConflict = append(r.FilesConflict, path.Join(parentPath, entry2.Name))
+		return []object.TreeEntry{*entry2}, nil
+	}
+	pth := path.Join(parentPath, entry1.Name)
+	content1, err := r.readBlob(entry1)
+	if err != nil {
+		return nil, err
+	}
+	content2, err := r.readBlob(entry2)
+	if err != nil {
+		return nil, err
+	}
+	contentBase, err := r.readBlob(entryBase)
+	if err != nil {
+		return nil, err
+	}
+	if IsBinary(content1) || IsBinary(content2) || IsBinary(contentBase) {
+		r.FilesConflict = append(r.FilesConflict, pth)
+		return []object.TreeEntry{*entry1}, nil
+	}
+
+	merged, conflict := Merge3(contentBase, content1, content2, r.labels)
+	if conflict && r.StubThreshold > 0 && int64(len(merged)) > r.StubThreshold {
+		merged = ConflictStub(entry1, entry2, entryBase, r.labels)
+		r.FilesStubbed = append(r.FilesStubbed, pth)
+	}
+	obj := r.storage.NewEncodedObject()
+	obj.SetType(plumbing.BlobObject)
+	w, err := obj.Writer()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(merged); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	hash, err := r.storage.SetEncodedObject(obj)
+	if err != nil {
+		return nil, fmt.Errorf("cannot save the merged blob of %q: %w", pth, err)
+	}
+	r.NewHashes = append(r.NewHashes, hash)
+	if conflict {
+		r.FilesConflict = append(r.FilesConflict, pth)
+	} else {
+		r.FilesResolved = append(r.FilesResolved, pth)
+	}
+	mode := entry1.Mode
+	if entryBase != nil && entry1.Mode == entryBase.Mode {
+		mode = entry2.Mode
+	}
+	return []object.TreeEntry{{Name: entry1.Name, Mode: mode, Hash: hash}}, nil
+}
+
+// ConflictStub returns the content of a conflicted file that refers to the blobs of the sides
+// instead of having their contents. It's one conflict hunk whose sides are the blob hashes, so
+// that the tools that look for the conflict markers still find it.
+func ConflictStub(ours, theirs, base *object.TreeEntry, labels Labels) []byte {
+	baseHash := "(none)"
+	if base != nil {
+		baseHash = base.Hash.String()
+	}
+	return []byte(strings.Join([]string{
+		"<<<<<<< " + labels.Ours + "\n",
+		"conflict stub: ours is blob " + ours.Hash.String() + "\n",
+		"||||||| " + labels.Base + "\n",
+		"conflict stub: base is blob " + baseHash + "\n",
+		"=======\n",
+		"conflict stub: theirs is blob " + theirs.Hash.String() + "\n",
+		">>>>>>> " + labels.Theirs + "\n",
+	}, ""))
+}
+
+func (r *Diff3Resolver) readBlob(entry *object.TreeEntry) ([]byte, error) {
+	if entry == nil {
+		return nil, nil
+	}
+	blob, err := object.GetBlob(r.storage, entry.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get the blob %s: %w", entry.Hash, err)
+	}
+	rd, err := blob.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer rd.Close()
+	return io.ReadAll(rd)
+}
+
+// ConflictBlobs returns the blobs that Diff3Resolver needs to merge the trees.
+func ConflictBlobs(storage storer.EncodedObjectStorer, tree1, tree2, mergeBase *object.Tree) ([]plumbing.Hash, error) {
+	var ret []plumbing.Hash
+	collector := func(parentPath string, entry1, entry2, entryBase *object.TreeEntry) ([]object.TreeEntry, error) {
+		if _, ok := mergeBlobEntries(entry1, entry2, entryBase); ok {
+			return nil, nil
+		}
+		if contentMergeable(entry1, entry2, entryBase) {
+			ret = append(ret, entry1.Hash, entry2.Hash)
+			if entryBase != nil {
+				ret = append(ret, entryBase.Hash)
+			}
+		}
+		return nil, nil
+	}
+	if _, err := MergeTree(storage, tree1, tree2, mergeBase, collector); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// mergeBlobEntries merges the file entries that don't need their contents merged: the contents
+// and the modes are merged separately, and each of them needs to be changed on at most one side or
+// changed in the same way. The entries need to be the same type, so a regular file is not merged
+// with a symlink.
+func mergeBlobEntries(entry1, entry2, entryBase *object.TreeEntry) (object.TreeEntry, bool) {
+	if entry1 == nil || entry2 == nil || entryBase == nil {
+		return object.TreeEntry{}, false
+	}
+	symlink := entryBase.Mode == filemode.Symlink
+	for _, entry := range []*object.TreeEntry{entry1, entry2, entryBase} {
+		if !(isRegularFile(entry.Mode) || entry.Mode == filemode.Symlink) || (entry.Mode == filemode.Symlink) != symlink {
+			return object.TreeEntry{}, false
+		}
+	}
+	hash, ok := mergeSides(entry1.Hash, entry2.Hash, entryBase.Hash)
+	if !ok {
+		return object.TreeEntry{}, false
+	}
+	mode, ok := mergeSides(entry1.Mode, entry2.Mode, entryBase.Mode)
+	if !ok {
+		return object.TreeEntry{}, false
+	}
+	return object.TreeEntry{Name: entry1.Name, Mode: mode, Hash: hash}, true
+}
+
+// mergeSides returns the side that changed the value from the base, or false if both sides
+// changed it differently.
+func mergeSides[T comparable](v1, v2, base T) (T, bool) {
+	switch {
+	case v1 == base:
+		return v2, true
+	case v2 == base || v1 == v2:
+		return v1, true
+	}
+	return base, false
+}
+
+// contentMergeable returns true if the conflict is between regular files that can be merged
+// line by line.
+func contentMergeable(entry1, entry2, entryBase *object.TreeEntry) bool {
+	return entry1 != nil && isRegularFile(entry1.Mode) &&
+		entry2 != nil && isRegularFile(entry2.Mode) &&
+		(entryBase == nil || isRegularFile(entryBase.Mode))
+}
+
+func isRegularFile(mode filemode.FileMode) bool {
+	return mode == filemode.Regular || mode == filemode.Executable
+}
+
+func mergeHunk(base, ours, theirs []string, labels Labels) ([]string, bool) {
+	if !hunkConflicts(base, ours, theirs) {
+		if equalLines(ours, base) {
+			return theirs, false
+		}
+		return ours, false
+	}
+	var out []string
+	out = append(out, "<<<<<<< "+labels.Ours+"\n")
+	out = appendTerminated(out, ours)
+	out = append(out, "||||||| "+labels.Base+"\n")
+	out = appendTerminated(out, base)
+	out = append(out, "=======\n")
+	out = appendTerminated(out, theirs)
+	out = append(out, ">>>>>>> "+labels.Theirs+"\n")
+	return out, true
+}
+
+// hunkConflicts returns true if both sides changed the hunk differently.
+func hunkConflicts(base, ours, theirs []string) bool {
+	return !equalLines(ours, base) && !equalLines(theirs, base) && !equalLines(ours, theirs)
+}
+
+// appendTerminated appends the lines, adding a newline to the last line if it doesn't have one
+// so that the following conflict marker starts on its own line.
+func appendTerminated(out, lines []string) []string {
+	out = append(out, lines...)
+	if len(lines) > 0 && !strings.HasSuffix(lines[len(lines)-1], "\n") {
+		out[len(out)-1] += "\n"
+	}
+	return out
+}
+
+// IsBinary returns true if the content looks binary, using the same heuristic as git.
+func IsBinary(content []byte) bool {
+	if len(content) > 8000 {
+		content = content[:8000]
+	}
+	return bytes.IndexByte(content, 0) >= 0
+}
+
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMerge3(t *testing.T) {
+	labels := Labels{Ours: "ours", Base: "base", Theirs: "theirs"}
+	tests := []struct {
+		name         string
+		base         string
+		ours         string
+		theirs       string
+		want         string
+		wantConflict bool
+	}{
+		{
+			name:   "disjoint changes",
+			base:   "a\nb\nc\nd\ne\n",
+			ours:   "A\nb\nc\nd\ne\n",
+			theirs: "a\nb\nc\nd\nE\n",
+			want:   "A\nb\nc\nd\nE\n",
+		},
+		{
+			name:   "insertions and deletions",
+			base:   "a\nb\nc\nd\n",
+			ours:   "a\nx\nb\nc\nd\n",
+			theirs: "a\nb\nd\ny\n",
+			want:   "a\nx\nb\nd\ny\n",
+		},
+		{
+			name:   "same change",
+			base:   "a\nb\nc\n",
+			ours:   "a\nB\nc\n",
+			theirs: "a\nB\nc\n",
+			want:   "a\nB\nc\n",
+		},
+		{
+			name:   "add/add",
+			base:   "",
+			ours:   "a\n",
+			theirs: "a\n",
+			want:   "a\n",
+		},
+		{
+			name:         "conflict",
+			base:         "a\nb\nc\n",
+			ours:         "a\nB1\nc\n",
+			theirs:       "a\nB2\nc\n",
+			want:         "a\n<<<<<<< ours\nB1\n||||||| base\nb\n=======\nB2\n>>>>>>> theirs\nc\n",
+			wantConflict: true,
+		},
+		{
+			name:         "conflict without a trailing newline",
+			base:         "a",
+			ours:         "b",
+			theirs:       "c",
+			want:         "<<<<<<< ours\nb\n||||||| base\na\n=======\nc\n>>>>>>> theirs\n",
+			wantConflict: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflict := Merge3([]byte(tt.base), []byte(tt.ours), []byte(tt.theirs), labels)
+			if diff := cmp.Diff(tt.want, string(got)); diff != "" {
+				t.Errorf("Merge3 diff (-want +got):\n%s", diff)
+			}
+			if conflict != tt.wantConflict {
+				t.Errorf("conflict = %v, want %v", conflict, tt.wantConflict)
+			}
+		})
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	got := Conflicts(
+		[]byte("a\nb\nc\nd\ne\n"),
+		[]byte("a\nB1\nc\nd\nE\n"),
+		[]byte("x\na\nB2\nc\nd\ne\n"),
+	)
+	want := []*ConflictHunk{
+		{
+			OursStart:   1,
+			BaseStart:   1,
+			TheirsStart: 2,
+			Ours:        []string{"B1\n"},
+			Base:        []string{"b\n"},
+			Theirs:      []string{"B2\n"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Conflicts() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiff3Resolver_Modes(t *testing.T) {
+	storage := memory.NewStorage()
+	blob := func(content string) plumbing.Hash {
+		hash, err := createBlob(storage, content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+	// The binary contents can't be merged line by line, so they are resolved only by the modes.
+	binBase, bin1, bin2 := blob("base\x00"), blob("ours\x00"), blob("theirs\x00")
+	target, target1, target2 := blob("target"), blob("target1"), blob("target2")
+	entry := func(mode filemode.FileMode, hash plumbing.Hash) *object.TreeEntry {
+		return &object.TreeEntry{Name: "file", Mode: mode, Hash: hash}
+	}
+
+	tests := []struct {
+		name                 string
+		entry1, entry2, base *object.TreeEntry
+		want                 *object.TreeEntry
+		wantConflict         bool
+	}{
+		{
+			name:   "content and mode",
+			entry1: entry(filemode.Executable, binBase),
+			entry2: entry(filemode.Regular, bin2),
+			base:   entry(filemode.Regular, binBase),
+			want:   entry(filemode.Executable, bin2),
+		},
+		{
+			name:   "mode and content",
+			entry1: entry(filemode.Regular, bin1),
+			entry2: entry(filemode.Regular, binBase),
+			base:   entry(filemode.Executable, binBase),
+			want:   entry(filemode.Regular, bin1),
+		},
+		{
+			name:   "same content and mode",
+			entry1: entry(filemode.Executable, bin1),
+			entry2: entry(filemode.Executable, bin1),
+			base:   entry(filemode.Regular, bin1),
+			want:   entry(filemode.Executable, bin1),
+		},
+		{
+			name:         "both contents",
+			entry1:       entry(filemode.Executable, bin1),
+			entry2:       entry(filemode.Regular, bin2),
+			base:         entry(filemode.Regular, binBase),
+			want:         entry(filemode.Executable, bin1),
+			wantConflict: true,
+		},
+		{
+			name:   "symlink target",
+			entry1: entry(filemode.Symlink, target1),
+			entry2: entry(filemode.Symlink, target),
+			base:   entry(filemode.Symlink, target),
+			want:   entry(filemode.Symlink, target1),
+		},
+		{
+			name:         "symlink targets",
+			entry1:       entry(filemode.Symlink, target1),
+			entry2:       entry(filemode.Symlink, target2),
+			base:         entry(filemode.Symlink, target),
+			want:         entry(filemode.Symlink, target1),
+			wantConflict: true,
+		},
+		{
+			name:         "file to symlink",
+			entry1:       entry(filemode.Symlink, target),
+			entry2:       entry(filemode.Regular, target1),
+			base:         entry(filemode.Regular, target),
+			want:         entry(filemode.Symlink, target),
+			wantConflict: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := NewDiff3Resolver(storage, CorpusLabels)
+			got, err := resolver.Resolve("dir", tt.entry1, tt.entry2, tt.base)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff([]object.TreeEntry{*tt.want}, got); diff != "" {
+				t.Errorf("Resolve() mismatch (-want +got):\n%s", diff)
+			}
+			if conflict := len(resolver.FilesConflict) > 0; conflict != tt.wantConflict {
+				t.Errorf("FilesConflict is %v, want conflict %t", resolver.FilesConflict, tt.wantConflict)
+			}
+			if len(resolver.NewHashes) > 0 {
+				t.Errorf("NewHashes is %v, want none", resolver.NewHashes)
+			}
+		})
+	}
+}
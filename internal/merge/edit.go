@@ -0,0 +1,112 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// TreeEdit is a change of a file by EditTree.
+type TreeEdit struct {
+	// Hash is the new blob of the file. ZeroHash deletes the file.
+	Hash plumbing.Hash
+	Mode filemode.FileMode
+}
+
+// EditTreeResult is the result of EditTree.
+type EditTreeResult struct {
+	TreeHash plumbing.Hash
+	// NewHashes are the trees created by the edits.
+	NewHashes []plumbing.Hash
+}
+
+// EditTree applies the edits keyed by the slash-separated paths to the tree. The directories
+// left empty are removed. The blobs of the edits need to be in the storage, but the other blobs
+// of the tree don't.
+func EditTree(storage storer.EncodedObjectStorer, tree *object.Tree, edits map[string]TreeEdit) (*EditTreeResult, error) {
+	te := &treeEditor{storage: storage}
+	hash, _, err := te.edit("", tree, edits, true)
+	if err != nil {
+		return nil, err
+	}
+	return &EditTreeResult{TreeHash: hash, NewHashes: te.newHashes}, nil
+}
+
+type treeEditor struct {
+	storage   storer.EncodedObjectStorer
+	newHashes []plumbing.Hash
+}
+
+// edit returns the hash of the edited tree, and false if the tree became empty. The root tree is
+// written even if it's empty.
+func (te *treeEditor) edit(pth string, tree *object.Tree, edits map[string]TreeEdit, root bool) (plumbing.Hash, bool, error) {
+	entries := map[string]object.TreeEntry{}
+	if tree != nil {
+		for _, entry := range tree.Entries {
+			entries[entry.Name] = entry
+		}
+	}
+	subEdits := map[string]map[string]TreeEdit{}
+	for p, e := range edits {
+		dir, rest, ok := strings.Cut(p, "/")
+		if !ok {
+			if e.Hash.IsZero() {
+				delete(entries, p)
+			} else {
+				entries[p] = object.TreeEntry{Name: p, Mode: e.Mode, Hash: e.Hash}
+			}
+			continue
+		}
+		if subEdits[dir] == nil {
+			subEdits[dir] = map[string]TreeEdit{}
+		}
+		subEdits[dir][rest] = e
+	}
+	for dir, sub := range subEdits {
+		var subtree *object.Tree
+		if entry, ok := entries[dir]; ok && entry.Mode == filemode.Dir {
+			var err error
+			subtree, err = object.GetTree(te.storage, entry.Hash)
+			if err != nil {
+				return plumbing.ZeroHash, false, fmt.Errorf("cannot get the tree of %q: %w", pathJoin(pth, dir), err)
+			}
+		}
+		hash, ok, err := te.edit(pathJoin(pth, dir), subtree, sub, false)
+		if err != nil {
+			return plumbing.ZeroHash, false, err
+		}
+		if ok {
+			entries[dir] = object.TreeEntry{Name: dir, Mode: filemode.Dir, Hash: hash}
+		} else {
+			delete(entries, dir)
+		}
+	}
+	if len(entries) == 0 && !root {
+		return plumbing.ZeroHash, false, nil
+	}
+
+	var newEntries []object.TreeEntry
+	for _, entry := range entries {
+		newEntries = append(newEntries, entry)
+	}
+	hash, err := encodeTree(te.storage, pth, newEntries)
+	if err != nil {
+		return plumbing.ZeroHash, false, err
+	}
+	te.newHashes = append(te.newHashes, hash)
+	return hash, true, nil
+}
+
+func pathJoin(dir, name string) string {
+	if dir == "" {
+		return name
+	}
+	return dir + "/" + name
+}
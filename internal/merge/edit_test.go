@@ -0,0 +1,69 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEditTree(t *testing.T) {
+	// /file1.txt Changed
+	// /dir1/file2.txt.from-cherry-pick Removed, dir1 becomes empty
+	// /dir2/file3.txt Unchanged
+	// /dir2/sub/file4.txt Added
+
+	storage := memory.NewStorage()
+	tree, err := restoreTree(storage, dumpedTree{
+		Files: map[string]string{"file1.txt": "A"},
+		Dirs: map[string]dumpedTree{
+			"dir1": {Files: map[string]string{"file2.txt.from-cherry-pick": "A"}},
+			"dir2": {Files: map[string]string{"file3.txt": "A"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := createBlob(storage, "B")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := EditTree(storage, tree, map[string]TreeEdit{
+		"file1.txt":                       {Hash: hash, Mode: filemode.Regular},
+		"dir1/file2.txt.from-cherry-pick": {},
+		"dir2/sub/file4.txt":              {Hash: hash, Mode: filemode.Regular},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := dumpTree(storage, result.TreeHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := dumpedTree{
+		Files: map[string]string{"file1.txt": "B"},
+		Dirs: map[string]dumpedTree{
+			"dir2": {
+				Files: map[string]string{"file3.txt": "A"},
+				Dirs: map[string]dumpedTree{
+					"sub": {
+						Files: map[string]string{"file4.txt": "B"},
+						Dirs:  map[string]dumpedTree{},
+					},
+				},
+			},
+		},
+	}
+	if !cmp.Equal(want, got) {
+		t.Error("Got a diff\n" + cmp.Diff(want, got))
+	}
+	// The root, dir2, and dir2/sub.
+	if len(result.NewHashes) != 3 {
+		t.Errorf("NewHashes has %d trees, want 3", len(result.NewHashes))
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aviator-co/niche-git/internal/diff"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// RenamedTrees are the trees rewritten by FollowRenames.
+type RenamedTrees struct {
+	Tree1     *object.Tree
+	Tree2     *object.Tree
+	MergeBase *object.Tree
+	// NewHashes are the trees created by the rewrite.
+	NewHashes []plumbing.Hash
+	// Followed are the renames applied to the other side.
+	Followed []diff.Rename
+}
+
+// FollowRenames rewrites the trees so that a file renamed on one side is at the new path in the
+// merge base and the other side too. MergeTree then merges the changes the other side made to
+// the old path into the renamed file, like git merge. renames1 and renames2 are the renames from
+// the merge base to each side.
+//
+// A rename is not followed if the other side renamed the file to a different path, has another
+// file at the old path, or already has a file at the new path. Those are merged as the deletes
+// and the adds.
+func FollowRenames(storage storer.EncodedObjectStorer, tree1, tree2, mergeBase *object.Tree, renames1, renames2 []diff.Rename) (*RenamedTrees, error) {
+	ret := &RenamedTrees{Tree1: tree1, Tree2: tree2, MergeBase: mergeBase}
+	if mergeBase == nil {
+		return ret, nil
+	}
+	newPaths1, newPaths2 := map[string]string{}, map[string]string{}
+	renamedTo1, renamedTo2 := map[string]bool{}, map[string]bool{}
+	for _, r := range renames1 {
+		newPaths1[r.OldPath] = r.NewPath
+		renamedTo1[r.NewPath] = true
+	}
+	for _, r := range renames2 {
+		newPaths2[r.OldPath] = r.NewPath
+		renamedTo2[r.NewPath] = true
+	}
+	edits1, edits2, editsBase := map[string]TreeEdit{}, map[string]TreeEdit{}, map[string]TreeEdit{}
+	// move moves the file at the old path to the new path in the tree if the tree has the file
+	// and doesn't have the new path.
+	move := func(tree *object.Tree, edits map[string]TreeEdit, oldPath, newPath string) bool {
+		entry, err := tree.FindEntry(oldPath)
+		if err != nil || !entry.Mode.IsFile() {
+			return false
+		}
+		if _, err := tree.FindEntry(newPath); err == nil {
+			return false
+		}
+		if _, ok := edits[newPath]; ok {
+			return false
+		}
+		edits[oldPath] = TreeEdit{}
+		edits[newPath] = TreeEdit{Hash: entry.Hash, Mode: entry.Mode}
+		return true
+	}
+	follow := func(oldPath, newPath string, other *object.Tree, otherEdits map[string]TreeEdit, r diff.Rename) {
+		if !move(other, otherEdits, oldPath, newPath) {
+			return
+		}
+		if !move(mergeBase, editsBase, oldPath, newPath) {
+			delete(otherEdits, oldPath)
+			delete(otherEdits, newPath)
+			return
+		}
+		ret.Followed = append(ret.Followed, r)
+	}
+	for _, r := range renames1 {
+		if newPath2, ok := newPaths2[r.OldPath]; ok {
+			if newPath2 == r.NewPath {
+				// Renamed to the same path on both sides. Only the merge base needs
+				// the rename.
+				if move(mergeBase, editsBase, r.OldPath, r.NewPath) {
+					ret.Followed = append(ret.Followed, r)
+				}
+			}
+			continue
+		}
+		if renamedTo2[r.OldPath] {
+			// The other side has another file at the old path.
+			continue
+		}
+		follow(r.OldPath, r.NewPath, tree2, edits2, r)
+	}
+	for _, r := range renames2 {
+		if _, ok := newPaths1[r.OldPath]; ok || renamedTo1[r.OldPath] {
+			continue
+		}
+		follow(r.OldPath, r.NewPath, tree1, edits1, r)
+	}
+	sort.Slice(ret.Followed, func(i, j int) bool { return ret.Followed[i].NewPath < ret.Followed[j].NewPath })
+
+	edit := func(tree *object.Tree, edits map[string]TreeEdit) (*object.Tree, error) {
+		if len(edits) == 0 {
+			return tree, nil
+		}
+		result, err := EditTree(storage, tree, edits)
+		if err != nil {
+			return nil, err
+		}
+		ret.NewHashes = append(ret.NewHashes, result.NewHashes...)
+		return object.GetTree(storage, result.TreeHash)
+	}
+	var err error
+	if ret.Tree1, err = edit(tree1, edits1); err != nil {
+		return nil, fmt.Errorf("cannot follow the renames in the first tree: %w", err)
+	}
+	if ret.Tree2, err = edit(tree2, edits2); err != nil {
+		return nil, fmt.Errorf("cannot follow the renames in the second tree: %w", err)
+	}
+	if ret.MergeBase, err = edit(mergeBase, editsBase); err != nil {
+		return nil, fmt.Errorf("cannot follow the renames in the merge base: %w", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"testing"
+
+	"github.com/aviator-co/niche-git/internal/diff"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFollowRenames(t *testing.T) {
+	// /old.txt Renamed to /dir/new.txt in tree1, modified in tree2
+
+	storage := memory.NewStorage()
+	base, err := restoreTree(storage, dumpedTree{
+		Files: map[string]string{"old.txt": "a\nb\nc\n"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree1, err := restoreTree(storage, dumpedTree{
+		Dirs: map[string]dumpedTree{
+			"dir": {Files: map[string]string{"new.txt": "a\nb\nc\n"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree2, err := restoreTree(storage, dumpedTree{
+		Files: map[string]string{"old.txt": "a\nb\nC\n"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	renames := []diff.Rename{{OldPath: "old.txt", NewPath: "dir/new.txt", Similarity: 100}}
+	renamed, err := FollowRenames(storage, tree1, tree2, base, renames, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(renames, renamed.Followed); diff != "" {
+		t.Errorf("Followed diff (-want +got):\n%s", diff)
+	}
+	result, err := MergeTree(storage, renamed.Tree1, renamed.Tree2, renamed.MergeBase, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := dumpTree(storage, result.TreeHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := dumpedTree{
+		Files: map[string]string{},
+		Dirs: map[string]dumpedTree{
+			"dir": {
+				Files: map[string]string{"new.txt": "a\nb\nC\n"},
+				Dirs:  map[string]dumpedTree{},
+			},
+		},
+	}
+	if !cmp.Equal(want, got) {
+		t.Error("Got a diff\n" + cmp.Diff(want, got))
+	}
+}
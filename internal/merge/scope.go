@@ -0,0 +1,170 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// PathScope is a set of path prefixes that restricts which part of a tree is considered.
+//
+// A path is in the scope if it is one of the prefixes or is under one of them. An empty scope
+// matches every path.
+type PathScope []string
+
+// Contains returns true if the path is in the scope.
+func (s PathScope) Contains(pth string) bool {
+	if len(s) == 0 {
+		return true
+	}
+	for _, prefix := range s {
+		prefix = strings.Trim(prefix, "/")
+		if prefix == "" || pth == prefix || strings.HasPrefix(pth, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isAncestor returns true if the path is a parent directory of one of the prefixes.
+func (s PathScope) isAncestor(pth string) bool {
+	if pth == "" {
+		return true
+	}
+	for _, prefix := range s {
+		if strings.HasPrefix(strings.Trim(prefix, "/"), pth+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// RestrictResult represents the result of RestrictTree.
+type RestrictResult struct {
+	// NewHashes are the OIDs of the trees that are newly created by the operation.
+	NewHashes []plumbing.Hash
+
+	// TreeHash is the hash of the restricted tree.
+	TreeHash plumbing.Hash
+}
+
+// RestrictTree creates a tree that has the contents of tree for the paths in the scope and the
+// contents of base for the rest.
+//
+// Merging the restricted tree instead of the original one discards the changes made outside of
+// the scope.
+func RestrictTree(storage storer.EncodedObjectStorer, tree, base *object.Tree, scope PathScope) (*RestrictResult, error) {
+	if len(scope) == 0 {
+		return &RestrictResult{TreeHash: tree.Hash}, nil
+	}
+	tr := &treeRestricter{storage: storage, scope: scope}
+	entry, err := tr.restrict(
+		"",
+		&object.TreeEntry{Mode: filemode.Dir, Hash: tree.Hash},
+		&object.TreeEntry{Mode: filemode.Dir, Hash: base.Hash},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		// Everything is removed. Create an empty tree.
+		treeHash, err := tr.writeTree("", nil)
+		if err != nil {
+			return nil, err
+		}
+		return &RestrictResult{NewHashes: tr.newHashes, TreeHash: treeHash}, nil
+	}
+	return &RestrictResult{NewHashes: tr.newHashes, TreeHash: entry.Hash}, nil
+}
+
+type treeRestricter struct {
+	storage   storer.EncodedObjectStorer
+	scope     PathScope
+	newHashes []plumbing.Hash
+}
+
+func (tr *treeRestricter) restrict(pth string, entry, baseEntry *object.TreeEntry) (*object.TreeEntry, error) {
+	if pth != "" && tr.scope.Contains(pth) {
+		return entry, nil
+	}
+	if !tr.scope.isAncestor(pth) {
+		return baseEntry, nil
+	}
+	if !hasChange(entry, baseEntry) {
+		return entry, nil
+	}
+	// This is a parent directory of the scope. Only the subdirectories in the scope should take
+	// the entry side.
+	entries, err := tr.dirEntries(entry)
+	if err != nil {
+		return nil, err
+	}
+	baseEntries, err := tr.dirEntries(baseEntry)
+	if err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for name := range entries {
+		names[name] = true
+	}
+	for name := range baseEntries {
+		names[name] = true
+	}
+	var resultEntries []object.TreeEntry
+	for name := range names {
+		resolved, err := tr.restrict(path.Join(pth, name), entries[name], baseEntries[name])
+		if err != nil {
+			return nil, err
+		}
+		if resolved != nil {
+			resultEntries = append(resultEntries, *resolved)
+		}
+	}
+	if len(resultEntries) == 0 {
+		return nil, nil
+	}
+	treeHash, err := tr.writeTree(pth, resultEntries)
+	if err != nil {
+		return nil, err
+	}
+	name := ""
+	if entry != nil {
+		name = entry.Name
+	} else if baseEntry != nil {
+		name = baseEntry.Name
+	}
+	return &object.TreeEntry{Name: name, Mode: filemode.Dir, Hash: treeHash}, nil
+}
+
+func (tr *treeRestricter) dirEntries(entry *object.TreeEntry) (map[string]*object.TreeEntry, error) {
+	ret := map[string]*object.TreeEntry{}
+	if entry == nil || entry.Mode != filemode.Dir {
+		// A file at the parent directory path of the scope is out of the scope.
+		return ret, nil
+	}
+	tree, err := object.GetTree(tr.storage, entry.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get a subtree: %w", err)
+	}
+	for i := range tree.Entries {
+		ret[tree.Entries[i].Name] = &tree.Entries[i]
+	}
+	return ret, nil
+}
+
+func (tr *treeRestricter) writeTree(pth string, entries []object.TreeEntry) (plumbing.Hash, error) {
+	newTreeHash, err := encodeTree(tr.storage, pth, entries)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	tr.newHashes = append(tr.newHashes, newTreeHash)
+	return newTreeHash, nil
+}
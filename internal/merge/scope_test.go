@@ -0,0 +1,80 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRestrictTree(t *testing.T) {
+	// /svc1/file1.txt Changed, in scope
+	// /svc1/sub/file2.txt Added, in scope
+	// /svc2/file3.txt Changed, out of scope
+	// /file4.txt Removed, out of scope
+
+	storage := memory.NewStorage()
+	tree, err := restoreTree(storage, dumpedTree{
+		Dirs: map[string]dumpedTree{
+			"svc1": {
+				Files: map[string]string{"file1.txt": "A"},
+				Dirs: map[string]dumpedTree{
+					"sub": {Files: map[string]string{"file2.txt": "A"}},
+				},
+			},
+			"svc2": {
+				Files: map[string]string{"file3.txt": "A"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	base, err := restoreTree(storage, dumpedTree{
+		Files: map[string]string{"file4.txt": "Base"},
+		Dirs: map[string]dumpedTree{
+			"svc1": {
+				Files: map[string]string{"file1.txt": "Base"},
+			},
+			"svc2": {
+				Files: map[string]string{"file3.txt": "Base"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := RestrictTree(storage, tree, base, PathScope{"svc1/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := dumpTree(storage, result.TreeHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := dumpedTree{
+		Files: map[string]string{"file4.txt": "Base"},
+		Dirs: map[string]dumpedTree{
+			"svc1": {
+				Files: map[string]string{"file1.txt": "A"},
+				Dirs: map[string]dumpedTree{
+					"sub": {
+						Files: map[string]string{"file2.txt": "A"},
+						Dirs:  map[string]dumpedTree{},
+					},
+				},
+			},
+			"svc2": {
+				Files: map[string]string{"file3.txt": "Base"},
+				Dirs:  map[string]dumpedTree{},
+			},
+		},
+	}
+	if !cmp.Equal(want, got) {
+		t.Error("Got a diff\n" + cmp.Diff(want, got))
+	}
+}
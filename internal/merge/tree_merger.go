@@ -6,7 +6,6 @@ package merge
 import (
 	"fmt"
 	"path"
-	"sort"
 
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/filemode"
@@ -26,6 +25,10 @@ type MergeResult struct {
 	FilesPickedEntry2  []string
 	FilesPickedEntry12 []string
 	FilesConflict      []string
+	// SubmoduleConflicts are the paths in FilesConflict that are a submodule on either side,
+	// e.g. both sides moved the gitlink to different commits, or one side moved it and the
+	// other removed it. A gitlink moved on one side only is merged without the resolver.
+	SubmoduleConflicts []string
 
 	// Tree is the result of the merge.
 	TreeHash plumbing.Hash
@@ -43,6 +46,7 @@ func MergeTree(
 	tm := &treeMerger{
 		storage:          storage,
 		conflictResolver: conflictResolver,
+		created:          map[plumbing.Hash]bool{},
 	}
 	treeHash, err := tm.Merge(tree1, tree2, mergeBase)
 	if err != nil {
@@ -54,6 +58,7 @@ func MergeTree(
 		FilesPickedEntry2:  tm.filesPickedEntry2,
 		FilesPickedEntry12: tm.filesPickedEntry12,
 		FilesConflict:      tm.filesConflict,
+		SubmoduleConflicts: tm.submoduleConflicts,
 		TreeHash:           treeHash,
 	}, nil
 }
@@ -63,11 +68,13 @@ type treeMerger struct {
 	conflictResolver resolver
 
 	newHashes []plumbing.Hash
+	created   map[plumbing.Hash]bool
 
 	filesPickedEntry1  []string
 	filesPickedEntry2  []string
 	filesPickedEntry12 []string
 	filesConflict      []string
+	submoduleConflicts []string
 }
 
 func (tm *treeMerger) Merge(tree1, tree2, mergeBase *object.Tree) (plumbing.Hash, error) {
@@ -91,30 +98,36 @@ func (tm *treeMerger) Merge(tree1, tree2, mergeBase *object.Tree) (plumbing.Hash
 }
 
 func (tm *treeMerger) mergeInternal(pth string, tree1, tree2, mergeBase *object.Tree) (plumbing.Hash, error) {
-	names := map[string]bool{}
-	entries1 := map[string]*object.TreeEntry{}
-	for _, entry := range tree1.Entries {
-		entries1[entry.Name] = &entry
-		names[entry.Name] = true
-	}
-	entries2 := map[string]*object.TreeEntry{}
-	for _, entry := range tree2.Entries {
-		entries2[entry.Name] = &entry
-		names[entry.Name] = true
-	}
-	baseEntries := map[string]*object.TreeEntry{}
+	var baseEntries []object.TreeEntry
 	if mergeBase != nil {
-		for _, entry := range mergeBase.Entries {
-			baseEntries[entry.Name] = &entry
-			names[entry.Name] = true
+		baseEntries = mergeBase.Entries
+	}
+	// The entries of the same name are in one element of triples. The pointers point into the
+	// entries of the trees, so that the entries are not copied.
+	triples := make([][3]*object.TreeEntry, 0, max(len(tree1.Entries), len(tree2.Entries)))
+	slots := make(map[string]int, cap(triples))
+	for side, entries := range [3][]object.TreeEntry{tree1.Entries, tree2.Entries, baseEntries} {
+		for i := range entries {
+			slot, ok := slots[entries[i].Name]
+			if !ok {
+				slot = len(triples)
+				slots[entries[i].Name] = slot
+				triples = append(triples, [3]*object.TreeEntry{})
+			}
+			triples[slot][side] = &entries[i]
 		}
 	}
 
-	var resultEntries []object.TreeEntry
-	for name := range names {
-		entry1 := entries1[name]
-		entry2 := entries2[name]
-		entryBase := baseEntries[name]
+	resultEntries := make([]object.TreeEntry, 0, len(triples))
+	for _, triple := range triples {
+		entry1, entry2, entryBase := triple[0], triple[1], triple[2]
+		var name string
+		for _, entry := range triple {
+			if entry != nil {
+				name = entry.Name
+				break
+			}
+		}
 		switch checkConflictType(entry1, entry2, entryBase) {
 		case conflictTypeNoChange:
 			resultEntries = append(resultEntries, *entryBase)
@@ -141,17 +154,17 @@ func (tm *treeMerger) mergeInternal(pth string, tree1, tree2, mergeBase *object.
 				// Recurse.
 				entry1Tree, err := object.GetTree(tm.storage, entry1.Hash)
 				if err != nil {
-					return plumbing.ZeroHash, fmt.Errorf("cannot get a subtree: %v", err)
+					return plumbing.ZeroHash, fmt.Errorf("cannot get a subtree: %w", err)
 				}
 				entry2Tree, err := object.GetTree(tm.storage, entry2.Hash)
 				if err != nil {
-					return plumbing.ZeroHash, fmt.Errorf("cannot get a subtree: %v", err)
+					return plumbing.ZeroHash, fmt.Errorf("cannot get a subtree: %w", err)
 				}
 				var entryBaseTree *object.Tree
 				if entryBase != nil && entryBase.Mode == filemode.Dir {
 					entryBaseTree, err = object.GetTree(tm.storage, entryBase.Hash)
 					if err != nil {
-						return plumbing.ZeroHash, fmt.Errorf("cannot get a subtree: %v", err)
+						return plumbing.ZeroHash, fmt.Errorf("cannot get a subtree: %w", err)
 					}
 				}
 				treeHash, err := tm.mergeInternal(path.Join(pth, name), entry1Tree, entry2Tree, entryBaseTree)
@@ -161,28 +174,56 @@ func (tm *treeMerger) mergeInternal(pth string, tree1, tree2, mergeBase *object.
 				resultEntries = append(resultEntries, object.TreeEntry{Name: name, Mode: filemode.Dir, Hash: treeHash})
 			} else {
 				tm.filesConflict = append(tm.filesConflict, path.Join(pth, name))
+				if isSubmodule(entry1) || isSubmodule(entry2) {
+					// The commits of the submodules are not in the storage, so the
+					// resolver cannot merge them and only picks an entry.
+					tm.submoduleConflicts = append(tm.submoduleConflicts, path.Join(pth, name))
+				}
 				resolvedEntries, err := tm.conflictResolver(pth, entry1, entry2, entryBase)
 				if err != nil {
-					return plumbing.ZeroHash, fmt.Errorf("Cannot resolve conflict: %v", err)
+					return plumbing.ZeroHash, fmt.Errorf("Cannot resolve conflict: %w", err)
 				}
 				resultEntries = append(resultEntries, resolvedEntries...)
 			}
 		}
 	}
-	sort.Sort(object.TreeEntrySorter(resultEntries))
-	newTree := object.Tree{Entries: resultEntries}
-	o := tm.storage.NewEncodedObject()
-	if err := newTree.Encode(o); err != nil {
-		return plumbing.ZeroHash, fmt.Errorf("Cannot create a new tree entry: %v", err)
+	// The result can be one of the trees as is, e.g. when the subtrees merged into the same
+	// content or the conflicts are resolved to the merge base. Such a tree exists already, so
+	// it's not encoded again.
+	for side, tree := range [3]*object.Tree{tree1, tree2, mergeBase} {
+		if tree != nil && len(resultEntries) == len(tree.Entries) && sameEntries(resultEntries, triples, slots, side) {
+			return tree.Hash, nil
+		}
 	}
-	newTreeHash, err := tm.storage.SetEncodedObject(o)
+	// The resolvers can return entries that git rejects, such as a file and a directory with
+	// the same name. They are caught here rather than by the server after the push.
+	newTreeHash, err := encodeTree(tm.storage, pth, resultEntries)
 	if err != nil {
-		return plumbing.ZeroHash, fmt.Errorf("Cannot save the new tree entry: %v", err)
+		return plumbing.ZeroHash, err
+	}
+	// The same tree can be created for multiple directories.
+	if !tm.created[newTreeHash] {
+		tm.created[newTreeHash] = true
+		tm.newHashes = append(tm.newHashes, newTreeHash)
 	}
-	tm.newHashes = append(tm.newHashes, newTreeHash)
 	return newTreeHash, nil
 }
 
+// sameEntries returns true if all the entries are the ones of the side of the triples.
+func sameEntries(entries []object.TreeEntry, triples [][3]*object.TreeEntry, slots map[string]int, side int) bool {
+	for _, entry := range entries {
+		slot, ok := slots[entry.Name]
+		if !ok {
+			return false
+		}
+		sideEntry := triples[slot][side]
+		if sideEntry == nil || sideEntry.Mode != entry.Mode || sideEntry.Hash != entry.Hash {
+			return false
+		}
+	}
+	return true
+}
+
 type conflictType int
 
 const (
@@ -212,6 +253,10 @@ func checkConflictType(entry1, entry2, entryBase *object.TreeEntry) conflictType
 	return conflictTypeConflict
 }
 
+func isSubmodule(entry *object.TreeEntry) bool {
+	return entry != nil && entry.Mode == filemode.Submodule
+}
+
 func hasChange(entry1, entry2 *object.TreeEntry) bool {
 	if entry1 == nil && entry2 != nil {
 		return true
@@ -4,8 +4,8 @@
 package merge
 
 import (
+	"fmt"
 	"io"
-	"sort"
 	"testing"
 
 	"github.com/go-git/go-git/v5/plumbing"
@@ -259,6 +259,236 @@ func TestMergeTree_NoBaseDir(t *testing.T) {
 	}
 }
 
+func TestMergeTree_ReuseUnchangedTree(t *testing.T) {
+	// /dir/a.txt A == B != Base
+	// /dir/b.txt A != Base && B == Base
+	// The merged dir and root are the ones of tree1.
+
+	storage := memory.NewStorage()
+	tree1, err := restoreTree(storage, dumpedTree{
+		Dirs: map[string]dumpedTree{
+			"dir": {Files: map[string]string{"a.txt": "AB", "b.txt": "A"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree2, err := restoreTree(storage, dumpedTree{
+		Dirs: map[string]dumpedTree{
+			"dir": {Files: map[string]string{"a.txt": "AB", "b.txt": "Base"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mergeBase, err := restoreTree(storage, dumpedTree{
+		Dirs: map[string]dumpedTree{
+			"dir": {Files: map[string]string{"a.txt": "Base", "b.txt": "Base"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := MergeTree(storage, tree1, tree2, mergeBase, testResolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TreeHash != tree1.Hash {
+		t.Errorf("TreeHash is %s, want tree1 %s", result.TreeHash, tree1.Hash)
+	}
+	if len(result.NewHashes) != 0 {
+		t.Errorf("NewHashes is %v, want none", result.NewHashes)
+	}
+	if diff := cmp.Diff([]string{"dir/a.txt"}, result.FilesPickedEntry12); diff != "" {
+		t.Errorf("FilesPickedEntry12 diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestMergeTree_ReuseMergeBaseTree(t *testing.T) {
+	// /dir1/a.txt A != B != Base, resolved to Base
+	// /dir2/a.txt A != B != Base, resolved to Base
+	// /dir3/a.txt A != B, no base, resolved to "C" in both dir3 and dir4
+	// dir1 and dir2 are the ones of the merge base. dir3 and dir4 are the same new tree.
+
+	storage := memory.NewStorage()
+	tree1, err := restoreTree(storage, dumpedTree{
+		Dirs: map[string]dumpedTree{
+			"dir1": {Files: map[string]string{"a.txt": "A"}},
+			"dir2": {Files: map[string]string{"a.txt": "A"}},
+			"dir3": {Files: map[string]string{"a.txt": "A"}},
+			"dir4": {Files: map[string]string{"a.txt": "A"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree2, err := restoreTree(storage, dumpedTree{
+		Dirs: map[string]dumpedTree{
+			"dir1": {Files: map[string]string{"a.txt": "B"}},
+			"dir2": {Files: map[string]string{"a.txt": "B"}},
+			"dir3": {Files: map[string]string{"a.txt": "B"}},
+			"dir4": {Files: map[string]string{"a.txt": "B"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mergeBase, err := restoreTree(storage, dumpedTree{
+		Dirs: map[string]dumpedTree{
+			"dir1": {Files: map[string]string{"a.txt": "Base"}},
+			"dir2": {Files: map[string]string{"a.txt": "Base"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolved, err := restoreTree(storage, dumpedTree{Files: map[string]string{"a.txt": "C"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := func(parentPath string, entry1, entry2, entryBase *object.TreeEntry) ([]object.TreeEntry, error) {
+		if entryBase != nil {
+			return []object.TreeEntry{*entryBase}, nil
+		}
+		return resolved.Entries, nil
+	}
+
+	result, err := MergeTree(storage, tree1, tree2, mergeBase, resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := object.GetTree(storage, result.TreeHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseDir1, err := mergeBase.FindEntry("dir1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range root.Entries {
+		want := baseDir1.Hash
+		if entry.Name == "dir3" || entry.Name == "dir4" {
+			want = resolved.Hash
+		}
+		if entry.Hash != want {
+			t.Errorf("%s is %s, want %s", entry.Name, entry.Hash, want)
+		}
+	}
+	if diff := cmp.Diff([]plumbing.Hash{resolved.Hash, result.TreeHash}, result.NewHashes); diff != "" {
+		t.Errorf("NewHashes diff (-want +got):\n%s", diff)
+	}
+}
+
+// benchmarkMergeTrees returns the trees of 100 dirs with 1000 files each. tree1 changes a file in
+// the first 60 dirs and tree2 changes another file in the last 60 dirs, so that 20 dirs are
+// merged file by file.
+func benchmarkMergeTrees(b *testing.B) (storage *memory.Storage, tree1, tree2, mergeBase *object.Tree) {
+	storage = memory.NewStorage()
+	build := func(change func(dir int) (string, bool)) *object.Tree {
+		root := dumpedTree{Dirs: map[string]dumpedTree{}}
+		for i := 0; i < 100; i++ {
+			files := map[string]string{}
+			for j := 0; j < 1000; j++ {
+				files[fmt.Sprintf("file%04d.txt", j)] = fmt.Sprintf("%d/%d", i, j)
+			}
+			if name, ok := change(i); ok {
+				files[name] = "changed"
+			}
+			root.Dirs[fmt.Sprintf("dir%03d", i)] = dumpedTree{Files: files}
+		}
+		tree, err := restoreTree(storage, root)
+		if err != nil {
+			b.Fatal(err)
+		}
+		return tree
+	}
+	tree1 = build(func(dir int) (string, bool) { return "file0001.txt", dir < 60 })
+	tree2 = build(func(dir int) (string, bool) { return "file0002.txt", dir >= 40 })
+	mergeBase = build(func(int) (string, bool) { return "", false })
+	return storage, tree1, tree2, mergeBase
+}
+
+func BenchmarkMergeTree(b *testing.B) {
+	storage, tree1, tree2, mergeBase := benchmarkMergeTrees(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := MergeTree(storage, tree1, tree2, mergeBase, testResolver); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestMergeTree_Submodules(t *testing.T) {
+	// /lib    moved only in B
+	// /tools  moved in A, removed in B
+	// /vendor moved in A and B to different commits
+	storage := memory.NewStorage()
+	commit := func(s string) plumbing.Hash {
+		// The commits of the submodules are not in the storage.
+		return plumbing.ComputeHash(plumbing.CommitObject, []byte(s))
+	}
+	blob, err := createBlob(storage, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeTree := func(entries ...object.TreeEntry) *object.Tree {
+		hash, err := encodeTree(storage, "", append([]object.TreeEntry{{Name: "a.txt", Mode: filemode.Regular, Hash: blob}}, entries...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tree, err := object.GetTree(storage, hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tree
+	}
+	mergeBase := writeTree(
+		object.TreeEntry{Name: "lib", Mode: filemode.Submodule, Hash: commit("base")},
+		object.TreeEntry{Name: "tools", Mode: filemode.Submodule, Hash: commit("base")},
+		object.TreeEntry{Name: "vendor", Mode: filemode.Submodule, Hash: commit("base")},
+	)
+	tree1 := writeTree(
+		object.TreeEntry{Name: "lib", Mode: filemode.Submodule, Hash: commit("base")},
+		object.TreeEntry{Name: "tools", Mode: filemode.Submodule, Hash: commit("A")},
+		object.TreeEntry{Name: "vendor", Mode: filemode.Submodule, Hash: commit("A")},
+	)
+	tree2 := writeTree(
+		object.TreeEntry{Name: "lib", Mode: filemode.Submodule, Hash: commit("B")},
+		object.TreeEntry{Name: "vendor", Mode: filemode.Submodule, Hash: commit("B")},
+	)
+
+	resolver := NewDiff3Resolver(storage, Labels{})
+	result, err := MergeTree(storage, tree1, tree2, mergeBase, resolver.Resolve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"lib"}, result.FilesPickedEntry2); diff != "" {
+		t.Errorf("FilesPickedEntry2 diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"tools", "vendor"}, result.SubmoduleConflicts); diff != "" {
+		t.Errorf("SubmoduleConflicts diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(result.FilesConflict, resolver.FilesConflict); diff != "" {
+		t.Errorf("the resolver's FilesConflict diff (-want +got):\n%s", diff)
+	}
+	tree, err := object.GetTree(storage, result.TreeHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The conflicts are left with the first side.
+	want := []object.TreeEntry{
+		{Name: "a.txt", Mode: filemode.Regular, Hash: blob},
+		{Name: "lib", Mode: filemode.Submodule, Hash: commit("B")},
+		{Name: "tools", Mode: filemode.Submodule, Hash: commit("A")},
+		{Name: "vendor", Mode: filemode.Submodule, Hash: commit("A")},
+	}
+	if diff := cmp.Diff(want, tree.Entries); diff != "" {
+		t.Errorf("merged tree diff (-want +got):\n%s", diff)
+	}
+}
+
 func testResolver(parentPath string, entry1, entry2, base *object.TreeEntry) ([]object.TreeEntry, error) {
 	var ret []object.TreeEntry
 	if entry1 != nil {
@@ -340,7 +570,7 @@ func restoreTree(storage storer.EncodedObjectStorer, root dumpedTree) (*object.T
 				entries = append(entries, object.TreeEntry{Name: name, Hash: subtreeHash, Mode: filemode.Dir})
 			}
 		}
-		sort.Sort(object.TreeEntrySorter(entries))
+		SortTreeEntries(entries)
 		newTree := object.Tree{Entries: entries}
 		o := storage.NewEncodedObject()
 		if err := newTree.Encode(o); err != nil {
@@ -0,0 +1,112 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// InvalidTreeError is returned when a tree to create has entries that git rejects.
+type InvalidTreeError struct {
+	// Path is the directory of the tree. Empty for the root tree.
+	Path string
+	// Entries are the names of the offending entries.
+	Entries []string
+	// Reason is what is wrong with the entries.
+	Reason string
+}
+
+func (e *InvalidTreeError) Error() string {
+	var names []string
+	for _, name := range e.Entries {
+		names = append(names, fmt.Sprintf("%q", name))
+	}
+	return fmt.Sprintf("invalid %s: %s: %s", treeDescription(e.Path), e.Reason, strings.Join(names, ", "))
+}
+
+func treeDescription(pth string) string {
+	if pth == "" {
+		return "root tree"
+	}
+	return fmt.Sprintf("tree at %q", pth)
+}
+
+// SortTreeEntries sorts the entries in the git tree order. A directory is compared as if its name
+// had a trailing slash, so the file "a.txt" comes before the directory "a". This is different
+// from object.TreeEntrySorter, which compares the names only.
+func SortTreeEntries(entries []object.TreeEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return treeEntrySortKey(entries[i]) < treeEntrySortKey(entries[j])
+	})
+}
+
+func treeEntrySortKey(entry object.TreeEntry) string {
+	if entry.Mode == filemode.Dir {
+		return entry.Name + "/"
+	}
+	return entry.Name
+}
+
+// ValidateTreeEntries checks the entries of the tree at pth, like git fsck does for the pushed
+// trees: the names are path components other than "." , "..", and ".git", they are unique, the
+// modes are the ones that git writes, and the entries are in the git tree order.
+func ValidateTreeEntries(pth string, entries []object.TreeEntry) error {
+	var invalidNames, invalidModes, duplicates []string
+	seen := map[string]bool{}
+	for _, entry := range entries {
+		name := entry.Name
+		if name == "" || name == "." || name == ".." || strings.EqualFold(name, ".git") || strings.ContainsAny(name, "/\x00") {
+			invalidNames = append(invalidNames, name)
+		}
+		switch entry.Mode {
+		case filemode.Dir, filemode.Regular, filemode.Executable, filemode.Symlink, filemode.Submodule:
+		default:
+			invalidModes = append(invalidModes, name)
+		}
+		if seen[name] {
+			duplicates = append(duplicates, name)
+		}
+		seen[name] = true
+	}
+	switch {
+	case len(invalidNames) > 0:
+		return &InvalidTreeError{Path: pth, Entries: invalidNames, Reason: "invalid names"}
+	case len(invalidModes) > 0:
+		return &InvalidTreeError{Path: pth, Entries: invalidModes, Reason: "invalid modes"}
+	case len(duplicates) > 0:
+		return &InvalidTreeError{Path: pth, Entries: duplicates, Reason: "duplicate entries"}
+	}
+	for i := 1; i < len(entries); i++ {
+		if treeEntrySortKey(entries[i-1]) >= treeEntrySortKey(entries[i]) {
+			return &InvalidTreeError{Path: pth, Entries: []string{entries[i-1].Name, entries[i].Name}, Reason: "entries out of order"}
+		}
+	}
+	return nil
+}
+
+// encodeTree sorts the entries of the tree at pth in the git tree order, validates them, and
+// stores the tree.
+func encodeTree(storage storer.EncodedObjectStorer, pth string, entries []object.TreeEntry) (plumbing.Hash, error) {
+	SortTreeEntries(entries)
+	if err := ValidateTreeEntries(pth, entries); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	tree := object.Tree{Entries: entries}
+	obj := storage.NewEncodedObject()
+	if err := tree.Encode(obj); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("cannot encode the %s: %w", treeDescription(pth), err)
+	}
+	hash, err := storage.SetEncodedObject(obj)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("cannot save the %s: %w", treeDescription(pth), err)
+	}
+	return hash, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package merge
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSortTreeEntries(t *testing.T) {
+	entries := []object.TreeEntry{
+		{Name: "foo", Mode: filemode.Dir},
+		{Name: "foo.txt", Mode: filemode.Regular},
+		{Name: "foo-bar", Mode: filemode.Regular},
+		{Name: "bar", Mode: filemode.Dir},
+		{Name: "foo0", Mode: filemode.Regular},
+	}
+	SortTreeEntries(entries)
+	var got []string
+	for _, entry := range entries {
+		got = append(got, entry.Name)
+	}
+	// "/" comes after "-" and "." and before "0".
+	want := []string{"bar", "foo-bar", "foo.txt", "foo", "foo0"}
+	if !cmp.Equal(want, got) {
+		t.Error("Got a diff\n" + cmp.Diff(want, got))
+	}
+	if err := ValidateTreeEntries("", entries); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValidateTreeEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []object.TreeEntry
+		want    *InvalidTreeError
+	}{
+		{
+			name: "invalid names",
+			entries: []object.TreeEntry{
+				{Name: "", Mode: filemode.Regular},
+				{Name: "..", Mode: filemode.Dir},
+				{Name: ".GIT", Mode: filemode.Dir},
+				{Name: "a/b", Mode: filemode.Regular},
+			},
+			want: &InvalidTreeError{Path: "dir", Entries: []string{"", "..", ".GIT", "a/b"}, Reason: "invalid names"},
+		},
+		{
+			name: "invalid modes",
+			entries: []object.TreeEntry{
+				{Name: "a", Mode: filemode.Regular},
+				{Name: "b", Mode: filemode.Deprecated},
+			},
+			want: &InvalidTreeError{Path: "dir", Entries: []string{"b"}, Reason: "invalid modes"},
+		},
+		{
+			name: "file and directory with the same name",
+			entries: []object.TreeEntry{
+				{Name: "a", Mode: filemode.Regular},
+				{Name: "a.txt", Mode: filemode.Regular},
+				{Name: "a", Mode: filemode.Dir},
+			},
+			want: &InvalidTreeError{Path: "dir", Entries: []string{"a"}, Reason: "duplicate entries"},
+		},
+		{
+			name: "out of order",
+			entries: []object.TreeEntry{
+				{Name: "a", Mode: filemode.Dir},
+				{Name: "a.txt", Mode: filemode.Regular},
+			},
+			want: &InvalidTreeError{Path: "dir", Entries: []string{"a", "a.txt"}, Reason: "entries out of order"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTreeEntries("dir", tt.entries)
+			var got *InvalidTreeError
+			if !errors.As(err, &got) {
+				t.Fatalf("want an InvalidTreeError, got %v", err)
+			}
+			if !cmp.Equal(tt.want, got) {
+				t.Error("Got a diff\n" + cmp.Diff(tt.want, got))
+			}
+		})
+	}
+}
+
+func TestMergeTree_DuplicateResolvedEntries(t *testing.T) {
+	storage := memory.NewStorage()
+	tree1, err := restoreTree(storage, dumpedTree{
+		Dirs: map[string]dumpedTree{"dir1": {Files: map[string]string{"file1.txt": "A"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree2, err := restoreTree(storage, dumpedTree{
+		Dirs: map[string]dumpedTree{"dir1": {Files: map[string]string{"file1.txt": "B"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mergeBase, err := restoreTree(storage, dumpedTree{
+		Dirs: map[string]dumpedTree{"dir1": {Files: map[string]string{"file1.txt": "Base"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A resolver that keeps both sides under the same name.
+	resolver := func(parentPath string, entry1, entry2, base *object.TreeEntry) ([]object.TreeEntry, error) {
+		return []object.TreeEntry{*entry1, *entry2}, nil
+	}
+	_, err = MergeTree(storage, tree1, tree2, mergeBase, resolver)
+	var got *InvalidTreeError
+	if !errors.As(err, &got) {
+		t.Fatalf("want an InvalidTreeError, got %v", err)
+	}
+	want := &InvalidTreeError{Path: "dir1", Entries: []string{"file1.txt"}, Reason: "duplicate entries"}
+	if !cmp.Equal(want, got) {
+		t.Error("Got a diff\n" + cmp.Diff(want, got))
+	}
+}
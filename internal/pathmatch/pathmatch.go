@@ -0,0 +1,76 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package pathmatch
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Match returns true if the slash-separated path matches the pattern.
+//
+// The pattern is matched against the whole path. Each path segment is matched with path.Match,
+// and a "**" segment matches zero or more segments. For example, ".github/workflows/**" matches
+// every file under .github/workflows, and "**/*.go" matches every Go file.
+func Match(pattern, pth string) bool {
+	return matchSegments(splitPath(pattern), splitPath(pth))
+}
+
+// Validate returns an error if any of the patterns is malformed. Match treats a malformed
+// segment as not matching, so the callers validate the patterns first.
+func Validate(patterns []string) error {
+	for _, pattern := range patterns {
+		for _, segment := range splitPath(pattern) {
+			if segment == "**" {
+				continue
+			}
+			if _, err := path.Match(segment, ""); err != nil {
+				return fmt.Errorf("invalid path pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// MatchAny returns true if the path matches any of the patterns.
+func MatchAny(patterns []string, pth string) bool {
+	for _, pattern := range patterns {
+		if Match(pattern, pth) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSegments(patterns, segments []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			rest := patterns[1:]
+			for i := 0; i <= len(segments); i++ {
+				if matchSegments(rest, segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if ok, err := path.Match(patterns[0], segments[0]); err != nil || !ok {
+			return false
+		}
+		patterns = patterns[1:]
+		segments = segments[1:]
+	}
+	return len(segments) == 0
+}
+
+func splitPath(pth string) []string {
+	pth = strings.Trim(pth, "/")
+	if pth == "" {
+		return nil
+	}
+	return strings.Split(pth, "/")
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package pathmatch
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		pth     string
+		want    bool
+	}{
+		{".github/workflows/**", ".github/workflows/ci.yml", true},
+		{".github/workflows/**", ".github/workflows/sub/ci.yml", true},
+		{".github/workflows/**", ".github/CODEOWNERS", false},
+		{"**/*.go", "main.go", true},
+		{"**/*.go", "cmd/niche-git/main.go", true},
+		{"**/*.go", "README.md", false},
+		{"*.md", "docs/README.md", false},
+		{"docs/*.md", "docs/README.md", true},
+		{"src/**/test/*", "src/test/a.txt", true},
+		{"src/**/test/*", "src/a/b/test/a.txt", true},
+		{"go.mod", "go.mod", true},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.pattern, tt.pth); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.pth, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate([]string{".github/workflows/**", "**/*.go", "src/[a-c]*/x"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	for _, pattern := range []string{"src/[a-c/x", "docs/\\"} {
+		if err := Validate([]string{"go.mod", pattern}); err == nil {
+			t.Errorf("expected an error for %q", pattern)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package push
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	formatcfg "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/go-git/go-git/v5/plumbing/format/pktline"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp/capability"
+	gogittransport "github.com/go-git/go-git/v5/plumbing/transport"
+)
+
+// advertisedReferences gets the refs and the capabilities advertised by receive-pack over HTTP.
+// go-git's decoder assumes the SHA-1 hashes, so this is used for the SHA-256 repositories
+// instead.
+func advertisedReferences(ctx context.Context, ep *gogittransport.Endpoint, client *http.Client) (*packp.AdvRefs, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep.String()+"/info/refs?service="+gogittransport.ReceivePackServiceName, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, &fetch.HTTPStatusError{StatusCode: resp.StatusCode}
+	}
+	return decodeAdvertisedReferences(resp.Body)
+}
+
+// decodeAdvertisedReferences decodes the receive-pack advertisement with the hash size of
+// fetch.ObjectFormat.
+func decodeAdvertisedReferences(r io.Reader) (*packp.AdvRefs, error) {
+	ar := packp.NewAdvRefs()
+	scanner := pktline.NewScanner(r)
+	first := true
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			// The flush after the service line and the one at the end.
+			continue
+		}
+		if bytes.HasPrefix(line, []byte("# service=")) {
+			continue
+		}
+		line = bytes.TrimSuffix(line, []byte("\n"))
+		if first {
+			first = false
+			var caps []byte
+			line, caps, _ = bytes.Cut(line, []byte{0})
+			if err := ar.Capabilities.Decode(caps); err != nil {
+				return nil, fmt.Errorf("cannot parse the capabilities: %w", err)
+			}
+			objectFormat := string(formatcfg.SHA1)
+			if values := ar.Capabilities.Get(capability.ObjectFormat); len(values) > 0 {
+				objectFormat = values[0]
+			}
+			if objectFormat != string(fetch.ObjectFormat) {
+				return nil, fmt.Errorf("the repository uses the %s object format, but this build supports %s", objectFormat, fetch.ObjectFormat)
+			}
+		}
+		hash, name, ok := strings.Cut(string(line), " ")
+		if !ok || !plumbing.IsHash(hash) {
+			return nil, fmt.Errorf("malformed ref advertisement %q", line)
+		}
+		if name == "capabilities^{}" || name == ".have" {
+			continue
+		}
+		ar.References[name] = plumbing.NewHash(hash)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse the ref advertisement: %w", err)
+	}
+	if first {
+		return nil, gogittransport.ErrEmptyRemoteRepository
+	}
+	gogittransport.FilterUnsupportedCapabilities(ar.Capabilities)
+	return ar, nil
+}
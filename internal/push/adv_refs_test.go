@@ -0,0 +1,70 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package push
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	formatcfg "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/go-git/go-git/v5/plumbing/format/pktline"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp/capability"
+)
+
+func TestDecodeAdvertisedReferences(t *testing.T) {
+	main := plumbing.NewHash(strings.Repeat("1", len(plumbing.ZeroHash.String())))
+	feature := plumbing.NewHash(strings.Repeat("2", len(plumbing.ZeroHash.String())))
+	// The servers advertise the object format if it's not SHA-1.
+	var objectFormat string
+	if fetch.ObjectFormat != formatcfg.SHA1 {
+		objectFormat = " object-format=" + string(fetch.ObjectFormat)
+	}
+	encode := func(lines ...string) *bytes.Buffer {
+		var buf bytes.Buffer
+		e := pktline.NewEncoder(&buf)
+		if err := e.EncodeString("# service=git-receive-pack\n"); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.EncodeString(lines...); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		return &buf
+	}
+
+	ar, err := decodeAdvertisedReferences(encode(
+		main.String()+" refs/heads/main\x00report-status atomic delete-refs"+objectFormat+"\n",
+		feature.String()+" refs/heads/feature\n",
+		feature.String()+" .have\n",
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ar.References) != 2 || ar.References["refs/heads/main"] != main || ar.References["refs/heads/feature"] != feature {
+		t.Errorf("unexpected refs: %v", ar.References)
+	}
+	if !ar.Capabilities.Supports(capability.Atomic) || !ar.Capabilities.Supports(capability.ReportStatus) {
+		t.Errorf("unexpected capabilities: %s", ar.Capabilities)
+	}
+
+	ar, err = decodeAdvertisedReferences(encode(plumbing.ZeroHash.String() + " capabilities^{}\x00report-status" + objectFormat + "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ar.References) != 0 {
+		t.Errorf("the empty repository has refs: %v", ar.References)
+	}
+
+	if _, err := decodeAdvertisedReferences(encode(main.String() + " refs/heads/main\x00report-status object-format=unknown\n")); err == nil {
+		t.Errorf("decoded the advertisement of an unknown object format")
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package push
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/packfile"
+	"github.com/go-git/go-git/v5/plumbing/hash"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// EncodePackfile encodes the objects into a packfile to push. The objects that have a base in
+// deltaBases are encoded as REF_DELTA if the delta is smaller. The bases are not in the packfile
+// (a thin pack), so they need to exist in the server. level is the zlib compression level.
+func EncodePackfile(storage storer.EncodedObjectStorer, hashes []plumbing.Hash, deltaBases map[plumbing.Hash]plumbing.Hash, level int) (*bytes.Buffer, error) {
+	buf := &bytes.Buffer{}
+	h := hash.New(hash.CryptoType)
+	w := io.MultiWriter(buf, h)
+	header := make([]byte, 12)
+	copy(header, "PACK")
+	binary.BigEndian.PutUint32(header[4:], 2)
+	binary.BigEndian.PutUint32(header[8:], uint32(len(hashes)))
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	for _, hash := range hashes {
+		obj, err := storage.EncodedObject(plumbing.AnyObject, hash)
+		if err != nil {
+			return nil, fmt.Errorf("cannot find %q to push: %w", hash.String(), err)
+		}
+		content, err := readObject(obj)
+		if err != nil {
+			return nil, err
+		}
+		typ, data := obj.Type(), content
+		var baseHash plumbing.Hash
+		if base, ok := deltaBases[hash]; ok {
+			if baseObj, err := storage.EncodedObject(obj.Type(), base); err == nil {
+				baseContent, err := readObject(baseObj)
+				if err != nil {
+					return nil, err
+				}
+				if delta := packfile.DiffDelta(baseContent, content); len(delta) < len(content) {
+					typ, data, baseHash = plumbing.REFDeltaObject, delta, base
+				}
+			}
+		}
+		if _, err := w.Write(entryHeader(typ, len(data))); err != nil {
+			return nil, err
+		}
+		if typ == plumbing.REFDeltaObject {
+			if _, err := w.Write(baseHash[:]); err != nil {
+				return nil, err
+			}
+		}
+		zw, err := zlib.NewWriterLevel(w, level)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := zw.Write(data); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+	}
+	buf.Write(h.Sum(nil))
+	return buf, nil
+}
+
+// entryHeader returns the type and the size of a packfile entry in the variable-length format.
+func entryHeader(typ plumbing.ObjectType, size int) []byte {
+	b := byte(typ)<<4 | byte(size&0x0f)
+	size >>= 4
+	var ret []byte
+	for size > 0 {
+		ret = append(ret, b|0x80)
+		b = byte(size & 0x7f)
+		size >>= 7
+	}
+	return append(ret, b)
+}
+
+func readObject(obj plumbing.EncodedObject) ([]byte, error) {
+	rd, err := obj.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %q: %w", obj.Hash().String(), err)
+	}
+	defer rd.Close()
+	return io.ReadAll(rd)
+}
+
+// DeltaBases pairs the new trees and blobs of the commits to push with the ones at the same paths
+// in their first parents, like git pairs the objects by their names. The pairs are the delta
+// bases for EncodePackfile. The parents need to be in the server, and only their objects in the
+// storage are used.
+func DeltaBases(storage storer.EncodedObjectStorer, hashes []plumbing.Hash) map[plumbing.Hash]plumbing.Hash {
+	inPack := map[plumbing.Hash]bool{}
+	for _, hash := range hashes {
+		inPack[hash] = true
+	}
+	ret := map[plumbing.Hash]plumbing.Hash{}
+	for _, hash := range hashes {
+		commit, err := object.GetCommit(storage, hash)
+		if err != nil || len(commit.ParentHashes) == 0 || inPack[commit.ParentHashes[0]] {
+			continue
+		}
+		parent, err := object.GetCommit(storage, commit.ParentHashes[0])
+		if err != nil {
+			continue
+		}
+		pairTrees(storage, inPack, commit.TreeHash, parent.TreeHash, ret)
+	}
+	return ret
+}
+
+func pairTrees(storage storer.EncodedObjectStorer, inPack map[plumbing.Hash]bool, hash, baseHash plumbing.Hash, ret map[plumbing.Hash]plumbing.Hash) {
+	if !inPack[hash] || inPack[baseHash] {
+		return
+	}
+	if _, ok := ret[hash]; ok {
+		return
+	}
+	tree, err := object.GetTree(storage, hash)
+	if err != nil {
+		return
+	}
+	base, err := object.GetTree(storage, baseHash)
+	if err != nil {
+		return
+	}
+	ret[hash] = baseHash
+	for _, entry := range tree.Entries {
+		if !inPack[entry.Hash] {
+			continue
+		}
+		baseEntry, err := base.FindEntry(entry.Name)
+		if err != nil || inPack[baseEntry.Hash] {
+			continue
+		}
+		switch {
+		case entry.Mode == filemode.Dir && baseEntry.Mode == filemode.Dir:
+			pairTrees(storage, inPack, entry.Hash, baseEntry.Hash, ret)
+		case entry.Mode.IsFile() && baseEntry.Mode.IsFile():
+			if _, ok := ret[entry.Hash]; !ok && storage.HasEncodedObject(baseEntry.Hash) == nil {
+				ret[entry.Hash] = baseEntry.Hash
+			}
+		}
+	}
+}
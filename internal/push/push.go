@@ -2,52 +2,169 @@ package push
 
 import (
 	"bytes"
-	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/fileurl"
 	"github.com/go-git/go-git/v5/plumbing"
+	formatcfg "github.com/go-git/go-git/v5/plumbing/format/config"
 	"github.com/go-git/go-git/v5/plumbing/protocol/packp"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp/capability"
 	gogittransport "github.com/go-git/go-git/v5/plumbing/transport"
+	gogitfile "github.com/go-git/go-git/v5/plumbing/transport/file"
 	gogithttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 )
 
 func Push(repoURL string, client *http.Client, packfile *bytes.Buffer, refUpdates []RefUpdate) (debug.PushDebugInfo, error) {
-	debugInfo := debug.PushDebugInfo{}
+	return push(repoURL, client, packfile, refUpdates, false)
+}
+
+// PushAtomic is Push that makes the server apply all the ref updates or none of them. This fails
+// if the server doesn't support atomic pushes.
+func PushAtomic(repoURL string, client *http.Client, packfile *bytes.Buffer, refUpdates []RefUpdate) (debug.PushDebugInfo, error) {
+	return push(repoURL, client, packfile, refUpdates, true)
+}
+
+// push pushes the packfile and updates the refs, and retries the push with the retry policy.
+//
+// A push that the server has applied can fail on the client side, e.g. when the response is lost.
+// Its retry then fails the compare-and-swap, as the refs have already moved. So, if a retried
+// push fails, the refs are read again, and the push succeeds if they are at the new values.
+func push(repoURL string, client *http.Client, packfile *bytes.Buffer, refUpdates []RefUpdate, atomic bool) (debug.PushDebugInfo, error) {
+	var pack []byte
 	if packfile != nil {
-		debugInfo.PackfileSize = packfile.Len()
+		pack = packfile.Bytes()
+	}
+	var debugInfo debug.PushDebugInfo
+	attempts := 0
+	err := fetch.WithRetries(fetch.Context(client), func() error {
+		attempts++
+		var err error
+		debugInfo, err = pushOnce(repoURL, client, pack, packfile != nil, refUpdates, atomic)
+		return err
+	})
+	if err != nil && attempts > 1 && refsUpdated(repoURL, client, refUpdates) {
+		return debugInfo, nil
 	}
+	return debugInfo, err
+}
 
-	ep, err := gogittransport.NewEndpoint(repoURL)
+// refsUpdated returns true if all the refs are at their new values on the server. A ref with
+// the zero new value needs to be absent. An error of reading the refs is treated as false.
+func refsUpdated(repoURL string, client *http.Client, refUpdates []RefUpdate) bool {
+	var prefixes []string
+	for _, u := range refUpdates {
+		prefixes = append(prefixes, u.Name.String())
+	}
+	refData, _, err := fetch.LsRefs(repoURL, client, prefixes)
 	if err != nil {
-		return debugInfo, err
+		return false
+	}
+	current := map[plumbing.ReferenceName]plumbing.Hash{}
+	for _, line := range refData {
+		parts := strings.Split(strings.TrimSpace(line), " ")
+		if len(parts) < 2 {
+			return false
+		}
+		current[plumbing.ReferenceName(parts[1])] = plumbing.NewHash(parts[0])
+	}
+	for _, u := range refUpdates {
+		if current[u.Name] != u.NewHash {
+			return false
+		}
 	}
+	return true
+}
 
-	crt := &capturingRoundTripper{inner: client.Transport}
-	httpClient := &http.Client{
-		Transport:     crt,
-		CheckRedirect: client.CheckRedirect,
-		Jar:           client.Jar,
-		Timeout:       client.Timeout,
+func pushOnce(repoURL string, client *http.Client, pack []byte, hasPackfile bool, refUpdates []RefUpdate, atomic bool) (debug.PushDebugInfo, error) {
+	debugInfo := debug.PushDebugInfo{}
+	if hasPackfile {
+		debugInfo.PackfileSize = len(pack)
 	}
 
-	transport := gogithttp.NewClient(httpClient)
+	// The ref advertisement is bounded separately, as a hung /info/refs would otherwise block
+	// the push indefinitely before the pack is even sent.
+	t := currentTimeouts(fetch.Context(client))
+	ctx, cancel := withTimeout(fetch.Context(client), t.Push)
+	defer cancel()
+	advCtx, advCancel := withTimeout(ctx, t.RefAdvertisement)
+	defer advCancel()
+
+	crt := &capturingRoundTripper{}
+	var ep *gogittransport.Endpoint
+	var transport gogittransport.Transport
+	var httpClient *http.Client
+	if fileurl.IsFileURL(repoURL) {
+		pth, err := fileurl.ToLocalPath(repoURL)
+		if err != nil {
+			return debugInfo, err
+		}
+		ep = &gogittransport.Endpoint{Protocol: "file", Path: pth}
+		transport = gogitfile.DefaultClient
+	} else {
+		var err error
+		ep, err = gogittransport.NewEndpoint(repoURL)
+		if err != nil {
+			return debugInfo, err
+		}
+		if client == nil {
+			client = http.DefaultClient
+		}
+		crt.inner = client.Transport
+		httpClient = &http.Client{
+			Transport:     crt,
+			CheckRedirect: client.CheckRedirect,
+			Jar:           client.Jar,
+			Timeout:       client.Timeout,
+		}
+		transport = gogithttp.NewClient(httpClient)
+	}
+	// Creating an HTTP session doesn't reach the server. The advertisement below is the first
+	// request.
 	sess, err := transport.NewReceivePackSession(ep, nil)
 	if err != nil {
 		return debugInfo, err
 	}
 	defer sess.Close()
+	// The refs can change even if the push fails midway.
+	defer fetch.InvalidateLsRefsCache(repoURL, client)
 
-	advRef, err := sess.AdvertisedReferences()
+	var advRef *packp.AdvRefs
+	if fetch.ObjectFormat == formatcfg.SHA1 {
+		advRef, err = sess.AdvertisedReferencesContext(advCtx)
+	} else if httpClient != nil {
+		advRef, err = advertisedReferences(advCtx, ep, httpClient)
+	} else {
+		// go-git's file transport decodes the advertisement by itself.
+		err = fmt.Errorf("pushing to a file URL is not supported with the %s object format", fetch.ObjectFormat)
+	}
 	debugInfo.RefAdvResponseHeaders = crt.lastResponseHTTPHeader
+	if ctx.Err() != nil {
+		err = timeoutError(err, ctx, "push", t.Push)
+	} else {
+		err = timeoutError(err, advCtx, "ref advertisement", t.RefAdvertisement)
+	}
 	if err != nil {
 		return debugInfo, err
 	}
 
 	req := packp.NewReferenceUpdateRequestFromCapabilities(advRef.Capabilities)
-	if packfile != nil {
-		req.Packfile = io.NopCloser(packfile)
+	if fetch.ObjectFormat != formatcfg.SHA1 {
+		req.Capabilities.Set(capability.ObjectFormat, string(fetch.ObjectFormat))
+	}
+	if atomic {
+		if !advRef.Capabilities.Supports(capability.Atomic) {
+			return debugInfo, errors.New("the server doesn't support atomic pushes")
+		}
+		req.Capabilities.Set(capability.Atomic)
+	}
+	if hasPackfile {
+		req.Packfile = io.NopCloser(bytes.NewReader(pack))
 	}
 	for _, u := range refUpdates {
 		cmd := &packp.Command{
@@ -63,7 +180,8 @@ func Push(repoURL string, client *http.Client, packfile *bytes.Buffer, refUpdate
 		}
 		req.Commands = append(req.Commands, cmd)
 	}
-	status, err := sess.ReceivePack(context.Background(), req)
+	status, err := sess.ReceivePack(ctx, req)
+	err = timeoutError(err, ctx, "push", t.Push)
 	debugInfo.PushResponseHeaders = crt.lastResponseHTTPHeader
 	if status != nil {
 		debugInfo.UnpackStatus = status.UnpackStatus
@@ -75,17 +193,34 @@ func Push(repoURL string, client *http.Client, packfile *bytes.Buffer, refUpdate
 		}
 
 	}
-	if err != nil {
-		return debugInfo, err
-	}
+	// go-git returns the rejected ref updates as an error too, so the status is checked first.
 	if status != nil {
-		if err := status.Error(); err != nil {
-			return debugInfo, err
+		if status.UnpackStatus != "ok" {
+			return debugInfo, status.Error()
+		}
+		for _, cs := range status.CommandStatuses {
+			if cs.Status != "ok" {
+				return debugInfo, &RefUpdateError{RefName: cs.ReferenceName.String(), Status: cs.Status}
+			}
 		}
 	}
+	if err != nil {
+		return debugInfo, err
+	}
 	return debugInfo, nil
 }
 
+// RefUpdateError is returned when the server rejects a ref update. This happens when the
+// expected old value doesn't match (compare-and-swap failure) or the update is not allowed.
+type RefUpdateError struct {
+	RefName string
+	Status  string
+}
+
+func (e *RefUpdateError) Error() string {
+	return fmt.Sprintf("command error on %s: %s", e.RefName, e.Status)
+}
+
 type RefUpdate struct {
 	Name plumbing.ReferenceName
 
@@ -0,0 +1,81 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Timeouts are the time limits of each push attempt. Zero means no limit.
+type Timeouts struct {
+	RefAdvertisement time.Duration
+	Push             time.Duration
+}
+
+var (
+	timeoutsMu sync.Mutex
+	timeouts   Timeouts
+)
+
+// SetTimeouts sets the default time limits of the pushes in the process. WithTimeouts overrides
+// them for a context.
+func SetTimeouts(t Timeouts) {
+	timeoutsMu.Lock()
+	defer timeoutsMu.Unlock()
+	timeouts = t
+}
+
+type timeoutsKey struct{}
+
+// WithTimeouts returns a context that has the time limits of the pushes.
+func WithTimeouts(ctx context.Context, t Timeouts) context.Context {
+	return context.WithValue(ctx, timeoutsKey{}, t)
+}
+
+// currentTimeouts returns the time limits of the context, or the process-wide ones.
+func currentTimeouts(ctx context.Context) Timeouts {
+	if t, ok := ctx.Value(timeoutsKey{}).(Timeouts); ok {
+		return t
+	}
+	timeoutsMu.Lock()
+	defer timeoutsMu.Unlock()
+	return timeouts
+}
+
+// TimeoutError is returned when a push attempt exceeds the Timeouts.
+type TimeoutError struct {
+	// Phase is what timed out: "ref advertisement" or "push".
+	Phase string
+	Limit time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("the %s didn't finish within %v", e.Phase, e.Limit)
+}
+
+// Timeout and Temporary make TimeoutError a net.Error, so that the attempt is retried like the
+// other timeouts. It doesn't wrap context.DeadlineExceeded for the same reason, as the deadline
+// of the caller's context is not retried.
+func (e *TimeoutError) Timeout() bool   { return true }
+func (e *TimeoutError) Temporary() bool { return true }
+
+// withTimeout returns the context with the timeout if it's positive.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// timeoutError returns TimeoutError if the error is caused by the deadline of the context.
+func timeoutError(err error, ctx context.Context, phase string, timeout time.Duration) error {
+	if err != nil && timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return &TimeoutError{Phase: phase, Limit: timeout}
+	}
+	return err
+}
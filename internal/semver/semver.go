@@ -0,0 +1,183 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Package semver parses and compares semantic versions (https://semver.org/).
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a semantic version.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+	// Prerelease is the dot-separated pre-release identifiers without the leading "-".
+	Prerelease string
+	// Build is the build metadata without the leading "+". This doesn't affect the precedence.
+	Build string
+}
+
+// Parse parses a semantic version such as "1.2.3", "1.2.3-rc.1", or "1.2.3+build.5".
+func Parse(s string) (Version, error) {
+	var v Version
+	rest := s
+	if i := strings.IndexByte(rest, '+'); i >= 0 {
+		v.Build = rest[i+1:]
+		rest = rest[:i]
+		if !validIdentifiers(v.Build, false) {
+			return Version{}, fmt.Errorf("invalid build metadata in %q", s)
+		}
+	}
+	if i := strings.IndexByte(rest, '-'); i >= 0 {
+		v.Prerelease = rest[i+1:]
+		rest = rest[:i]
+		if !validIdentifiers(v.Prerelease, true) {
+			return Version{}, fmt.Errorf("invalid pre-release in %q", s)
+		}
+	}
+	parts := strings.Split(rest, ".")
+	if len(parts) != 3 {
+		return Version{}, fmt.Errorf("%q is not in MAJOR.MINOR.PATCH form", s)
+	}
+	nums := make([]int, 3)
+	for i, p := range parts {
+		if !isNumeric(p) || (len(p) > 1 && p[0] == '0') {
+			return Version{}, fmt.Errorf("invalid version number %q in %q", p, s)
+		}
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid version number %q in %q: %w", p, s, err)
+		}
+		nums[i] = n
+	}
+	v.Major, v.Minor, v.Patch = nums[0], nums[1], nums[2]
+	return v, nil
+}
+
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// IsPrerelease returns true if the version has pre-release identifiers.
+func (v Version) IsPrerelease() bool {
+	return v.Prerelease != ""
+}
+
+// Bump returns the next version for the part, which is "major", "minor", or "patch". The
+// pre-release identifiers and the build metadata are dropped. A pre-release is bumped to its
+// release if the release is the next version (e.g. 1.3.0-rc.1 is bumped to 1.3.0 by "minor").
+func (v Version) Bump(part string) (Version, error) {
+	next := Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	switch part {
+	case "major":
+		if !v.IsPrerelease() || v.Minor != 0 || v.Patch != 0 {
+			next = Version{Major: v.Major + 1}
+		}
+	case "minor":
+		if !v.IsPrerelease() || v.Patch != 0 {
+			next = Version{Major: v.Major, Minor: v.Minor + 1}
+		}
+	case "patch":
+		if !v.IsPrerelease() {
+			next.Patch++
+		}
+	default:
+		return Version{}, fmt.Errorf("unknown version part %q, expected major, minor, or patch", part)
+	}
+	return next, nil
+}
+
+// Compare returns -1, 0, or 1 if a has lower, the same, or higher precedence than b.
+func Compare(a, b Version) int {
+	for _, d := range []int{a.Major - b.Major, a.Minor - b.Minor, a.Patch - b.Patch} {
+		if d < 0 {
+			return -1
+		}
+		if d > 0 {
+			return 1
+		}
+	}
+	// A release has higher precedence than its pre-releases.
+	switch {
+	case a.Prerelease == b.Prerelease:
+		return 0
+	case a.Prerelease == "":
+		return 1
+	case b.Prerelease == "":
+		return -1
+	}
+	as := strings.Split(a.Prerelease, ".")
+	bs := strings.Split(b.Prerelease, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if c := compareIdentifier(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	}
+	return 0
+}
+
+// compareIdentifier compares pre-release identifiers. The numeric identifiers are compared
+// numerically and have lower precedence than the alphanumeric ones.
+func compareIdentifier(a, b string) int {
+	aNum, bNum := isNumeric(a), isNumeric(b)
+	switch {
+	case aNum && bNum:
+		if len(a) != len(b) {
+			if len(a) < len(b) {
+				return -1
+			}
+			return 1
+		}
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func validIdentifiers(s string, noLeadingZero bool) bool {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return false
+		}
+		for _, c := range id {
+			if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-') {
+				return false
+			}
+		}
+		if noLeadingZero && isNumeric(id) && len(id) > 1 && id[0] == '0' {
+			return false
+		}
+	}
+	return true
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package semver
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Version
+		wantErr bool
+	}{
+		{s: "1.2.3", want: Version{Major: 1, Minor: 2, Patch: 3}},
+		{s: "0.0.0", want: Version{}},
+		{s: "1.2.3-rc.1", want: Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"}},
+		{s: "1.2.3-rc.1+build.5", want: Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}},
+		{s: "1.2.3+build-5", want: Version{Major: 1, Minor: 2, Patch: 3, Build: "build-5"}},
+		{s: "1.2", wantErr: true},
+		{s: "01.2.3", wantErr: true},
+		{s: "1.2.3-01", wantErr: true},
+		{s: "1.2.3-", wantErr: true},
+		{s: "v1.2.3", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.s, got, tt.want)
+		}
+		if err == nil && got.String() != tt.s {
+			t.Errorf("Parse(%q).String() = %q", tt.s, got.String())
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	// In the ascending order of the precedence.
+	versions := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.1.0",
+		"1.10.0",
+		"2.0.0",
+	}
+	for i := range versions {
+		for j := range versions {
+			a, err := Parse(versions[i])
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := Parse(versions[j])
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := Compare(a, b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", versions[i], versions[j], got, want)
+			}
+		}
+	}
+}
+
+func TestBump(t *testing.T) {
+	tests := []struct {
+		v    string
+		part string
+		want string
+	}{
+		{"1.2.3", "patch", "1.2.4"},
+		{"1.2.3", "minor", "1.3.0"},
+		{"1.2.3", "major", "2.0.0"},
+		{"1.2.3+build", "patch", "1.2.4"},
+		{"1.2.4-rc.1", "patch", "1.2.4"},
+		{"1.3.0-rc.1", "minor", "1.3.0"},
+		{"1.3.1-rc.1", "minor", "1.4.0"},
+		{"2.0.0-rc.1", "major", "2.0.0"},
+		{"2.1.0-rc.1", "major", "3.0.0"},
+	}
+	for _, tt := range tests {
+		v, err := Parse(tt.v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := v.Bump(tt.part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("Parse(%q).Bump(%q) = %q, want %q", tt.v, tt.part, got, tt.want)
+		}
+	}
+}
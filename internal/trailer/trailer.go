@@ -0,0 +1,114 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package trailer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Trailer is a "Key: Value" line at the end of a commit message. See man 1 git-interpret-trailers.
+type Trailer struct {
+	Key   string
+	Value string
+}
+
+func (t Trailer) String() string {
+	return t.Key + ": " + t.Value
+}
+
+var trailerLineRE = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*):\s*(.*)$`)
+
+// Parse returns the trailers in the last paragraph of the message.
+//
+// The last paragraph is treated as a trailer block only if all of its lines are trailers.
+func Parse(message string) []Trailer {
+	paragraphs := strings.Split(strings.TrimRight(message, "\n"), "\n\n")
+	if len(paragraphs) < 2 {
+		// The first paragraph is a title, not a trailer block.
+		return nil
+	}
+	var ret []Trailer
+	for _, line := range strings.Split(paragraphs[len(paragraphs)-1], "\n") {
+		m := trailerLineRE.FindStringSubmatch(line)
+		if m == nil {
+			return nil
+		}
+		ret = append(ret, Trailer{Key: m[1], Value: strings.TrimSpace(m[2])})
+	}
+	return ret
+}
+
+// Append appends the trailers to the message.
+//
+// Trailers that already exist in the message with the same key (case insensitive) and value
+// are not added again. If the message already ends with a trailer block, the trailers are added
+// to that block. Otherwise, a new block is created after a blank line.
+func Append(message string, trailers []Trailer) string {
+	existing := Parse(message)
+	var toAdd []Trailer
+	for _, t := range trailers {
+		if containsTrailer(existing, t) || containsTrailer(toAdd, t) {
+			continue
+		}
+		toAdd = append(toAdd, t)
+	}
+	if len(toAdd) == 0 {
+		return message
+	}
+	var sb strings.Builder
+	sb.WriteString(strings.TrimRight(message, "\n"))
+	if len(existing) > 0 {
+		sb.WriteString("\n")
+	} else {
+		sb.WriteString("\n\n")
+	}
+	for _, t := range toAdd {
+		sb.WriteString(t.String())
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func containsTrailer(trailers []Trailer, t Trailer) bool {
+	for _, e := range trailers {
+		if strings.EqualFold(e.Key, t.Key) && e.Value == t.Value {
+			return true
+		}
+	}
+	return false
+}
+
+// SignedOffBy returns a Signed-off-by trailer for the given identity.
+func SignedOffBy(name, email string) Trailer {
+	return Trailer{Key: "Signed-off-by", Value: name + " <" + email + ">"}
+}
+
+// WithSignOff returns the trailers followed by a Signed-off-by trailer for the given identity if
+// signOff is true, like `git commit --signoff`. Append doesn't add it if the message already has
+// it. The given slice is not modified.
+func WithSignOff(trailers []Trailer, signOff bool, name, email string) []Trailer {
+	if !signOff {
+		return trailers
+	}
+	return append(append([]Trailer{}, trailers...), SignedOffBy(name, email))
+}
+
+var trailerKeyRE = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*$`)
+
+// Validate returns an error if a trailer would not be parsed back as the same trailer. The key
+// needs to be alphanumeric with hyphens, and the value needs to be a non-empty single line
+// without leading or trailing spaces.
+func Validate(trailers []Trailer) error {
+	for _, t := range trailers {
+		if !trailerKeyRE.MatchString(t.Key) {
+			return fmt.Errorf("invalid trailer key %q", t.Key)
+		}
+		if t.Value == "" || strings.ContainsAny(t.Value, "\r\n") || strings.TrimSpace(t.Value) != t.Value {
+			return fmt.Errorf("invalid value of the trailer %q: %q", t.Key, t.Value)
+		}
+	}
+	return nil
+}
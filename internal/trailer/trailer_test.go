@@ -0,0 +1,83 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package trailer
+
+import "testing"
+
+func TestAppend(t *testing.T) {
+	signOff := SignedOffBy("Foo Bar", "foo@example.com")
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{
+			name:    "title only",
+			message: "Fix a bug\n",
+			want:    "Fix a bug\n\nSigned-off-by: Foo Bar <foo@example.com>\n",
+		},
+		{
+			name:    "body without trailers",
+			message: "Fix a bug\n\nThis fixes the bug.\n",
+			want:    "Fix a bug\n\nThis fixes the bug.\n\nSigned-off-by: Foo Bar <foo@example.com>\n",
+		},
+		{
+			name:    "existing trailer block",
+			message: "Fix a bug\n\nChange-Id: I1234\n",
+			want:    "Fix a bug\n\nChange-Id: I1234\nSigned-off-by: Foo Bar <foo@example.com>\n",
+		},
+		{
+			name:    "already signed off",
+			message: "Fix a bug\n\nsigned-off-by: Foo Bar <foo@example.com>\n",
+			want:    "Fix a bug\n\nsigned-off-by: Foo Bar <foo@example.com>\n",
+		},
+	}
+	for _, tt := range tests {
+		if got := Append(tt.message, []Trailer{signOff}); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		trailer Trailer
+		valid   bool
+	}{
+		{Trailer{Key: "Co-authored-by", Value: "Foo Bar <foo@example.com>"}, true},
+		{Trailer{Key: "Change-Id", Value: "I1234"}, true},
+		{Trailer{Key: "Change Id", Value: "I1234"}, false},
+		{Trailer{Key: "-Change-Id", Value: "I1234"}, false},
+		{Trailer{Key: "Change-Id", Value: ""}, false},
+		{Trailer{Key: "Change-Id", Value: "I1234\nI5678"}, false},
+		{Trailer{Key: "Change-Id", Value: " I1234"}, false},
+	}
+	for _, tt := range tests {
+		if err := Validate([]Trailer{tt.trailer}); (err == nil) != tt.valid {
+			t.Errorf("Validate(%q) = %v, want valid %v", tt.trailer, err, tt.valid)
+		}
+		// A valid trailer is parsed back as is.
+		if tt.valid {
+			if got := Parse(Append("Title\n", []Trailer{tt.trailer})); len(got) != 1 || got[0] != tt.trailer {
+				t.Errorf("Parse(Append(%q)) = %q", tt.trailer, got)
+			}
+		}
+	}
+}
+
+func TestWithSignOff(t *testing.T) {
+	trailers := make([]Trailer, 1, 2)
+	trailers[0] = Trailer{Key: "Change-Id", Value: "I1234"}
+	if got := WithSignOff(trailers, false, "Foo Bar", "foo@example.com"); len(got) != 1 {
+		t.Errorf("WithSignOff(false) = %v, want the trailers as is", got)
+	}
+	got := WithSignOff(trailers, true, "Foo Bar", "foo@example.com")
+	if len(got) != 2 || got[1] != SignedOffBy("Foo Bar", "foo@example.com") {
+		t.Errorf("WithSignOff(true) = %v", got)
+	}
+	// The spare capacity of the given slice is not written to.
+	if extended := trailers[:2]; extended[1] != (Trailer{}) {
+		t.Errorf("WithSignOff modified the given slice: %v", extended)
+	}
+}
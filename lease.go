@@ -0,0 +1,88 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// PushWithLease updates the refs like git push --force-with-lease. Each ref update needs OldHash,
+// the value of the ref that the caller last saw (ZeroHash if the ref shouldn't exist). The
+// current values are read with ls-refs right before receive-pack, bypassing the ls-refs caches,
+// and the push fails with LeaseBrokenError if any of them differs. OldHash is also sent as the
+// compare-and-swap old value, so a ref that is updated between the check and the push still
+// fails with LeaseBrokenError.
+func PushWithLease(repoURL string, client *http.Client, args PushUpdateRefsArgs) (*PushUpdateRefsResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	for _, u := range args.RefUpdates {
+		if u.OldHash == nil {
+			return nil, debug.FetchDebugInfo{}, nil, fmt.Errorf("%s: OldHash is required by PushWithLease", u.Name)
+		}
+	}
+	result, fetchDebugInfo, pushDebugInfo, err := pushUpdateRefs(repoURL, client, args, true)
+	var refUpdateErr *RefUpdateError
+	if errors.As(err, &refUpdateErr) {
+		// The server doesn't tell the current values. If a lease is broken now, it is the
+		// likely cause of the rejection.
+		if leaseErr := checkLeases(repoURL, client, args.RefUpdates); leaseErr != nil {
+			return result, fetchDebugInfo, pushDebugInfo, leaseErr
+		}
+	}
+	return result, fetchDebugInfo, pushDebugInfo, err
+}
+
+// BrokenLease is a ref whose current value is not the expected one.
+type BrokenLease struct {
+	Name plumbing.ReferenceName
+	// Expected is the OldHash of the ref update.
+	Expected plumbing.Hash
+	// Actual is the current value of the ref. ZeroHash if the ref doesn't exist.
+	Actual plumbing.Hash
+}
+
+// LeaseBrokenError is returned by PushWithLease when the refs are not at the expected values.
+type LeaseBrokenError struct {
+	Refs []*BrokenLease
+}
+
+func (e *LeaseBrokenError) Error() string {
+	msg := "the refs are not at the expected values:"
+	for _, ref := range e.Refs {
+		msg += fmt.Sprintf(" %s (expected %s, actual %s)", ref.Name, ref.Expected, ref.Actual)
+	}
+	return msg
+}
+
+// checkLeases returns LeaseBrokenError if the current values of the refs are not their OldHash.
+func checkLeases(repoURL string, client *http.Client, refUpdates []RefUpdate) error {
+	var refNames []string
+	for _, u := range refUpdates {
+		refNames = append(refNames, u.Name.String())
+	}
+	refInfos, err := lsRefsUncached(repoURL, client, refNames)
+	if err != nil {
+		return fmt.Errorf("failed to check the leases: %w", err)
+	}
+	// The prefixes also match the refs under the names, so only the exact names are used.
+	current := map[plumbing.ReferenceName]plumbing.Hash{}
+	for _, info := range refInfos {
+		if plumbing.IsHash(info.Hash) {
+			current[plumbing.ReferenceName(info.Name)] = plumbing.NewHash(info.Hash)
+		}
+	}
+	var broken []*BrokenLease
+	for _, u := range refUpdates {
+		if actual := current[u.Name]; actual != *u.OldHash {
+			broken = append(broken, &BrokenLease{Name: u.Name, Expected: *u.OldHash, Actual: actual})
+		}
+	}
+	if len(broken) > 0 {
+		return &LeaseBrokenError{Refs: broken}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+type CheckLinearHistoryArgs struct {
+	// Ref is the branch whose history is checked.
+	Ref plumbing.ReferenceName
+	// SinceCommit, if set, limits the check to the commits that are reachable from Ref but not
+	// from it, like git log SinceCommit..Ref. It needs to be an ancestor of Ref. If not set, the
+	// whole history of Ref is checked.
+	SinceCommit plumbing.Hash
+}
+
+type CheckLinearHistoryResult struct {
+	// Linear is true if none of the checked commits is a merge commit.
+	Linear bool
+	// RefHash is the value of Ref that was checked.
+	RefHash plumbing.Hash
+	// CommitCount is the number of the checked commits.
+	CommitCount int
+	// MergeCommits are the checked commits that have multiple parents, newest first.
+	MergeCommits []*CommitInfo
+}
+
+// CheckLinearHistory reports whether the history of a branch has no merge commits. This is a
+// gate before the operations that need a linear history, such as rebase-refs. Only the commits
+// are fetched, without the trees.
+func CheckLinearHistory(repoURL string, client *http.Client, args CheckLinearHistoryArgs) (*CheckLinearHistoryResult, debug.FetchDebugInfo, error) {
+	if err := validateRefNames(args.Ref); err != nil {
+		return nil, debug.FetchDebugInfo{}, err
+	}
+	refInfos, _, err := LsRefs(repoURL, client, []string{args.Ref.String()})
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, err
+	}
+	result := &CheckLinearHistoryResult{}
+	for _, info := range refInfos {
+		if info.Name == args.Ref.String() && plumbing.IsHash(info.Hash) {
+			result.RefHash = plumbing.NewHash(info.Hash)
+		}
+	}
+	if result.RefHash.IsZero() {
+		return nil, debug.FetchDebugInfo{}, fmt.Errorf("%s does not exist", args.Ref)
+	}
+	if result.RefHash == args.SinceCommit {
+		result.Linear = true
+		return result, debug.FetchDebugInfo{}, nil
+	}
+
+	fetchArgs := FetchCommitsArgs{WantCommitHashes: []plumbing.Hash{result.RefHash}}
+	if !args.SinceCommit.IsZero() {
+		fetchArgs.StopAtHashes = []plumbing.Hash{args.SinceCommit}
+	}
+	commits, debugInfo, err := FetchCommits(repoURL, client, fetchArgs)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	sinceReached := args.SinceCommit.IsZero()
+	for _, commit := range commits {
+		if len(commit.ParentHashes) > 1 {
+			result.MergeCommits = append(result.MergeCommits, commit)
+		}
+		for _, parent := range commit.ParentHashes {
+			if parent == args.SinceCommit.String() {
+				sinceReached = true
+			}
+		}
+	}
+	if !sinceReached {
+		return nil, debugInfo, fmt.Errorf("%q is not an ancestor of %s", args.SinceCommit.String(), args.Ref)
+	}
+	// The whole history is not fetched in the order of git log.
+	sort.SliceStable(result.MergeCommits, func(i, j int) bool {
+		return result.MergeCommits[i].Committer.Timestamp.After(result.MergeCommits[j].Committer.Timestamp)
+	})
+	result.CommitCount = len(commits)
+	result.Linear = len(result.MergeCommits) == 0
+	return result, debugInfo, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"sync"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// lockedStorage is an object storage that can be written from multiple goroutines. The objects
+// themselves are immutable once stored, so only the accesses to the storage are locked.
+type lockedStorage struct {
+	mu      sync.Mutex
+	storage *objectStorage
+}
+
+var _ storer.EncodedObjectStorer = &lockedStorage{}
+
+func (s *lockedStorage) NewEncodedObject() plumbing.EncodedObject {
+	return &plumbing.MemoryObject{}
+}
+
+func (s *lockedStorage) SetEncodedObject(obj plumbing.EncodedObject) (plumbing.Hash, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.SetEncodedObject(obj)
+}
+
+func (s *lockedStorage) EncodedObject(typ plumbing.ObjectType, hash plumbing.Hash) (plumbing.EncodedObject, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.EncodedObject(typ, hash)
+}
+
+// IterEncodedObjects iterates over a snapshot of the objects at the time of the call.
+func (s *lockedStorage) IterEncodedObjects(typ plumbing.ObjectType) (storer.EncodedObjectIter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	iter, err := s.storage.IterEncodedObjects(typ)
+	if err != nil {
+		return nil, err
+	}
+	var objs []plumbing.EncodedObject
+	if err := iter.ForEach(func(obj plumbing.EncodedObject) error {
+		objs = append(objs, obj)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return storer.NewEncodedObjectSliceIter(objs), nil
+}
+
+func (s *lockedStorage) HasEncodedObject(hash plumbing.Hash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.HasEncodedObject(hash)
+}
+
+func (s *lockedStorage) EncodedObjectSize(hash plumbing.Hash) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.EncodedObjectSize(hash)
+}
+
+func (s *lockedStorage) AddAlternate(remote string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.AddAlternate(remote)
+}
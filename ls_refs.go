@@ -7,6 +7,7 @@ import (
 	"errors"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/aviator-co/niche-git/debug"
 	"github.com/aviator-co/niche-git/internal/fetch"
@@ -28,18 +29,44 @@ type RefInfo struct {
 	SymbolicTarget string `json:"symbolicTarget,omitempty"`
 }
 
+// SetLsRefsCacheTTL enables caching the LsRefs results in the process for the duration, so that
+// repeated calls with the same repository URL and ref prefixes don't reach the server. The cache
+// of a repository is invalidated when this process pushes to it. Zero disables the cache, which
+// is the default.
+//
+// The results are shared only among the clients with the same Session.SetCacheScope. Set it for
+// each set of the credentials if the process serves multiple callers.
+func SetLsRefsCacheTTL(ttl time.Duration) {
+	fetch.SetLsRefsCacheTTL(ttl)
+}
+
 func LsRefs(repoURL string, client *http.Client, refPrefixes []string) ([]*RefInfo, debug.LsRefsDebugInfo, error) {
-	rawRefData, headers, err := fetch.LsRefs(repoURL, client, refPrefixes)
-	debugInfo := debug.LsRefsDebugInfo{ResponseHeaders: headers}
+	rawRefData, headers, cached, err := fetch.CachedLsRefs(repoURL, client, refPrefixes)
+	debugInfo := debug.LsRefsDebugInfo{ResponseHeaders: headers, Cached: cached}
 	if err != nil {
 		return nil, debugInfo, err
 	}
+	refs, err := parseRefInfos(rawRefData)
+	return refs, debugInfo, err
+}
+
+// lsRefsUncached is LsRefs that always reaches the server, for the checks that need the current
+// values of the refs.
+func lsRefsUncached(repoURL string, client *http.Client, refPrefixes []string) ([]*RefInfo, error) {
+	rawRefData, _, err := fetch.LsRefs(repoURL, client, refPrefixes)
+	if err != nil {
+		return nil, err
+	}
+	return parseRefInfos(rawRefData)
+}
+
+func parseRefInfos(rawRefData []string) ([]*RefInfo, error) {
 	var refs []*RefInfo
 	for _, line := range rawRefData {
 		line = strings.TrimSpace(line)
 		parts := strings.Split(line, " ")
 		if len(parts) < 2 {
-			return nil, debugInfo, errors.New("invalid ref line: " + line)
+			return nil, errors.New("invalid ref line: " + line)
 		}
 		info := &RefInfo{
 			Name: parts[1],
@@ -52,10 +79,10 @@ func LsRefs(repoURL string, client *http.Client, refPrefixes []string) ([]*RefIn
 			} else if strings.HasPrefix(p, "peeled:") {
 				info.PeeledHash = strings.TrimPrefix(p, "peeled:")
 			} else {
-				return nil, debugInfo, errors.New("invalid ref line: " + line)
+				return nil, errors.New("invalid ref line: " + line)
 			}
 		}
 		refs = append(refs, info)
 	}
-	return refs, debugInfo, nil
+	return refs, nil
 }
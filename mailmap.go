@@ -0,0 +1,71 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/mailmap"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// fetchMailmap fetches the .mailmap file of the commit. Only the commit, its root tree, and the
+// file are fetched. Returns nil if the commit doesn't have the file.
+func fetchMailmap(repoURL string, client *http.Client, commitHash plumbing.Hash, debugInfo *debug.FetchDebugInfo) (*mailmap.Mailmap, error) {
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, err
+	}
+	defer storage.Close()
+	pack, packDebugInfo, err := fetch.FetchCommitOnlyHistoryPackfile(repoURL, client, []plumbing.Hash{commitHash}, nil, nil, fetch.FetchOptions{Depth: 1})
+	defer pack.Close()
+	debugInfo.PackfileSize += packDebugInfo.PackfileSize
+	debugInfo.Spooled = debugInfo.Spooled || packDebugInfo.Spooled
+	if err != nil {
+		return nil, err
+	}
+	if err := parseFetchedPackfile(storage, pack, debugInfo); err != nil {
+		return nil, err
+	}
+	commit, err := object.GetCommit(storage, commitHash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", commitHash.String(), err)
+	}
+	*debugInfo, err = fetchTreeOnly(repoURL, client, storage, []plumbing.Hash{commit.TreeHash}, *debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := object.GetTree(storage, commit.TreeHash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find the tree of %q in the fetched packfile: %w", commitHash.String(), err)
+	}
+	return loadMailmap(repoURL, client, storage, tree, debugInfo)
+}
+
+// loadMailmap fetches and parses the .mailmap file of the tree. Returns nil if the tree doesn't
+// have the file.
+func loadMailmap(repoURL string, client *http.Client, storage *objectStorage, tree *object.Tree, debugInfo *debug.FetchDebugInfo) (*mailmap.Mailmap, error) {
+	entry, err := tree.FindEntry(mailmap.FileName)
+	if err != nil || !entry.Mode.IsFile() {
+		return nil, nil
+	}
+	if err := fetchBlobs(repoURL, client, storage, []plumbing.Hash{entry.Hash}, debugInfo); err != nil {
+		return nil, err
+	}
+	content, err := readBlob(storage, entry.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return mailmap.Parse(content), nil
+}
+
+// applyMailmap replaces the author and the committer of the commit with the canonical ones.
+func applyMailmap(info *CommitInfo, m *mailmap.Mailmap) {
+	info.Author.Name, info.Author.Email = m.Map(info.Author.Name, info.Author.Email)
+	info.Committer.Name, info.Committer.Email = m.Map(info.Committer.Name, info.Committer.Email)
+}
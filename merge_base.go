@@ -0,0 +1,414 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// GetMergeBase returns the best common ancestor of the two commits, like git merge-base. Only
+// the commits are fetched. If there are multiple best common ancestors, the newest one is
+// returned. If the commits have no common ancestor, the error is NoCommonAncestorError, which
+// reports the root commits of the two histories.
+func GetMergeBase(repoURL string, client *http.Client, commitHash1, commitHash2 plumbing.Hash) (plumbing.Hash, debug.FetchDebugInfo, error) {
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return plumbing.ZeroHash, debug.FetchDebugInfo{}, err
+	}
+	defer storage.Close()
+	return fetchMergeBase(repoURL, client, storage, commitHash1, commitHash2)
+}
+
+type MergeBaseOptions struct {
+	// LatestAcrossRoots returns the newest of the best common ancestors if there are multiple.
+	// This happens with criss-cross merges, and in the repositories with multiple root commits,
+	// e.g. with imported histories, when the histories of different roots are merged into the
+	// two sides separately. If not set, such merge bases fail with MultipleMergeBasesError.
+	// GetMergeBase always sets it.
+	LatestAcrossRoots bool
+	// FindRoots fetches the whole histories of the two commits to report their root commits.
+	// The histories are fetched to the roots anyway if the commits have no common ancestor.
+	FindRoots bool
+	// ApplyReplaceRefs makes the operation honor refs/replace/* on the remote. The replaced
+	// commits are walked with the parents of their replacements, like git does by default.
+	ApplyReplaceRefs bool
+}
+
+type MergeBaseResult struct {
+	// MergeBase is the best common ancestor of the two commits.
+	MergeBase plumbing.Hash
+	// MergeBases are all the best common ancestors, the newest first.
+	MergeBases []plumbing.Hash
+	// Roots1 and Roots2 are the root commits of the histories of the two commits. Set with
+	// FindRoots.
+	Roots1 []plumbing.Hash
+	Roots2 []plumbing.Hash
+	// ReplacedCommits are the commits in the fetched histories that are replaced by
+	// refs/replace/*. Set with ApplyReplaceRefs.
+	ReplacedCommits []plumbing.Hash
+}
+
+// GetMergeBaseWithOptions is GetMergeBase that reports all the best common ancestors and the
+// root commits of the two histories.
+func GetMergeBaseWithOptions(repoURL string, client *http.Client, commitHash1, commitHash2 plumbing.Hash, opts MergeBaseOptions) (*MergeBaseResult, debug.FetchDebugInfo, error) {
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, err
+	}
+	defer storage.Close()
+	var replacements commitReplacements
+	if opts.ApplyReplaceRefs {
+		if replacements, err = fetchReplaceRefs(repoURL, client); err != nil {
+			return nil, debug.FetchDebugInfo{}, err
+		}
+	}
+	return fetchMergeBases(repoURL, client, storage, commitHash1, commitHash2, replacements, opts)
+}
+
+// NoCommonAncestorError is returned when the two commits have independent histories. The whole
+// histories are fetched to find this out, and their root commits are reported.
+type NoCommonAncestorError struct {
+	CommitHash1 plumbing.Hash
+	CommitHash2 plumbing.Hash
+	// Roots1 and Roots2 are the root commits of the histories of the two commits.
+	Roots1 []plumbing.Hash
+	Roots2 []plumbing.Hash
+}
+
+func (e *NoCommonAncestorError) Error() string {
+	return fmt.Sprintf("%q and %q have no common ancestor; the roots of %q are %s, and the roots of %q are %s",
+		e.CommitHash1.String(), e.CommitHash2.String(),
+		e.CommitHash1.String(), joinHashes(e.Roots1), e.CommitHash2.String(), joinHashes(e.Roots2))
+}
+
+// MultipleMergeBasesError is returned by GetMergeBaseWithOptions when the two commits have
+// multiple best common ancestors and LatestAcrossRoots is not set.
+type MultipleMergeBasesError struct {
+	// MergeBases are the best common ancestors, the newest first.
+	MergeBases []plumbing.Hash
+}
+
+func (e *MultipleMergeBasesError) Error() string {
+	return fmt.Sprintf("there are multiple merge bases: %s", joinHashes(e.MergeBases))
+}
+
+func joinHashes(hashes []plumbing.Hash) string {
+	var ss []string
+	for _, hash := range hashes {
+		ss = append(ss, hash.String())
+	}
+	return strings.Join(ss, ", ")
+}
+
+// mergeBaseInitialDepth is the depth of the history fetched first to find a merge base. The
+// depth doubles in each following round.
+const mergeBaseInitialDepth = 32
+
+// fetchMergeBase fetches the histories of the two commits into the storage and returns their
+// merge base. If there are multiple merge bases (criss-cross merges), the one with the newest
+// committer timestamp is returned.
+func fetchMergeBase(repoURL string, client *http.Client, storage *objectStorage, commitHash1, commitHash2 plumbing.Hash) (plumbing.Hash, debug.FetchDebugInfo, error) {
+	result, debugInfo, err := fetchMergeBases(repoURL, client, storage, commitHash1, commitHash2, nil, MergeBaseOptions{LatestAcrossRoots: true})
+	if err != nil {
+		return plumbing.ZeroHash, debugInfo, err
+	}
+	return result.MergeBase, debugInfo, nil
+}
+
+// fetchMergeBases fetches the histories of the two commits into the storage and returns their
+// merge bases. The replaced commits are walked with the parents of their replacements, and the
+// replacements are fetched as the walk reaches them.
+//
+// The histories are fetched step by step from the tips. Each round fetches only the history
+// beyond the commits fetched so far, and the rounds stop once the merge base is found. The first
+// round fetches mergeBaseInitialDepth commits. The following rounds fetch the commits back to a
+// time that doubles the period fetched so far, so that the rounds follow the pace of the
+// history rather than the commit count. The servers refuse a time that selects no commits, and
+// such a round falls back to a depth that doubles in each round. With FindRoots, the rounds
+// continue to the roots. The rounds are recorded in debugInfo.Iterations.
+func fetchMergeBases(repoURL string, client *http.Client, storage *objectStorage, commitHash1, commitHash2 plumbing.Hash, replacements commitReplacements, opts MergeBaseOptions) (*MergeBaseResult, debug.FetchDebugInfo, error) {
+	var debugInfo debug.FetchDebugInfo
+	wants := []plumbing.Hash{replacements.resolve(commitHash1), replacements.resolve(commitHash2)}
+	// The commits wanted in the previous rounds are sent as haves, with the commits whose parents
+	// are not fetched as shallows, so that the history fetched from the other side is not fetched
+	// again.
+	var haves, shallows []plumbing.Hash
+	depth := mergeBaseInitialDepth
+	var since time.Time
+	for {
+		iteration := &debug.FetchIteration{Wants: len(wants)}
+		var pack *fetch.Packfile
+		var packDebugInfo debug.FetchDebugInfo
+		var err error
+		if !since.IsZero() {
+			pack, packDebugInfo, err = fetch.FetchCommitOnlyHistoryPackfile(repoURL, client, wants, haves, shallows, fetch.FetchOptions{Since: since})
+			if err == nil && pack.Len() > 0 {
+				iteration.Since = since.UTC().Format(time.RFC3339)
+			} else {
+				pack.Close()
+				pack = nil
+			}
+		}
+		if pack == nil {
+			iteration.Depth = depth
+			pack, packDebugInfo, err = fetch.FetchCommitOnlyHistoryPackfile(repoURL, client, wants, haves, shallows, fetch.FetchOptions{Depth: depth})
+		}
+		iteration.PackfileSize = packDebugInfo.PackfileSize
+		debugInfo.Iterations = append(debugInfo.Iterations, iteration)
+		debugInfo.ResponseHeaders = packDebugInfo.ResponseHeaders
+		debugInfo.PackfileSize += packDebugInfo.PackfileSize
+		if packDebugInfo.Fallback != "" {
+			debugInfo.Fallback = packDebugInfo.Fallback
+		}
+		debugInfo.Spooled = debugInfo.Spooled || packDebugInfo.Spooled
+		if err != nil {
+			pack.Close()
+			return nil, debugInfo, err
+		}
+		commits, duplicates := debugInfo.ObjectStats.Commits, debugInfo.ObjectStats.Duplicates
+		err = parseFetchedPackfile(storage, pack, &debugInfo)
+		pack.Close()
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		iteration.Commits = debugInfo.ObjectStats.Commits - commits
+		iteration.Duplicates = debugInfo.ObjectStats.Duplicates - duplicates
+
+		if iteration.Commits == 0 {
+			return nil, debugInfo, fmt.Errorf("the server sent no commits for %d wants", len(wants))
+		}
+
+		bases, frontier, boundaries, err := partialMergeBases(storage, replacements, commitHash1, commitHash2, !opts.LatestAcrossRoots)
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		if len(frontier) == 0 || (bases != nil && !opts.FindRoots) {
+			result, err := mergeBaseResult(storage, replacements, commitHash1, commitHash2, bases, opts)
+			return result, debugInfo, err
+		}
+		haves = append(haves, wants...)
+		wants, shallows = frontier, boundaries
+		depth *= 2
+		since, err = nextMergeBaseSince(storage, replacements, []plumbing.Hash{commitHash1, commitHash2}, boundaries)
+		if err != nil {
+			return nil, debugInfo, err
+		}
+	}
+}
+
+// nextMergeBaseSince returns the time to fetch the history back to in the next round. The period
+// from the newest tip to the oldest commit whose parents are not fetched is doubled, with at
+// least an hour.
+func nextMergeBaseSince(storage *objectStorage, replacements commitReplacements, tips, boundaries []plumbing.Hash) (time.Time, error) {
+	var newest, oldest time.Time
+	for _, hash := range tips {
+		commit, err := replacements.getCommit(storage, hash)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+		}
+		if newest.IsZero() || commit.Committer.When.After(newest) {
+			newest = commit.Committer.When
+		}
+	}
+	for _, hash := range boundaries {
+		commit, err := replacements.getCommit(storage, hash)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+		}
+		if oldest.IsZero() || commit.Committer.When.Before(oldest) {
+			oldest = commit.Committer.When
+		}
+	}
+	period := newest.Sub(oldest)
+	if period < time.Hour {
+		period = time.Hour
+	}
+	return oldest.Add(-period), nil
+}
+
+// mergeBaseResult returns the result for the merge bases found by partialMergeBases. The roots
+// are collected if the whole histories are fetched.
+func mergeBaseResult(storage *objectStorage, replacements commitReplacements, commitHash1, commitHash2 plumbing.Hash, bases []*object.Commit, opts MergeBaseOptions) (*MergeBaseResult, error) {
+	replaced, err := replacements.replacedIn(storage, commitHash1, commitHash2)
+	if err != nil {
+		return nil, err
+	}
+	result := &MergeBaseResult{ReplacedCommits: replaced}
+	for _, base := range bases {
+		result.MergeBases = append(result.MergeBases, base.Hash)
+	}
+	if len(bases) == 0 || opts.FindRoots {
+		if result.Roots1, err = rootCommits(storage, replacements, commitHash1); err != nil {
+			return nil, err
+		}
+		if result.Roots2, err = rootCommits(storage, replacements, commitHash2); err != nil {
+			return nil, err
+		}
+	}
+	if len(bases) == 0 {
+		return nil, &NoCommonAncestorError{
+			CommitHash1: commitHash1,
+			CommitHash2: commitHash2,
+			Roots1:      result.Roots1,
+			Roots2:      result.Roots2,
+		}
+	}
+	if len(bases) > 1 && !opts.LatestAcrossRoots {
+		return nil, &MultipleMergeBasesError{MergeBases: result.MergeBases}
+	}
+	result.MergeBase = result.MergeBases[0]
+	return result, nil
+}
+
+// rootCommits returns the commits without parents in the history of the commit, which must be
+// fetched to the roots.
+func rootCommits(storage *objectStorage, replacements commitReplacements, commitHash plumbing.Hash) ([]plumbing.Hash, error) {
+	var roots []plumbing.Hash
+	seen := map[plumbing.Hash]bool{}
+	queue := []plumbing.Hash{commitHash}
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+		if seen[hash] {
+			continue
+		}
+		seen[hash] = true
+		commit, err := replacements.getCommit(storage, hash)
+		if err != nil {
+			return nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+		}
+		if len(commit.ParentHashes) == 0 {
+			roots = append(roots, hash)
+		}
+		queue = append(queue, commit.ParentHashes...)
+	}
+	sort.Slice(roots, func(i, j int) bool { return roots[i].String() < roots[j].String() })
+	return roots, nil
+}
+
+// partialMergeBases returns the merge bases of the two commits from the history fetched into
+// the storage so far, the newest first. The parents that are not fetched yet are returned with
+// the commits that have them as parents. If the merge bases cannot be decided yet, they are nil.
+// If the whole histories are fetched and there's no common ancestor, they are empty.
+//
+// The newest merge base is decided if it's newer than all the commits whose parents are not
+// fetched, as the commits beyond them are expected to be older than those commits. This is the
+// same heuristic as git, which relies on the committer timestamps. With all, every merge base
+// must be newer than them, as an older one may turn out to be an ancestor of a commit that is
+// not fetched yet.
+func partialMergeBases(storage *objectStorage, replacements commitReplacements, commitHash1, commitHash2 plumbing.Hash, all bool) ([]*object.Commit, []plumbing.Hash, []plumbing.Hash, error) {
+	var frontier []plumbing.Hash
+	inFrontier := map[plumbing.Hash]bool{}
+	boundaries := map[plumbing.Hash]*object.Commit{}
+	ancestors := func(start plumbing.Hash) (map[plumbing.Hash]*object.Commit, error) {
+		ret := map[plumbing.Hash]*object.Commit{}
+		queue := []plumbing.Hash{start}
+		for len(queue) > 0 {
+			hash := queue[0]
+			queue = queue[1:]
+			if _, ok := ret[hash]; ok {
+				continue
+			}
+			commit, err := replacements.getCommit(storage, hash)
+			if err != nil {
+				if hash == start {
+					return nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+				}
+				// The replacement is fetched instead of the replaced commit.
+				want := replacements.resolve(hash)
+				if !inFrontier[want] {
+					inFrontier[want] = true
+					frontier = append(frontier, want)
+				}
+				continue
+			}
+			ret[hash] = commit
+			for _, parent := range commit.ParentHashes {
+				if _, err := storage.EncodedObject(plumbing.CommitObject, replacements.resolve(parent)); err != nil {
+					boundaries[hash] = commit
+				}
+				queue = append(queue, parent)
+			}
+		}
+		return ret, nil
+	}
+	ancestors1, err := ancestors(commitHash1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ancestors2, err := ancestors(commitHash2)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	common := map[plumbing.Hash]*object.Commit{}
+	for hash, commit := range ancestors1 {
+		if _, ok := ancestors2[hash]; ok {
+			common[hash] = commit
+		}
+	}
+	// The common ancestors that are ancestors of other common ancestors are not the best.
+	notBest := map[plumbing.Hash]bool{}
+	var queue []plumbing.Hash
+	for _, commit := range common {
+		queue = append(queue, commit.ParentHashes...)
+	}
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+		commit, ok := common[hash]
+		if !ok || notBest[hash] {
+			continue
+		}
+		notBest[hash] = true
+		queue = append(queue, commit.ParentHashes...)
+	}
+	var bases []*object.Commit
+	for hash, commit := range common {
+		if !notBest[hash] {
+			bases = append(bases, commit)
+		}
+	}
+	sort.Slice(bases, func(i, j int) bool {
+		if !bases[i].Committer.When.Equal(bases[j].Committer.When) {
+			return bases[i].Committer.When.After(bases[j].Committer.When)
+		}
+		return bases[i].Hash.String() < bases[j].Hash.String()
+	})
+
+	if len(frontier) == 0 {
+		// The whole histories are fetched.
+		if bases == nil {
+			bases = []*object.Commit{}
+		}
+		return bases, nil, nil, nil
+	}
+	var boundaryHashes []plumbing.Hash
+	var newestBoundary time.Time
+	for hash, boundary := range boundaries {
+		boundaryHashes = append(boundaryHashes, hash)
+		if boundary.Committer.When.After(newestBoundary) {
+			newestBoundary = boundary.Committer.When
+		}
+	}
+	var decided []*object.Commit
+	for _, base := range bases {
+		if base.Committer.When.After(newestBoundary) {
+			decided = append(decided, base)
+		}
+	}
+	if len(decided) == 0 || (all && len(decided) < len(bases)) {
+		return nil, frontier, boundaryHashes, nil
+	}
+	return decided, frontier, boundaryHashes, nil
+}
@@ -0,0 +1,268 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/merge"
+	"github.com/aviator-co/niche-git/internal/pathmatch"
+	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/aviator-co/niche-git/internal/trailer"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+type MergeBranchesArgs struct {
+	// Into is the revision to merge into (e.g. refs/heads/main). It becomes the first parent
+	// of the merge commit. This is a commit hash, a ref name, or a branch or tag name.
+	Into string
+	// From is the revision to merge. It becomes the second parent of the merge commit.
+	From string
+	// FromFirstParent makes From the first parent of the merge commit and Into the second.
+	// The trees are merged the same way, with Into as ours.
+	FromFirstParent bool
+	// EmbedMergeTag makes the merge commit have the tag in the mergetag header if From is a
+	// signed annotated tag, like git merge does for a signed tag.
+	EmbedMergeTag bool
+
+	// CommitMessage is the message of the merge commit. If empty, a message in the same format
+	// as git-merge is used.
+	CommitMessage string
+	// SignOff appends a Signed-off-by trailer for the committer to the commit message if it's
+	// not already there.
+	SignOff bool
+	// Trailers are appended to the commit message, e.g. Co-authored-by or Change-Id. A trailer
+	// that already exists in the message is not added again.
+	Trailers  []Trailer
+	Author    object.Signature
+	Committer object.Signature
+	// Timezone, if set, is the timezone of the Author and Committer times. By default, they
+	// keep their own timezones.
+	Timezone *time.Location
+
+	// Ref is the ref to push the created commit to.
+	Ref plumbing.ReferenceName
+	// CurrentRefHash, if set, is the expected current value of the ref. This is used for
+	// compare-and-swap.
+	CurrentRefHash *plumbing.Hash
+	// AdditionalRefUpdates are the ref updates pushed atomically with the update of Ref, e.g. to
+	// move a tracking ref to the created commit with ToCreatedCommit or to delete a temporary
+	// ref.
+	AdditionalRefUpdates []RefUpdate
+
+	// AbortOnConflict makes the operation fail without pushing if there is a conflict that
+	// cannot be merged line by line.
+	AbortOnConflict bool
+	// IncludeConflictHunks makes the result have the conflicting hunks of ConflictOpenFiles in
+	// ConflictContents.
+	IncludeConflictHunks bool
+	// ConflictStubThreshold, if positive, makes a conflicted file whose merged content with the
+	// conflict markers is larger than this many bytes a compact conflict stub that refers to
+	// the blobs of ours, the merge base, and theirs instead, so that the large content is not
+	// pushed again. The conflicting hunks are available with IncludeConflictHunks.
+	ConflictStubThreshold int64
+	// RenameThreshold, if positive, makes the merge follow the files renamed on one side whose
+	// contents are at least this percent similar, so that the changes the other side made to
+	// the old path are merged into the renamed file. The blobs of the deleted and the added
+	// files are fetched for this. See DefaultRenameThreshold.
+	RenameThreshold int
+
+	// ProtectedPaths are the path patterns that the operation must not modify. If the merge
+	// commit modifies any of them from Into, the operation fails with ProtectedPathError without
+	// pushing. See pathmatch.Match for the pattern syntax.
+	ProtectedPaths []string
+	// AllowProtectedPathChanges overrides ProtectedPaths and lets the operation modify them.
+	AllowProtectedPathChanges bool
+
+	// Signer, if set, signs the created commit.
+	Signer signing.Signer
+	// PackOptions are the options of the pushed packfile.
+	PackOptions PackOptions
+}
+
+type MergeBranchesResult struct {
+	CommitHash plumbing.Hash
+	MergeBase  plumbing.Hash
+	// UpToDate is true if From is already merged into Into. Nothing is pushed in this case, and
+	// CommitHash is Into.
+	UpToDate bool
+	// MergedFiles are the files changed only in From.
+	MergedFiles []string
+	// ConflictResolvedFiles are the files changed in both sides that are merged line by line
+	// without conflicts.
+	ConflictResolvedFiles []string
+	// ConflictOpenFiles are the files with conflicts. The text files have the conflict markers.
+	ConflictOpenFiles []string
+	// ConflictStubFiles are the files in ConflictOpenFiles written as conflict stubs. Set only
+	// when ConflictStubThreshold is used.
+	ConflictStubFiles []string
+	// ConflictSubmodules are the submodules in ConflictOpenFiles. They are left at the gitlink of
+	// Into.
+	ConflictSubmodules []string
+	// ConflictContents are the conflicting hunks of ConflictOpenFiles. Set only when
+	// IncludeConflictHunks is used. Ours is Into and theirs is From.
+	ConflictContents []*ConflictContent
+	// Renames are the renames that the merge followed. Set only when RenameThreshold is used.
+	Renames []*RenamedFile
+	// ProtectedFiles are the files that match ProtectedPaths among the files that the merge
+	// commit modifies from Into.
+	ProtectedFiles []string
+}
+
+// MergeBranches creates a merge commit of two revisions and pushes it to the specified ref. The
+// files changed in both sides are merged line by line like git merge.
+func MergeBranches(repoURL string, client *http.Client, args MergeBranchesArgs) (*MergeBranchesResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	args.Author = signatureIn(args.Author, args.Timezone)
+	args.Committer = signatureIn(args.Committer, args.Timezone)
+	refNames := withAdditionalRefNames(args.Ref, args.AdditionalRefUpdates)
+	if err := validateRefNames(refNames...); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := trailer.Validate(args.Trailers); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := pathmatch.Validate(args.ProtectedPaths); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	unlock, err := lockRefs(repoURL, refNames...)
+	defer unlock()
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	into, err := resolveRevision(repoURL, client, args.Into)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	from, err := resolveRevision(repoURL, client, args.From)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	defer storage.Close()
+	mergeBase, fetchDebugInfo, err := fetchMergeBase(repoURL, client, storage, into, from)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	result := &MergeBranchesResult{MergeBase: mergeBase}
+	if mergeBase == from {
+		result.CommitHash = into
+		result.UpToDate = true
+		return result, fetchDebugInfo, nil, nil
+	}
+
+	// The first fetch has only the commits.
+	if err := fetchBlobNone(repoURL, client, storage, []plumbing.Hash{into, from, mergeBase}, &fetchDebugInfo); err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	treeInto, err := getTreeFromCommit(storage, into)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	treeFrom, err := getTreeFromCommit(storage, from)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	treeBase, err := getTreeFromCommit(storage, mergeBase)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+
+	// ProtectedPaths compare the result with the tree of Into before following the renames.
+	targetTree := treeInto
+	var renameNewHashes []plumbing.Hash
+	if args.RenameThreshold > 0 {
+		renamed, err := followRenames(repoURL, client, storage, treeInto, treeFrom, treeBase, args.RenameThreshold, &fetchDebugInfo)
+		if err != nil {
+			return nil, fetchDebugInfo, nil, err
+		}
+		treeInto, treeFrom, treeBase = renamed.Tree1, renamed.Tree2, renamed.MergeBase
+		renameNewHashes = renamed.NewHashes
+		result.Renames = toRenamedFiles(renamed.Followed)
+	}
+
+	// Only the blobs of the files changed in both sides are needed.
+	blobHashes, err := merge.ConflictBlobs(storage, treeInto, treeFrom, treeBase)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, fmt.Errorf("failed to merge the trees: %w", err)
+	}
+	if err := fetchBlobs(repoURL, client, storage, blobHashes, &fetchDebugInfo); err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+
+	labels := merge.Labels{Ours: args.Into, Base: "merge base", Theirs: args.From}
+	resolver := merge.NewDiff3Resolver(storage, labels)
+	resolver.StubThreshold = args.ConflictStubThreshold
+	mergeResult, err := merge.MergeTree(storage, treeInto, treeFrom, treeBase, resolver.Resolve)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, fmt.Errorf("failed to merge the trees: %w", err)
+	}
+	result.MergedFiles = mergeResult.FilesPickedEntry2
+	result.ConflictResolvedFiles = resolver.FilesResolved
+	result.ConflictOpenFiles = resolver.FilesConflict
+	result.ConflictStubFiles = resolver.FilesStubbed
+	result.ConflictSubmodules = mergeResult.SubmoduleConflicts
+	if args.IncludeConflictHunks && len(resolver.FilesConflict) > 0 {
+		// The blobs are already fetched for the resolver.
+		result.ConflictContents, err = conflictContents(repoURL, client, storage, resolver.FilesConflict, treeInto, treeBase, treeFrom, labels, &fetchDebugInfo)
+		if err != nil {
+			return result, fetchDebugInfo, nil, fmt.Errorf("failed to get the conflict contents: %w", err)
+		}
+	}
+	if args.AbortOnConflict && len(resolver.FilesConflict) > 0 {
+		return result, fetchDebugInfo, nil, &ConflictError{Files: resolver.FilesConflict}
+	}
+	result.ProtectedFiles, err = checkProtectedPaths(storage, targetTree, mergeResult.TreeHash, args.ProtectedPaths, args.AllowProtectedPathChanges)
+	if err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+
+	commitMessage := args.CommitMessage
+	if commitMessage == "" {
+		commitMessage = fmt.Sprintf("Merge %s into %s\n", args.From, args.Into)
+	}
+	commitMessage = trailer.Append(commitMessage, trailer.WithSignOff(args.Trailers, args.SignOff, args.Committer.Name, args.Committer.Email))
+	parents := []plumbing.Hash{into, from}
+	if args.FromFirstParent {
+		parents = []plumbing.Hash{from, into}
+	}
+	commit := &object.Commit{
+		Message:      commitMessage,
+		Author:       args.Author,
+		Committer:    args.Committer,
+		TreeHash:     mergeResult.TreeHash,
+		ParentHashes: parents,
+	}
+	if args.EmbedMergeTag {
+		commit.MergeTag, err = signedMergeTag(repoURL, client, storage, args.From, &fetchDebugInfo)
+		if err != nil {
+			return result, fetchDebugInfo, nil, err
+		}
+	}
+	commitHash, err := storeCommit(storage, commit, args.Signer)
+	if err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+	result.CommitHash = commitHash
+
+	newHashes := append(append(append([]plumbing.Hash{commitHash}, mergeResult.NewHashes...), resolver.NewHashes...), renameNewHashes...)
+	buf, err := encodePushPackfile(storage, newHashes, args.PackOptions)
+	if err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+	pushDebugInfo, err := pushCreatedCommit(repoURL, client, buf, push.RefUpdate{
+		Name:    args.Ref,
+		OldHash: args.CurrentRefHash,
+		NewHash: commitHash,
+	}, args.AdditionalRefUpdates)
+	return result, fetchDebugInfo, &pushDebugInfo, err
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// signedMergeTag returns the content of the tag object for the mergetag header if the revision
+// is a signed annotated tag. Returns an empty string for the other revisions, same as git merge.
+func signedMergeTag(repoURL string, client *http.Client, storage *objectStorage, rev string, debugInfo *debug.FetchDebugInfo) (string, error) {
+	tagHash, err := resolveAnnotatedTag(repoURL, client, rev)
+	if err != nil {
+		return "", err
+	}
+	if tagHash.IsZero() {
+		return "", nil
+	}
+	if _, err := storage.EncodedObject(plumbing.TagObject, tagHash); err != nil {
+		pack, packDebugInfo, err := fetch.FetchCommitOnlyHistoryPackfile(repoURL, client, []plumbing.Hash{tagHash}, nil, nil, fetch.FetchOptions{Depth: 1})
+		defer pack.Close()
+		debugInfo.PackfileSize += packDebugInfo.PackfileSize
+		debugInfo.Spooled = debugInfo.Spooled || packDebugInfo.Spooled
+		if err != nil {
+			return "", err
+		}
+		if err := parseFetchedPackfile(storage, pack, debugInfo); err != nil {
+			return "", err
+		}
+	}
+	obj, err := storage.EncodedObject(plumbing.TagObject, tagHash)
+	if err != nil {
+		return "", fmt.Errorf("cannot find the tag %q in the fetched packfile: %w", tagHash.String(), err)
+	}
+	tag, err := object.DecodeTag(storage, obj)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse the tag %q: %w", tagHash.String(), err)
+	}
+	if tag.PGPSignature == "" {
+		return "", nil
+	}
+	rd, err := obj.Reader()
+	if err != nil {
+		return "", fmt.Errorf("cannot read the tag %q: %w", tagHash.String(), err)
+	}
+	defer rd.Close()
+	content, err := io.ReadAll(rd)
+	if err != nil {
+		return "", fmt.Errorf("cannot read the tag %q: %w", tagHash.String(), err)
+	}
+	return string(content), nil
+}
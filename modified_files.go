@@ -4,60 +4,122 @@
 package nichegit
 
 import (
-	"bytes"
 	"fmt"
 	"net/http"
+	"regexp"
+	"sort"
 
 	"github.com/aviator-co/niche-git/debug"
 	"github.com/aviator-co/niche-git/internal/diff"
-	"github.com/aviator-co/niche-git/internal/fetch"
 	"github.com/go-git/go-git/v5/plumbing"
-	"github.com/go-git/go-git/v5/plumbing/format/packfile"
 	"github.com/go-git/go-git/v5/plumbing/object"
-	"github.com/go-git/go-git/v5/storage/memory"
 )
 
 // FetchModifiedFiles returns the list of files that were modified between two commits.
 func FetchModifiedFiles(repoURL string, client *http.Client, commitHash1, commitHash2 plumbing.Hash) ([]string, debug.FetchDebugInfo, error) {
-	packfilebs, debugInfo, err := fetch.FetchBlobNonePackfile(repoURL, client, []plumbing.Hash{commitHash1, commitHash2})
-	if err != nil {
+	result, debugInfo, err := FetchModifiedFilesWithRenames(repoURL, client, FetchModifiedFilesArgs{
+		CommitHash1: commitHash1,
+		CommitHash2: commitHash2,
+	})
+	if result == nil {
 		return nil, debugInfo, err
 	}
+	return result.Files, debugInfo, err
+}
+
+type FetchModifiedFilesArgs struct {
+	CommitHash1 plumbing.Hash
+	CommitHash2 plumbing.Hash
+	// RenameThreshold, if positive, makes the result have the renamed files whose contents are
+	// at least this percent similar, like `git diff -M<n>%`. The blobs of the deleted and the
+	// added files are fetched for this. See DefaultRenameThreshold.
+	RenameThreshold int
+	// MatchPattern, if set, makes the result have the matches of the regular expression in the
+	// modified files before and after the change, e.g. to find the migration IDs or the test
+	// annotations that are changed. The blobs of the modified files are fetched for this.
+	MatchPattern *regexp.Regexp
+	// MaxMatches is the maximum number of the matches reported per side of a file. Defaults to
+	// DefaultMaxMatches.
+	MaxMatches int
+}
+
+type FetchModifiedFilesResult struct {
+	// Files are the modified files. A renamed file appears with both of the paths.
+	Files []string
+	// Submodules are the paths in Files that are submodules on either side, e.g. a submodule
+	// moved to another commit, sorted by the path.
+	Submodules []string
+	// Renames are the renamed files. Set only when RenameThreshold is used.
+	Renames []*RenamedFile
+	// Matches are the matches of MatchPattern in the modified files, sorted by the path. The
+	// files without matches are omitted. Set only when MatchPattern is used.
+	Matches []*FileMatches
+}
 
-	storage := memory.NewStorage()
-	parser, err := packfile.NewParserWithStorage(packfile.NewScanner(bytes.NewReader(packfilebs)), storage)
+// FetchModifiedFilesWithRenames returns the list of files that were modified between two commits,
+// and the renames among them.
+func FetchModifiedFilesWithRenames(repoURL string, client *http.Client, args FetchModifiedFilesArgs) (*FetchModifiedFilesResult, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "FetchModifiedFilesWithRenames", args, func() (*FetchModifiedFilesResult, debug.FetchDebugInfo, error) {
+		return fetchModifiedFilesWithRenames(repoURL, client, args)
+	})
+}
+
+func fetchModifiedFilesWithRenames(repoURL string, client *http.Client, args FetchModifiedFilesArgs) (*FetchModifiedFilesResult, debug.FetchDebugInfo, error) {
+	var debugInfo debug.FetchDebugInfo
+	storage, err := newObjectStorage(client)
 	if err != nil {
-		return nil, debugInfo, fmt.Errorf("failed to parse packfile: %v", err)
+		return nil, debugInfo, err
 	}
-	if _, err := parser.Parse(); err != nil {
-		return nil, debugInfo, fmt.Errorf("failed to parse packfile: %v", err)
+	defer storage.Close()
+	if err := fetchBlobNone(repoURL, client, storage, []plumbing.Hash{args.CommitHash1, args.CommitHash2}, &debugInfo); err != nil {
+		return nil, debugInfo, err
 	}
 
-	commit1, err := object.GetCommit(storage, commitHash1)
+	commit1, err := object.GetCommit(storage, args.CommitHash1)
 	if err != nil {
-		return nil, debugInfo, fmt.Errorf("cannot find %q in the fetched packfile: %v", commitHash1, err)
+		return nil, debugInfo, fmt.Errorf("cannot find %q in the fetched packfile: %w", args.CommitHash1, err)
 	}
-	commit2, err := object.GetCommit(storage, commitHash2)
+	commit2, err := object.GetCommit(storage, args.CommitHash2)
 	if err != nil {
-		return nil, debugInfo, fmt.Errorf("cannot find %q in the fetched packfile: %v", commitHash2, err)
+		return nil, debugInfo, fmt.Errorf("cannot find %q in the fetched packfile: %w", args.CommitHash2, err)
 	}
 
 	tree1, err := commit1.Tree()
 	if err != nil {
-		return nil, debugInfo, fmt.Errorf("cannot find the tree of %q in the fetched packfile: %v", commitHash1, err)
+		return nil, debugInfo, fmt.Errorf("cannot find the tree of %q in the fetched packfile: %w", args.CommitHash1, err)
 	}
 	tree2, err := commit2.Tree()
 	if err != nil {
-		return nil, debugInfo, fmt.Errorf("cannot find the tree of %q in the fetched packfile: %v", commitHash2, err)
+		return nil, debugInfo, fmt.Errorf("cannot find the tree of %q in the fetched packfile: %w", args.CommitHash2, err)
 	}
 
-	modified, err := diff.DiffTree(storage, tree1, tree2)
-	if err != nil {
-		return nil, debugInfo, fmt.Errorf("failed to take file diffs: %v", err)
+	var modified map[string]diff.BlobHashes
+	result := &FetchModifiedFilesResult{}
+	if args.RenameThreshold > 0 {
+		var renames []diff.Rename
+		renames, modified, err = detectRenames(repoURL, client, storage, tree1, tree2, args.RenameThreshold, &debugInfo)
+		if err != nil {
+			return nil, debugInfo, err
+		}
+		result.Renames = toRenamedFiles(renames)
+	} else {
+		modified, err = diff.DiffTree(storage, tree1, tree2)
+		if err != nil {
+			return nil, debugInfo, fmt.Errorf("failed to take file diffs: %w", err)
+		}
+	}
+	for pth, hashes := range modified {
+		result.Files = append(result.Files, pth)
+		if hashes.IsSubmodule() {
+			result.Submodules = append(result.Submodules, pth)
+		}
 	}
-	var ret []string
-	for pth := range modified {
-		ret = append(ret, pth)
+	sort.Strings(result.Submodules)
+	if args.MatchPattern != nil {
+		result.Matches, err = findPatternMatches(repoURL, client, storage, tree1, tree2, result.Files, args.MatchPattern, args.MaxMatches, &debugInfo)
+		if err != nil {
+			return nil, debugInfo, err
+		}
 	}
-	return ret, debugInfo, nil
+	return result, debugInfo, nil
 }
@@ -0,0 +1,8 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Package nichegitpb is the gRPC service of the niche-git commands that `niche-git grpc-serve`
+// serves. The requests are the flags of the commands and the responses are their JSON outputs.
+package nichegitpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative nichegit.proto
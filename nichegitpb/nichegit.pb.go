@@ -0,0 +1,16876 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: nichegit.proto
+
+package nichegitpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CheckIgnoredRequest is the flags of check-ignored.
+type CheckIgnoredRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash to read the .gitignore files from
+	CommitHash string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// Paths to check. A path with a trailing slash is checked as a directory
+	Paths []string `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,4,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,7,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,8,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *CheckIgnoredRequest) Reset() {
+	*x = CheckIgnoredRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckIgnoredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIgnoredRequest) ProtoMessage() {}
+
+func (x *CheckIgnoredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIgnoredRequest.ProtoReflect.Descriptor instead.
+func (*CheckIgnoredRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CheckIgnoredRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *CheckIgnoredRequest) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *CheckIgnoredRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *CheckIgnoredRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *CheckIgnoredRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *CheckIgnoredRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *CheckIgnoredRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *CheckIgnoredRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// CheckIgnoredResponse is the output of check-ignored.
+type CheckIgnoredResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paths     []*PathIgnored  `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	DebugInfo *FetchDebugInfo `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string          `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *CheckIgnoredResponse) Reset() {
+	*x = CheckIgnoredResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckIgnoredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIgnoredResponse) ProtoMessage() {}
+
+func (x *CheckIgnoredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIgnoredResponse.ProtoReflect.Descriptor instead.
+func (*CheckIgnoredResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CheckIgnoredResponse) GetPaths() []*PathIgnored {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *CheckIgnoredResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *CheckIgnoredResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CheckIgnoredResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *CheckIgnoredResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// CheckLinearHistoryRequest is the flags of check-linear-history.
+type CheckLinearHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// A branch ref name (e.g. refs/heads/foobar) whose history is checked
+	Ref string `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	// Optional ancestor commit hash of the ref. Only the commits after it are checked, like git log SINCE..REF. If not specified, the whole history is checked
+	SinceCommit string `protobuf:"bytes,3,opt,name=since_commit,json=sinceCommit,proto3" json:"since_commit,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,4,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,7,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,8,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *CheckLinearHistoryRequest) Reset() {
+	*x = CheckLinearHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckLinearHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckLinearHistoryRequest) ProtoMessage() {}
+
+func (x *CheckLinearHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckLinearHistoryRequest.ProtoReflect.Descriptor instead.
+func (*CheckLinearHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CheckLinearHistoryRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *CheckLinearHistoryRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *CheckLinearHistoryRequest) GetSinceCommit() string {
+	if x != nil {
+		return x.SinceCommit
+	}
+	return ""
+}
+
+func (x *CheckLinearHistoryRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *CheckLinearHistoryRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *CheckLinearHistoryRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *CheckLinearHistoryRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *CheckLinearHistoryRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// CheckLinearHistoryResponse is the output of check-linear-history.
+type CheckLinearHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Linear       bool            `protobuf:"varint,1,opt,name=linear,proto3" json:"linear,omitempty"`
+	RefHash      string          `protobuf:"bytes,2,opt,name=ref_hash,json=refHash,proto3" json:"ref_hash,omitempty"`
+	CommitCount  int64           `protobuf:"varint,3,opt,name=commit_count,json=commitCount,proto3" json:"commit_count,omitempty"`
+	MergeCommits []*CommitInfo   `protobuf:"bytes,4,rep,name=merge_commits,json=mergeCommits,proto3" json:"merge_commits,omitempty"`
+	DebugInfo    *FetchDebugInfo `protobuf:"bytes,5,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error        string          `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode    string          `protobuf:"bytes,7,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,8,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *CheckLinearHistoryResponse) Reset() {
+	*x = CheckLinearHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckLinearHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckLinearHistoryResponse) ProtoMessage() {}
+
+func (x *CheckLinearHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckLinearHistoryResponse.ProtoReflect.Descriptor instead.
+func (*CheckLinearHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CheckLinearHistoryResponse) GetLinear() bool {
+	if x != nil {
+		return x.Linear
+	}
+	return false
+}
+
+func (x *CheckLinearHistoryResponse) GetRefHash() string {
+	if x != nil {
+		return x.RefHash
+	}
+	return ""
+}
+
+func (x *CheckLinearHistoryResponse) GetCommitCount() int64 {
+	if x != nil {
+		return x.CommitCount
+	}
+	return 0
+}
+
+func (x *CheckLinearHistoryResponse) GetMergeCommits() []*CommitInfo {
+	if x != nil {
+		return x.MergeCommits
+	}
+	return nil
+}
+
+func (x *CheckLinearHistoryResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *CheckLinearHistoryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CheckLinearHistoryResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *CheckLinearHistoryResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// CheckRemergeRequest is the flags of check-remerge.
+type CheckRemergeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Head commit hash of the PR
+	PrHead string `protobuf:"bytes,2,opt,name=pr_head,json=prHead,proto3" json:"pr_head,omitempty"`
+	// Trunk commit hash that the squash result was computed on
+	EnqueuedBase string `protobuf:"bytes,3,opt,name=enqueued_base,json=enqueuedBase,proto3" json:"enqueued_base,omitempty"`
+	// Current trunk commit hash
+	CurrentTrunk string `protobuf:"bytes,4,opt,name=current_trunk,json=currentTrunk,proto3" json:"current_trunk,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,5,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,7,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,8,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,9,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *CheckRemergeRequest) Reset() {
+	*x = CheckRemergeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckRemergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRemergeRequest) ProtoMessage() {}
+
+func (x *CheckRemergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRemergeRequest.ProtoReflect.Descriptor instead.
+func (*CheckRemergeRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CheckRemergeRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *CheckRemergeRequest) GetPrHead() string {
+	if x != nil {
+		return x.PrHead
+	}
+	return ""
+}
+
+func (x *CheckRemergeRequest) GetEnqueuedBase() string {
+	if x != nil {
+		return x.EnqueuedBase
+	}
+	return ""
+}
+
+func (x *CheckRemergeRequest) GetCurrentTrunk() string {
+	if x != nil {
+		return x.CurrentTrunk
+	}
+	return ""
+}
+
+func (x *CheckRemergeRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *CheckRemergeRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *CheckRemergeRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *CheckRemergeRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *CheckRemergeRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// CheckRemergeResponse is the output of check-remerge.
+type CheckRemergeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemergeNeeded    bool            `protobuf:"varint,1,opt,name=remerge_needed,json=remergeNeeded,proto3" json:"remerge_needed,omitempty"`
+	PrFiles          []string        `protobuf:"bytes,2,rep,name=pr_files,json=prFiles,proto3" json:"pr_files,omitempty"`
+	TrunkFiles       []string        `protobuf:"bytes,3,rep,name=trunk_files,json=trunkFiles,proto3" json:"trunk_files,omitempty"`
+	OverlappingFiles []string        `protobuf:"bytes,4,rep,name=overlapping_files,json=overlappingFiles,proto3" json:"overlapping_files,omitempty"`
+	DebugInfo        *FetchDebugInfo `protobuf:"bytes,5,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error            string          `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode        string          `protobuf:"bytes,7,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,8,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *CheckRemergeResponse) Reset() {
+	*x = CheckRemergeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckRemergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRemergeResponse) ProtoMessage() {}
+
+func (x *CheckRemergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRemergeResponse.ProtoReflect.Descriptor instead.
+func (*CheckRemergeResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CheckRemergeResponse) GetRemergeNeeded() bool {
+	if x != nil {
+		return x.RemergeNeeded
+	}
+	return false
+}
+
+func (x *CheckRemergeResponse) GetPrFiles() []string {
+	if x != nil {
+		return x.PrFiles
+	}
+	return nil
+}
+
+func (x *CheckRemergeResponse) GetTrunkFiles() []string {
+	if x != nil {
+		return x.TrunkFiles
+	}
+	return nil
+}
+
+func (x *CheckRemergeResponse) GetOverlappingFiles() []string {
+	if x != nil {
+		return x.OverlappingFiles
+	}
+	return nil
+}
+
+func (x *CheckRemergeResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *CheckRemergeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CheckRemergeResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *CheckRemergeResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// CherryPickRequest is the flags of cherry-pick.
+type CherryPickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hashes to cherry-pick in order
+	Commits []string `protobuf:"bytes,2,rep,name=commits,proto3" json:"commits,omitempty"`
+	// Commit range in the form of START..END to cherry-pick from the oldest after --commits, same as git cherry-pick START..END. Can be specified multiple times
+	Range []string `protobuf:"bytes,3,rep,name=range,proto3" json:"range,omitempty"`
+	// Commit hash where the first cherry-picked commit is created on top of
+	CherryPickOnto string `protobuf:"bytes,4,opt,name=cherry_pick_onto,json=cherryPickOnto,proto3" json:"cherry_pick_onto,omitempty"`
+	// Commiter name
+	Committer string `protobuf:"bytes,5,opt,name=committer,proto3" json:"committer,omitempty"`
+	// Commiter email address
+	CommitterEmail string `protobuf:"bytes,6,opt,name=committer_email,json=committerEmail,proto3" json:"committer_email,omitempty"`
+	// Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	CommitterTime string `protobuf:"bytes,7,opt,name=committer_time,json=committerTime,proto3" json:"committer_time,omitempty"`
+	// Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used
+	Timezone string `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// A ref name (e.g. refs/heads/foobar) to push
+	Ref string `protobuf:"bytes,9,opt,name=ref,proto3" json:"ref,omitempty"`
+	// The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.
+	CurrentRefHash string `protobuf:"bytes,10,opt,name=current_ref_hash,json=currentRefHash,proto3" json:"current_ref_hash,omitempty"`
+	// Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times
+	AdditionalRefUpdate []string `protobuf:"bytes,11,rep,name=additional_ref_update,json=additionalRefUpdate,proto3" json:"additional_ref_update,omitempty"`
+	// Abort the operation if any commit has a merge conflict
+	AbortOnConflict bool `protobuf:"varint,12,opt,name=abort_on_conflict,json=abortOnConflict,proto3" json:"abort_on_conflict,omitempty"`
+	// Report the commits to create, their conflicts, and the number of the objects to push without pushing them
+	DryRun bool `protobuf:"varint,13,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Optional trailer in the form of KEY: VALUE appended to the message of each created commit (e.g. "Co-authored-by: Foo <foo@example.com>"). A trailer already in the message is not added again. Can be specified multiple times
+	Trailer []string `protobuf:"bytes,14,rep,name=trailer,proto3" json:"trailer,omitempty"`
+	// Add a Signed-off-by trailer for the committer to the messages of the created commits
+	Signoff bool `protobuf:"varint,15,opt,name=signoff,proto3" json:"signoff,omitempty"`
+	// Optional path patterns (e.g. .github/workflows/**) that must not be modified. The operation aborts if the change touches them
+	ProtectedPaths []string `protobuf:"bytes,16,rep,name=protected_paths,json=protectedPaths,proto3" json:"protected_paths,omitempty"`
+	// Allow modifying the paths specified by --protected-paths
+	AllowProtectedPathChanges bool `protobuf:"varint,17,opt,name=allow_protected_path_changes,json=allowProtectedPathChanges,proto3" json:"allow_protected_path_changes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,18,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,19,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,20,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Encode the pushed trees and blobs as deltas to the ones in the parent commit when that is smaller
+	DeltaCompression bool `protobuf:"varint,21,opt,name=delta_compression,json=deltaCompression,proto3" json:"delta_compression,omitempty"`
+	// Optional zlib compression level of the pushed packfile, from 1 (fastest) to 9 (smallest). Zero, which is the default, means the zlib default
+	CompressionLevel int32 `protobuf:"varint,22,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// Exit with a non-zero status if conflicts are reported, even if the commits are pushed
+	FailOnConflict bool `protobuf:"varint,23,opt,name=fail_on_conflict,json=failOnConflict,proto3" json:"fail_on_conflict,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,24,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,25,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,26,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,27,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,28,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *CherryPickRequest) Reset() {
+	*x = CherryPickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CherryPickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CherryPickRequest) ProtoMessage() {}
+
+func (x *CherryPickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CherryPickRequest.ProtoReflect.Descriptor instead.
+func (*CherryPickRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CherryPickRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *CherryPickRequest) GetCommits() []string {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *CherryPickRequest) GetRange() []string {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *CherryPickRequest) GetCherryPickOnto() string {
+	if x != nil {
+		return x.CherryPickOnto
+	}
+	return ""
+}
+
+func (x *CherryPickRequest) GetCommitter() string {
+	if x != nil {
+		return x.Committer
+	}
+	return ""
+}
+
+func (x *CherryPickRequest) GetCommitterEmail() string {
+	if x != nil {
+		return x.CommitterEmail
+	}
+	return ""
+}
+
+func (x *CherryPickRequest) GetCommitterTime() string {
+	if x != nil {
+		return x.CommitterTime
+	}
+	return ""
+}
+
+func (x *CherryPickRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CherryPickRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *CherryPickRequest) GetCurrentRefHash() string {
+	if x != nil {
+		return x.CurrentRefHash
+	}
+	return ""
+}
+
+func (x *CherryPickRequest) GetAdditionalRefUpdate() []string {
+	if x != nil {
+		return x.AdditionalRefUpdate
+	}
+	return nil
+}
+
+func (x *CherryPickRequest) GetAbortOnConflict() bool {
+	if x != nil {
+		return x.AbortOnConflict
+	}
+	return false
+}
+
+func (x *CherryPickRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CherryPickRequest) GetTrailer() []string {
+	if x != nil {
+		return x.Trailer
+	}
+	return nil
+}
+
+func (x *CherryPickRequest) GetSignoff() bool {
+	if x != nil {
+		return x.Signoff
+	}
+	return false
+}
+
+func (x *CherryPickRequest) GetProtectedPaths() []string {
+	if x != nil {
+		return x.ProtectedPaths
+	}
+	return nil
+}
+
+func (x *CherryPickRequest) GetAllowProtectedPathChanges() bool {
+	if x != nil {
+		return x.AllowProtectedPathChanges
+	}
+	return false
+}
+
+func (x *CherryPickRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *CherryPickRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *CherryPickRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *CherryPickRequest) GetDeltaCompression() bool {
+	if x != nil {
+		return x.DeltaCompression
+	}
+	return false
+}
+
+func (x *CherryPickRequest) GetCompressionLevel() int32 {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return 0
+}
+
+func (x *CherryPickRequest) GetFailOnConflict() bool {
+	if x != nil {
+		return x.FailOnConflict
+	}
+	return false
+}
+
+func (x *CherryPickRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *CherryPickRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *CherryPickRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+func (x *CherryPickRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *CherryPickRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// CherryPickResponse is the output of cherry-pick.
+type CherryPickResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitHash     string                `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	Commits        []*CherryPickedCommit `protobuf:"bytes,2,rep,name=commits,proto3" json:"commits,omitempty"`
+	NewObjects     int64                 `protobuf:"varint,3,opt,name=new_objects,json=newObjects,proto3" json:"new_objects,omitempty"`
+	ProtectedFiles []string              `protobuf:"bytes,4,rep,name=protected_files,json=protectedFiles,proto3" json:"protected_files,omitempty"`
+	FetchDebugInfo *FetchDebugInfo       `protobuf:"bytes,5,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo  *PushDebugInfo        `protobuf:"bytes,6,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error          string                `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode      string                `protobuf:"bytes,8,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,9,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *CherryPickResponse) Reset() {
+	*x = CherryPickResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CherryPickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CherryPickResponse) ProtoMessage() {}
+
+func (x *CherryPickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CherryPickResponse.ProtoReflect.Descriptor instead.
+func (*CherryPickResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CherryPickResponse) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *CherryPickResponse) GetCommits() []*CherryPickedCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *CherryPickResponse) GetNewObjects() int64 {
+	if x != nil {
+		return x.NewObjects
+	}
+	return 0
+}
+
+func (x *CherryPickResponse) GetProtectedFiles() []string {
+	if x != nil {
+		return x.ProtectedFiles
+	}
+	return nil
+}
+
+func (x *CherryPickResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *CherryPickResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *CherryPickResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CherryPickResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *CherryPickResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// CleanupScratchRefsRequest is the flags of cleanup-scratch-refs.
+type CleanupScratchRefsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Namespace of the scratch refs
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	// Delete the scratch refs older than this
+	MaxAge *durationpb.Duration `protobuf:"bytes,3,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// Report the refs to delete without deleting them
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,5,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,7,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,8,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,9,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,10,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+}
+
+func (x *CleanupScratchRefsRequest) Reset() {
+	*x = CleanupScratchRefsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanupScratchRefsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupScratchRefsRequest) ProtoMessage() {}
+
+func (x *CleanupScratchRefsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupScratchRefsRequest.ProtoReflect.Descriptor instead.
+func (*CleanupScratchRefsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CleanupScratchRefsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *CleanupScratchRefsRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *CleanupScratchRefsRequest) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *CleanupScratchRefsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CleanupScratchRefsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *CleanupScratchRefsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *CleanupScratchRefsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *CleanupScratchRefsRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *CleanupScratchRefsRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *CleanupScratchRefsRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+// CleanupScratchRefsResponse is the output of cleanup-scratch-refs.
+type CleanupScratchRefsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletedRefs   []*ScratchRefOutput `protobuf:"bytes,1,rep,name=deleted_refs,json=deletedRefs,proto3" json:"deleted_refs,omitempty"`
+	PushDebugInfo *PushDebugInfo      `protobuf:"bytes,2,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error         string              `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode     string              `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *CleanupScratchRefsResponse) Reset() {
+	*x = CleanupScratchRefsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanupScratchRefsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupScratchRefsResponse) ProtoMessage() {}
+
+func (x *CleanupScratchRefsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupScratchRefsResponse.ProtoReflect.Descriptor instead.
+func (*CleanupScratchRefsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CleanupScratchRefsResponse) GetDeletedRefs() []*ScratchRefOutput {
+	if x != nil {
+		return x.DeletedRefs
+	}
+	return nil
+}
+
+func (x *CleanupScratchRefsResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *CleanupScratchRefsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CleanupScratchRefsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *CleanupScratchRefsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// CompareRefsRequest is the flags of compare-refs.
+type CompareRefsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// A revision to compare. A commit hash, a ref name, or a branch or tag name
+	RefA string `protobuf:"bytes,2,opt,name=ref_a,json=refA,proto3" json:"ref_a,omitempty"`
+	// The other revision to compare. A commit hash, a ref name, or a branch or tag name
+	RefB string `protobuf:"bytes,3,opt,name=ref_b,json=refB,proto3" json:"ref_b,omitempty"`
+	// Optional maximum number of the commits listed for each side. The ahead and behind counts are not limited. Zero, which is the default, means no limit
+	MaxCommits int32 `protobuf:"varint,4,opt,name=max_commits,json=maxCommits,proto3" json:"max_commits,omitempty"`
+	// Honor refs/replace/* on the remote when finding the merge base and walking the commits
+	ApplyReplaceRefs bool `protobuf:"varint,5,opt,name=apply_replace_refs,json=applyReplaceRefs,proto3" json:"apply_replace_refs,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,6,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,8,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,9,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,10,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+	// Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket
+	WebLinks string `protobuf:"bytes,11,opt,name=web_links,json=webLinks,proto3" json:"web_links,omitempty"`
+	// Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders
+	WebLinkTemplate []string `protobuf:"bytes,12,rep,name=web_link_template,json=webLinkTemplate,proto3" json:"web_link_template,omitempty"`
+	// Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix
+	WebLinkRepoUrl string `protobuf:"bytes,13,opt,name=web_link_repo_url,json=webLinkRepoUrl,proto3" json:"web_link_repo_url,omitempty"`
+}
+
+func (x *CompareRefsRequest) Reset() {
+	*x = CompareRefsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareRefsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRefsRequest) ProtoMessage() {}
+
+func (x *CompareRefsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRefsRequest.ProtoReflect.Descriptor instead.
+func (*CompareRefsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CompareRefsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *CompareRefsRequest) GetRefA() string {
+	if x != nil {
+		return x.RefA
+	}
+	return ""
+}
+
+func (x *CompareRefsRequest) GetRefB() string {
+	if x != nil {
+		return x.RefB
+	}
+	return ""
+}
+
+func (x *CompareRefsRequest) GetMaxCommits() int32 {
+	if x != nil {
+		return x.MaxCommits
+	}
+	return 0
+}
+
+func (x *CompareRefsRequest) GetApplyReplaceRefs() bool {
+	if x != nil {
+		return x.ApplyReplaceRefs
+	}
+	return false
+}
+
+func (x *CompareRefsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *CompareRefsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *CompareRefsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *CompareRefsRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *CompareRefsRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+func (x *CompareRefsRequest) GetWebLinks() string {
+	if x != nil {
+		return x.WebLinks
+	}
+	return ""
+}
+
+func (x *CompareRefsRequest) GetWebLinkTemplate() []string {
+	if x != nil {
+		return x.WebLinkTemplate
+	}
+	return nil
+}
+
+func (x *CompareRefsRequest) GetWebLinkRepoUrl() string {
+	if x != nil {
+		return x.WebLinkRepoUrl
+	}
+	return ""
+}
+
+// CompareRefsResponse is the output of compare-refs.
+type CompareRefsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefAHash      string        `protobuf:"bytes,1,opt,name=ref_a_hash,json=refAHash,proto3" json:"ref_a_hash,omitempty"`
+	RefBHash      string        `protobuf:"bytes,2,opt,name=ref_b_hash,json=refBHash,proto3" json:"ref_b_hash,omitempty"`
+	MergeBase     string        `protobuf:"bytes,3,opt,name=merge_base,json=mergeBase,proto3" json:"merge_base,omitempty"`
+	Ahead         int64         `protobuf:"varint,4,opt,name=ahead,proto3" json:"ahead,omitempty"`
+	Behind        int64         `protobuf:"varint,5,opt,name=behind,proto3" json:"behind,omitempty"`
+	AheadCommits  []*CommitInfo `protobuf:"bytes,6,rep,name=ahead_commits,json=aheadCommits,proto3" json:"ahead_commits,omitempty"`
+	BehindCommits []*CommitInfo `protobuf:"bytes,7,rep,name=behind_commits,json=behindCommits,proto3" json:"behind_commits,omitempty"`
+	// ReplacedCommits are the commits replaced by refs/replace/*. Set with --apply-replace-refs.
+	ReplacedCommits []string        `protobuf:"bytes,8,rep,name=replaced_commits,json=replacedCommits,proto3" json:"replaced_commits,omitempty"`
+	CompareWebUrl   string          `protobuf:"bytes,9,opt,name=compare_web_url,json=compareWebURL,proto3" json:"compare_web_url,omitempty"`
+	DebugInfo       *FetchDebugInfo `protobuf:"bytes,10,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error           string          `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode       string          `protobuf:"bytes,12,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,13,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *CompareRefsResponse) Reset() {
+	*x = CompareRefsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareRefsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRefsResponse) ProtoMessage() {}
+
+func (x *CompareRefsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRefsResponse.ProtoReflect.Descriptor instead.
+func (*CompareRefsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CompareRefsResponse) GetRefAHash() string {
+	if x != nil {
+		return x.RefAHash
+	}
+	return ""
+}
+
+func (x *CompareRefsResponse) GetRefBHash() string {
+	if x != nil {
+		return x.RefBHash
+	}
+	return ""
+}
+
+func (x *CompareRefsResponse) GetMergeBase() string {
+	if x != nil {
+		return x.MergeBase
+	}
+	return ""
+}
+
+func (x *CompareRefsResponse) GetAhead() int64 {
+	if x != nil {
+		return x.Ahead
+	}
+	return 0
+}
+
+func (x *CompareRefsResponse) GetBehind() int64 {
+	if x != nil {
+		return x.Behind
+	}
+	return 0
+}
+
+func (x *CompareRefsResponse) GetAheadCommits() []*CommitInfo {
+	if x != nil {
+		return x.AheadCommits
+	}
+	return nil
+}
+
+func (x *CompareRefsResponse) GetBehindCommits() []*CommitInfo {
+	if x != nil {
+		return x.BehindCommits
+	}
+	return nil
+}
+
+func (x *CompareRefsResponse) GetReplacedCommits() []string {
+	if x != nil {
+		return x.ReplacedCommits
+	}
+	return nil
+}
+
+func (x *CompareRefsResponse) GetCompareWebUrl() string {
+	if x != nil {
+		return x.CompareWebUrl
+	}
+	return ""
+}
+
+func (x *CompareRefsResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *CompareRefsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CompareRefsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *CompareRefsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// EmptyCommitRequest is the flags of empty-commit.
+type EmptyCommitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// A ref name (e.g. refs/heads/foobar) to push the empty commit to
+	Ref string `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	// Optional commit hash to create the empty commit on top of, which is the expected current value of the ref. If not specified, the current value of the ref is used. The ref is pushed with compare-and-swap against it
+	Parent string `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Commit message of the empty commit
+	CommitMessage string `protobuf:"bytes,4,opt,name=commit_message,json=commitMessage,proto3" json:"commit_message,omitempty"`
+	// Author name
+	Author string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	// Author email address
+	AuthorEmail string `protobuf:"bytes,6,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	// Author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	AuthorTime string `protobuf:"bytes,7,opt,name=author_time,json=authorTime,proto3" json:"author_time,omitempty"`
+	// Commiter name
+	Committer string `protobuf:"bytes,8,opt,name=committer,proto3" json:"committer,omitempty"`
+	// Commiter email address
+	CommitterEmail string `protobuf:"bytes,9,opt,name=committer_email,json=committerEmail,proto3" json:"committer_email,omitempty"`
+	// Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	CommitterTime string `protobuf:"bytes,10,opt,name=committer_time,json=committerTime,proto3" json:"committer_time,omitempty"`
+	// Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used
+	Timezone string `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times
+	AdditionalRefUpdate []string `protobuf:"bytes,12,rep,name=additional_ref_update,json=additionalRefUpdate,proto3" json:"additional_ref_update,omitempty"`
+	// Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. "Deployed-To: production"). A trailer already in the message is not added again. Can be specified multiple times
+	Trailer []string `protobuf:"bytes,13,rep,name=trailer,proto3" json:"trailer,omitempty"`
+	// Add a Signed-off-by trailer for the committer to the commit message
+	Signoff bool `protobuf:"varint,14,opt,name=signoff,proto3" json:"signoff,omitempty"`
+	// Optional path patterns (e.g. .github/workflows/**) that must not be modified. They are only validated, as the empty commit doesn't modify any file
+	ProtectedPaths []string `protobuf:"bytes,15,rep,name=protected_paths,json=protectedPaths,proto3" json:"protected_paths,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,16,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,17,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,18,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,19,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,20,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,21,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,22,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,23,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *EmptyCommitRequest) Reset() {
+	*x = EmptyCommitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyCommitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyCommitRequest) ProtoMessage() {}
+
+func (x *EmptyCommitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyCommitRequest.ProtoReflect.Descriptor instead.
+func (*EmptyCommitRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EmptyCommitRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetCommitMessage() string {
+	if x != nil {
+		return x.CommitMessage
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetAuthorTime() string {
+	if x != nil {
+		return x.AuthorTime
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetCommitter() string {
+	if x != nil {
+		return x.Committer
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetCommitterEmail() string {
+	if x != nil {
+		return x.CommitterEmail
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetCommitterTime() string {
+	if x != nil {
+		return x.CommitterTime
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetAdditionalRefUpdate() []string {
+	if x != nil {
+		return x.AdditionalRefUpdate
+	}
+	return nil
+}
+
+func (x *EmptyCommitRequest) GetTrailer() []string {
+	if x != nil {
+		return x.Trailer
+	}
+	return nil
+}
+
+func (x *EmptyCommitRequest) GetSignoff() bool {
+	if x != nil {
+		return x.Signoff
+	}
+	return false
+}
+
+func (x *EmptyCommitRequest) GetProtectedPaths() []string {
+	if x != nil {
+		return x.ProtectedPaths
+	}
+	return nil
+}
+
+func (x *EmptyCommitRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *EmptyCommitRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *EmptyCommitRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *EmptyCommitRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *EmptyCommitRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *EmptyCommitRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+func (x *EmptyCommitRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *EmptyCommitRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// EmptyCommitResponse is the output of empty-commit.
+type EmptyCommitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitHash     string          `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	Parent         string          `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	CommitMessage  string          `protobuf:"bytes,3,opt,name=commit_message,json=commitMessage,proto3" json:"commit_message,omitempty"`
+	FetchDebugInfo *FetchDebugInfo `protobuf:"bytes,4,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo  *PushDebugInfo  `protobuf:"bytes,5,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error          string          `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode      string          `protobuf:"bytes,7,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,8,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *EmptyCommitResponse) Reset() {
+	*x = EmptyCommitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyCommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyCommitResponse) ProtoMessage() {}
+
+func (x *EmptyCommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyCommitResponse.ProtoReflect.Descriptor instead.
+func (*EmptyCommitResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EmptyCommitResponse) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *EmptyCommitResponse) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *EmptyCommitResponse) GetCommitMessage() string {
+	if x != nil {
+		return x.CommitMessage
+	}
+	return ""
+}
+
+func (x *EmptyCommitResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *EmptyCommitResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *EmptyCommitResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EmptyCommitResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *EmptyCommitResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GenerateChangelogRequest is the flags of generate-changelog.
+type GenerateChangelogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Optional start of the range, exclusive. A commit hash, a ref name, or a branch or tag name. Defaults to the root commits
+	FromRef string `protobuf:"bytes,2,opt,name=from_ref,json=fromRef,proto3" json:"from_ref,omitempty"`
+	// End of the range, inclusive. A commit hash, a ref name, or a branch or tag name
+	ToRef string `protobuf:"bytes,3,opt,name=to_ref,json=toRef,proto3" json:"to_ref,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,4,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,7,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,8,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *GenerateChangelogRequest) Reset() {
+	*x = GenerateChangelogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateChangelogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateChangelogRequest) ProtoMessage() {}
+
+func (x *GenerateChangelogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateChangelogRequest.ProtoReflect.Descriptor instead.
+func (*GenerateChangelogRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GenerateChangelogRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GenerateChangelogRequest) GetFromRef() string {
+	if x != nil {
+		return x.FromRef
+	}
+	return ""
+}
+
+func (x *GenerateChangelogRequest) GetToRef() string {
+	if x != nil {
+		return x.ToRef
+	}
+	return ""
+}
+
+func (x *GenerateChangelogRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GenerateChangelogRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GenerateChangelogRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GenerateChangelogRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GenerateChangelogRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// GenerateChangelogResponse is the output of generate-changelog.
+type GenerateChangelogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changelog *Changelog      `protobuf:"bytes,1,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	DebugInfo *FetchDebugInfo `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string          `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GenerateChangelogResponse) Reset() {
+	*x = GenerateChangelogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateChangelogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateChangelogResponse) ProtoMessage() {}
+
+func (x *GenerateChangelogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateChangelogResponse.ProtoReflect.Descriptor instead.
+func (*GenerateChangelogResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GenerateChangelogResponse) GetChangelog() *Changelog {
+	if x != nil {
+		return x.Changelog
+	}
+	return nil
+}
+
+func (x *GenerateChangelogResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GenerateChangelogResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GenerateChangelogResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GenerateChangelogResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetAttributesRequest is the flags of get-attributes.
+type GetAttributesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash to read the .gitattributes files from
+	CommitHash string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// Paths to look up
+	Paths []string `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Optional attribute names to look up. Defaults to all the attributes specified for the paths
+	Attributes []string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,5,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,7,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,8,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,9,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *GetAttributesRequest) Reset() {
+	*x = GetAttributesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributesRequest) ProtoMessage() {}
+
+func (x *GetAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributesRequest.ProtoReflect.Descriptor instead.
+func (*GetAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetAttributesRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetAttributesRequest) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *GetAttributesRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *GetAttributesRequest) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GetAttributesRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetAttributesRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetAttributesRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetAttributesRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetAttributesRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// GetAttributesResponse is the output of get-attributes.
+type GetAttributesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paths     []*PathAttributes `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	DebugInfo *FetchDebugInfo   `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string            `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string            `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetAttributesResponse) Reset() {
+	*x = GetAttributesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributesResponse) ProtoMessage() {}
+
+func (x *GetAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributesResponse.ProtoReflect.Descriptor instead.
+func (*GetAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAttributesResponse) GetPaths() []*PathAttributes {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *GetAttributesResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetAttributesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetAttributesResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetAttributesResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetBlameRequest is the flags of get-blame.
+type GetBlameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash to blame the file at
+	CommitHash string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// Path of the file
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Number of the commits in the history to look into. The older lines are marked as boundary
+	MaxCommits *int32 `protobuf:"varint,4,opt,name=max_commits,json=maxCommits,proto3,oneof" json:"max_commits,omitempty"`
+	// Report the canonical authors with the .mailmap file of the commit
+	ApplyMailmap bool `protobuf:"varint,5,opt,name=apply_mailmap,json=applyMailmap,proto3" json:"apply_mailmap,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,6,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,8,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,9,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,10,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *GetBlameRequest) Reset() {
+	*x = GetBlameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlameRequest) ProtoMessage() {}
+
+func (x *GetBlameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlameRequest.ProtoReflect.Descriptor instead.
+func (*GetBlameRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetBlameRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetBlameRequest) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *GetBlameRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetBlameRequest) GetMaxCommits() int32 {
+	if x != nil && x.MaxCommits != nil {
+		return *x.MaxCommits
+	}
+	return 0
+}
+
+func (x *GetBlameRequest) GetApplyMailmap() bool {
+	if x != nil {
+		return x.ApplyMailmap
+	}
+	return false
+}
+
+func (x *GetBlameRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetBlameRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetBlameRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetBlameRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetBlameRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// GetBlameResponse is the output of get-blame.
+type GetBlameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lines     []*BlameLine    `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	DebugInfo *FetchDebugInfo `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string          `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetBlameResponse) Reset() {
+	*x = GetBlameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlameResponse) ProtoMessage() {}
+
+func (x *GetBlameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlameResponse.ProtoReflect.Descriptor instead.
+func (*GetBlameResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetBlameResponse) GetLines() []*BlameLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *GetBlameResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetBlameResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetBlameResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetBlameResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetCommitGraphRequest is the flags of get-commit-graph.
+type GetCommitGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hashes of the tips of the graph
+	WantCommitHashes []string `protobuf:"bytes,2,rep,name=want_commit_hashes,json=wantCommitHashes,proto3" json:"want_commit_hashes,omitempty"`
+	// Optional commit hashes whose history is excluded from the graph, like git log ^<hash>
+	HaveCommitHashes []string `protobuf:"bytes,3,rep,name=have_commit_hashes,json=haveCommitHashes,proto3" json:"have_commit_hashes,omitempty"`
+	// Optional maximum number of the commits in the graph. The newest commits by the committer time are kept
+	MaxCount int32 `protobuf:"varint,4,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,5,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,7,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,8,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,9,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+	// Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket
+	WebLinks string `protobuf:"bytes,10,opt,name=web_links,json=webLinks,proto3" json:"web_links,omitempty"`
+	// Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders
+	WebLinkTemplate []string `protobuf:"bytes,11,rep,name=web_link_template,json=webLinkTemplate,proto3" json:"web_link_template,omitempty"`
+	// Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix
+	WebLinkRepoUrl string `protobuf:"bytes,12,opt,name=web_link_repo_url,json=webLinkRepoUrl,proto3" json:"web_link_repo_url,omitempty"`
+}
+
+func (x *GetCommitGraphRequest) Reset() {
+	*x = GetCommitGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommitGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommitGraphRequest) ProtoMessage() {}
+
+func (x *GetCommitGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommitGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetCommitGraphRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCommitGraphRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetCommitGraphRequest) GetWantCommitHashes() []string {
+	if x != nil {
+		return x.WantCommitHashes
+	}
+	return nil
+}
+
+func (x *GetCommitGraphRequest) GetHaveCommitHashes() []string {
+	if x != nil {
+		return x.HaveCommitHashes
+	}
+	return nil
+}
+
+func (x *GetCommitGraphRequest) GetMaxCount() int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *GetCommitGraphRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetCommitGraphRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetCommitGraphRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetCommitGraphRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetCommitGraphRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+func (x *GetCommitGraphRequest) GetWebLinks() string {
+	if x != nil {
+		return x.WebLinks
+	}
+	return ""
+}
+
+func (x *GetCommitGraphRequest) GetWebLinkTemplate() []string {
+	if x != nil {
+		return x.WebLinkTemplate
+	}
+	return nil
+}
+
+func (x *GetCommitGraphRequest) GetWebLinkRepoUrl() string {
+	if x != nil {
+		return x.WebLinkRepoUrl
+	}
+	return ""
+}
+
+// GetCommitGraphResponse is the output of get-commit-graph.
+type GetCommitGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commits   []*CommitGraphNode `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	DebugInfo *FetchDebugInfo    `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string             `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string             `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetCommitGraphResponse) Reset() {
+	*x = GetCommitGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommitGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommitGraphResponse) ProtoMessage() {}
+
+func (x *GetCommitGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommitGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetCommitGraphResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCommitGraphResponse) GetCommits() []*CommitGraphNode {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *GetCommitGraphResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetCommitGraphResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetCommitGraphResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetCommitGraphResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetCommitsRequest is the flags of get-commits.
+type GetCommitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Want commit hashes
+	WantCommitHashes []string `protobuf:"bytes,2,rep,name=want_commit_hashes,json=wantCommitHashes,proto3" json:"want_commit_hashes,omitempty"`
+	// Have commit hashes
+	HaveCommitHashes []string `protobuf:"bytes,3,rep,name=have_commit_hashes,json=haveCommitHashes,proto3" json:"have_commit_hashes,omitempty"`
+	// Honor refs/replace/* on the remote when reporting the commits
+	ApplyReplaceRefs bool `protobuf:"varint,4,opt,name=apply_replace_refs,json=applyReplaceRefs,proto3" json:"apply_replace_refs,omitempty"`
+	// Negotiate the have commits with the server and return only the commits not reachable from them. Fails if the server doesn't have some of the have commits
+	OnlyNewCommits bool `protobuf:"varint,5,opt,name=only_new_commits,json=onlyNewCommits,proto3" json:"only_new_commits,omitempty"`
+	// Optional commit hash whose .mailmap file makes the authors and the committers canonical
+	MailmapCommitHash string `protobuf:"bytes,6,opt,name=mailmap_commit_hash,json=mailmapCommitHash,proto3" json:"mailmap_commit_hash,omitempty"`
+	// Optional maximum number of the commits to return. The history is walked newest first and fetched only as deep as needed
+	MaxCount int32 `protobuf:"varint,7,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	// Optional committer time in RFC3339 format (e.g. 2024-01-01T00:00:00Z) to stop the walk at the older commits
+	Since string `protobuf:"bytes,8,opt,name=since,proto3" json:"since,omitempty"`
+	// Optional committer time in RFC3339 format (e.g. 2024-01-01T00:00:00Z) to skip the newer commits
+	Until string `protobuf:"bytes,9,opt,name=until,proto3" json:"until,omitempty"`
+	// Optional commit hashes whose history is excluded, like git log ^<hash>
+	StopAtHashes []string `protobuf:"bytes,10,rep,name=stop_at_hashes,json=stopAtHashes,proto3" json:"stop_at_hashes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,11,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,12,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,13,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,14,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,15,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+	// Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket
+	WebLinks string `protobuf:"bytes,16,opt,name=web_links,json=webLinks,proto3" json:"web_links,omitempty"`
+	// Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders
+	WebLinkTemplate []string `protobuf:"bytes,17,rep,name=web_link_template,json=webLinkTemplate,proto3" json:"web_link_template,omitempty"`
+	// Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix
+	WebLinkRepoUrl string `protobuf:"bytes,18,opt,name=web_link_repo_url,json=webLinkRepoUrl,proto3" json:"web_link_repo_url,omitempty"`
+}
+
+func (x *GetCommitsRequest) Reset() {
+	*x = GetCommitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommitsRequest) ProtoMessage() {}
+
+func (x *GetCommitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommitsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommitsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCommitsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetCommitsRequest) GetWantCommitHashes() []string {
+	if x != nil {
+		return x.WantCommitHashes
+	}
+	return nil
+}
+
+func (x *GetCommitsRequest) GetHaveCommitHashes() []string {
+	if x != nil {
+		return x.HaveCommitHashes
+	}
+	return nil
+}
+
+func (x *GetCommitsRequest) GetApplyReplaceRefs() bool {
+	if x != nil {
+		return x.ApplyReplaceRefs
+	}
+	return false
+}
+
+func (x *GetCommitsRequest) GetOnlyNewCommits() bool {
+	if x != nil {
+		return x.OnlyNewCommits
+	}
+	return false
+}
+
+func (x *GetCommitsRequest) GetMailmapCommitHash() string {
+	if x != nil {
+		return x.MailmapCommitHash
+	}
+	return ""
+}
+
+func (x *GetCommitsRequest) GetMaxCount() int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *GetCommitsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *GetCommitsRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *GetCommitsRequest) GetStopAtHashes() []string {
+	if x != nil {
+		return x.StopAtHashes
+	}
+	return nil
+}
+
+func (x *GetCommitsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetCommitsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetCommitsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetCommitsRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetCommitsRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+func (x *GetCommitsRequest) GetWebLinks() string {
+	if x != nil {
+		return x.WebLinks
+	}
+	return ""
+}
+
+func (x *GetCommitsRequest) GetWebLinkTemplate() []string {
+	if x != nil {
+		return x.WebLinkTemplate
+	}
+	return nil
+}
+
+func (x *GetCommitsRequest) GetWebLinkRepoUrl() string {
+	if x != nil {
+		return x.WebLinkRepoUrl
+	}
+	return ""
+}
+
+// GetCommitsResponse is the output of get-commits.
+type GetCommitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commits   []*CommitInfo   `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	DebugInfo *FetchDebugInfo `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string          `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetCommitsResponse) Reset() {
+	*x = GetCommitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommitsResponse) ProtoMessage() {}
+
+func (x *GetCommitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommitsResponse.ProtoReflect.Descriptor instead.
+func (*GetCommitsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCommitsResponse) GetCommits() []*CommitInfo {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *GetCommitsResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetCommitsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetCommitsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetCommitsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetCommitsSinceSemverTagRequest is the flags of get-commits-since-semver-tag.
+type GetCommitsSinceSemverTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// The part of the tag names before the version (e.g. v or service-a/v)
+	TagPrefix *string `protobuf:"bytes,2,opt,name=tag_prefix,json=tagPrefix,proto3,oneof" json:"tag_prefix,omitempty"`
+	// Consider the pre-release versions (e.g. 1.2.3-rc.1) as the latest tag
+	IncludePrereleases bool `protobuf:"varint,3,opt,name=include_prereleases,json=includePrereleases,proto3" json:"include_prereleases,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,4,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Commit hash of the end of the range
+	HeadCommitHash string `protobuf:"bytes,7,opt,name=head_commit_hash,json=headCommitHash,proto3" json:"head_commit_hash,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,8,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,9,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *GetCommitsSinceSemverTagRequest) Reset() {
+	*x = GetCommitsSinceSemverTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommitsSinceSemverTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommitsSinceSemverTagRequest) ProtoMessage() {}
+
+func (x *GetCommitsSinceSemverTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommitsSinceSemverTagRequest.ProtoReflect.Descriptor instead.
+func (*GetCommitsSinceSemverTagRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetCommitsSinceSemverTagRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetCommitsSinceSemverTagRequest) GetTagPrefix() string {
+	if x != nil && x.TagPrefix != nil {
+		return *x.TagPrefix
+	}
+	return ""
+}
+
+func (x *GetCommitsSinceSemverTagRequest) GetIncludePrereleases() bool {
+	if x != nil {
+		return x.IncludePrereleases
+	}
+	return false
+}
+
+func (x *GetCommitsSinceSemverTagRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetCommitsSinceSemverTagRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetCommitsSinceSemverTagRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetCommitsSinceSemverTagRequest) GetHeadCommitHash() string {
+	if x != nil {
+		return x.HeadCommitHash
+	}
+	return ""
+}
+
+func (x *GetCommitsSinceSemverTagRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetCommitsSinceSemverTagRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// GetCommitsSinceSemverTagResponse is the output of get-commits-since-semver-tag.
+type GetCommitsSinceSemverTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag       *SemverTag      `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Commits   []*CommitInfo   `protobuf:"bytes,2,rep,name=commits,proto3" json:"commits,omitempty"`
+	DebugInfo *FetchDebugInfo `protobuf:"bytes,3,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string          `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string          `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,6,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetCommitsSinceSemverTagResponse) Reset() {
+	*x = GetCommitsSinceSemverTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommitsSinceSemverTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommitsSinceSemverTagResponse) ProtoMessage() {}
+
+func (x *GetCommitsSinceSemverTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommitsSinceSemverTagResponse.ProtoReflect.Descriptor instead.
+func (*GetCommitsSinceSemverTagResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCommitsSinceSemverTagResponse) GetTag() *SemverTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *GetCommitsSinceSemverTagResponse) GetCommits() []*CommitInfo {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *GetCommitsSinceSemverTagResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetCommitsSinceSemverTagResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetCommitsSinceSemverTagResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetCommitsSinceSemverTagResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetFileHistoryRequest is the flags of get-file-history.
+type GetFileHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash to take the history of
+	CommitHash string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// Files or directories to list the modifying commits of
+	Paths []string `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Maximum number of the commits to return
+	PageSize *int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Maximum number of the commits to look into for the page
+	MaxCommits *int32 `protobuf:"varint,5,opt,name=max_commits,json=maxCommits,proto3,oneof" json:"max_commits,omitempty"`
+	// Optional nextCursor of the previous output to get the next page
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,7,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,9,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,10,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,11,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+	// Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket
+	WebLinks string `protobuf:"bytes,12,opt,name=web_links,json=webLinks,proto3" json:"web_links,omitempty"`
+	// Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders
+	WebLinkTemplate []string `protobuf:"bytes,13,rep,name=web_link_template,json=webLinkTemplate,proto3" json:"web_link_template,omitempty"`
+	// Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix
+	WebLinkRepoUrl string `protobuf:"bytes,14,opt,name=web_link_repo_url,json=webLinkRepoUrl,proto3" json:"web_link_repo_url,omitempty"`
+}
+
+func (x *GetFileHistoryRequest) Reset() {
+	*x = GetFileHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileHistoryRequest) ProtoMessage() {}
+
+func (x *GetFileHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetFileHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetFileHistoryRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetFileHistoryRequest) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *GetFileHistoryRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *GetFileHistoryRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *GetFileHistoryRequest) GetMaxCommits() int32 {
+	if x != nil && x.MaxCommits != nil {
+		return *x.MaxCommits
+	}
+	return 0
+}
+
+func (x *GetFileHistoryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetFileHistoryRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetFileHistoryRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetFileHistoryRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetFileHistoryRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetFileHistoryRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+func (x *GetFileHistoryRequest) GetWebLinks() string {
+	if x != nil {
+		return x.WebLinks
+	}
+	return ""
+}
+
+func (x *GetFileHistoryRequest) GetWebLinkTemplate() []string {
+	if x != nil {
+		return x.WebLinkTemplate
+	}
+	return nil
+}
+
+func (x *GetFileHistoryRequest) GetWebLinkRepoUrl() string {
+	if x != nil {
+		return x.WebLinkRepoUrl
+	}
+	return ""
+}
+
+// GetFileHistoryResponse is the output of get-file-history.
+type GetFileHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commits    []*CommitInfo   `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	NextCursor string          `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	DebugInfo  *FetchDebugInfo `protobuf:"bytes,3,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error      string          `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode  string          `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,6,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetFileHistoryResponse) Reset() {
+	*x = GetFileHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileHistoryResponse) ProtoMessage() {}
+
+func (x *GetFileHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetFileHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetFileHistoryResponse) GetCommits() []*CommitInfo {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *GetFileHistoryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetFileHistoryResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetFileHistoryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetFileHistoryResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetFileHistoryResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetFileOwnersRequest is the flags of get-file-owners.
+type GetFileOwnersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash that the change is made on. The owners are taken from its history
+	BaseCommitHash string `protobuf:"bytes,2,opt,name=base_commit_hash,json=baseCommitHash,proto3" json:"base_commit_hash,omitempty"`
+	// Commit hash with the change
+	HeadCommitHash string `protobuf:"bytes,3,opt,name=head_commit_hash,json=headCommitHash,proto3" json:"head_commit_hash,omitempty"`
+	// Number of the commits in the history of the base commit to look into
+	MaxCommits *int32 `protobuf:"varint,4,opt,name=max_commits,json=maxCommits,proto3,oneof" json:"max_commits,omitempty"`
+	// Count the commits of the aliases for the canonical authors with the .mailmap file of the base commit
+	ApplyMailmap bool `protobuf:"varint,5,opt,name=apply_mailmap,json=applyMailmap,proto3" json:"apply_mailmap,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,6,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,8,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,9,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,10,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *GetFileOwnersRequest) Reset() {
+	*x = GetFileOwnersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileOwnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileOwnersRequest) ProtoMessage() {}
+
+func (x *GetFileOwnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileOwnersRequest.ProtoReflect.Descriptor instead.
+func (*GetFileOwnersRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetFileOwnersRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetFileOwnersRequest) GetBaseCommitHash() string {
+	if x != nil {
+		return x.BaseCommitHash
+	}
+	return ""
+}
+
+func (x *GetFileOwnersRequest) GetHeadCommitHash() string {
+	if x != nil {
+		return x.HeadCommitHash
+	}
+	return ""
+}
+
+func (x *GetFileOwnersRequest) GetMaxCommits() int32 {
+	if x != nil && x.MaxCommits != nil {
+		return *x.MaxCommits
+	}
+	return 0
+}
+
+func (x *GetFileOwnersRequest) GetApplyMailmap() bool {
+	if x != nil {
+		return x.ApplyMailmap
+	}
+	return false
+}
+
+func (x *GetFileOwnersRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetFileOwnersRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetFileOwnersRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetFileOwnersRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetFileOwnersRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// GetFileOwnersResponse is the output of get-file-owners.
+type GetFileOwnersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files     []*FileOwners   `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	DebugInfo *FetchDebugInfo `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string          `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetFileOwnersResponse) Reset() {
+	*x = GetFileOwnersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileOwnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileOwnersResponse) ProtoMessage() {}
+
+func (x *GetFileOwnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileOwnersResponse.ProtoReflect.Descriptor instead.
+func (*GetFileOwnersResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetFileOwnersResponse) GetFiles() []*FileOwners {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *GetFileOwnersResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetFileOwnersResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetFileOwnersResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetFileOwnersResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetImpactedServicesRequest is the flags of get-impacted-services.
+type GetImpactedServicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// First commit hash
+	CommitHash1 string `protobuf:"bytes,2,opt,name=commit_hash1,json=commitHash1,proto3" json:"commit_hash1,omitempty"`
+	// Second commit hash
+	CommitHash2 string `protobuf:"bytes,3,opt,name=commit_hash2,json=commitHash2,proto3" json:"commit_hash2,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,4,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,7,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,8,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *GetImpactedServicesRequest) Reset() {
+	*x = GetImpactedServicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetImpactedServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImpactedServicesRequest) ProtoMessage() {}
+
+func (x *GetImpactedServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImpactedServicesRequest.ProtoReflect.Descriptor instead.
+func (*GetImpactedServicesRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetImpactedServicesRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetImpactedServicesRequest) GetCommitHash1() string {
+	if x != nil {
+		return x.CommitHash1
+	}
+	return ""
+}
+
+func (x *GetImpactedServicesRequest) GetCommitHash2() string {
+	if x != nil {
+		return x.CommitHash2
+	}
+	return ""
+}
+
+func (x *GetImpactedServicesRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetImpactedServicesRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetImpactedServicesRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetImpactedServicesRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetImpactedServicesRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// GetImpactedServicesResponse is the output of get-impacted-services.
+type GetImpactedServicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services       []*ImpactedService `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	UnmatchedFiles []string           `protobuf:"bytes,2,rep,name=unmatched_files,json=unmatchedFiles,proto3" json:"unmatched_files,omitempty"`
+	DebugInfo      *FetchDebugInfo    `protobuf:"bytes,3,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error          string             `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode      string             `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,6,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetImpactedServicesResponse) Reset() {
+	*x = GetImpactedServicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetImpactedServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImpactedServicesResponse) ProtoMessage() {}
+
+func (x *GetImpactedServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImpactedServicesResponse.ProtoReflect.Descriptor instead.
+func (*GetImpactedServicesResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetImpactedServicesResponse) GetServices() []*ImpactedService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *GetImpactedServicesResponse) GetUnmatchedFiles() []string {
+	if x != nil {
+		return x.UnmatchedFiles
+	}
+	return nil
+}
+
+func (x *GetImpactedServicesResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetImpactedServicesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetImpactedServicesResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetImpactedServicesResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetLatestSemverTagRequest is the flags of get-latest-semver-tag.
+type GetLatestSemverTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// The part of the tag names before the version (e.g. v or service-a/v)
+	TagPrefix *string `protobuf:"bytes,2,opt,name=tag_prefix,json=tagPrefix,proto3,oneof" json:"tag_prefix,omitempty"`
+	// Consider the pre-release versions (e.g. 1.2.3-rc.1) as the latest tag
+	IncludePrereleases bool `protobuf:"varint,3,opt,name=include_prereleases,json=includePrereleases,proto3" json:"include_prereleases,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,4,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+}
+
+func (x *GetLatestSemverTagRequest) Reset() {
+	*x = GetLatestSemverTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLatestSemverTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestSemverTagRequest) ProtoMessage() {}
+
+func (x *GetLatestSemverTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestSemverTagRequest.ProtoReflect.Descriptor instead.
+func (*GetLatestSemverTagRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetLatestSemverTagRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetLatestSemverTagRequest) GetTagPrefix() string {
+	if x != nil && x.TagPrefix != nil {
+		return *x.TagPrefix
+	}
+	return ""
+}
+
+func (x *GetLatestSemverTagRequest) GetIncludePrereleases() bool {
+	if x != nil {
+		return x.IncludePrereleases
+	}
+	return false
+}
+
+func (x *GetLatestSemverTagRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetLatestSemverTagRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetLatestSemverTagRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+// GetLatestSemverTagResponse is the output of get-latest-semver-tag.
+type GetLatestSemverTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag       *SemverTag       `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	DebugInfo *LsRefsDebugInfo `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string           `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string           `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetLatestSemverTagResponse) Reset() {
+	*x = GetLatestSemverTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLatestSemverTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestSemverTagResponse) ProtoMessage() {}
+
+func (x *GetLatestSemverTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestSemverTagResponse.ProtoReflect.Descriptor instead.
+func (*GetLatestSemverTagResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetLatestSemverTagResponse) GetTag() *SemverTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *GetLatestSemverTagResponse) GetDebugInfo() *LsRefsDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetLatestSemverTagResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetLatestSemverTagResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetLatestSemverTagResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetModifiedFilesRequest is the flags of get-modified-files.
+type GetModifiedFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// First commit hash
+	CommitHash1 string `protobuf:"bytes,2,opt,name=commit_hash1,json=commitHash1,proto3" json:"commit_hash1,omitempty"`
+	// Second commit hash
+	CommitHash2 string `protobuf:"bytes,3,opt,name=commit_hash2,json=commitHash2,proto3" json:"commit_hash2,omitempty"`
+	// Optional similarity in percent (e.g. 50, which is git's default) for a deleted file and an added file to be reported as a rename. Zero, which is the default, disables the rename detection
+	RenameThreshold int32 `protobuf:"varint,4,opt,name=rename_threshold,json=renameThreshold,proto3" json:"rename_threshold,omitempty"`
+	// Optional regular expression (Go RE2 syntax) to report the matches of in the modified files before and after the change, with the capture groups and the line numbers. The blobs of the modified files are fetched for this
+	MatchPattern string `protobuf:"bytes,5,opt,name=match_pattern,json=matchPattern,proto3" json:"match_pattern,omitempty"`
+	// Maximum number of the matches of --match-pattern reported per side of a file
+	MaxMatches *int32 `protobuf:"varint,6,opt,name=max_matches,json=maxMatches,proto3,oneof" json:"max_matches,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,7,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,9,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,10,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,11,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+	// Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket
+	WebLinks string `protobuf:"bytes,12,opt,name=web_links,json=webLinks,proto3" json:"web_links,omitempty"`
+	// Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders
+	WebLinkTemplate []string `protobuf:"bytes,13,rep,name=web_link_template,json=webLinkTemplate,proto3" json:"web_link_template,omitempty"`
+	// Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix
+	WebLinkRepoUrl string `protobuf:"bytes,14,opt,name=web_link_repo_url,json=webLinkRepoUrl,proto3" json:"web_link_repo_url,omitempty"`
+}
+
+func (x *GetModifiedFilesRequest) Reset() {
+	*x = GetModifiedFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModifiedFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModifiedFilesRequest) ProtoMessage() {}
+
+func (x *GetModifiedFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModifiedFilesRequest.ProtoReflect.Descriptor instead.
+func (*GetModifiedFilesRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetModifiedFilesRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetModifiedFilesRequest) GetCommitHash1() string {
+	if x != nil {
+		return x.CommitHash1
+	}
+	return ""
+}
+
+func (x *GetModifiedFilesRequest) GetCommitHash2() string {
+	if x != nil {
+		return x.CommitHash2
+	}
+	return ""
+}
+
+func (x *GetModifiedFilesRequest) GetRenameThreshold() int32 {
+	if x != nil {
+		return x.RenameThreshold
+	}
+	return 0
+}
+
+func (x *GetModifiedFilesRequest) GetMatchPattern() string {
+	if x != nil {
+		return x.MatchPattern
+	}
+	return ""
+}
+
+func (x *GetModifiedFilesRequest) GetMaxMatches() int32 {
+	if x != nil && x.MaxMatches != nil {
+		return *x.MaxMatches
+	}
+	return 0
+}
+
+func (x *GetModifiedFilesRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetModifiedFilesRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetModifiedFilesRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetModifiedFilesRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetModifiedFilesRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+func (x *GetModifiedFilesRequest) GetWebLinks() string {
+	if x != nil {
+		return x.WebLinks
+	}
+	return ""
+}
+
+func (x *GetModifiedFilesRequest) GetWebLinkTemplate() []string {
+	if x != nil {
+		return x.WebLinkTemplate
+	}
+	return nil
+}
+
+func (x *GetModifiedFilesRequest) GetWebLinkRepoUrl() string {
+	if x != nil {
+		return x.WebLinkRepoUrl
+	}
+	return ""
+}
+
+// GetModifiedFilesResponse is the output of get-modified-files.
+type GetModifiedFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files      []string       `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Submodules []string       `protobuf:"bytes,2,rep,name=submodules,proto3" json:"submodules,omitempty"`
+	Renames    []*RenamedFile `protobuf:"bytes,3,rep,name=renames,proto3" json:"renames,omitempty"`
+	Matches    []*FileMatches `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"`
+	// CompareWebURL is the web UI URL of the changes from commit 1 to commit 2.
+	CompareWebUrl string          `protobuf:"bytes,5,opt,name=compare_web_url,json=compareWebURL,proto3" json:"compare_web_url,omitempty"`
+	DebugInfo     *FetchDebugInfo `protobuf:"bytes,6,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error         string          `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode     string          `protobuf:"bytes,8,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,9,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetModifiedFilesResponse) Reset() {
+	*x = GetModifiedFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModifiedFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModifiedFilesResponse) ProtoMessage() {}
+
+func (x *GetModifiedFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModifiedFilesResponse.ProtoReflect.Descriptor instead.
+func (*GetModifiedFilesResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetModifiedFilesResponse) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *GetModifiedFilesResponse) GetSubmodules() []string {
+	if x != nil {
+		return x.Submodules
+	}
+	return nil
+}
+
+func (x *GetModifiedFilesResponse) GetRenames() []*RenamedFile {
+	if x != nil {
+		return x.Renames
+	}
+	return nil
+}
+
+func (x *GetModifiedFilesResponse) GetMatches() []*FileMatches {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *GetModifiedFilesResponse) GetCompareWebUrl() string {
+	if x != nil {
+		return x.CompareWebUrl
+	}
+	return ""
+}
+
+func (x *GetModifiedFilesResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetModifiedFilesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetModifiedFilesResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetModifiedFilesResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetObjectRequest is the flags of get-object.
+type GetObjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Hash of the object to get
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Format the content like git cat-file -p. This lists the tree entries as text instead of the raw tree
+	Pretty bool `protobuf:"varint,3,opt,name=pretty,proto3" json:"pretty,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,4,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,7,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,8,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *GetObjectRequest) Reset() {
+	*x = GetObjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectRequest) ProtoMessage() {}
+
+func (x *GetObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetObjectRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetObjectRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *GetObjectRequest) GetPretty() bool {
+	if x != nil {
+		return x.Pretty
+	}
+	return false
+}
+
+func (x *GetObjectRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetObjectRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetObjectRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetObjectRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetObjectRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// GetObjectResponse is the output of get-object.
+type GetObjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Size int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Content is the content if it's valid UTF-8. Otherwise, ContentBase64 has it.
+	Content       string          `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	ContentBase64 string          `protobuf:"bytes,5,opt,name=content_base64,json=contentBase64,proto3" json:"content_base64,omitempty"`
+	DebugInfo     *FetchDebugInfo `protobuf:"bytes,6,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error         string          `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode     string          `protobuf:"bytes,8,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,9,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetObjectResponse) Reset() {
+	*x = GetObjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectResponse) ProtoMessage() {}
+
+func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetObjectResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *GetObjectResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetObjectResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetObjectResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GetObjectResponse) GetContentBase64() string {
+	if x != nil {
+		return x.ContentBase64
+	}
+	return ""
+}
+
+func (x *GetObjectResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetObjectResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetObjectResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetObjectResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetTreeRequest is the flags of get-tree.
+type GetTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash to list the tree of
+	CommitHash string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// Optional directory to list. Empty, which is the default, means the root directory
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Optional number of the levels to list under --path. 1 lists only the direct entries. Zero, which is the default, lists all the entries recursively
+	Depth int32 `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Skip looking up the blob sizes
+	SkipSizes bool `protobuf:"varint,5,opt,name=skip_sizes,json=skipSizes,proto3" json:"skip_sizes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,6,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,8,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,9,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,10,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+	// Optional hosting service to add the web UI URLs of the commits, the files, and the comparisons to the output for: github, gitlab, or bitbucket
+	WebLinks string `protobuf:"bytes,11,opt,name=web_links,json=webLinks,proto3" json:"web_links,omitempty"`
+	// Optional URL template of the web links in the form of KIND=TEMPLATE, where KIND is commit, blob, or compare. Overrides the template of --web-links. Can be specified multiple times. See the README for the placeholders
+	WebLinkTemplate []string `protobuf:"bytes,12,rep,name=web_link_template,json=webLinkTemplate,proto3" json:"web_link_template,omitempty"`
+	// Optional web UI URL of the repository for the web links. Empty, which is the default, means --repo-url without the credentials and the .git suffix
+	WebLinkRepoUrl string `protobuf:"bytes,13,opt,name=web_link_repo_url,json=webLinkRepoUrl,proto3" json:"web_link_repo_url,omitempty"`
+}
+
+func (x *GetTreeRequest) Reset() {
+	*x = GetTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreeRequest) ProtoMessage() {}
+
+func (x *GetTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetTreeRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetTreeRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetTreeRequest) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *GetTreeRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetTreeRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *GetTreeRequest) GetSkipSizes() bool {
+	if x != nil {
+		return x.SkipSizes
+	}
+	return false
+}
+
+func (x *GetTreeRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetTreeRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetTreeRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetTreeRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetTreeRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+func (x *GetTreeRequest) GetWebLinks() string {
+	if x != nil {
+		return x.WebLinks
+	}
+	return ""
+}
+
+func (x *GetTreeRequest) GetWebLinkTemplate() []string {
+	if x != nil {
+		return x.WebLinkTemplate
+	}
+	return nil
+}
+
+func (x *GetTreeRequest) GetWebLinkRepoUrl() string {
+	if x != nil {
+		return x.WebLinkRepoUrl
+	}
+	return ""
+}
+
+// GetTreeResponse is the output of get-tree.
+type GetTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries   []*TreeEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	DebugInfo *FetchDebugInfo `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string          `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetTreeResponse) Reset() {
+	*x = GetTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreeResponse) ProtoMessage() {}
+
+func (x *GetTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetTreeResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTreeResponse) GetEntries() []*TreeEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetTreeResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetTreeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetTreeResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetTreeResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// GetTreeStatsRequest is the flags of get-tree-stats.
+type GetTreeStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash to take the statistics of
+	CommitHash string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// Number of the largest files to report. Negative skips the file size lookup
+	LargestFiles *int32 `protobuf:"varint,3,opt,name=largest_files,json=largestFiles,proto3,oneof" json:"largest_files,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,4,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,7,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,8,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *GetTreeStatsRequest) Reset() {
+	*x = GetTreeStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTreeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreeStatsRequest) ProtoMessage() {}
+
+func (x *GetTreeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreeStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTreeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetTreeStatsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GetTreeStatsRequest) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *GetTreeStatsRequest) GetLargestFiles() int32 {
+	if x != nil && x.LargestFiles != nil {
+		return *x.LargestFiles
+	}
+	return 0
+}
+
+func (x *GetTreeStatsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *GetTreeStatsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *GetTreeStatsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetTreeStatsRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *GetTreeStatsRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// GetTreeStatsResponse is the output of get-tree-stats.
+type GetTreeStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files          int64           `protobuf:"varint,1,opt,name=files,proto3" json:"files,omitempty"`
+	Directories    int64           `protobuf:"varint,2,opt,name=directories,proto3" json:"directories,omitempty"`
+	Symlinks       int64           `protobuf:"varint,3,opt,name=symlinks,proto3" json:"symlinks,omitempty"`
+	Submodules     int64           `protobuf:"varint,4,opt,name=submodules,proto3" json:"submodules,omitempty"`
+	MaxDepth       int64           `protobuf:"varint,5,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	TotalFileBytes int64           `protobuf:"varint,6,opt,name=total_file_bytes,json=totalFileBytes,proto3" json:"total_file_bytes,omitempty"`
+	LargestFiles   []*FileSize     `protobuf:"bytes,7,rep,name=largest_files,json=largestFiles,proto3" json:"largest_files,omitempty"`
+	DebugInfo      *FetchDebugInfo `protobuf:"bytes,8,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error          string          `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode      string          `protobuf:"bytes,10,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,11,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *GetTreeStatsResponse) Reset() {
+	*x = GetTreeStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTreeStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreeStatsResponse) ProtoMessage() {}
+
+func (x *GetTreeStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreeStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTreeStatsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetTreeStatsResponse) GetFiles() int64 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+func (x *GetTreeStatsResponse) GetDirectories() int64 {
+	if x != nil {
+		return x.Directories
+	}
+	return 0
+}
+
+func (x *GetTreeStatsResponse) GetSymlinks() int64 {
+	if x != nil {
+		return x.Symlinks
+	}
+	return 0
+}
+
+func (x *GetTreeStatsResponse) GetSubmodules() int64 {
+	if x != nil {
+		return x.Submodules
+	}
+	return 0
+}
+
+func (x *GetTreeStatsResponse) GetMaxDepth() int64 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *GetTreeStatsResponse) GetTotalFileBytes() int64 {
+	if x != nil {
+		return x.TotalFileBytes
+	}
+	return 0
+}
+
+func (x *GetTreeStatsResponse) GetLargestFiles() []*FileSize {
+	if x != nil {
+		return x.LargestFiles
+	}
+	return nil
+}
+
+func (x *GetTreeStatsResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *GetTreeStatsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetTreeStatsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetTreeStatsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// HasObjectsRequest is the flags of has-objects.
+type HasObjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Comma-separated hashes of the objects to look up
+	Hashes []string `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,3,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,5,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,6,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,7,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *HasObjectsRequest) Reset() {
+	*x = HasObjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasObjectsRequest) ProtoMessage() {}
+
+func (x *HasObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasObjectsRequest.ProtoReflect.Descriptor instead.
+func (*HasObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *HasObjectsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *HasObjectsRequest) GetHashes() []string {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+func (x *HasObjectsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *HasObjectsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *HasObjectsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *HasObjectsRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *HasObjectsRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// HasObjectsResponse is the output of has-objects.
+type HasObjectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Existing  []string        `protobuf:"bytes,1,rep,name=existing,proto3" json:"existing,omitempty"`
+	Missing   []string        `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`
+	DebugInfo *FetchDebugInfo `protobuf:"bytes,3,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string          `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string          `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,6,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *HasObjectsResponse) Reset() {
+	*x = HasObjectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasObjectsResponse) ProtoMessage() {}
+
+func (x *HasObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasObjectsResponse.ProtoReflect.Descriptor instead.
+func (*HasObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *HasObjectsResponse) GetExisting() []string {
+	if x != nil {
+		return x.Existing
+	}
+	return nil
+}
+
+func (x *HasObjectsResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *HasObjectsResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *HasObjectsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *HasObjectsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *HasObjectsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// LsRefsRequest is the flags of ls-refs.
+type LsRefsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Ref prefixes
+	RefPrefixes []string `protobuf:"bytes,2,rep,name=ref_prefixes,json=refPrefixes,proto3" json:"ref_prefixes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,3,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,5,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+}
+
+func (x *LsRefsRequest) Reset() {
+	*x = LsRefsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsRefsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsRefsRequest) ProtoMessage() {}
+
+func (x *LsRefsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsRefsRequest.ProtoReflect.Descriptor instead.
+func (*LsRefsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LsRefsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *LsRefsRequest) GetRefPrefixes() []string {
+	if x != nil {
+		return x.RefPrefixes
+	}
+	return nil
+}
+
+func (x *LsRefsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *LsRefsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *LsRefsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+// LsRefsResponse is the output of ls-refs.
+type LsRefsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Refs      []*RefInfo       `protobuf:"bytes,1,rep,name=refs,proto3" json:"refs,omitempty"`
+	DebugInfo *LsRefsDebugInfo `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string           `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string           `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *LsRefsResponse) Reset() {
+	*x = LsRefsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsRefsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsRefsResponse) ProtoMessage() {}
+
+func (x *LsRefsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsRefsResponse.ProtoReflect.Descriptor instead.
+func (*LsRefsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LsRefsResponse) GetRefs() []*RefInfo {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+func (x *LsRefsResponse) GetDebugInfo() *LsRefsDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *LsRefsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LsRefsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *LsRefsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// MergeBranchesRequest is the flags of merge-branches.
+type MergeBranchesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Revision to merge into (e.g. refs/heads/main). It becomes the first parent
+	Into string `protobuf:"bytes,2,opt,name=into,proto3" json:"into,omitempty"`
+	// Revision to merge. It becomes the second parent
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Make --from the first parent and --into the second parent
+	FromFirstParent bool `protobuf:"varint,4,opt,name=from_first_parent,json=fromFirstParent,proto3" json:"from_first_parent,omitempty"`
+	// If --from is a signed annotated tag, embed it in the mergetag header of the merge commit like git merge
+	EmbedMergeTag bool `protobuf:"varint,5,opt,name=embed_merge_tag,json=embedMergeTag,proto3" json:"embed_merge_tag,omitempty"`
+	// Optional commit message of the merge commit. Defaults to the git-merge style message
+	CommitMessage string `protobuf:"bytes,6,opt,name=commit_message,json=commitMessage,proto3" json:"commit_message,omitempty"`
+	// Author name
+	Author string `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
+	// Author email address
+	AuthorEmail string `protobuf:"bytes,8,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	// Author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	AuthorTime string `protobuf:"bytes,9,opt,name=author_time,json=authorTime,proto3" json:"author_time,omitempty"`
+	// Commiter name
+	Committer string `protobuf:"bytes,10,opt,name=committer,proto3" json:"committer,omitempty"`
+	// Commiter email address
+	CommitterEmail string `protobuf:"bytes,11,opt,name=committer_email,json=committerEmail,proto3" json:"committer_email,omitempty"`
+	// Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	CommitterTime string `protobuf:"bytes,12,opt,name=committer_time,json=committerTime,proto3" json:"committer_time,omitempty"`
+	// Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used
+	Timezone string `protobuf:"bytes,13,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// A ref name (e.g. refs/heads/foobar) to push
+	Ref string `protobuf:"bytes,14,opt,name=ref,proto3" json:"ref,omitempty"`
+	// The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.
+	CurrentRefHash string `protobuf:"bytes,15,opt,name=current_ref_hash,json=currentRefHash,proto3" json:"current_ref_hash,omitempty"`
+	// Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times
+	AdditionalRefUpdate []string `protobuf:"bytes,16,rep,name=additional_ref_update,json=additionalRefUpdate,proto3" json:"additional_ref_update,omitempty"`
+	// Abort the operation if there is a merge conflict
+	AbortOnConflict bool `protobuf:"varint,17,opt,name=abort_on_conflict,json=abortOnConflict,proto3" json:"abort_on_conflict,omitempty"`
+	// Include the conflicting hunks of the files with conflicts in the output
+	IncludeConflictHunks bool `protobuf:"varint,18,opt,name=include_conflict_hunks,json=includeConflictHunks,proto3" json:"include_conflict_hunks,omitempty"`
+	// Optional size in bytes beyond which a conflicted file is written as a conflict stub that refers to the blobs of the sides instead of having the conflict markers. Zero, which is the default, always writes the conflict markers
+	ConflictStubThreshold int64 `protobuf:"varint,19,opt,name=conflict_stub_threshold,json=conflictStubThreshold,proto3" json:"conflict_stub_threshold,omitempty"`
+	// Optional similarity in percent (e.g. 50, which is git's default) for a deleted file and an added file to be a rename. The changes to the old path are applied to the renamed file. Zero, which is the default, disables the rename detection
+	RenameThreshold int32 `protobuf:"varint,20,opt,name=rename_threshold,json=renameThreshold,proto3" json:"rename_threshold,omitempty"`
+	// Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. "Co-authored-by: Foo <foo@example.com>"). A trailer already in the message is not added again. Can be specified multiple times
+	Trailer []string `protobuf:"bytes,21,rep,name=trailer,proto3" json:"trailer,omitempty"`
+	// Add a Signed-off-by trailer for the committer to the commit message
+	Signoff bool `protobuf:"varint,22,opt,name=signoff,proto3" json:"signoff,omitempty"`
+	// Optional path patterns (e.g. .github/workflows/**) that must not be modified. The operation aborts if the change touches them
+	ProtectedPaths []string `protobuf:"bytes,23,rep,name=protected_paths,json=protectedPaths,proto3" json:"protected_paths,omitempty"`
+	// Allow modifying the paths specified by --protected-paths
+	AllowProtectedPathChanges bool `protobuf:"varint,24,opt,name=allow_protected_path_changes,json=allowProtectedPathChanges,proto3" json:"allow_protected_path_changes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,25,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,26,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,27,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Encode the pushed trees and blobs as deltas to the ones in the parent commit when that is smaller
+	DeltaCompression bool `protobuf:"varint,28,opt,name=delta_compression,json=deltaCompression,proto3" json:"delta_compression,omitempty"`
+	// Optional zlib compression level of the pushed packfile, from 1 (fastest) to 9 (smallest). Zero, which is the default, means the zlib default
+	CompressionLevel int32 `protobuf:"varint,29,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// Exit with a non-zero status if conflicts are reported, even if the commit is pushed
+	FailOnConflict bool `protobuf:"varint,30,opt,name=fail_on_conflict,json=failOnConflict,proto3" json:"fail_on_conflict,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,31,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,32,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,33,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,34,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,35,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *MergeBranchesRequest) Reset() {
+	*x = MergeBranchesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeBranchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeBranchesRequest) ProtoMessage() {}
+
+func (x *MergeBranchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeBranchesRequest.ProtoReflect.Descriptor instead.
+func (*MergeBranchesRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MergeBranchesRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetInto() string {
+	if x != nil {
+		return x.Into
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetFromFirstParent() bool {
+	if x != nil {
+		return x.FromFirstParent
+	}
+	return false
+}
+
+func (x *MergeBranchesRequest) GetEmbedMergeTag() bool {
+	if x != nil {
+		return x.EmbedMergeTag
+	}
+	return false
+}
+
+func (x *MergeBranchesRequest) GetCommitMessage() string {
+	if x != nil {
+		return x.CommitMessage
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetAuthorTime() string {
+	if x != nil {
+		return x.AuthorTime
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetCommitter() string {
+	if x != nil {
+		return x.Committer
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetCommitterEmail() string {
+	if x != nil {
+		return x.CommitterEmail
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetCommitterTime() string {
+	if x != nil {
+		return x.CommitterTime
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetCurrentRefHash() string {
+	if x != nil {
+		return x.CurrentRefHash
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetAdditionalRefUpdate() []string {
+	if x != nil {
+		return x.AdditionalRefUpdate
+	}
+	return nil
+}
+
+func (x *MergeBranchesRequest) GetAbortOnConflict() bool {
+	if x != nil {
+		return x.AbortOnConflict
+	}
+	return false
+}
+
+func (x *MergeBranchesRequest) GetIncludeConflictHunks() bool {
+	if x != nil {
+		return x.IncludeConflictHunks
+	}
+	return false
+}
+
+func (x *MergeBranchesRequest) GetConflictStubThreshold() int64 {
+	if x != nil {
+		return x.ConflictStubThreshold
+	}
+	return 0
+}
+
+func (x *MergeBranchesRequest) GetRenameThreshold() int32 {
+	if x != nil {
+		return x.RenameThreshold
+	}
+	return 0
+}
+
+func (x *MergeBranchesRequest) GetTrailer() []string {
+	if x != nil {
+		return x.Trailer
+	}
+	return nil
+}
+
+func (x *MergeBranchesRequest) GetSignoff() bool {
+	if x != nil {
+		return x.Signoff
+	}
+	return false
+}
+
+func (x *MergeBranchesRequest) GetProtectedPaths() []string {
+	if x != nil {
+		return x.ProtectedPaths
+	}
+	return nil
+}
+
+func (x *MergeBranchesRequest) GetAllowProtectedPathChanges() bool {
+	if x != nil {
+		return x.AllowProtectedPathChanges
+	}
+	return false
+}
+
+func (x *MergeBranchesRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *MergeBranchesRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *MergeBranchesRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *MergeBranchesRequest) GetDeltaCompression() bool {
+	if x != nil {
+		return x.DeltaCompression
+	}
+	return false
+}
+
+func (x *MergeBranchesRequest) GetCompressionLevel() int32 {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return 0
+}
+
+func (x *MergeBranchesRequest) GetFailOnConflict() bool {
+	if x != nil {
+		return x.FailOnConflict
+	}
+	return false
+}
+
+func (x *MergeBranchesRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *MergeBranchesRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *MergeBranchesRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+func (x *MergeBranchesRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *MergeBranchesRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// MergeBranchesResponse is the output of merge-branches.
+type MergeBranchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitHash            string             `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	MergeBase             string             `protobuf:"bytes,2,opt,name=merge_base,json=mergeBase,proto3" json:"merge_base,omitempty"`
+	UpToDate              bool               `protobuf:"varint,3,opt,name=up_to_date,json=upToDate,proto3" json:"up_to_date,omitempty"`
+	MergedFiles           []string           `protobuf:"bytes,4,rep,name=merged_files,json=mergedFiles,proto3" json:"merged_files,omitempty"`
+	ConflictResolvedFiles []string           `protobuf:"bytes,5,rep,name=conflict_resolved_files,json=conflictResolvedFiles,proto3" json:"conflict_resolved_files,omitempty"`
+	ConflictOpenFiles     []string           `protobuf:"bytes,6,rep,name=conflict_open_files,json=conflictOpenFiles,proto3" json:"conflict_open_files,omitempty"`
+	ConflictStubFiles     []string           `protobuf:"bytes,7,rep,name=conflict_stub_files,json=conflictStubFiles,proto3" json:"conflict_stub_files,omitempty"`
+	ConflictSubmodules    []string           `protobuf:"bytes,8,rep,name=conflict_submodules,json=conflictSubmodules,proto3" json:"conflict_submodules,omitempty"`
+	ConflictContents      []*ConflictContent `protobuf:"bytes,9,rep,name=conflict_contents,json=conflictContents,proto3" json:"conflict_contents,omitempty"`
+	Renames               []*RenamedFile     `protobuf:"bytes,10,rep,name=renames,proto3" json:"renames,omitempty"`
+	ProtectedFiles        []string           `protobuf:"bytes,11,rep,name=protected_files,json=protectedFiles,proto3" json:"protected_files,omitempty"`
+	FetchDebugInfo        *FetchDebugInfo    `protobuf:"bytes,12,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo         *PushDebugInfo     `protobuf:"bytes,13,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error                 string             `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode             string             `protobuf:"bytes,15,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,16,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *MergeBranchesResponse) Reset() {
+	*x = MergeBranchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeBranchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeBranchesResponse) ProtoMessage() {}
+
+func (x *MergeBranchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeBranchesResponse.ProtoReflect.Descriptor instead.
+func (*MergeBranchesResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MergeBranchesResponse) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *MergeBranchesResponse) GetMergeBase() string {
+	if x != nil {
+		return x.MergeBase
+	}
+	return ""
+}
+
+func (x *MergeBranchesResponse) GetUpToDate() bool {
+	if x != nil {
+		return x.UpToDate
+	}
+	return false
+}
+
+func (x *MergeBranchesResponse) GetMergedFiles() []string {
+	if x != nil {
+		return x.MergedFiles
+	}
+	return nil
+}
+
+func (x *MergeBranchesResponse) GetConflictResolvedFiles() []string {
+	if x != nil {
+		return x.ConflictResolvedFiles
+	}
+	return nil
+}
+
+func (x *MergeBranchesResponse) GetConflictOpenFiles() []string {
+	if x != nil {
+		return x.ConflictOpenFiles
+	}
+	return nil
+}
+
+func (x *MergeBranchesResponse) GetConflictStubFiles() []string {
+	if x != nil {
+		return x.ConflictStubFiles
+	}
+	return nil
+}
+
+func (x *MergeBranchesResponse) GetConflictSubmodules() []string {
+	if x != nil {
+		return x.ConflictSubmodules
+	}
+	return nil
+}
+
+func (x *MergeBranchesResponse) GetConflictContents() []*ConflictContent {
+	if x != nil {
+		return x.ConflictContents
+	}
+	return nil
+}
+
+func (x *MergeBranchesResponse) GetRenames() []*RenamedFile {
+	if x != nil {
+		return x.Renames
+	}
+	return nil
+}
+
+func (x *MergeBranchesResponse) GetProtectedFiles() []string {
+	if x != nil {
+		return x.ProtectedFiles
+	}
+	return nil
+}
+
+func (x *MergeBranchesResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *MergeBranchesResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *MergeBranchesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MergeBranchesResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *MergeBranchesResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// PathsExistRequest is the flags of paths-exist.
+type PathsExistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash to look up the paths in
+	CommitHash string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// Paths to check
+	Paths []string `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,4,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,7,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,8,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *PathsExistRequest) Reset() {
+	*x = PathsExistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathsExistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathsExistRequest) ProtoMessage() {}
+
+func (x *PathsExistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathsExistRequest.ProtoReflect.Descriptor instead.
+func (*PathsExistRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PathsExistRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *PathsExistRequest) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *PathsExistRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *PathsExistRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *PathsExistRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *PathsExistRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *PathsExistRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *PathsExistRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// PathsExistResponse is the output of paths-exist.
+type PathsExistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paths     []*PathExistence `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	DebugInfo *FetchDebugInfo  `protobuf:"bytes,2,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error     string           `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode string           `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,5,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *PathsExistResponse) Reset() {
+	*x = PathsExistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathsExistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathsExistResponse) ProtoMessage() {}
+
+func (x *PathsExistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathsExistResponse.ProtoReflect.Descriptor instead.
+func (*PathsExistResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PathsExistResponse) GetPaths() []*PathExistence {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *PathsExistResponse) GetDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *PathsExistResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PathsExistResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *PathsExistResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// PushNextSemverTagRequest is the flags of push-next-semver-tag.
+type PushNextSemverTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// The part of the tag names before the version (e.g. v or service-a/v)
+	TagPrefix *string `protobuf:"bytes,2,opt,name=tag_prefix,json=tagPrefix,proto3,oneof" json:"tag_prefix,omitempty"`
+	// Consider the pre-release versions (e.g. 1.2.3-rc.1) as the latest tag
+	IncludePrereleases bool `protobuf:"varint,3,opt,name=include_prereleases,json=includePrereleases,proto3" json:"include_prereleases,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,4,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Version part to increment: major, minor, or patch
+	Bump *string `protobuf:"bytes,7,opt,name=bump,proto3,oneof" json:"bump,omitempty"`
+	// Version to use if there's no tag yet
+	InitialVersion *string `protobuf:"bytes,8,opt,name=initial_version,json=initialVersion,proto3,oneof" json:"initial_version,omitempty"`
+	// Commit hash to tag
+	CommitHash string `protobuf:"bytes,9,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// Optional tag message. If set, an annotated tag is created
+	Message string `protobuf:"bytes,10,opt,name=message,proto3" json:"message,omitempty"`
+	// Tagger name of the annotated tag
+	Tagger string `protobuf:"bytes,11,opt,name=tagger,proto3" json:"tagger,omitempty"`
+	// Tagger email address of the annotated tag
+	TaggerEmail string `protobuf:"bytes,12,opt,name=tagger_email,json=taggerEmail,proto3" json:"tagger_email,omitempty"`
+	// Tag time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	TaggerTime string `protobuf:"bytes,13,opt,name=tagger_time,json=taggerTime,proto3" json:"tagger_time,omitempty"`
+	// Optional timezone of the tag time, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used
+	Timezone string `protobuf:"bytes,14,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,15,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,16,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+}
+
+func (x *PushNextSemverTagRequest) Reset() {
+	*x = PushNextSemverTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushNextSemverTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushNextSemverTagRequest) ProtoMessage() {}
+
+func (x *PushNextSemverTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushNextSemverTagRequest.ProtoReflect.Descriptor instead.
+func (*PushNextSemverTagRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PushNextSemverTagRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagRequest) GetTagPrefix() string {
+	if x != nil && x.TagPrefix != nil {
+		return *x.TagPrefix
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagRequest) GetIncludePrereleases() bool {
+	if x != nil {
+		return x.IncludePrereleases
+	}
+	return false
+}
+
+func (x *PushNextSemverTagRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *PushNextSemverTagRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *PushNextSemverTagRequest) GetBump() string {
+	if x != nil && x.Bump != nil {
+		return *x.Bump
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagRequest) GetInitialVersion() string {
+	if x != nil && x.InitialVersion != nil {
+		return *x.InitialVersion
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagRequest) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagRequest) GetTagger() string {
+	if x != nil {
+		return x.Tagger
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagRequest) GetTaggerEmail() string {
+	if x != nil {
+		return x.TaggerEmail
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagRequest) GetTaggerTime() string {
+	if x != nil {
+		return x.TaggerTime
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *PushNextSemverTagRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+// PushNextSemverTagResponse is the output of push-next-semver-tag.
+type PushNextSemverTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousTag    *SemverTag      `protobuf:"bytes,1,opt,name=previous_tag,json=previousTag,proto3" json:"previous_tag,omitempty"`
+	Tag            *SemverTag      `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	FetchDebugInfo *FetchDebugInfo `protobuf:"bytes,3,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo  *PushDebugInfo  `protobuf:"bytes,4,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error          string          `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode      string          `protobuf:"bytes,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,7,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *PushNextSemverTagResponse) Reset() {
+	*x = PushNextSemverTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushNextSemverTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushNextSemverTagResponse) ProtoMessage() {}
+
+func (x *PushNextSemverTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushNextSemverTagResponse.ProtoReflect.Descriptor instead.
+func (*PushNextSemverTagResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PushNextSemverTagResponse) GetPreviousTag() *SemverTag {
+	if x != nil {
+		return x.PreviousTag
+	}
+	return nil
+}
+
+func (x *PushNextSemverTagResponse) GetTag() *SemverTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *PushNextSemverTagResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *PushNextSemverTagResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *PushNextSemverTagResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *PushNextSemverTagResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// RebaseRefsRequest is the flags of rebase-refs.
+type RebaseRefsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Prefix of the refs to rebase (e.g. refs/heads/stack/user1/)
+	RefPrefix string `protobuf:"bytes,2,opt,name=ref_prefix,json=refPrefix,proto3" json:"ref_prefix,omitempty"`
+	// Commit hash to rebase the refs onto
+	Onto string `protobuf:"bytes,3,opt,name=onto,proto3" json:"onto,omitempty"`
+	// Commiter name
+	Committer string `protobuf:"bytes,4,opt,name=committer,proto3" json:"committer,omitempty"`
+	// Commiter email address
+	CommitterEmail string `protobuf:"bytes,5,opt,name=committer_email,json=committerEmail,proto3" json:"committer_email,omitempty"`
+	// Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	CommitterTime string `protobuf:"bytes,6,opt,name=committer_time,json=committerTime,proto3" json:"committer_time,omitempty"`
+	// Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used
+	Timezone string `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Abort the operation if any commit has a merge conflict
+	AbortOnConflict bool `protobuf:"varint,8,opt,name=abort_on_conflict,json=abortOnConflict,proto3" json:"abort_on_conflict,omitempty"`
+	// Report the rebased refs, their conflicts, and the number of the objects to push without pushing them
+	DryRun bool `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// How to rebase the merge commits of the refs: reject (fail the operation), flatten (drop them and rebase the other commits in a line, like git rebase), or preserve (recreate them, like git rebase --rebase-merges)
+	MergeCommits *string `protobuf:"bytes,10,opt,name=merge_commits,json=mergeCommits,proto3,oneof" json:"merge_commits,omitempty"`
+	// If the atomic push fails because some refs are updated by others meanwhile, push the other refs without the atomicity. The updated refs and the refs stacked on them are reported as deferred
+	PushUnaffectedRefs bool `protobuf:"varint,11,opt,name=push_unaffected_refs,json=pushUnaffectedRefs,proto3" json:"push_unaffected_refs,omitempty"`
+	// Optional trailer in the form of KEY: VALUE appended to the message of each created commit (e.g. "Co-authored-by: Foo <foo@example.com>"). A trailer already in the message is not added again. Can be specified multiple times
+	Trailer []string `protobuf:"bytes,12,rep,name=trailer,proto3" json:"trailer,omitempty"`
+	// Add a Signed-off-by trailer for the committer to the messages of the created commits
+	Signoff bool `protobuf:"varint,13,opt,name=signoff,proto3" json:"signoff,omitempty"`
+	// Optional path patterns (e.g. .github/workflows/**) that must not be modified. The operation aborts if the change touches them
+	ProtectedPaths []string `protobuf:"bytes,14,rep,name=protected_paths,json=protectedPaths,proto3" json:"protected_paths,omitempty"`
+	// Allow modifying the paths specified by --protected-paths
+	AllowProtectedPathChanges bool `protobuf:"varint,15,opt,name=allow_protected_path_changes,json=allowProtectedPathChanges,proto3" json:"allow_protected_path_changes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,16,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,17,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,18,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Encode the pushed trees and blobs as deltas to the ones in the parent commit when that is smaller
+	DeltaCompression bool `protobuf:"varint,19,opt,name=delta_compression,json=deltaCompression,proto3" json:"delta_compression,omitempty"`
+	// Optional zlib compression level of the pushed packfile, from 1 (fastest) to 9 (smallest). Zero, which is the default, means the zlib default
+	CompressionLevel int32 `protobuf:"varint,20,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// Exit with a non-zero status if conflicts are reported, even if the refs are pushed
+	FailOnConflict bool `protobuf:"varint,21,opt,name=fail_on_conflict,json=failOnConflict,proto3" json:"fail_on_conflict,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,22,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,23,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,24,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,25,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,26,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *RebaseRefsRequest) Reset() {
+	*x = RebaseRefsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebaseRefsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebaseRefsRequest) ProtoMessage() {}
+
+func (x *RebaseRefsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebaseRefsRequest.ProtoReflect.Descriptor instead.
+func (*RebaseRefsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RebaseRefsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *RebaseRefsRequest) GetRefPrefix() string {
+	if x != nil {
+		return x.RefPrefix
+	}
+	return ""
+}
+
+func (x *RebaseRefsRequest) GetOnto() string {
+	if x != nil {
+		return x.Onto
+	}
+	return ""
+}
+
+func (x *RebaseRefsRequest) GetCommitter() string {
+	if x != nil {
+		return x.Committer
+	}
+	return ""
+}
+
+func (x *RebaseRefsRequest) GetCommitterEmail() string {
+	if x != nil {
+		return x.CommitterEmail
+	}
+	return ""
+}
+
+func (x *RebaseRefsRequest) GetCommitterTime() string {
+	if x != nil {
+		return x.CommitterTime
+	}
+	return ""
+}
+
+func (x *RebaseRefsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *RebaseRefsRequest) GetAbortOnConflict() bool {
+	if x != nil {
+		return x.AbortOnConflict
+	}
+	return false
+}
+
+func (x *RebaseRefsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RebaseRefsRequest) GetMergeCommits() string {
+	if x != nil && x.MergeCommits != nil {
+		return *x.MergeCommits
+	}
+	return ""
+}
+
+func (x *RebaseRefsRequest) GetPushUnaffectedRefs() bool {
+	if x != nil {
+		return x.PushUnaffectedRefs
+	}
+	return false
+}
+
+func (x *RebaseRefsRequest) GetTrailer() []string {
+	if x != nil {
+		return x.Trailer
+	}
+	return nil
+}
+
+func (x *RebaseRefsRequest) GetSignoff() bool {
+	if x != nil {
+		return x.Signoff
+	}
+	return false
+}
+
+func (x *RebaseRefsRequest) GetProtectedPaths() []string {
+	if x != nil {
+		return x.ProtectedPaths
+	}
+	return nil
+}
+
+func (x *RebaseRefsRequest) GetAllowProtectedPathChanges() bool {
+	if x != nil {
+		return x.AllowProtectedPathChanges
+	}
+	return false
+}
+
+func (x *RebaseRefsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *RebaseRefsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *RebaseRefsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *RebaseRefsRequest) GetDeltaCompression() bool {
+	if x != nil {
+		return x.DeltaCompression
+	}
+	return false
+}
+
+func (x *RebaseRefsRequest) GetCompressionLevel() int32 {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return 0
+}
+
+func (x *RebaseRefsRequest) GetFailOnConflict() bool {
+	if x != nil {
+		return x.FailOnConflict
+	}
+	return false
+}
+
+func (x *RebaseRefsRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *RebaseRefsRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *RebaseRefsRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+func (x *RebaseRefsRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *RebaseRefsRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// RebaseRefsResponse is the output of rebase-refs.
+type RebaseRefsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Refs           []*RebasedRef   `protobuf:"bytes,1,rep,name=refs,proto3" json:"refs,omitempty"`
+	NewObjects     int64           `protobuf:"varint,2,opt,name=new_objects,json=newObjects,proto3" json:"new_objects,omitempty"`
+	FetchDebugInfo *FetchDebugInfo `protobuf:"bytes,3,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo  *PushDebugInfo  `protobuf:"bytes,4,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error          string          `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode      string          `protobuf:"bytes,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,7,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *RebaseRefsResponse) Reset() {
+	*x = RebaseRefsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebaseRefsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebaseRefsResponse) ProtoMessage() {}
+
+func (x *RebaseRefsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebaseRefsResponse.ProtoReflect.Descriptor instead.
+func (*RebaseRefsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RebaseRefsResponse) GetRefs() []*RebasedRef {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+func (x *RebaseRefsResponse) GetNewObjects() int64 {
+	if x != nil {
+		return x.NewObjects
+	}
+	return 0
+}
+
+func (x *RebaseRefsResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *RebaseRefsResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *RebaseRefsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RebaseRefsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *RebaseRefsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// ResolveConflictsRequest is the flags of resolve-conflicts.
+type ResolveConflictsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash of the commit with the conflicts, which is pushed to the conflict ref
+	ConflictCommit string `protobuf:"bytes,2,opt,name=conflict_commit,json=conflictCommit,proto3" json:"conflict_commit,omitempty"`
+	// Resolution in the form of PATH=FILE. The conflicting file at PATH is replaced with the content of the local FILE. Can be specified multiple times
+	Resolution []string `protobuf:"bytes,3,rep,name=resolution,proto3" json:"resolution,omitempty"`
+	// Path of a conflicting file to resolve by deleting it. Can be specified multiple times
+	DeletePath []string `protobuf:"bytes,4,rep,name=delete_path,json=deletePath,proto3" json:"delete_path,omitempty"`
+	// Optional commit message of the resolved commit. Defaults to the message of the conflict commit
+	CommitMessage string `protobuf:"bytes,5,opt,name=commit_message,json=commitMessage,proto3" json:"commit_message,omitempty"`
+	// Author name
+	Author string `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	// Author email address
+	AuthorEmail string `protobuf:"bytes,7,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	// Author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	AuthorTime string `protobuf:"bytes,8,opt,name=author_time,json=authorTime,proto3" json:"author_time,omitempty"`
+	// Commiter name
+	Committer string `protobuf:"bytes,9,opt,name=committer,proto3" json:"committer,omitempty"`
+	// Commiter email address
+	CommitterEmail string `protobuf:"bytes,10,opt,name=committer_email,json=committerEmail,proto3" json:"committer_email,omitempty"`
+	// Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	CommitterTime string `protobuf:"bytes,11,opt,name=committer_time,json=committerTime,proto3" json:"committer_time,omitempty"`
+	// Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used
+	Timezone string `protobuf:"bytes,12,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// A ref name (e.g. refs/heads/foobar) to push
+	Ref string `protobuf:"bytes,13,opt,name=ref,proto3" json:"ref,omitempty"`
+	// The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.
+	CurrentRefHash string `protobuf:"bytes,14,opt,name=current_ref_hash,json=currentRefHash,proto3" json:"current_ref_hash,omitempty"`
+	// Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times
+	AdditionalRefUpdate []string `protobuf:"bytes,15,rep,name=additional_ref_update,json=additionalRefUpdate,proto3" json:"additional_ref_update,omitempty"`
+	// Optional ref of the conflict commit. It's deleted atomically with the push if it still points to the conflict commit
+	ConflictRef string `protobuf:"bytes,16,opt,name=conflict_ref,json=conflictRef,proto3" json:"conflict_ref,omitempty"`
+	// Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. "Co-authored-by: Foo <foo@example.com>"). A trailer already in the message is not added again. Can be specified multiple times
+	Trailer []string `protobuf:"bytes,17,rep,name=trailer,proto3" json:"trailer,omitempty"`
+	// Add a Signed-off-by trailer for the committer to the commit message
+	Signoff bool `protobuf:"varint,18,opt,name=signoff,proto3" json:"signoff,omitempty"`
+	// Optional path patterns (e.g. .github/workflows/**) that must not be modified. The operation aborts if the change touches them
+	ProtectedPaths []string `protobuf:"bytes,19,rep,name=protected_paths,json=protectedPaths,proto3" json:"protected_paths,omitempty"`
+	// Allow modifying the paths specified by --protected-paths
+	AllowProtectedPathChanges bool `protobuf:"varint,20,opt,name=allow_protected_path_changes,json=allowProtectedPathChanges,proto3" json:"allow_protected_path_changes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,21,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,22,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,23,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Encode the pushed trees and blobs as deltas to the ones in the parent commit when that is smaller
+	DeltaCompression bool `protobuf:"varint,24,opt,name=delta_compression,json=deltaCompression,proto3" json:"delta_compression,omitempty"`
+	// Optional zlib compression level of the pushed packfile, from 1 (fastest) to 9 (smallest). Zero, which is the default, means the zlib default
+	CompressionLevel int32 `protobuf:"varint,25,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,26,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,27,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,28,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,29,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,30,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *ResolveConflictsRequest) Reset() {
+	*x = ResolveConflictsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveConflictsRequest) ProtoMessage() {}
+
+func (x *ResolveConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveConflictsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ResolveConflictsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetConflictCommit() string {
+	if x != nil {
+		return x.ConflictCommit
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetResolution() []string {
+	if x != nil {
+		return x.Resolution
+	}
+	return nil
+}
+
+func (x *ResolveConflictsRequest) GetDeletePath() []string {
+	if x != nil {
+		return x.DeletePath
+	}
+	return nil
+}
+
+func (x *ResolveConflictsRequest) GetCommitMessage() string {
+	if x != nil {
+		return x.CommitMessage
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetAuthorTime() string {
+	if x != nil {
+		return x.AuthorTime
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetCommitter() string {
+	if x != nil {
+		return x.Committer
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetCommitterEmail() string {
+	if x != nil {
+		return x.CommitterEmail
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetCommitterTime() string {
+	if x != nil {
+		return x.CommitterTime
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetCurrentRefHash() string {
+	if x != nil {
+		return x.CurrentRefHash
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetAdditionalRefUpdate() []string {
+	if x != nil {
+		return x.AdditionalRefUpdate
+	}
+	return nil
+}
+
+func (x *ResolveConflictsRequest) GetConflictRef() string {
+	if x != nil {
+		return x.ConflictRef
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetTrailer() []string {
+	if x != nil {
+		return x.Trailer
+	}
+	return nil
+}
+
+func (x *ResolveConflictsRequest) GetSignoff() bool {
+	if x != nil {
+		return x.Signoff
+	}
+	return false
+}
+
+func (x *ResolveConflictsRequest) GetProtectedPaths() []string {
+	if x != nil {
+		return x.ProtectedPaths
+	}
+	return nil
+}
+
+func (x *ResolveConflictsRequest) GetAllowProtectedPathChanges() bool {
+	if x != nil {
+		return x.AllowProtectedPathChanges
+	}
+	return false
+}
+
+func (x *ResolveConflictsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *ResolveConflictsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *ResolveConflictsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *ResolveConflictsRequest) GetDeltaCompression() bool {
+	if x != nil {
+		return x.DeltaCompression
+	}
+	return false
+}
+
+func (x *ResolveConflictsRequest) GetCompressionLevel() int32 {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return 0
+}
+
+func (x *ResolveConflictsRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *ResolveConflictsRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *ResolveConflictsRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+func (x *ResolveConflictsRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *ResolveConflictsRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// ResolveConflictsResponse is the output of resolve-conflicts.
+type ResolveConflictsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitHash      string          `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	ResolvedFiles   []string        `protobuf:"bytes,2,rep,name=resolved_files,json=resolvedFiles,proto3" json:"resolved_files,omitempty"`
+	UnresolvedFiles []string        `protobuf:"bytes,3,rep,name=unresolved_files,json=unresolvedFiles,proto3" json:"unresolved_files,omitempty"`
+	ProtectedFiles  []string        `protobuf:"bytes,4,rep,name=protected_files,json=protectedFiles,proto3" json:"protected_files,omitempty"`
+	FetchDebugInfo  *FetchDebugInfo `protobuf:"bytes,5,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo   *PushDebugInfo  `protobuf:"bytes,6,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error           string          `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode       string          `protobuf:"bytes,8,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,9,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *ResolveConflictsResponse) Reset() {
+	*x = ResolveConflictsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveConflictsResponse) ProtoMessage() {}
+
+func (x *ResolveConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveConflictsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ResolveConflictsResponse) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *ResolveConflictsResponse) GetResolvedFiles() []string {
+	if x != nil {
+		return x.ResolvedFiles
+	}
+	return nil
+}
+
+func (x *ResolveConflictsResponse) GetUnresolvedFiles() []string {
+	if x != nil {
+		return x.UnresolvedFiles
+	}
+	return nil
+}
+
+func (x *ResolveConflictsResponse) GetProtectedFiles() []string {
+	if x != nil {
+		return x.ProtectedFiles
+	}
+	return nil
+}
+
+func (x *ResolveConflictsResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *ResolveConflictsResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *ResolveConflictsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ResolveConflictsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *ResolveConflictsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// RestoreRefsRequest is the flags of restore-refs.
+type RestoreRefsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,2,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,5,opt,name=quiet,proto3" json:"quiet,omitempty"`
+}
+
+func (x *RestoreRefsRequest) Reset() {
+	*x = RestoreRefsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreRefsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRefsRequest) ProtoMessage() {}
+
+func (x *RestoreRefsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRefsRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRefsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RestoreRefsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *RestoreRefsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *RestoreRefsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *RestoreRefsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *RestoreRefsRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+// RestoreRefsResponse is the output of restore-refs.
+type RestoreRefsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedRefs    []string        `protobuf:"bytes,1,rep,name=updated_refs,json=updatedRefs,proto3" json:"updated_refs,omitempty"`
+	DeletedRefs    []string        `protobuf:"bytes,2,rep,name=deleted_refs,json=deletedRefs,proto3" json:"deleted_refs,omitempty"`
+	FetchDebugInfo *FetchDebugInfo `protobuf:"bytes,3,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo  *PushDebugInfo  `protobuf:"bytes,4,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error          string          `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode      string          `protobuf:"bytes,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,7,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *RestoreRefsResponse) Reset() {
+	*x = RestoreRefsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreRefsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRefsResponse) ProtoMessage() {}
+
+func (x *RestoreRefsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRefsResponse.ProtoReflect.Descriptor instead.
+func (*RestoreRefsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RestoreRefsResponse) GetUpdatedRefs() []string {
+	if x != nil {
+		return x.UpdatedRefs
+	}
+	return nil
+}
+
+func (x *RestoreRefsResponse) GetDeletedRefs() []string {
+	if x != nil {
+		return x.DeletedRefs
+	}
+	return nil
+}
+
+func (x *RestoreRefsResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *RestoreRefsResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *RestoreRefsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RestoreRefsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *RestoreRefsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// RevertRequest is the flags of revert.
+type RevertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash of the commit to revert
+	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// The parent number (starting from 1) of the mainline. Required if the commit is a merge commit. The changes the merge made relative to this parent are reverted
+	Mainline int32 `protobuf:"varint,3,opt,name=mainline,proto3" json:"mainline,omitempty"`
+	// Commit hash where the revert commit is created on top of
+	RevertOnto string `protobuf:"bytes,4,opt,name=revert_onto,json=revertOnto,proto3" json:"revert_onto,omitempty"`
+	// Optional commit message of the revert commit. Defaults to the git-revert style message
+	CommitMessage string `protobuf:"bytes,5,opt,name=commit_message,json=commitMessage,proto3" json:"commit_message,omitempty"`
+	// Author name
+	Author string `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	// Author email address
+	AuthorEmail string `protobuf:"bytes,7,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	// Author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	AuthorTime string `protobuf:"bytes,8,opt,name=author_time,json=authorTime,proto3" json:"author_time,omitempty"`
+	// Commiter name
+	Committer string `protobuf:"bytes,9,opt,name=committer,proto3" json:"committer,omitempty"`
+	// Commiter email address
+	CommitterEmail string `protobuf:"bytes,10,opt,name=committer_email,json=committerEmail,proto3" json:"committer_email,omitempty"`
+	// Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	CommitterTime string `protobuf:"bytes,11,opt,name=committer_time,json=committerTime,proto3" json:"committer_time,omitempty"`
+	// Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used
+	Timezone string `protobuf:"bytes,12,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// A ref name (e.g. refs/heads/foobar) to push
+	Ref string `protobuf:"bytes,13,opt,name=ref,proto3" json:"ref,omitempty"`
+	// The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.
+	CurrentRefHash string `protobuf:"bytes,14,opt,name=current_ref_hash,json=currentRefHash,proto3" json:"current_ref_hash,omitempty"`
+	// Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times
+	AdditionalRefUpdate []string `protobuf:"bytes,15,rep,name=additional_ref_update,json=additionalRefUpdate,proto3" json:"additional_ref_update,omitempty"`
+	// Abort the operation if there is a merge conflict
+	AbortOnConflict bool `protobuf:"varint,16,opt,name=abort_on_conflict,json=abortOnConflict,proto3" json:"abort_on_conflict,omitempty"`
+	// Include the conflicting hunks of the files with conflicts in the output
+	IncludeConflictHunks bool `protobuf:"varint,17,opt,name=include_conflict_hunks,json=includeConflictHunks,proto3" json:"include_conflict_hunks,omitempty"`
+	// Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. "Co-authored-by: Foo <foo@example.com>"). A trailer already in the message is not added again. Can be specified multiple times
+	Trailer []string `protobuf:"bytes,18,rep,name=trailer,proto3" json:"trailer,omitempty"`
+	// Add a Signed-off-by trailer for the committer to the commit message
+	Signoff bool `protobuf:"varint,19,opt,name=signoff,proto3" json:"signoff,omitempty"`
+	// Optional path patterns (e.g. .github/workflows/**) that must not be modified. The operation aborts if the change touches them
+	ProtectedPaths []string `protobuf:"bytes,20,rep,name=protected_paths,json=protectedPaths,proto3" json:"protected_paths,omitempty"`
+	// Allow modifying the paths specified by --protected-paths
+	AllowProtectedPathChanges bool `protobuf:"varint,21,opt,name=allow_protected_path_changes,json=allowProtectedPathChanges,proto3" json:"allow_protected_path_changes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,22,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,23,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,24,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Encode the pushed trees and blobs as deltas to the ones in the parent commit when that is smaller
+	DeltaCompression bool `protobuf:"varint,25,opt,name=delta_compression,json=deltaCompression,proto3" json:"delta_compression,omitempty"`
+	// Optional zlib compression level of the pushed packfile, from 1 (fastest) to 9 (smallest). Zero, which is the default, means the zlib default
+	CompressionLevel int32 `protobuf:"varint,26,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// Exit with a non-zero status if conflicts are reported, even if the commit is pushed
+	FailOnConflict bool `protobuf:"varint,27,opt,name=fail_on_conflict,json=failOnConflict,proto3" json:"fail_on_conflict,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,28,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,29,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,30,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,31,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,32,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *RevertRequest) Reset() {
+	*x = RevertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertRequest) ProtoMessage() {}
+
+func (x *RevertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertRequest.ProtoReflect.Descriptor instead.
+func (*RevertRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RevertRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetMainline() int32 {
+	if x != nil {
+		return x.Mainline
+	}
+	return 0
+}
+
+func (x *RevertRequest) GetRevertOnto() string {
+	if x != nil {
+		return x.RevertOnto
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetCommitMessage() string {
+	if x != nil {
+		return x.CommitMessage
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetAuthorTime() string {
+	if x != nil {
+		return x.AuthorTime
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetCommitter() string {
+	if x != nil {
+		return x.Committer
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetCommitterEmail() string {
+	if x != nil {
+		return x.CommitterEmail
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetCommitterTime() string {
+	if x != nil {
+		return x.CommitterTime
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetCurrentRefHash() string {
+	if x != nil {
+		return x.CurrentRefHash
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetAdditionalRefUpdate() []string {
+	if x != nil {
+		return x.AdditionalRefUpdate
+	}
+	return nil
+}
+
+func (x *RevertRequest) GetAbortOnConflict() bool {
+	if x != nil {
+		return x.AbortOnConflict
+	}
+	return false
+}
+
+func (x *RevertRequest) GetIncludeConflictHunks() bool {
+	if x != nil {
+		return x.IncludeConflictHunks
+	}
+	return false
+}
+
+func (x *RevertRequest) GetTrailer() []string {
+	if x != nil {
+		return x.Trailer
+	}
+	return nil
+}
+
+func (x *RevertRequest) GetSignoff() bool {
+	if x != nil {
+		return x.Signoff
+	}
+	return false
+}
+
+func (x *RevertRequest) GetProtectedPaths() []string {
+	if x != nil {
+		return x.ProtectedPaths
+	}
+	return nil
+}
+
+func (x *RevertRequest) GetAllowProtectedPathChanges() bool {
+	if x != nil {
+		return x.AllowProtectedPathChanges
+	}
+	return false
+}
+
+func (x *RevertRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *RevertRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *RevertRequest) GetDeltaCompression() bool {
+	if x != nil {
+		return x.DeltaCompression
+	}
+	return false
+}
+
+func (x *RevertRequest) GetCompressionLevel() int32 {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return 0
+}
+
+func (x *RevertRequest) GetFailOnConflict() bool {
+	if x != nil {
+		return x.FailOnConflict
+	}
+	return false
+}
+
+func (x *RevertRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *RevertRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *RevertRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+func (x *RevertRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *RevertRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// RevertResponse is the output of revert.
+type RevertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitHash        string             `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	RevertedFiles     []string           `protobuf:"bytes,2,rep,name=reverted_files,json=revertedFiles,proto3" json:"reverted_files,omitempty"`
+	ConflictOpenFiles []string           `protobuf:"bytes,3,rep,name=conflict_open_files,json=conflictOpenFiles,proto3" json:"conflict_open_files,omitempty"`
+	ConflictContents  []*ConflictContent `protobuf:"bytes,4,rep,name=conflict_contents,json=conflictContents,proto3" json:"conflict_contents,omitempty"`
+	ProtectedFiles    []string           `protobuf:"bytes,5,rep,name=protected_files,json=protectedFiles,proto3" json:"protected_files,omitempty"`
+	FetchDebugInfo    *FetchDebugInfo    `protobuf:"bytes,6,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo     *PushDebugInfo     `protobuf:"bytes,7,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error             string             `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode         string             `protobuf:"bytes,9,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,10,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *RevertResponse) Reset() {
+	*x = RevertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertResponse) ProtoMessage() {}
+
+func (x *RevertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertResponse.ProtoReflect.Descriptor instead.
+func (*RevertResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RevertResponse) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *RevertResponse) GetRevertedFiles() []string {
+	if x != nil {
+		return x.RevertedFiles
+	}
+	return nil
+}
+
+func (x *RevertResponse) GetConflictOpenFiles() []string {
+	if x != nil {
+		return x.ConflictOpenFiles
+	}
+	return nil
+}
+
+func (x *RevertResponse) GetConflictContents() []*ConflictContent {
+	if x != nil {
+		return x.ConflictContents
+	}
+	return nil
+}
+
+func (x *RevertResponse) GetProtectedFiles() []string {
+	if x != nil {
+		return x.ProtectedFiles
+	}
+	return nil
+}
+
+func (x *RevertResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *RevertResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *RevertResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RevertResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *RevertResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// RevertMergeRequest is the flags of revert-merge.
+type RevertMergeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash of the merge commit to revert
+	MergeCommit string `protobuf:"bytes,2,opt,name=merge_commit,json=mergeCommit,proto3" json:"merge_commit,omitempty"`
+	// The parent number (starting from 1) of the mainline. The changes the merge made relative to this parent are reverted
+	Mainline *int32 `protobuf:"varint,3,opt,name=mainline,proto3,oneof" json:"mainline,omitempty"`
+	// Commit hash where the revert commit is created on top of
+	RevertOnto string `protobuf:"bytes,4,opt,name=revert_onto,json=revertOnto,proto3" json:"revert_onto,omitempty"`
+	// Optional commit message of the revert commit. Defaults to the git-revert style message
+	CommitMessage string `protobuf:"bytes,5,opt,name=commit_message,json=commitMessage,proto3" json:"commit_message,omitempty"`
+	// Author name
+	Author string `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	// Author email address
+	AuthorEmail string `protobuf:"bytes,7,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	// Author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	AuthorTime string `protobuf:"bytes,8,opt,name=author_time,json=authorTime,proto3" json:"author_time,omitempty"`
+	// Commiter name
+	Committer string `protobuf:"bytes,9,opt,name=committer,proto3" json:"committer,omitempty"`
+	// Commiter email address
+	CommitterEmail string `protobuf:"bytes,10,opt,name=committer_email,json=committerEmail,proto3" json:"committer_email,omitempty"`
+	// Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	CommitterTime string `protobuf:"bytes,11,opt,name=committer_time,json=committerTime,proto3" json:"committer_time,omitempty"`
+	// Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used
+	Timezone string `protobuf:"bytes,12,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// A ref name (e.g. refs/heads/foobar) to push
+	Ref string `protobuf:"bytes,13,opt,name=ref,proto3" json:"ref,omitempty"`
+	// The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.
+	CurrentRefHash string `protobuf:"bytes,14,opt,name=current_ref_hash,json=currentRefHash,proto3" json:"current_ref_hash,omitempty"`
+	// Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times
+	AdditionalRefUpdate []string `protobuf:"bytes,15,rep,name=additional_ref_update,json=additionalRefUpdate,proto3" json:"additional_ref_update,omitempty"`
+	// Abort the operation if there is a merge conflict
+	AbortOnConflict bool `protobuf:"varint,16,opt,name=abort_on_conflict,json=abortOnConflict,proto3" json:"abort_on_conflict,omitempty"`
+	// Include the conflicting hunks of the files with conflicts in the output
+	IncludeConflictHunks bool `protobuf:"varint,17,opt,name=include_conflict_hunks,json=includeConflictHunks,proto3" json:"include_conflict_hunks,omitempty"`
+	// Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. "Co-authored-by: Foo <foo@example.com>"). A trailer already in the message is not added again. Can be specified multiple times
+	Trailer []string `protobuf:"bytes,18,rep,name=trailer,proto3" json:"trailer,omitempty"`
+	// Add a Signed-off-by trailer for the committer to the commit message
+	Signoff bool `protobuf:"varint,19,opt,name=signoff,proto3" json:"signoff,omitempty"`
+	// Optional path patterns (e.g. .github/workflows/**) that must not be modified. The operation aborts if the change touches them
+	ProtectedPaths []string `protobuf:"bytes,20,rep,name=protected_paths,json=protectedPaths,proto3" json:"protected_paths,omitempty"`
+	// Allow modifying the paths specified by --protected-paths
+	AllowProtectedPathChanges bool `protobuf:"varint,21,opt,name=allow_protected_path_changes,json=allowProtectedPathChanges,proto3" json:"allow_protected_path_changes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,22,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,23,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,24,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Encode the pushed trees and blobs as deltas to the ones in the parent commit when that is smaller
+	DeltaCompression bool `protobuf:"varint,25,opt,name=delta_compression,json=deltaCompression,proto3" json:"delta_compression,omitempty"`
+	// Optional zlib compression level of the pushed packfile, from 1 (fastest) to 9 (smallest). Zero, which is the default, means the zlib default
+	CompressionLevel int32 `protobuf:"varint,26,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// Exit with a non-zero status if conflicts are reported, even if the commit is pushed
+	FailOnConflict bool `protobuf:"varint,27,opt,name=fail_on_conflict,json=failOnConflict,proto3" json:"fail_on_conflict,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,28,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,29,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,30,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,31,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,32,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *RevertMergeRequest) Reset() {
+	*x = RevertMergeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertMergeRequest) ProtoMessage() {}
+
+func (x *RevertMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertMergeRequest.ProtoReflect.Descriptor instead.
+func (*RevertMergeRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RevertMergeRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetMergeCommit() string {
+	if x != nil {
+		return x.MergeCommit
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetMainline() int32 {
+	if x != nil && x.Mainline != nil {
+		return *x.Mainline
+	}
+	return 0
+}
+
+func (x *RevertMergeRequest) GetRevertOnto() string {
+	if x != nil {
+		return x.RevertOnto
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetCommitMessage() string {
+	if x != nil {
+		return x.CommitMessage
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetAuthorTime() string {
+	if x != nil {
+		return x.AuthorTime
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetCommitter() string {
+	if x != nil {
+		return x.Committer
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetCommitterEmail() string {
+	if x != nil {
+		return x.CommitterEmail
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetCommitterTime() string {
+	if x != nil {
+		return x.CommitterTime
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetCurrentRefHash() string {
+	if x != nil {
+		return x.CurrentRefHash
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetAdditionalRefUpdate() []string {
+	if x != nil {
+		return x.AdditionalRefUpdate
+	}
+	return nil
+}
+
+func (x *RevertMergeRequest) GetAbortOnConflict() bool {
+	if x != nil {
+		return x.AbortOnConflict
+	}
+	return false
+}
+
+func (x *RevertMergeRequest) GetIncludeConflictHunks() bool {
+	if x != nil {
+		return x.IncludeConflictHunks
+	}
+	return false
+}
+
+func (x *RevertMergeRequest) GetTrailer() []string {
+	if x != nil {
+		return x.Trailer
+	}
+	return nil
+}
+
+func (x *RevertMergeRequest) GetSignoff() bool {
+	if x != nil {
+		return x.Signoff
+	}
+	return false
+}
+
+func (x *RevertMergeRequest) GetProtectedPaths() []string {
+	if x != nil {
+		return x.ProtectedPaths
+	}
+	return nil
+}
+
+func (x *RevertMergeRequest) GetAllowProtectedPathChanges() bool {
+	if x != nil {
+		return x.AllowProtectedPathChanges
+	}
+	return false
+}
+
+func (x *RevertMergeRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *RevertMergeRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *RevertMergeRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *RevertMergeRequest) GetDeltaCompression() bool {
+	if x != nil {
+		return x.DeltaCompression
+	}
+	return false
+}
+
+func (x *RevertMergeRequest) GetCompressionLevel() int32 {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return 0
+}
+
+func (x *RevertMergeRequest) GetFailOnConflict() bool {
+	if x != nil {
+		return x.FailOnConflict
+	}
+	return false
+}
+
+func (x *RevertMergeRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *RevertMergeRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *RevertMergeRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+func (x *RevertMergeRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *RevertMergeRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// RevertMergeResponse is the output of revert-merge.
+type RevertMergeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitHash        string             `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	RevertedFiles     []string           `protobuf:"bytes,2,rep,name=reverted_files,json=revertedFiles,proto3" json:"reverted_files,omitempty"`
+	ConflictOpenFiles []string           `protobuf:"bytes,3,rep,name=conflict_open_files,json=conflictOpenFiles,proto3" json:"conflict_open_files,omitempty"`
+	ConflictContents  []*ConflictContent `protobuf:"bytes,4,rep,name=conflict_contents,json=conflictContents,proto3" json:"conflict_contents,omitempty"`
+	ProtectedFiles    []string           `protobuf:"bytes,5,rep,name=protected_files,json=protectedFiles,proto3" json:"protected_files,omitempty"`
+	FetchDebugInfo    *FetchDebugInfo    `protobuf:"bytes,6,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo     *PushDebugInfo     `protobuf:"bytes,7,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error             string             `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode         string             `protobuf:"bytes,9,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,10,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *RevertMergeResponse) Reset() {
+	*x = RevertMergeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertMergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertMergeResponse) ProtoMessage() {}
+
+func (x *RevertMergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertMergeResponse.ProtoReflect.Descriptor instead.
+func (*RevertMergeResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RevertMergeResponse) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *RevertMergeResponse) GetRevertedFiles() []string {
+	if x != nil {
+		return x.RevertedFiles
+	}
+	return nil
+}
+
+func (x *RevertMergeResponse) GetConflictOpenFiles() []string {
+	if x != nil {
+		return x.ConflictOpenFiles
+	}
+	return nil
+}
+
+func (x *RevertMergeResponse) GetConflictContents() []*ConflictContent {
+	if x != nil {
+		return x.ConflictContents
+	}
+	return nil
+}
+
+func (x *RevertMergeResponse) GetProtectedFiles() []string {
+	if x != nil {
+		return x.ProtectedFiles
+	}
+	return nil
+}
+
+func (x *RevertMergeResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *RevertMergeResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *RevertMergeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RevertMergeResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *RevertMergeResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// RewordCommitsRequest is the flags of reword-commits.
+type RewordCommitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// A branch ref name (e.g. refs/heads/foobar) whose commits are rewritten. It's pushed with compare-and-swap against its current value
+	Ref string `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	// Number of the commits to rewrite from the tip along the first parents
+	Count *int32 `protobuf:"varint,3,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	// Optional Go text/template of the new commit messages. It's executed with .Hash, .ShortHash, .Message, .Subject, .Body, .Author, and .Index of each commit, and can use appendTrailer MESSAGE KEY VALUE. If empty, the messages are kept
+	MessageTemplate string `protobuf:"bytes,4,opt,name=message_template,json=messageTemplate,proto3" json:"message_template,omitempty"`
+	// Optional author name to replace the authors of the commits with
+	Author string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	// Optional author email address to replace the authors of the commits with
+	AuthorEmail string `protobuf:"bytes,6,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	// Optional author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z). If empty, the original author times are kept
+	AuthorTime string `protobuf:"bytes,7,opt,name=author_time,json=authorTime,proto3" json:"author_time,omitempty"`
+	// Commiter name
+	Committer string `protobuf:"bytes,8,opt,name=committer,proto3" json:"committer,omitempty"`
+	// Commiter email address
+	CommitterEmail string `protobuf:"bytes,9,opt,name=committer_email,json=committerEmail,proto3" json:"committer_email,omitempty"`
+	// Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	CommitterTime string `protobuf:"bytes,10,opt,name=committer_time,json=committerTime,proto3" json:"committer_time,omitempty"`
+	// Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used
+	Timezone string `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commits. NEW_HASH can be HEAD for the new tip. Can be specified multiple times
+	AdditionalRefUpdate []string `protobuf:"bytes,12,rep,name=additional_ref_update,json=additionalRefUpdate,proto3" json:"additional_ref_update,omitempty"`
+	// Report the rewritten commits and their messages without pushing them
+	DryRun bool `protobuf:"varint,13,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Optional trailer in the form of KEY: VALUE appended to the message of each created commit (e.g. "Co-authored-by: Foo <foo@example.com>"). A trailer already in the message is not added again. Can be specified multiple times
+	Trailer []string `protobuf:"bytes,14,rep,name=trailer,proto3" json:"trailer,omitempty"`
+	// Add a Signed-off-by trailer for the committer to the messages of the created commits
+	Signoff bool `protobuf:"varint,15,opt,name=signoff,proto3" json:"signoff,omitempty"`
+	// Optional path patterns (e.g. .github/workflows/**) that must not be modified. The operation aborts if the change touches them
+	ProtectedPaths []string `protobuf:"bytes,16,rep,name=protected_paths,json=protectedPaths,proto3" json:"protected_paths,omitempty"`
+	// Allow modifying the paths specified by --protected-paths
+	AllowProtectedPathChanges bool `protobuf:"varint,17,opt,name=allow_protected_path_changes,json=allowProtectedPathChanges,proto3" json:"allow_protected_path_changes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,18,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,19,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,20,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional zlib compression level of the pushed packfile, from 1 (fastest) to 9 (smallest). Zero, which is the default, means the zlib default
+	CompressionLevel int32 `protobuf:"varint,21,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,22,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,23,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,24,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,25,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,26,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *RewordCommitsRequest) Reset() {
+	*x = RewordCommitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewordCommitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewordCommitsRequest) ProtoMessage() {}
+
+func (x *RewordCommitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewordCommitsRequest.ProtoReflect.Descriptor instead.
+func (*RewordCommitsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RewordCommitsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *RewordCommitsRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *RewordCommitsRequest) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *RewordCommitsRequest) GetMessageTemplate() string {
+	if x != nil {
+		return x.MessageTemplate
+	}
+	return ""
+}
+
+func (x *RewordCommitsRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *RewordCommitsRequest) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *RewordCommitsRequest) GetAuthorTime() string {
+	if x != nil {
+		return x.AuthorTime
+	}
+	return ""
+}
+
+func (x *RewordCommitsRequest) GetCommitter() string {
+	if x != nil {
+		return x.Committer
+	}
+	return ""
+}
+
+func (x *RewordCommitsRequest) GetCommitterEmail() string {
+	if x != nil {
+		return x.CommitterEmail
+	}
+	return ""
+}
+
+func (x *RewordCommitsRequest) GetCommitterTime() string {
+	if x != nil {
+		return x.CommitterTime
+	}
+	return ""
+}
+
+func (x *RewordCommitsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *RewordCommitsRequest) GetAdditionalRefUpdate() []string {
+	if x != nil {
+		return x.AdditionalRefUpdate
+	}
+	return nil
+}
+
+func (x *RewordCommitsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RewordCommitsRequest) GetTrailer() []string {
+	if x != nil {
+		return x.Trailer
+	}
+	return nil
+}
+
+func (x *RewordCommitsRequest) GetSignoff() bool {
+	if x != nil {
+		return x.Signoff
+	}
+	return false
+}
+
+func (x *RewordCommitsRequest) GetProtectedPaths() []string {
+	if x != nil {
+		return x.ProtectedPaths
+	}
+	return nil
+}
+
+func (x *RewordCommitsRequest) GetAllowProtectedPathChanges() bool {
+	if x != nil {
+		return x.AllowProtectedPathChanges
+	}
+	return false
+}
+
+func (x *RewordCommitsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *RewordCommitsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *RewordCommitsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *RewordCommitsRequest) GetCompressionLevel() int32 {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return 0
+}
+
+func (x *RewordCommitsRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *RewordCommitsRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *RewordCommitsRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+func (x *RewordCommitsRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *RewordCommitsRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// RewordCommitsResponse is the output of reword-commits.
+type RewordCommitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitHash     string            `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	Commits        []*RewordedCommit `protobuf:"bytes,2,rep,name=commits,proto3" json:"commits,omitempty"`
+	ProtectedFiles []string          `protobuf:"bytes,3,rep,name=protected_files,json=protectedFiles,proto3" json:"protected_files,omitempty"`
+	FetchDebugInfo *FetchDebugInfo   `protobuf:"bytes,4,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo  *PushDebugInfo    `protobuf:"bytes,5,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error          string            `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode      string            `protobuf:"bytes,7,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,8,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *RewordCommitsResponse) Reset() {
+	*x = RewordCommitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewordCommitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewordCommitsResponse) ProtoMessage() {}
+
+func (x *RewordCommitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewordCommitsResponse.ProtoReflect.Descriptor instead.
+func (*RewordCommitsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RewordCommitsResponse) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *RewordCommitsResponse) GetCommits() []*RewordedCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *RewordCommitsResponse) GetProtectedFiles() []string {
+	if x != nil {
+		return x.ProtectedFiles
+	}
+	return nil
+}
+
+func (x *RewordCommitsResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *RewordCommitsResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *RewordCommitsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RewordCommitsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *RewordCommitsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// SnapshotRefsRequest is the flags of snapshot-refs.
+type SnapshotRefsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Ref prefixes
+	RefPrefixes []string `protobuf:"bytes,2,rep,name=ref_prefixes,json=refPrefixes,proto3" json:"ref_prefixes,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,3,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,5,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,6,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,7,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+}
+
+func (x *SnapshotRefsRequest) Reset() {
+	*x = SnapshotRefsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotRefsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotRefsRequest) ProtoMessage() {}
+
+func (x *SnapshotRefsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotRefsRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotRefsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SnapshotRefsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *SnapshotRefsRequest) GetRefPrefixes() []string {
+	if x != nil {
+		return x.RefPrefixes
+	}
+	return nil
+}
+
+func (x *SnapshotRefsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *SnapshotRefsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *SnapshotRefsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *SnapshotRefsRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *SnapshotRefsRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+// SnapshotRefsResponse is the output of snapshot-refs.
+type SnapshotRefsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefPrefixes []string          `protobuf:"bytes,1,rep,name=ref_prefixes,json=refPrefixes,proto3" json:"ref_prefixes,omitempty"`
+	Refs        map[string]string `protobuf:"bytes,2,rep,name=refs,proto3" json:"refs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DebugInfo   *LsRefsDebugInfo  `protobuf:"bytes,3,opt,name=debug_info,json=debugInfo,proto3" json:"debug_info,omitempty"`
+	Error       string            `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode   string            `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,6,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *SnapshotRefsResponse) Reset() {
+	*x = SnapshotRefsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotRefsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotRefsResponse) ProtoMessage() {}
+
+func (x *SnapshotRefsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotRefsResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotRefsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SnapshotRefsResponse) GetRefPrefixes() []string {
+	if x != nil {
+		return x.RefPrefixes
+	}
+	return nil
+}
+
+func (x *SnapshotRefsResponse) GetRefs() map[string]string {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+func (x *SnapshotRefsResponse) GetDebugInfo() *LsRefsDebugInfo {
+	if x != nil {
+		return x.DebugInfo
+	}
+	return nil
+}
+
+func (x *SnapshotRefsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SnapshotRefsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *SnapshotRefsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// SquashCherryPickRequest is the flags of squash-cherry-pick.
+type SquashCherryPickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Commit hash where cherry-pick from
+	CherryPickFrom string `protobuf:"bytes,2,opt,name=cherry_pick_from,json=cherryPickFrom,proto3" json:"cherry_pick_from,omitempty"`
+	// Commit hash where cherry-pick to
+	CherryPickTo string `protobuf:"bytes,3,opt,name=cherry_pick_to,json=cherryPickTo,proto3" json:"cherry_pick_to,omitempty"`
+	// The merge base of the cherry-pick from. The changes from this commit to cherry-pick-from will be applied to cherry-pick-to.
+	CherryPickBase string `protobuf:"bytes,4,opt,name=cherry_pick_base,json=cherryPickBase,proto3" json:"cherry_pick_base,omitempty"`
+	// Commit message of the squashed commit
+	CommitMessage string `protobuf:"bytes,5,opt,name=commit_message,json=commitMessage,proto3" json:"commit_message,omitempty"`
+	// Author name
+	Author string `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	// Author email address
+	AuthorEmail string `protobuf:"bytes,7,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	// Author time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	AuthorTime string `protobuf:"bytes,8,opt,name=author_time,json=authorTime,proto3" json:"author_time,omitempty"`
+	// Commiter name
+	Committer string `protobuf:"bytes,9,opt,name=committer,proto3" json:"committer,omitempty"`
+	// Commiter email address
+	CommitterEmail string `protobuf:"bytes,10,opt,name=committer_email,json=committerEmail,proto3" json:"committer_email,omitempty"`
+	// Commit time in RFC3339 format (e.g. 2024-01-01T00:00:00Z)
+	CommitterTime string `protobuf:"bytes,11,opt,name=committer_time,json=committerTime,proto3" json:"committer_time,omitempty"`
+	// Optional timezone of the author and commit times, either an IANA timezone name (e.g. UTC, Asia/Tokyo), Local, or a UTC offset (e.g. +09:00). If empty, the timezones of the given times or the local timezone are used
+	Timezone string `protobuf:"bytes,12,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// A ref name (e.g. refs/heads/foobar) to push
+	Ref string `protobuf:"bytes,13,opt,name=ref,proto3" json:"ref,omitempty"`
+	// The expected current commit hash of the ref. If this is specified, the push will use the current commit hash. This is used for compare-and-swap.
+	CurrentRefHash string `protobuf:"bytes,14,opt,name=current_ref_hash,json=currentRefHash,proto3" json:"current_ref_hash,omitempty"`
+	// Optional ref update in the form of REF:NEW_HASH[:OLD_HASH] pushed atomically with the created commit. NEW_HASH can be HEAD for the created commit. Can be specified multiple times
+	AdditionalRefUpdate []string `protobuf:"bytes,15,rep,name=additional_ref_update,json=additionalRefUpdate,proto3" json:"additional_ref_update,omitempty"`
+	// Abort the operation if there is a merge conflict
+	AbortOnConflict bool `protobuf:"varint,16,opt,name=abort_on_conflict,json=abortOnConflict,proto3" json:"abort_on_conflict,omitempty"`
+	// Include the conflicting hunks of the files with conflicts in the output
+	IncludeConflictHunks bool `protobuf:"varint,17,opt,name=include_conflict_hunks,json=includeConflictHunks,proto3" json:"include_conflict_hunks,omitempty"`
+	// Optional similarity in percent (e.g. 50, which is git's default) for a deleted file and an added file to be a rename. The changes to the old path are applied to the renamed file. Zero, which is the default, disables the rename detection
+	RenameThreshold int32 `protobuf:"varint,18,opt,name=rename_threshold,json=renameThreshold,proto3" json:"rename_threshold,omitempty"`
+	// Optional scratch ref namespace (e.g. refs/niche-git/tmp/). With --abort-on-conflict, the commit with the conflicts is pushed to a new ref under it, which cleanup-scratch-refs expires
+	ConflictRefNamespace string `protobuf:"bytes,19,opt,name=conflict_ref_namespace,json=conflictRefNamespace,proto3" json:"conflict_ref_namespace,omitempty"`
+	// Optional path prefixes to restrict the cherry-pick to. The changes outside of them are discarded
+	PathScope []string `protobuf:"bytes,20,rep,name=path_scope,json=pathScope,proto3" json:"path_scope,omitempty"`
+	// Optional path patterns (e.g. .github/workflows/**) that must not be modified. The operation aborts if the change touches them
+	ProtectedPaths []string `protobuf:"bytes,21,rep,name=protected_paths,json=protectedPaths,proto3" json:"protected_paths,omitempty"`
+	// Allow modifying the paths specified by --protected-paths
+	AllowProtectedPathChanges bool `protobuf:"varint,22,opt,name=allow_protected_path_changes,json=allowProtectedPathChanges,proto3" json:"allow_protected_path_changes,omitempty"`
+	// Optional maximum number of files the change can modify. The operation aborts if exceeded
+	MaxChangedFiles int32 `protobuf:"varint,23,opt,name=max_changed_files,json=maxChangedFiles,proto3" json:"max_changed_files,omitempty"`
+	// Optional maximum total bytes of the files that the commit adds or modifies. The operation aborts if exceeded
+	MaxNewBlobBytes int64 `protobuf:"varint,24,opt,name=max_new_blob_bytes,json=maxNewBlobBytes,proto3" json:"max_new_blob_bytes,omitempty"`
+	// Add a Signed-off-by trailer for the committer to the commit message
+	Signoff bool `protobuf:"varint,25,opt,name=signoff,proto3" json:"signoff,omitempty"`
+	// Verify Git LFS file locks before pushing. The operation aborts if the change modifies files locked by others
+	VerifyLfsLocks bool `protobuf:"varint,26,opt,name=verify_lfs_locks,json=verifyLfsLocks,proto3" json:"verify_lfs_locks,omitempty"`
+	// Optional trailer in the form of KEY: VALUE appended to the message of the created commit (e.g. "Co-authored-by: Foo <foo@example.com>"). A trailer already in the message is not added again. Can be specified multiple times
+	Trailer []string `protobuf:"bytes,27,rep,name=trailer,proto3" json:"trailer,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,28,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,29,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,30,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Encode the pushed trees and blobs as deltas to the ones in the parent commit when that is smaller
+	DeltaCompression bool `protobuf:"varint,31,opt,name=delta_compression,json=deltaCompression,proto3" json:"delta_compression,omitempty"`
+	// Optional zlib compression level of the pushed packfile, from 1 (fastest) to 9 (smallest). Zero, which is the default, means the zlib default
+	CompressionLevel int32 `protobuf:"varint,32,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// Exit with a non-zero status if conflicts are reported, even if the commit is pushed
+	FailOnConflict bool `protobuf:"varint,33,opt,name=fail_on_conflict,json=failOnConflict,proto3" json:"fail_on_conflict,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,34,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,35,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,36,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+	// Optional packfile size in bytes beyond which fetched packfiles are spooled to a temporary file instead of memory
+	PackfileSpoolThreshold int64 `protobuf:"varint,37,opt,name=packfile_spool_threshold,json=packfileSpoolThreshold,proto3" json:"packfile_spool_threshold,omitempty"`
+	// Optional comma-separated protocols of the packfile URIs to accept, e.g. https, for the servers that offload packfiles to CDNs with packfile-uris. Not set, which is the default, disables it. Only for the servers that advertise packfile-uris
+	PackfileUriProtocols []string `protobuf:"bytes,38,rep,name=packfile_uri_protocols,json=packfileUriProtocols,proto3" json:"packfile_uri_protocols,omitempty"`
+}
+
+func (x *SquashCherryPickRequest) Reset() {
+	*x = SquashCherryPickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SquashCherryPickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SquashCherryPickRequest) ProtoMessage() {}
+
+func (x *SquashCherryPickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SquashCherryPickRequest.ProtoReflect.Descriptor instead.
+func (*SquashCherryPickRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SquashCherryPickRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetCherryPickFrom() string {
+	if x != nil {
+		return x.CherryPickFrom
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetCherryPickTo() string {
+	if x != nil {
+		return x.CherryPickTo
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetCherryPickBase() string {
+	if x != nil {
+		return x.CherryPickBase
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetCommitMessage() string {
+	if x != nil {
+		return x.CommitMessage
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetAuthorTime() string {
+	if x != nil {
+		return x.AuthorTime
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetCommitter() string {
+	if x != nil {
+		return x.Committer
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetCommitterEmail() string {
+	if x != nil {
+		return x.CommitterEmail
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetCommitterTime() string {
+	if x != nil {
+		return x.CommitterTime
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetCurrentRefHash() string {
+	if x != nil {
+		return x.CurrentRefHash
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetAdditionalRefUpdate() []string {
+	if x != nil {
+		return x.AdditionalRefUpdate
+	}
+	return nil
+}
+
+func (x *SquashCherryPickRequest) GetAbortOnConflict() bool {
+	if x != nil {
+		return x.AbortOnConflict
+	}
+	return false
+}
+
+func (x *SquashCherryPickRequest) GetIncludeConflictHunks() bool {
+	if x != nil {
+		return x.IncludeConflictHunks
+	}
+	return false
+}
+
+func (x *SquashCherryPickRequest) GetRenameThreshold() int32 {
+	if x != nil {
+		return x.RenameThreshold
+	}
+	return 0
+}
+
+func (x *SquashCherryPickRequest) GetConflictRefNamespace() string {
+	if x != nil {
+		return x.ConflictRefNamespace
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetPathScope() []string {
+	if x != nil {
+		return x.PathScope
+	}
+	return nil
+}
+
+func (x *SquashCherryPickRequest) GetProtectedPaths() []string {
+	if x != nil {
+		return x.ProtectedPaths
+	}
+	return nil
+}
+
+func (x *SquashCherryPickRequest) GetAllowProtectedPathChanges() bool {
+	if x != nil {
+		return x.AllowProtectedPathChanges
+	}
+	return false
+}
+
+func (x *SquashCherryPickRequest) GetMaxChangedFiles() int32 {
+	if x != nil {
+		return x.MaxChangedFiles
+	}
+	return 0
+}
+
+func (x *SquashCherryPickRequest) GetMaxNewBlobBytes() int64 {
+	if x != nil {
+		return x.MaxNewBlobBytes
+	}
+	return 0
+}
+
+func (x *SquashCherryPickRequest) GetSignoff() bool {
+	if x != nil {
+		return x.Signoff
+	}
+	return false
+}
+
+func (x *SquashCherryPickRequest) GetVerifyLfsLocks() bool {
+	if x != nil {
+		return x.VerifyLfsLocks
+	}
+	return false
+}
+
+func (x *SquashCherryPickRequest) GetTrailer() []string {
+	if x != nil {
+		return x.Trailer
+	}
+	return nil
+}
+
+func (x *SquashCherryPickRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *SquashCherryPickRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *SquashCherryPickRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *SquashCherryPickRequest) GetDeltaCompression() bool {
+	if x != nil {
+		return x.DeltaCompression
+	}
+	return false
+}
+
+func (x *SquashCherryPickRequest) GetCompressionLevel() int32 {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return 0
+}
+
+func (x *SquashCherryPickRequest) GetFailOnConflict() bool {
+	if x != nil {
+		return x.FailOnConflict
+	}
+	return false
+}
+
+func (x *SquashCherryPickRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *SquashCherryPickRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *SquashCherryPickRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+func (x *SquashCherryPickRequest) GetPackfileSpoolThreshold() int64 {
+	if x != nil {
+		return x.PackfileSpoolThreshold
+	}
+	return 0
+}
+
+func (x *SquashCherryPickRequest) GetPackfileUriProtocols() []string {
+	if x != nil {
+		return x.PackfileUriProtocols
+	}
+	return nil
+}
+
+// SquashCherryPickResponse is the output of squash-cherry-pick.
+type SquashCherryPickResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitHash            string             `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	CherryPickedFiles     []string           `protobuf:"bytes,2,rep,name=cherry_picked_files,json=cherryPickedFiles,proto3" json:"cherry_picked_files,omitempty"`
+	ConflictOpenFiles     []string           `protobuf:"bytes,3,rep,name=conflict_open_files,json=conflictOpenFiles,proto3" json:"conflict_open_files,omitempty"`
+	ConflictContents      []*ConflictContent `protobuf:"bytes,4,rep,name=conflict_contents,json=conflictContents,proto3" json:"conflict_contents,omitempty"`
+	Renames               []*RenamedFile     `protobuf:"bytes,5,rep,name=renames,proto3" json:"renames,omitempty"`
+	ConflictResolvedFiles []string           `protobuf:"bytes,6,rep,name=conflict_resolved_files,json=conflictResolvedFiles,proto3" json:"conflict_resolved_files,omitempty"`
+	SkippedFiles          []string           `protobuf:"bytes,7,rep,name=skipped_files,json=skippedFiles,proto3" json:"skipped_files,omitempty"`
+	ProtectedFiles        []string           `protobuf:"bytes,8,rep,name=protected_files,json=protectedFiles,proto3" json:"protected_files,omitempty"`
+	ConflictRef           string             `protobuf:"bytes,9,opt,name=conflict_ref,json=conflictRef,proto3" json:"conflict_ref,omitempty"`
+	FetchDebugInfo        *FetchDebugInfo    `protobuf:"bytes,10,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo         *PushDebugInfo     `protobuf:"bytes,11,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error                 string             `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode             string             `protobuf:"bytes,13,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,14,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *SquashCherryPickResponse) Reset() {
+	*x = SquashCherryPickResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SquashCherryPickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SquashCherryPickResponse) ProtoMessage() {}
+
+func (x *SquashCherryPickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SquashCherryPickResponse.ProtoReflect.Descriptor instead.
+func (*SquashCherryPickResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SquashCherryPickResponse) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *SquashCherryPickResponse) GetCherryPickedFiles() []string {
+	if x != nil {
+		return x.CherryPickedFiles
+	}
+	return nil
+}
+
+func (x *SquashCherryPickResponse) GetConflictOpenFiles() []string {
+	if x != nil {
+		return x.ConflictOpenFiles
+	}
+	return nil
+}
+
+func (x *SquashCherryPickResponse) GetConflictContents() []*ConflictContent {
+	if x != nil {
+		return x.ConflictContents
+	}
+	return nil
+}
+
+func (x *SquashCherryPickResponse) GetRenames() []*RenamedFile {
+	if x != nil {
+		return x.Renames
+	}
+	return nil
+}
+
+func (x *SquashCherryPickResponse) GetConflictResolvedFiles() []string {
+	if x != nil {
+		return x.ConflictResolvedFiles
+	}
+	return nil
+}
+
+func (x *SquashCherryPickResponse) GetSkippedFiles() []string {
+	if x != nil {
+		return x.SkippedFiles
+	}
+	return nil
+}
+
+func (x *SquashCherryPickResponse) GetProtectedFiles() []string {
+	if x != nil {
+		return x.ProtectedFiles
+	}
+	return nil
+}
+
+func (x *SquashCherryPickResponse) GetConflictRef() string {
+	if x != nil {
+		return x.ConflictRef
+	}
+	return ""
+}
+
+func (x *SquashCherryPickResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *SquashCherryPickResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *SquashCherryPickResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SquashCherryPickResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *SquashCherryPickResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+// UpdateRefsRequest is the flags of update-refs.
+type UpdateRefsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git reposiotry URL
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// Ref update in the form of REF:NEW_HASH[:OLD_HASH]. OLD_HASH is used for compare-and-swap. Can be specified multiple times
+	RefUpdate []string `protobuf:"bytes,2,rep,name=ref_update,json=refUpdate,proto3" json:"ref_update,omitempty"`
+	// Optional repository URL to copy the new values from if they are missing in the repository
+	SourceRepoUrl string `protobuf:"bytes,3,opt,name=source_repo_url,json=sourceRepoUrl,proto3" json:"source_repo_url,omitempty"`
+	// Check that the refs are at OLD_HASH right before pushing, like git push --force-with-lease, and fail with LEASE_BROKEN if not. All the ref updates need OLD_HASH
+	WithLease bool `protobuf:"varint,4,opt,name=with_lease,json=withLease,proto3" json:"with_lease,omitempty"`
+	// Verbosity of the debug info in the output: none, basic (sizes and statuses), or full (including HTTP headers)
+	DebugLevel *string `protobuf:"bytes,5,opt,name=debug_level,json=debugLevel,proto3,oneof" json:"debug_level,omitempty"`
+	// Optional time limit of the whole operation, including all the fetches, the pushes, and the retries. Zero, which is the default, means no limit
+	Timeout *durationpb.Duration `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional number of the retries of a fetch or a push that fails with a 5xx response, a timeout, or a reset connection, with exponential backoff. Zero, which is the default, disables retrying
+	MaxRetries int32 `protobuf:"varint,7,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Omit the debug info from the output
+	Quiet bool `protobuf:"varint,8,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	// Optional time limit of each push attempt, including getting the advertised refs. A timed-out attempt is retried with --max-retries. Zero, which is the default, means no limit
+	PushTimeout *durationpb.Duration `protobuf:"bytes,9,opt,name=push_timeout,json=pushTimeout,proto3" json:"push_timeout,omitempty"`
+	// Optional time limit of getting the advertised refs (GET /info/refs) before each push attempt. Zero, which is the default, means no limit other than --push-timeout
+	RefAdvTimeout *durationpb.Duration `protobuf:"bytes,10,opt,name=ref_adv_timeout,json=refAdvTimeout,proto3" json:"ref_adv_timeout,omitempty"`
+}
+
+func (x *UpdateRefsRequest) Reset() {
+	*x = UpdateRefsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRefsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRefsRequest) ProtoMessage() {}
+
+func (x *UpdateRefsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRefsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRefsRequest) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateRefsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *UpdateRefsRequest) GetRefUpdate() []string {
+	if x != nil {
+		return x.RefUpdate
+	}
+	return nil
+}
+
+func (x *UpdateRefsRequest) GetSourceRepoUrl() string {
+	if x != nil {
+		return x.SourceRepoUrl
+	}
+	return ""
+}
+
+func (x *UpdateRefsRequest) GetWithLease() bool {
+	if x != nil {
+		return x.WithLease
+	}
+	return false
+}
+
+func (x *UpdateRefsRequest) GetDebugLevel() string {
+	if x != nil && x.DebugLevel != nil {
+		return *x.DebugLevel
+	}
+	return ""
+}
+
+func (x *UpdateRefsRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *UpdateRefsRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *UpdateRefsRequest) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *UpdateRefsRequest) GetPushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PushTimeout
+	}
+	return nil
+}
+
+func (x *UpdateRefsRequest) GetRefAdvTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RefAdvTimeout
+	}
+	return nil
+}
+
+// UpdateRefsResponse is the output of update-refs.
+type UpdateRefsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForwardedObjects []string             `protobuf:"bytes,1,rep,name=forwarded_objects,json=forwardedObjects,proto3" json:"forwarded_objects,omitempty"`
+	MissingObjects   []string             `protobuf:"bytes,2,rep,name=missing_objects,json=missingObjects,proto3" json:"missing_objects,omitempty"`
+	BrokenLeases     []*BrokenLeaseOutput `protobuf:"bytes,3,rep,name=broken_leases,json=brokenLeases,proto3" json:"broken_leases,omitempty"`
+	FetchDebugInfo   *FetchDebugInfo      `protobuf:"bytes,4,opt,name=fetch_debug_info,json=fetchDebugInfo,proto3" json:"fetch_debug_info,omitempty"`
+	PushDebugInfo    *PushDebugInfo       `protobuf:"bytes,5,opt,name=push_debug_info,json=pushDebugInfo,proto3" json:"push_debug_info,omitempty"`
+	Error            string               `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode        string               `protobuf:"bytes,7,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// BudgetDebugInfo is the time spent on the operation. Set if the timeout is set and the
+	// debug level is not none.
+	BudgetDebugInfo *BudgetDebugInfo `protobuf:"bytes,8,opt,name=budget_debug_info,json=budgetDebugInfo,proto3" json:"budget_debug_info,omitempty"`
+}
+
+func (x *UpdateRefsResponse) Reset() {
+	*x = UpdateRefsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRefsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRefsResponse) ProtoMessage() {}
+
+func (x *UpdateRefsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRefsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRefsResponse) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateRefsResponse) GetForwardedObjects() []string {
+	if x != nil {
+		return x.ForwardedObjects
+	}
+	return nil
+}
+
+func (x *UpdateRefsResponse) GetMissingObjects() []string {
+	if x != nil {
+		return x.MissingObjects
+	}
+	return nil
+}
+
+func (x *UpdateRefsResponse) GetBrokenLeases() []*BrokenLeaseOutput {
+	if x != nil {
+		return x.BrokenLeases
+	}
+	return nil
+}
+
+func (x *UpdateRefsResponse) GetFetchDebugInfo() *FetchDebugInfo {
+	if x != nil {
+		return x.FetchDebugInfo
+	}
+	return nil
+}
+
+func (x *UpdateRefsResponse) GetPushDebugInfo() *PushDebugInfo {
+	if x != nil {
+		return x.PushDebugInfo
+	}
+	return nil
+}
+
+func (x *UpdateRefsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *UpdateRefsResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *UpdateRefsResponse) GetBudgetDebugInfo() *BudgetDebugInfo {
+	if x != nil {
+		return x.BudgetDebugInfo
+	}
+	return nil
+}
+
+type PathIgnored struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path       string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Ignored    bool   `protobuf:"varint,2,opt,name=ignored,proto3" json:"ignored,omitempty"`
+	Tracked    bool   `protobuf:"varint,3,opt,name=tracked,proto3" json:"tracked,omitempty"`
+	Source     string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	LineNumber int64  `protobuf:"varint,5,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	Pattern    string `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *PathIgnored) Reset() {
+	*x = PathIgnored{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathIgnored) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathIgnored) ProtoMessage() {}
+
+func (x *PathIgnored) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathIgnored.ProtoReflect.Descriptor instead.
+func (*PathIgnored) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PathIgnored) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PathIgnored) GetIgnored() bool {
+	if x != nil {
+		return x.Ignored
+	}
+	return false
+}
+
+func (x *PathIgnored) GetTracked() bool {
+	if x != nil {
+		return x.Tracked
+	}
+	return false
+}
+
+func (x *PathIgnored) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PathIgnored) GetLineNumber() int64 {
+	if x != nil {
+		return x.LineNumber
+	}
+	return 0
+}
+
+func (x *PathIgnored) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type FetchDebugInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ResponseHeaders is a map of response headers.
+	ResponseHeaders map[string]*structpb.ListValue `protobuf:"bytes,1,rep,name=response_headers,json=responseHeaders,proto3" json:"response_headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// PackfileSize is the size of the packfile in bytes, including the packfiles downloaded
+	// from the packfile URIs.
+	PackfileSize int64 `protobuf:"varint,2,opt,name=packfile_size,json=packfileSize,proto3" json:"packfile_size,omitempty"`
+	// PackfileURIs is the number of the packfiles that the server offloaded to the packfile
+	// URIs.
+	PackfileUris int64 `protobuf:"varint,3,opt,name=packfile_uris,json=packfileURIs,proto3" json:"packfile_uris,omitempty"`
+	// Fallback is the fetch strategy used when the server refused to serve the objects by
+	// their IDs, e.g. "want-ref" on a server that hides refs. Empty if not used.
+	Fallback string `protobuf:"bytes,4,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// ResumedPackfileSize is the size of the packfiles loaded from a previous attempt of a
+	// resumable fetch.
+	ResumedPackfileSize int64 `protobuf:"varint,5,opt,name=resumed_packfile_size,json=resumedPackfileSize,proto3" json:"resumed_packfile_size,omitempty"`
+	// Spooled is true if the packfile exceeded the spool threshold and was kept in a temporary
+	// file instead of memory.
+	Spooled bool `protobuf:"varint,6,opt,name=spooled,proto3" json:"spooled,omitempty"`
+	// CachedObjects is the number of the objects loaded from the object cache instead of being
+	// fetched.
+	CachedObjects int64 `protobuf:"varint,7,opt,name=cached_objects,json=cachedObjects,proto3" json:"cached_objects,omitempty"`
+	// StoredResult is true if the result is the one stored in the ResultStore for an identical
+	// call, and nothing was fetched.
+	StoredResult bool `protobuf:"varint,8,opt,name=stored_result,json=storedResult,proto3" json:"stored_result,omitempty"`
+	// NegotiationRounds is the number of the requests that sent the haves before the packfile
+	// was sent, and CommonHaves is the number of the haves that the server acknowledged.
+	NegotiationRounds int64 `protobuf:"varint,9,opt,name=negotiation_rounds,json=negotiationRounds,proto3" json:"negotiation_rounds,omitempty"`
+	CommonHaves       int64 `protobuf:"varint,10,opt,name=common_haves,json=commonHaves,proto3" json:"common_haves,omitempty"`
+	// ObjectStats is the breakdown of the parsed objects.
+	ObjectStats *ObjectStats `protobuf:"bytes,11,opt,name=object_stats,json=objectStats,proto3" json:"object_stats,omitempty"`
+	// Warnings are the inefficiencies found in the fetch, such as objects fetched more than
+	// once in the same operation.
+	Warnings []string `protobuf:"bytes,12,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Iterations are the rounds of the operations that deepen the history step by step, in
+	// the order of the rounds.
+	Iterations []*FetchIteration `protobuf:"bytes,13,rep,name=iterations,proto3" json:"iterations,omitempty"`
+}
+
+func (x *FetchDebugInfo) Reset() {
+	*x = FetchDebugInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchDebugInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchDebugInfo) ProtoMessage() {}
+
+func (x *FetchDebugInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchDebugInfo.ProtoReflect.Descriptor instead.
+func (*FetchDebugInfo) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FetchDebugInfo) GetResponseHeaders() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+func (x *FetchDebugInfo) GetPackfileSize() int64 {
+	if x != nil {
+		return x.PackfileSize
+	}
+	return 0
+}
+
+func (x *FetchDebugInfo) GetPackfileUris() int64 {
+	if x != nil {
+		return x.PackfileUris
+	}
+	return 0
+}
+
+func (x *FetchDebugInfo) GetFallback() string {
+	if x != nil {
+		return x.Fallback
+	}
+	return ""
+}
+
+func (x *FetchDebugInfo) GetResumedPackfileSize() int64 {
+	if x != nil {
+		return x.ResumedPackfileSize
+	}
+	return 0
+}
+
+func (x *FetchDebugInfo) GetSpooled() bool {
+	if x != nil {
+		return x.Spooled
+	}
+	return false
+}
+
+func (x *FetchDebugInfo) GetCachedObjects() int64 {
+	if x != nil {
+		return x.CachedObjects
+	}
+	return 0
+}
+
+func (x *FetchDebugInfo) GetStoredResult() bool {
+	if x != nil {
+		return x.StoredResult
+	}
+	return false
+}
+
+func (x *FetchDebugInfo) GetNegotiationRounds() int64 {
+	if x != nil {
+		return x.NegotiationRounds
+	}
+	return 0
+}
+
+func (x *FetchDebugInfo) GetCommonHaves() int64 {
+	if x != nil {
+		return x.CommonHaves
+	}
+	return 0
+}
+
+func (x *FetchDebugInfo) GetObjectStats() *ObjectStats {
+	if x != nil {
+		return x.ObjectStats
+	}
+	return nil
+}
+
+func (x *FetchDebugInfo) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *FetchDebugInfo) GetIterations() []*FetchIteration {
+	if x != nil {
+		return x.Iterations
+	}
+	return nil
+}
+
+type CommitInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash         string           `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Author       *CommitSignature `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Committer    *CommitSignature `protobuf:"bytes,3,opt,name=committer,proto3" json:"committer,omitempty"`
+	Message      string           `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	TreeHash     string           `protobuf:"bytes,5,opt,name=tree_hash,json=treeHash,proto3" json:"tree_hash,omitempty"`
+	ParentHashes []string         `protobuf:"bytes,6,rep,name=parent_hashes,json=parentHashes,proto3" json:"parent_hashes,omitempty"`
+	ReplacedBy   string           `protobuf:"bytes,7,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	WebUrl       string           `protobuf:"bytes,8,opt,name=web_url,json=webURL,proto3" json:"web_url,omitempty"`
+}
+
+func (x *CommitInfo) Reset() {
+	*x = CommitInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitInfo) ProtoMessage() {}
+
+func (x *CommitInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitInfo.ProtoReflect.Descriptor instead.
+func (*CommitInfo) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CommitInfo) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *CommitInfo) GetAuthor() *CommitSignature {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *CommitInfo) GetCommitter() *CommitSignature {
+	if x != nil {
+		return x.Committer
+	}
+	return nil
+}
+
+func (x *CommitInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CommitInfo) GetTreeHash() string {
+	if x != nil {
+		return x.TreeHash
+	}
+	return ""
+}
+
+func (x *CommitInfo) GetParentHashes() []string {
+	if x != nil {
+		return x.ParentHashes
+	}
+	return nil
+}
+
+func (x *CommitInfo) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+func (x *CommitInfo) GetWebUrl() string {
+	if x != nil {
+		return x.WebUrl
+	}
+	return ""
+}
+
+type CherryPickedCommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginalCommitHash string   `protobuf:"bytes,1,opt,name=original_commit_hash,json=originalCommitHash,proto3" json:"original_commit_hash,omitempty"`
+	CommitHash         string   `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	CherryPickedFiles  []string `protobuf:"bytes,3,rep,name=cherry_picked_files,json=cherryPickedFiles,proto3" json:"cherry_picked_files,omitempty"`
+	ConflictOpenFiles  []string `protobuf:"bytes,4,rep,name=conflict_open_files,json=conflictOpenFiles,proto3" json:"conflict_open_files,omitempty"`
+}
+
+func (x *CherryPickedCommit) Reset() {
+	*x = CherryPickedCommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CherryPickedCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CherryPickedCommit) ProtoMessage() {}
+
+func (x *CherryPickedCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CherryPickedCommit.ProtoReflect.Descriptor instead.
+func (*CherryPickedCommit) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CherryPickedCommit) GetOriginalCommitHash() string {
+	if x != nil {
+		return x.OriginalCommitHash
+	}
+	return ""
+}
+
+func (x *CherryPickedCommit) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *CherryPickedCommit) GetCherryPickedFiles() []string {
+	if x != nil {
+		return x.CherryPickedFiles
+	}
+	return nil
+}
+
+func (x *CherryPickedCommit) GetConflictOpenFiles() []string {
+	if x != nil {
+		return x.ConflictOpenFiles
+	}
+	return nil
+}
+
+type PushDebugInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PackfileSize is the size of the sent packfile in bytes.
+	PackfileSize int64 `protobuf:"varint,1,opt,name=packfile_size,json=packfileSize,proto3" json:"packfile_size,omitempty"`
+	// RefAdvHeaders is the headers of the HTTP response in calling /info/refs
+	RefAdvResponseHeaders map[string]*structpb.ListValue `protobuf:"bytes,2,rep,name=ref_adv_response_headers,json=refAdvResponseHeaders,proto3" json:"ref_adv_response_headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// PushResponseHeaders is the headers of the HTTP response in calling /git-receive-pack
+	PushResponseHeaders map[string]*structpb.ListValue `protobuf:"bytes,3,rep,name=push_response_headers,json=pushResponseHeaders,proto3" json:"push_response_headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// UnpackStatus is the status sent from the server for unpacking the packfile.
+	UnpackStatus string `protobuf:"bytes,4,opt,name=unpack_status,json=unpackStatus,proto3" json:"unpack_status,omitempty"`
+	// CommandStatuses is the status of each command sent to the server.
+	CommandStatuses []*PushCommandStatus `protobuf:"bytes,5,rep,name=command_statuses,json=commandStatuses,proto3" json:"command_statuses,omitempty"`
+}
+
+func (x *PushDebugInfo) Reset() {
+	*x = PushDebugInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushDebugInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushDebugInfo) ProtoMessage() {}
+
+func (x *PushDebugInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushDebugInfo.ProtoReflect.Descriptor instead.
+func (*PushDebugInfo) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PushDebugInfo) GetPackfileSize() int64 {
+	if x != nil {
+		return x.PackfileSize
+	}
+	return 0
+}
+
+func (x *PushDebugInfo) GetRefAdvResponseHeaders() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.RefAdvResponseHeaders
+	}
+	return nil
+}
+
+func (x *PushDebugInfo) GetPushResponseHeaders() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.PushResponseHeaders
+	}
+	return nil
+}
+
+func (x *PushDebugInfo) GetUnpackStatus() string {
+	if x != nil {
+		return x.UnpackStatus
+	}
+	return ""
+}
+
+func (x *PushDebugInfo) GetCommandStatuses() []*PushCommandStatus {
+	if x != nil {
+		return x.CommandStatuses
+	}
+	return nil
+}
+
+type ScratchRefOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hash      string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Purpose   string                 `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ScratchRefOutput) Reset() {
+	*x = ScratchRefOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScratchRefOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScratchRefOutput) ProtoMessage() {}
+
+func (x *ScratchRefOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScratchRefOutput.ProtoReflect.Descriptor instead.
+func (*ScratchRefOutput) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ScratchRefOutput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScratchRefOutput) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ScratchRefOutput) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *ScratchRefOutput) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Changelog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromHash string            `protobuf:"bytes,1,opt,name=from_hash,json=fromHash,proto3" json:"from_hash,omitempty"`
+	ToHash   string            `protobuf:"bytes,2,opt,name=to_hash,json=toHash,proto3" json:"to_hash,omitempty"`
+	Groups   []*ChangelogGroup `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	Markdown string            `protobuf:"bytes,4,opt,name=markdown,proto3" json:"markdown,omitempty"`
+}
+
+func (x *Changelog) Reset() {
+	*x = Changelog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Changelog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Changelog) ProtoMessage() {}
+
+func (x *Changelog) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Changelog.ProtoReflect.Descriptor instead.
+func (*Changelog) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Changelog) GetFromHash() string {
+	if x != nil {
+		return x.FromHash
+	}
+	return ""
+}
+
+func (x *Changelog) GetToHash() string {
+	if x != nil {
+		return x.ToHash
+	}
+	return ""
+}
+
+func (x *Changelog) GetGroups() []*ChangelogGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Changelog) GetMarkdown() string {
+	if x != nil {
+		return x.Markdown
+	}
+	return ""
+}
+
+type PathAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path       string            `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Attributes map[string]string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PathAttributes) Reset() {
+	*x = PathAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathAttributes) ProtoMessage() {}
+
+func (x *PathAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathAttributes.ProtoReflect.Descriptor instead.
+func (*PathAttributes) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PathAttributes) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PathAttributes) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type BlameLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LineNumber         int64            `protobuf:"varint,1,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	Content            string           `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	CommitHash         string           `protobuf:"bytes,3,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	OriginalLineNumber int64            `protobuf:"varint,4,opt,name=original_line_number,json=originalLineNumber,proto3" json:"original_line_number,omitempty"`
+	Author             *CommitSignature `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Boundary           bool             `protobuf:"varint,6,opt,name=boundary,proto3" json:"boundary,omitempty"`
+}
+
+func (x *BlameLine) Reset() {
+	*x = BlameLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlameLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlameLine) ProtoMessage() {}
+
+func (x *BlameLine) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlameLine.ProtoReflect.Descriptor instead.
+func (*BlameLine) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *BlameLine) GetLineNumber() int64 {
+	if x != nil {
+		return x.LineNumber
+	}
+	return 0
+}
+
+func (x *BlameLine) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *BlameLine) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *BlameLine) GetOriginalLineNumber() int64 {
+	if x != nil {
+		return x.OriginalLineNumber
+	}
+	return 0
+}
+
+func (x *BlameLine) GetAuthor() *CommitSignature {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *BlameLine) GetBoundary() bool {
+	if x != nil {
+		return x.Boundary
+	}
+	return false
+}
+
+type CommitGraphNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash         string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHashes []string               `protobuf:"bytes,2,rep,name=parent_hashes,json=parentHashes,proto3" json:"parent_hashes,omitempty"`
+	Generation   int64                  `protobuf:"varint,3,opt,name=generation,proto3" json:"generation,omitempty"`
+	AuthorTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=author_time,json=authorTime,proto3" json:"author_time,omitempty"`
+	WebUrl       string                 `protobuf:"bytes,5,opt,name=web_url,json=webURL,proto3" json:"web_url,omitempty"`
+}
+
+func (x *CommitGraphNode) Reset() {
+	*x = CommitGraphNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitGraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitGraphNode) ProtoMessage() {}
+
+func (x *CommitGraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitGraphNode.ProtoReflect.Descriptor instead.
+func (*CommitGraphNode) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CommitGraphNode) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *CommitGraphNode) GetParentHashes() []string {
+	if x != nil {
+		return x.ParentHashes
+	}
+	return nil
+}
+
+func (x *CommitGraphNode) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *CommitGraphNode) GetAuthorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AuthorTime
+	}
+	return nil
+}
+
+func (x *CommitGraphNode) GetWebUrl() string {
+	if x != nil {
+		return x.WebUrl
+	}
+	return ""
+}
+
+type SemverTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Hash       string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	CommitHash string `protobuf:"bytes,4,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+}
+
+func (x *SemverTag) Reset() {
+	*x = SemverTag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SemverTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemverTag) ProtoMessage() {}
+
+func (x *SemverTag) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemverTag.ProtoReflect.Descriptor instead.
+func (*SemverTag) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SemverTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SemverTag) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SemverTag) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SemverTag) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+type FileOwners struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path    string        `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Authors []*FileAuthor `protobuf:"bytes,2,rep,name=authors,proto3" json:"authors,omitempty"`
+}
+
+func (x *FileOwners) Reset() {
+	*x = FileOwners{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileOwners) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileOwners) ProtoMessage() {}
+
+func (x *FileOwners) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileOwners.ProtoReflect.Descriptor instead.
+func (*FileOwners) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *FileOwners) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileOwners) GetAuthors() []*FileAuthor {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+type ImpactedService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Files []string `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *ImpactedService) Reset() {
+	*x = ImpactedService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImpactedService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpactedService) ProtoMessage() {}
+
+func (x *ImpactedService) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpactedService.ProtoReflect.Descriptor instead.
+func (*ImpactedService) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ImpactedService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImpactedService) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type LsRefsDebugInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ResponseHeaders is the headers of the HTTP response when fetching the packfile.
+	ResponseHeaders map[string]*structpb.ListValue `protobuf:"bytes,1,rep,name=response_headers,json=responseHeaders,proto3" json:"response_headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Cached is true if the result is from the ls-refs cache.
+	Cached bool `protobuf:"varint,2,opt,name=cached,proto3" json:"cached,omitempty"`
+}
+
+func (x *LsRefsDebugInfo) Reset() {
+	*x = LsRefsDebugInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsRefsDebugInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsRefsDebugInfo) ProtoMessage() {}
+
+func (x *LsRefsDebugInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsRefsDebugInfo.ProtoReflect.Descriptor instead.
+func (*LsRefsDebugInfo) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *LsRefsDebugInfo) GetResponseHeaders() map[string]*structpb.ListValue {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+func (x *LsRefsDebugInfo) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+type RenamedFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldPath    string `protobuf:"bytes,1,opt,name=old_path,json=oldPath,proto3" json:"old_path,omitempty"`
+	NewPath    string `protobuf:"bytes,2,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	Similarity int64  `protobuf:"varint,3,opt,name=similarity,proto3" json:"similarity,omitempty"`
+}
+
+func (x *RenamedFile) Reset() {
+	*x = RenamedFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenamedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenamedFile) ProtoMessage() {}
+
+func (x *RenamedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenamedFile.ProtoReflect.Descriptor instead.
+func (*RenamedFile) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RenamedFile) GetOldPath() string {
+	if x != nil {
+		return x.OldPath
+	}
+	return ""
+}
+
+func (x *RenamedFile) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+func (x *RenamedFile) GetSimilarity() int64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type FileMatches struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string          `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Before    []*PatternMatch `protobuf:"bytes,2,rep,name=before,proto3" json:"before,omitempty"`
+	After     []*PatternMatch `protobuf:"bytes,3,rep,name=after,proto3" json:"after,omitempty"`
+	Truncated bool            `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *FileMatches) Reset() {
+	*x = FileMatches{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileMatches) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileMatches) ProtoMessage() {}
+
+func (x *FileMatches) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileMatches.ProtoReflect.Descriptor instead.
+func (*FileMatches) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *FileMatches) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileMatches) GetBefore() []*PatternMatch {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *FileMatches) GetAfter() []*PatternMatch {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *FileMatches) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type TreeEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Mode   string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Hash   string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	Size   int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	WebUrl string `protobuf:"bytes,6,opt,name=web_url,json=webURL,proto3" json:"web_url,omitempty"`
+}
+
+func (x *TreeEntry) Reset() {
+	*x = TreeEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TreeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeEntry) ProtoMessage() {}
+
+func (x *TreeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeEntry.ProtoReflect.Descriptor instead.
+func (*TreeEntry) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *TreeEntry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TreeEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TreeEntry) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *TreeEntry) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *TreeEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TreeEntry) GetWebUrl() string {
+	if x != nil {
+		return x.WebUrl
+	}
+	return ""
+}
+
+type FileSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *FileSize) Reset() {
+	*x = FileSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSize) ProtoMessage() {}
+
+func (x *FileSize) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSize.ProtoReflect.Descriptor instead.
+func (*FileSize) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *FileSize) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileSize) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type RefInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hash           string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	PeeledHash     string `protobuf:"bytes,3,opt,name=peeled_hash,json=peeledHash,proto3" json:"peeled_hash,omitempty"`
+	SymbolicTarget string `protobuf:"bytes,4,opt,name=symbolic_target,json=symbolicTarget,proto3" json:"symbolic_target,omitempty"`
+}
+
+func (x *RefInfo) Reset() {
+	*x = RefInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefInfo) ProtoMessage() {}
+
+func (x *RefInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefInfo.ProtoReflect.Descriptor instead.
+func (*RefInfo) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RefInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RefInfo) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *RefInfo) GetPeeledHash() string {
+	if x != nil {
+		return x.PeeledHash
+	}
+	return ""
+}
+
+func (x *RefInfo) GetSymbolicTarget() string {
+	if x != nil {
+		return x.SymbolicTarget
+	}
+	return ""
+}
+
+type ConflictContent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path        string          `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	OursLabel   string          `protobuf:"bytes,2,opt,name=ours_label,json=oursLabel,proto3" json:"ours_label,omitempty"`
+	BaseLabel   string          `protobuf:"bytes,3,opt,name=base_label,json=baseLabel,proto3" json:"base_label,omitempty"`
+	TheirsLabel string          `protobuf:"bytes,4,opt,name=theirs_label,json=theirsLabel,proto3" json:"theirs_label,omitempty"`
+	Unmergeable bool            `protobuf:"varint,5,opt,name=unmergeable,proto3" json:"unmergeable,omitempty"`
+	Hunks       []*ConflictHunk `protobuf:"bytes,6,rep,name=hunks,proto3" json:"hunks,omitempty"`
+}
+
+func (x *ConflictContent) Reset() {
+	*x = ConflictContent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConflictContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConflictContent) ProtoMessage() {}
+
+func (x *ConflictContent) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConflictContent.ProtoReflect.Descriptor instead.
+func (*ConflictContent) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ConflictContent) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ConflictContent) GetOursLabel() string {
+	if x != nil {
+		return x.OursLabel
+	}
+	return ""
+}
+
+func (x *ConflictContent) GetBaseLabel() string {
+	if x != nil {
+		return x.BaseLabel
+	}
+	return ""
+}
+
+func (x *ConflictContent) GetTheirsLabel() string {
+	if x != nil {
+		return x.TheirsLabel
+	}
+	return ""
+}
+
+func (x *ConflictContent) GetUnmergeable() bool {
+	if x != nil {
+		return x.Unmergeable
+	}
+	return false
+}
+
+func (x *ConflictContent) GetHunks() []*ConflictHunk {
+	if x != nil {
+		return x.Hunks
+	}
+	return nil
+}
+
+type PathExistence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Exists bool   `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	Type   string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Mode   string `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Hash   string `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *PathExistence) Reset() {
+	*x = PathExistence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathExistence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathExistence) ProtoMessage() {}
+
+func (x *PathExistence) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathExistence.ProtoReflect.Descriptor instead.
+func (*PathExistence) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PathExistence) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PathExistence) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *PathExistence) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PathExistence) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *PathExistence) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type RebasedRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OldHash        string                `protobuf:"bytes,2,opt,name=old_hash,json=oldHash,proto3" json:"old_hash,omitempty"`
+	NewHash        string                `protobuf:"bytes,3,opt,name=new_hash,json=newHash,proto3" json:"new_hash,omitempty"`
+	Base           string                `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
+	Commits        []*CherryPickedCommit `protobuf:"bytes,5,rep,name=commits,proto3" json:"commits,omitempty"`
+	Deferred       bool                  `protobuf:"varint,6,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	ProtectedFiles []string              `protobuf:"bytes,7,rep,name=protected_files,json=protectedFiles,proto3" json:"protected_files,omitempty"`
+}
+
+func (x *RebasedRef) Reset() {
+	*x = RebasedRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebasedRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebasedRef) ProtoMessage() {}
+
+func (x *RebasedRef) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebasedRef.ProtoReflect.Descriptor instead.
+func (*RebasedRef) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RebasedRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RebasedRef) GetOldHash() string {
+	if x != nil {
+		return x.OldHash
+	}
+	return ""
+}
+
+func (x *RebasedRef) GetNewHash() string {
+	if x != nil {
+		return x.NewHash
+	}
+	return ""
+}
+
+func (x *RebasedRef) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *RebasedRef) GetCommits() []*CherryPickedCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *RebasedRef) GetDeferred() bool {
+	if x != nil {
+		return x.Deferred
+	}
+	return false
+}
+
+func (x *RebasedRef) GetProtectedFiles() []string {
+	if x != nil {
+		return x.ProtectedFiles
+	}
+	return nil
+}
+
+type RewordedCommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginalCommitHash string `protobuf:"bytes,1,opt,name=original_commit_hash,json=originalCommitHash,proto3" json:"original_commit_hash,omitempty"`
+	CommitHash         string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	Message            string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RewordedCommit) Reset() {
+	*x = RewordedCommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewordedCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewordedCommit) ProtoMessage() {}
+
+func (x *RewordedCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewordedCommit.ProtoReflect.Descriptor instead.
+func (*RewordedCommit) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RewordedCommit) GetOriginalCommitHash() string {
+	if x != nil {
+		return x.OriginalCommitHash
+	}
+	return ""
+}
+
+func (x *RewordedCommit) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *RewordedCommit) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BrokenLeaseOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Expected string `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual   string `protobuf:"bytes,3,opt,name=actual,proto3" json:"actual,omitempty"`
+}
+
+func (x *BrokenLeaseOutput) Reset() {
+	*x = BrokenLeaseOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrokenLeaseOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrokenLeaseOutput) ProtoMessage() {}
+
+func (x *BrokenLeaseOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrokenLeaseOutput.ProtoReflect.Descriptor instead.
+func (*BrokenLeaseOutput) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *BrokenLeaseOutput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BrokenLeaseOutput) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *BrokenLeaseOutput) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+// BudgetDebugInfo is the time spent on an operation that has a time limit.
+type BudgetDebugInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TimeoutMillis is the time limit of the operation in milliseconds.
+	TimeoutMillis int64 `protobuf:"varint,1,opt,name=timeout_millis,json=timeoutMillis,proto3" json:"timeout_millis,omitempty"`
+	// ElapsedMillis is the time spent on the operation in milliseconds.
+	ElapsedMillis int64 `protobuf:"varint,2,opt,name=elapsed_millis,json=elapsedMillis,proto3" json:"elapsed_millis,omitempty"`
+	// TimedOut is true if the operation was stopped at the time limit.
+	TimedOut bool `protobuf:"varint,3,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+}
+
+func (x *BudgetDebugInfo) Reset() {
+	*x = BudgetDebugInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BudgetDebugInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetDebugInfo) ProtoMessage() {}
+
+func (x *BudgetDebugInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetDebugInfo.ProtoReflect.Descriptor instead.
+func (*BudgetDebugInfo) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *BudgetDebugInfo) GetTimeoutMillis() int64 {
+	if x != nil {
+		return x.TimeoutMillis
+	}
+	return 0
+}
+
+func (x *BudgetDebugInfo) GetElapsedMillis() int64 {
+	if x != nil {
+		return x.ElapsedMillis
+	}
+	return 0
+}
+
+func (x *BudgetDebugInfo) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+// ObjectStats is the number and the total inflated size of the objects of each type.
+type ObjectStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commits     int64 `protobuf:"varint,1,opt,name=commits,proto3" json:"commits,omitempty"`
+	CommitBytes int64 `protobuf:"varint,2,opt,name=commit_bytes,json=commitBytes,proto3" json:"commit_bytes,omitempty"`
+	Trees       int64 `protobuf:"varint,3,opt,name=trees,proto3" json:"trees,omitempty"`
+	TreeBytes   int64 `protobuf:"varint,4,opt,name=tree_bytes,json=treeBytes,proto3" json:"tree_bytes,omitempty"`
+	Blobs       int64 `protobuf:"varint,5,opt,name=blobs,proto3" json:"blobs,omitempty"`
+	BlobBytes   int64 `protobuf:"varint,6,opt,name=blob_bytes,json=blobBytes,proto3" json:"blob_bytes,omitempty"`
+	Tags        int64 `protobuf:"varint,7,opt,name=tags,proto3" json:"tags,omitempty"`
+	TagBytes    int64 `protobuf:"varint,8,opt,name=tag_bytes,json=tagBytes,proto3" json:"tag_bytes,omitempty"`
+	// Duplicates is the number of objects that had been fetched already in the operation.
+	Duplicates int64 `protobuf:"varint,9,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+}
+
+func (x *ObjectStats) Reset() {
+	*x = ObjectStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectStats) ProtoMessage() {}
+
+func (x *ObjectStats) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectStats.ProtoReflect.Descriptor instead.
+func (*ObjectStats) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ObjectStats) GetCommits() int64 {
+	if x != nil {
+		return x.Commits
+	}
+	return 0
+}
+
+func (x *ObjectStats) GetCommitBytes() int64 {
+	if x != nil {
+		return x.CommitBytes
+	}
+	return 0
+}
+
+func (x *ObjectStats) GetTrees() int64 {
+	if x != nil {
+		return x.Trees
+	}
+	return 0
+}
+
+func (x *ObjectStats) GetTreeBytes() int64 {
+	if x != nil {
+		return x.TreeBytes
+	}
+	return 0
+}
+
+func (x *ObjectStats) GetBlobs() int64 {
+	if x != nil {
+		return x.Blobs
+	}
+	return 0
+}
+
+func (x *ObjectStats) GetBlobBytes() int64 {
+	if x != nil {
+		return x.BlobBytes
+	}
+	return 0
+}
+
+func (x *ObjectStats) GetTags() int64 {
+	if x != nil {
+		return x.Tags
+	}
+	return 0
+}
+
+func (x *ObjectStats) GetTagBytes() int64 {
+	if x != nil {
+		return x.TagBytes
+	}
+	return 0
+}
+
+func (x *ObjectStats) GetDuplicates() int64 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+// FetchIteration is a round of a fetch that deepens the history step by step.
+type FetchIteration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Wants is the number of the commits that the round fetched the history from.
+	Wants int64 `protobuf:"varint,1,opt,name=wants,proto3" json:"wants,omitempty"`
+	// Depth is the depth of the history fetched in the round. Zero if the round is limited by
+	// Since.
+	Depth int64 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Since is the time that the history was fetched back to in the round, in RFC 3339. Empty
+	// if the round is limited by Depth.
+	Since string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	// PackfileSize is the size of the packfile of the round in bytes.
+	PackfileSize int64 `protobuf:"varint,4,opt,name=packfile_size,json=packfileSize,proto3" json:"packfile_size,omitempty"`
+	// Commits is the number of the commits fetched in the round.
+	Commits int64 `protobuf:"varint,5,opt,name=commits,proto3" json:"commits,omitempty"`
+	// Duplicates is the number of the objects that had been fetched in the earlier rounds.
+	Duplicates int64 `protobuf:"varint,6,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+}
+
+func (x *FetchIteration) Reset() {
+	*x = FetchIteration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchIteration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchIteration) ProtoMessage() {}
+
+func (x *FetchIteration) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchIteration.ProtoReflect.Descriptor instead.
+func (*FetchIteration) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *FetchIteration) GetWants() int64 {
+	if x != nil {
+		return x.Wants
+	}
+	return 0
+}
+
+func (x *FetchIteration) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *FetchIteration) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *FetchIteration) GetPackfileSize() int64 {
+	if x != nil {
+		return x.PackfileSize
+	}
+	return 0
+}
+
+func (x *FetchIteration) GetCommits() int64 {
+	if x != nil {
+		return x.Commits
+	}
+	return 0
+}
+
+func (x *FetchIteration) GetDuplicates() int64 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+type CommitSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *CommitSignature) Reset() {
+	*x = CommitSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitSignature) ProtoMessage() {}
+
+func (x *CommitSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitSignature.ProtoReflect.Descriptor instead.
+func (*CommitSignature) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CommitSignature) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommitSignature) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CommitSignature) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type PushCommandStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is the name of the reference.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Status is the status of the command.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *PushCommandStatus) Reset() {
+	*x = PushCommandStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushCommandStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushCommandStatus) ProtoMessage() {}
+
+func (x *PushCommandStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushCommandStatus.ProtoReflect.Descriptor instead.
+func (*PushCommandStatus) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PushCommandStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PushCommandStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ChangelogGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Title   string            `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Entries []*ChangelogEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ChangelogGroup) Reset() {
+	*x = ChangelogGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangelogGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangelogGroup) ProtoMessage() {}
+
+func (x *ChangelogGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangelogGroup.ProtoReflect.Descriptor instead.
+func (*ChangelogGroup) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ChangelogGroup) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChangelogGroup) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChangelogGroup) GetEntries() []*ChangelogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type FileAuthor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email   string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Commits int64  `protobuf:"varint,3,opt,name=commits,proto3" json:"commits,omitempty"`
+}
+
+func (x *FileAuthor) Reset() {
+	*x = FileAuthor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileAuthor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileAuthor) ProtoMessage() {}
+
+func (x *FileAuthor) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileAuthor.ProtoReflect.Descriptor instead.
+func (*FileAuthor) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *FileAuthor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileAuthor) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *FileAuthor) GetCommits() int64 {
+	if x != nil {
+		return x.Commits
+	}
+	return 0
+}
+
+type PatternMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line        int64             `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Text        string            `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Groups      []string          `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	NamedGroups map[string]string `protobuf:"bytes,4,rep,name=named_groups,json=namedGroups,proto3" json:"named_groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PatternMatch) Reset() {
+	*x = PatternMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatternMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatternMatch) ProtoMessage() {}
+
+func (x *PatternMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatternMatch.ProtoReflect.Descriptor instead.
+func (*PatternMatch) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PatternMatch) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *PatternMatch) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PatternMatch) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *PatternMatch) GetNamedGroups() map[string]string {
+	if x != nil {
+		return x.NamedGroups
+	}
+	return nil
+}
+
+type ConflictHunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OursStartLine   int64    `protobuf:"varint,1,opt,name=ours_start_line,json=oursStartLine,proto3" json:"ours_start_line,omitempty"`
+	BaseStartLine   int64    `protobuf:"varint,2,opt,name=base_start_line,json=baseStartLine,proto3" json:"base_start_line,omitempty"`
+	TheirsStartLine int64    `protobuf:"varint,3,opt,name=theirs_start_line,json=theirsStartLine,proto3" json:"theirs_start_line,omitempty"`
+	OursLines       []string `protobuf:"bytes,4,rep,name=ours_lines,json=oursLines,proto3" json:"ours_lines,omitempty"`
+	BaseLines       []string `protobuf:"bytes,5,rep,name=base_lines,json=baseLines,proto3" json:"base_lines,omitempty"`
+	TheirsLines     []string `protobuf:"bytes,6,rep,name=theirs_lines,json=theirsLines,proto3" json:"theirs_lines,omitempty"`
+}
+
+func (x *ConflictHunk) Reset() {
+	*x = ConflictHunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConflictHunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConflictHunk) ProtoMessage() {}
+
+func (x *ConflictHunk) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConflictHunk.ProtoReflect.Descriptor instead.
+func (*ConflictHunk) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ConflictHunk) GetOursStartLine() int64 {
+	if x != nil {
+		return x.OursStartLine
+	}
+	return 0
+}
+
+func (x *ConflictHunk) GetBaseStartLine() int64 {
+	if x != nil {
+		return x.BaseStartLine
+	}
+	return 0
+}
+
+func (x *ConflictHunk) GetTheirsStartLine() int64 {
+	if x != nil {
+		return x.TheirsStartLine
+	}
+	return 0
+}
+
+func (x *ConflictHunk) GetOursLines() []string {
+	if x != nil {
+		return x.OursLines
+	}
+	return nil
+}
+
+func (x *ConflictHunk) GetBaseLines() []string {
+	if x != nil {
+		return x.BaseLines
+	}
+	return nil
+}
+
+func (x *ConflictHunk) GetTheirsLines() []string {
+	if x != nil {
+		return x.TheirsLines
+	}
+	return nil
+}
+
+type ChangelogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash       string           `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ShortHash  string           `protobuf:"bytes,2,opt,name=short_hash,json=shortHash,proto3" json:"short_hash,omitempty"`
+	Type       string           `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Scope      string           `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	Subject    string           `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	Breaking   bool             `protobuf:"varint,6,opt,name=breaking,proto3" json:"breaking,omitempty"`
+	References []string         `protobuf:"bytes,7,rep,name=references,proto3" json:"references,omitempty"`
+	Author     *CommitSignature `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
+}
+
+func (x *ChangelogEntry) Reset() {
+	*x = ChangelogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nichegit_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangelogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangelogEntry) ProtoMessage() {}
+
+func (x *ChangelogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_nichegit_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangelogEntry.ProtoReflect.Descriptor instead.
+func (*ChangelogEntry) Descriptor() ([]byte, []int) {
+	return file_nichegit_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ChangelogEntry) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ChangelogEntry) GetShortHash() string {
+	if x != nil {
+		return x.ShortHash
+	}
+	return ""
+}
+
+func (x *ChangelogEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChangelogEntry) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ChangelogEntry) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ChangelogEntry) GetBreaking() bool {
+	if x != nil {
+		return x.Breaking
+	}
+	return false
+}
+
+func (x *ChangelogEntry) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *ChangelogEntry) GetAuthor() *CommitSignature {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+var File_nichegit_proto protoreflect.FileDescriptor
+
+var file_nichegit_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0b, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x02, 0x0a,
+	0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73,
+	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70,
+	0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16,
+	0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x22, 0x81, 0x02, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x69, 0x63,
+	0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x49, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x64, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xe7, 0x02, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65,
+	0x66, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f,
+	0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b,
+	0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x22, 0xeb, 0x02, 0x0a, 0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x8f,
+	0x03, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72,
+	0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x72, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x48, 0x65, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x42, 0x61, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54,
+	0x72, 0x75, 0x6e, 0x6b, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f,
+	0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b,
+	0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x22, 0xe1, 0x02, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x72, 0x75, 0x6e, 0x6b, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x72, 0x75, 0x6e, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0xfc, 0x08, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x50,
+	0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65,
+	0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x5f,
+	0x70, 0x69, 0x63, 0x6b, 0x5f, 0x6f, 0x6e, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x4f, 0x6e, 0x74, 0x6f, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x28, 0x0a, 0x10, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x52, 0x65, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x62, 0x6f,
+	0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e,
+	0x6f, 0x66, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x6f,
+	0x66, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x61,
+	0x69, 0x6c, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x69, 0x65, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x71, 0x75, 0x69,
+	0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x64, 0x76, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x41, 0x64, 0x76, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53,
+	0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a,
+	0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x22, 0xc4, 0x03, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x39, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x72, 0x72,
+	0x79, 0x50, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x77,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x45, 0x0a, 0x10, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63,
+	0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x66, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75,
+	0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69,
+	0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xd7, 0x03, 0x0a, 0x19, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x53, 0x63, 0x72, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x66,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f,
+	0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x71, 0x75, 0x69, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x64, 0x76, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x41, 0x64, 0x76, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x22, 0xa1, 0x02, 0x0a, 0x1a, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x53, 0x63, 0x72, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x72,
+	0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x72, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x66, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x66, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73,
+	0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48,
+	0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x98, 0x04, 0x0a, 0x12, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x13, 0x0a, 0x05, 0x72, 0x65,
+	0x66, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x66, 0x41, 0x12,
+	0x13, 0x0a, 0x05, 0x72, 0x65, 0x66, 0x5f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x65, 0x66, 0x42, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x66, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63,
+	0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66,
+	0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x11, 0x77, 0x65, 0x62, 0x5f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6f,
+	0x55, 0x72, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x22, 0xaa, 0x04, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x72,
+	0x65, 0x66, 0x5f, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x66, 0x41, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x0a, 0x72, 0x65, 0x66,
+	0x5f, 0x62, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x66, 0x42, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x68, 0x65, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x65,
+	0x68, 0x69, 0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x61, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x69,
+	0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x68, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x69, 0x63,
+	0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x62, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x57,
+	0x65, 0x62, 0x55, 0x52, 0x4c, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x8a, 0x07, 0x0a, 0x12, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55,
+	0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x72, 0x65, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x6f,
+	0x66, 0x66, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x66,
+	0x66, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0c,
+	0x70, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70,
+	0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65,
+	0x66, 0x5f, 0x61, 0x64, 0x76, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x72, 0x65, 0x66, 0x41, 0x64, 0x76, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x38, 0x0a,
+	0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xff, 0x02,
+	0x0a, 0x13, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0f,
+	0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0xe3, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x52,
+	0x65, 0x66, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x52, 0x65, 0x66, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x8c, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x84, 0x03, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74,
+	0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55,
+	0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x85, 0x02, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0xb8, 0x03, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55,
+	0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x6d, 0x61, 0x69, 0x6c, 0x6d, 0x61, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4d, 0x61, 0x69, 0x6c, 0x6d,
+	0x61, 0x70, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38,
+	0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69,
+	0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xfb,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x9b, 0x04, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72,
+	0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x61, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x77,
+	0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x68, 0x61, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x68, 0x61, 0x76,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69,
+	0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x62, 0x4c, 0x69,
+	0x6e, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x29, 0x0a, 0x11, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x65, 0x62, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x8b, 0x02, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x3a, 0x0a,
+	0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48,
+	0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xf1, 0x05, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x61, 0x6e,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x77, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x61, 0x76, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x68, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x66, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6e, 0x65, 0x77, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6f,
+	0x6e, 0x6c, 0x79, 0x4e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x6d, 0x61, 0x69, 0x6c, 0x6d, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x61, 0x69, 0x6c,
+	0x6d, 0x61, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x61,
+	0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x74, 0x6f, 0x70, 0x41, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b,
+	0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75,
+	0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x5f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x62,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x29, 0x0a, 0x11, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x65,
+	0x62, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x82, 0x02, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63,
+	0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0xc6, 0x03, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c,
+	0x12, 0x22, 0x0a, 0x0a, 0x74, 0x61, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x61, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x68, 0x65, 0x61,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x18, 0x70,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55,
+	0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x74, 0x61, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xba, 0x02, 0x0a, 0x20, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65,
+	0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x76, 0x65,
+	0x72, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x69, 0x63,
+	0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0a,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a,
+	0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xd7, 0x04, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63,
+	0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66,
+	0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x11, 0x77, 0x65, 0x62, 0x5f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6f,
+	0x55, 0x72, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x22, 0xa7, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xdc, 0x03, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12,
+	0x28, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x65, 0x61,
+	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70,
+	0x6c, 0x79, 0x5f, 0x6d, 0x61, 0x69, 0x6c, 0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4d, 0x61, 0x69, 0x6c, 0x6d, 0x61, 0x70, 0x12, 0x24,
+	0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72,
+	0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x81, 0x02, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xf9,
+	0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x31, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x32, 0x12, 0x24,
+	0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72,
+	0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xbb, 0x02, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x75,
+	0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3a, 0x0a,
+	0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48,
+	0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa6, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72,
+	0x6c, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x61, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x61, 0x67, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x65, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x61, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x22, 0x82, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53,
+	0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x76,
+	0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x73, 0x52,
+	0x65, 0x66, 0x73, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xf0, 0x04, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x31, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x31,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x32,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61,
+	0x73, 0x68, 0x32, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e,
+	0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77,
+	0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x29,
+	0x0a, 0x11, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x65, 0x62, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x9b, 0x03, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07,
+	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f,
+	0x77, 0x65, 0x62, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x57, 0x65, 0x62, 0x55, 0x52, 0x4c, 0x12, 0x3a, 0x0a, 0x0a,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a,
+	0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xd5, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x72, 0x65, 0x74, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x72, 0x65,
+	0x74, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63,
+	0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66,
+	0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22,
+	0xcb, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x73, 0x65,
+	0x36, 0x34, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x85, 0x04,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b, 0x69, 0x70,
+	0x53, 0x69, 0x7a, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f,
+	0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63,
+	0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x65, 0x62, 0x4c, 0x69,
+	0x6e, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x11, 0x77, 0x65,
+	0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
+	0x70, 0x6f, 0x55, 0x72, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xfe, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x69, 0x63,
+	0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x89, 0x03, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x61,
+	0x72, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f,
+	0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b,
+	0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x22, 0xc8, 0x03, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x6c,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x73,
+	0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x52, 0x0c, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xc2, 0x02,
+	0x0a, 0x11, 0x48, 0x61, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73,
+	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70,
+	0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16,
+	0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x22, 0x85, 0x02, 0x0a, 0x12, 0x48, 0x61, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12,
+	0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69,
+	0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xd9, 0x01, 0x0a, 0x0d, 0x4c,
+	0x73, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x5f, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x66, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x4c, 0x73, 0x52, 0x65, 0x66,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x65, 0x66,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x72,
+	0x65, 0x66, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x73, 0x52, 0x65, 0x66, 0x73, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0xa4, 0x0b, 0x0a, 0x14, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f,
+	0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x69, 0x6e, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x11, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x72, 0x73,
+	0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x65, 0x66, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x28,
+	0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x48, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x36,
+	0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x75, 0x62, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x15, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x53, 0x74, 0x75, 0x62, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x15, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x6f, 0x66, 0x66, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x69,
+	0x67, 0x6e, 0x6f, 0x66, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x3f,
+	0x0a, 0x1c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x71, 0x75, 0x69, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x64, 0x76, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x41, 0x64, 0x76, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69,
+	0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x23, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x93, 0x06, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x0a, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x70, 0x54, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x53, 0x74, 0x75, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x53, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x11, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x07, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x75, 0x73,
+	0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d,
+	0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xe1, 0x02, 0x0a,
+	0x11, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f,
+	0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b,
+	0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x22, 0x81, 0x02, 0x0a, 0x12, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0xbd, 0x05, 0x0a, 0x18, 0x50, 0x75, 0x73, 0x68, 0x4e, 0x65, 0x78,
+	0x74, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x22, 0x0a, 0x0a,
+	0x74, 0x61, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x09, 0x74, 0x61, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01,
+	0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x17, 0x0a,
+	0x04, 0x62, 0x75, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x62,
+	0x75, 0x6d, 0x70, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x67, 0x67, 0x65,
+	0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x61, 0x67, 0x67, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61,
+	0x67, 0x67, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x64, 0x76,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x41, 0x64,
+	0x76, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x61, 0x67,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x62, 0x75, 0x6d, 0x70,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8a, 0x03, 0x0a, 0x19, 0x50, 0x75, 0x73, 0x68, 0x4e, 0x65, 0x78,
+	0x74, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x74,
+	0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67,
+	0x52, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x54, 0x61, 0x67, 0x12, 0x28, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x69, 0x63,
+	0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54,
+	0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x45, 0x0a, 0x10, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e,
+	0x66, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42,
+	0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0xd3, 0x08, 0x0a, 0x11, 0x52, 0x65, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55,
+	0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x6e, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6f, 0x6e, 0x74, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x62, 0x6f, 0x72,
+	0x74, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x12, 0x28, 0x0a, 0x0d, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30,
+	0x0a, 0x14, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x75, 0x6e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x70, 0x75,
+	0x73, 0x68, 0x55, 0x6e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x66, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69,
+	0x67, 0x6e, 0x6f, 0x66, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x6f, 0x66, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x3f, 0x0a,
+	0x1c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x71,
+	0x75, 0x69, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x64, 0x76, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x41, 0x64, 0x76, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xec, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x64, 0x52, 0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x65, 0x77, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6e, 0x65, 0x77, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x10,
+	0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x66, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xc9, 0x09, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x32, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x66, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x13, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x5f, 0x72, 0x65, 0x66, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x66, 0x66, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x66, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x13,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x69, 0x65, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x71, 0x75, 0x69, 0x65,
+	0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x64, 0x76, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x41, 0x64, 0x76, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73,
+	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70,
+	0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16,
+	0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x22, 0xc0, 0x03, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0e, 0x66, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69,
+	0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xd1, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xe5, 0x02, 0x0a, 0x13, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x66, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x66, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e,
+	0x66, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42,
+	0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x93, 0x0a, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x74,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x4f,
+	0x6e, 0x74, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72,
+	0x65, 0x66, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x15,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x62, 0x6f,
+	0x72, 0x74, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x34, 0x0a, 0x16,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x5f, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x48, 0x75, 0x6e,
+	0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x69, 0x67, 0x6e, 0x6f, 0x66, 0x66, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x6f, 0x66, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12,
+	0x3f, 0x0a, 0x1c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x64, 0x76, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x41, 0x64, 0x76,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66,
+	0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72,
+	0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x86, 0x04, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x49, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a,
+	0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0xb5, 0x0a, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55,
+	0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x5f, 0x6f, 0x6e, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x4f, 0x6e, 0x74, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x32, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x66, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x13, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x5f, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x48, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x66, 0x66, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x66, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x14, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a,
+	0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x61,
+	0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75,
+	0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x73,
+	0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f,
+	0x61, 0x64, 0x76, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65,
+	0x66, 0x41, 0x64, 0x76, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x70,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18,
+	0x20, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55,
+	0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x6d, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x8b, 0x04, 0x0a, 0x13, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4f,
+	0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x10,
+	0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x66, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa4, 0x08, 0x0a, 0x14, 0x52, 0x65, 0x77, 0x6f, 0x72,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x19, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x66, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x66, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74,
+	0x68, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x69, 0x65, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x71, 0x75, 0x69,
+	0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x64, 0x76, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x41, 0x64, 0x76, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x73, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53,
+	0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a,
+	0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xa2, 0x03,
+	0x0a, 0x15, 0x52, 0x65, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x6f, 0x72, 0x64, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0e, 0x66, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x42, 0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0xe0, 0x02, 0x0a, 0x13, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65,
+	0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33,
+	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x64, 0x76, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x41, 0x64, 0x76, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xef, 0x02, 0x0a, 0x14, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x12, 0x3f, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x72, 0x65,
+	0x66, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x73, 0x52, 0x65, 0x66, 0x73, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x37,
+	0x0a, 0x09, 0x52, 0x65, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc5, 0x0c, 0x0a, 0x17, 0x53, 0x71, 0x75, 0x61,
+	0x73, 0x68, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x28,
+	0x0a, 0x10, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x5f, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79,
+	0x50, 0x69, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x68, 0x65, 0x72,
+	0x72, 0x79, 0x5f, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x54, 0x6f, 0x12, 0x28,
+	0x0a, 0x10, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x5f, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79,
+	0x50, 0x69, 0x63, 0x6b, 0x42, 0x61, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x66, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x13, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x48, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x66, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68,
+	0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x74, 0x68, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73,
+	0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61,
+	0x78, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a,
+	0x12, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4e, 0x65,
+	0x77, 0x42, 0x6c, 0x6f, 0x62, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69,
+	0x67, 0x6e, 0x6f, 0x66, 0x66, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x6f, 0x66, 0x66, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x6c,
+	0x66, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x66, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33,
+	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x20, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x28,
+	0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x69, 0x65,
+	0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x12, 0x3c,
+	0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x23,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x70, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x0f,
+	0x72, 0x65, 0x66, 0x5f, 0x61, 0x64, 0x76, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x72, 0x65, 0x66, 0x41, 0x64, 0x76, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x6f, 0x6f, 0x6c,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63,
+	0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x73, 0x18, 0x26, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x66,
+	0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22,
+	0xcd, 0x05, 0x0a, 0x18, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79,
+	0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2e, 0x0a,
+	0x13, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x5f, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x65, 0x72,
+	0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x49, 0x0a,
+	0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x52, 0x65, 0x66, 0x12, 0x45, 0x0a, 0x10, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0f,
+	0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0xb7, 0x03, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x5f,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74,
+	0x68, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x64,
+	0x76, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x41,
+	0x64, 0x76, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xb9, 0x03, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0d, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0c, 0x62,
+	0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0e, 0x66, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69,
+	0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa8, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x68, 0x49, 0x67,
+	0x6e, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x22, 0xb5, 0x05, 0x0a, 0x0e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x5b, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x52, 0x49, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70,
+	0x6f, 0x6f, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x70, 0x6f,
+	0x6f, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6e, 0x65,
+	0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x76, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x48, 0x61, 0x76,
+	0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x69,
+	0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x5e, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa8, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x34, 0x0a, 0x06, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69,
+	0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x65, 0x65, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x65, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x65,
+	0x62, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x62,
+	0x55, 0x52, 0x4c, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69,
+	0x63, 0x6b, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2e, 0x0a,
+	0x13, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x5f, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x65, 0x72,
+	0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xc7, 0x04,
+	0x0a, 0x0d, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x6e, 0x0a, 0x18, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x64, 0x76, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x41, 0x64, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x72,
+	0x65, 0x66, 0x41, 0x64, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x67, 0x0a, 0x15, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x70, 0x75, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x75, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x1a, 0x64, 0x0a,
+	0x1a, 0x52, 0x65, 0x66, 0x41, 0x64, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x62, 0x0a, 0x18, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x10, 0x53, 0x63, 0x72, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x66, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x09, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x12, 0x33, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x6c, 0x6f, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0xb0,
+	0x01, 0x0a, 0x0e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x4b, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xeb, 0x01, 0x0a, 0x09, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34, 0x0a,
+	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x22,
+	0xc0, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x65, 0x62,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x62, 0x55,
+	0x52, 0x4c, 0x22, 0x6e, 0x0a, 0x09, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x53, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x07,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x0f, 0x4c, 0x73, 0x52, 0x65, 0x66, 0x73, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x5c, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x73, 0x52, 0x65, 0x66, 0x73, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x1a, 0x5e,
+	0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x63,
+	0x0a, 0x0b, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x22, 0xa3, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x09, 0x54, 0x72,
+	0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x77,
+	0x65, 0x62, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65,
+	0x62, 0x55, 0x52, 0x4c, 0x22, 0x32, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x7b, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x65, 0x65, 0x6c, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x65, 0x65, 0x6c, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6f, 0x75, 0x72, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6f, 0x75, 0x72, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x68, 0x65, 0x69, 0x72, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x68, 0x65, 0x69, 0x72, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20,
+	0x0a, 0x0b, 0x75, 0x6e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x2f, 0x0a, 0x05, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x48, 0x75, 0x6e, 0x6b, 0x52, 0x05, 0x68, 0x75, 0x6e, 0x6b,
+	0x73, 0x22, 0x77, 0x0a, 0x0d, 0x50, 0x61, 0x74, 0x68, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xea, 0x01, 0x0a, 0x0a, 0x52,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x64, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x6c, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69, 0x63,
+	0x6b, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x0e, 0x52, 0x65, 0x77, 0x6f, 0x72,
+	0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x11, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x22, 0x7c, 0x0a, 0x0f, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x22, 0x85, 0x02, 0x0a, 0x0b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x72, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x72, 0x65, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x72, 0x65, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
+	0x62, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62,
+	0x6c, 0x6f, 0x62, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x61, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x74, 0x61, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x0e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x77, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x75, 0x0a,
+	0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x3f, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x71, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c,
+	0x6f, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x0c, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x6e,
+	0x61, 0x6d, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4e, 0x61, 0x6d,
+	0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x6e,
+	0x61, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x4e, 0x61,
+	0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xeb, 0x01, 0x0a, 0x0c, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x48, 0x75, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x6f,
+	0x75, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c,
+	0x69, 0x6e, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x61,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74,
+	0x68, 0x65, 0x69, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x68, 0x65, 0x69, 0x72, 0x73, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x72, 0x73, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x75, 0x72,
+	0x73, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65,
+	0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x69, 0x72, 0x73, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x68, 0x65,
+	0x69, 0x72, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x34,
+	0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x32, 0xfa, 0x17, 0x0a, 0x08, 0x4e, 0x69, 0x63, 0x68, 0x65, 0x47, 0x69,
+	0x74, 0x12, 0x53, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x64, 0x12, 0x20, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c,
+	0x69, 0x6e, 0x65, 0x61, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x20, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b,
+	0x12, 0x1e, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x65, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x53, 0x63, 0x72, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x66, 0x73, 0x12, 0x26, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x53, 0x63, 0x72,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x65, 0x61, 0x6e, 0x75, 0x70, 0x53, 0x63, 0x72, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x66, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x52, 0x65, 0x66, 0x73, 0x12, 0x1f, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x66,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x69, 0x63,
+	0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f,
+	0x67, 0x12, 0x25, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x56, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x21, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42,
+	0x6c, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x12, 0x22, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6e, 0x69, 0x63,
+	0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x69, 0x63,
+	0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65,
+	0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x2c, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x53, 0x69,
+	0x6e, 0x63, 0x65, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x69, 0x63,
+	0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x21, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x27,
+	0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x65, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65,
+	0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x26, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53,
+	0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65,
+	0x12, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x6e, 0x69,
+	0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x0a, 0x48, 0x61, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1e,
+	0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x73,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x73,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x06, 0x4c, 0x73, 0x52, 0x65, 0x66, 0x73, 0x12, 0x1a, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x73, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x73, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x50, 0x75, 0x73,
+	0x68, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x25,
+	0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73,
+	0x68, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x65, 0x6d, 0x76,
+	0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
+	0x0a, 0x52, 0x65, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x73, 0x12, 0x1e, 0x2e, 0x6e, 0x69,
+	0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x69,
+	0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x12, 0x24, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x66, 0x73, 0x12, 0x1f, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x6e, 0x69, 0x63, 0x68,
+	0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x12, 0x1f, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x52, 0x65, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x20, 0x2e, 0x6e,
+	0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5f, 0x0a, 0x10, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x68, 0x65, 0x72, 0x72,
+	0x79, 0x50, 0x69, 0x63, 0x6b, 0x12, 0x24, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79,
+	0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x69,
+	0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68,
+	0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x73,
+	0x12, 0x1e, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x2d, 0x63, 0x6f, 0x2f, 0x6e, 0x69, 0x63, 0x68, 0x65,
+	0x2d, 0x67, 0x69, 0x74, 0x2f, 0x6e, 0x69, 0x63, 0x68, 0x65, 0x67, 0x69, 0x74, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_nichegit_proto_rawDescOnce sync.Once
+	file_nichegit_proto_rawDescData = file_nichegit_proto_rawDesc
+)
+
+func file_nichegit_proto_rawDescGZIP() []byte {
+	file_nichegit_proto_rawDescOnce.Do(func() {
+		file_nichegit_proto_rawDescData = protoimpl.X.CompressGZIP(file_nichegit_proto_rawDescData)
+	})
+	return file_nichegit_proto_rawDescData
+}
+
+var file_nichegit_proto_msgTypes = make([]protoimpl.MessageInfo, 111)
+var file_nichegit_proto_goTypes = []interface{}{
+	(*CheckIgnoredRequest)(nil),              // 0: nichegit.v1.CheckIgnoredRequest
+	(*CheckIgnoredResponse)(nil),             // 1: nichegit.v1.CheckIgnoredResponse
+	(*CheckLinearHistoryRequest)(nil),        // 2: nichegit.v1.CheckLinearHistoryRequest
+	(*CheckLinearHistoryResponse)(nil),       // 3: nichegit.v1.CheckLinearHistoryResponse
+	(*CheckRemergeRequest)(nil),              // 4: nichegit.v1.CheckRemergeRequest
+	(*CheckRemergeResponse)(nil),             // 5: nichegit.v1.CheckRemergeResponse
+	(*CherryPickRequest)(nil),                // 6: nichegit.v1.CherryPickRequest
+	(*CherryPickResponse)(nil),               // 7: nichegit.v1.CherryPickResponse
+	(*CleanupScratchRefsRequest)(nil),        // 8: nichegit.v1.CleanupScratchRefsRequest
+	(*CleanupScratchRefsResponse)(nil),       // 9: nichegit.v1.CleanupScratchRefsResponse
+	(*CompareRefsRequest)(nil),               // 10: nichegit.v1.CompareRefsRequest
+	(*CompareRefsResponse)(nil),              // 11: nichegit.v1.CompareRefsResponse
+	(*EmptyCommitRequest)(nil),               // 12: nichegit.v1.EmptyCommitRequest
+	(*EmptyCommitResponse)(nil),              // 13: nichegit.v1.EmptyCommitResponse
+	(*GenerateChangelogRequest)(nil),         // 14: nichegit.v1.GenerateChangelogRequest
+	(*GenerateChangelogResponse)(nil),        // 15: nichegit.v1.GenerateChangelogResponse
+	(*GetAttributesRequest)(nil),             // 16: nichegit.v1.GetAttributesRequest
+	(*GetAttributesResponse)(nil),            // 17: nichegit.v1.GetAttributesResponse
+	(*GetBlameRequest)(nil),                  // 18: nichegit.v1.GetBlameRequest
+	(*GetBlameResponse)(nil),                 // 19: nichegit.v1.GetBlameResponse
+	(*GetCommitGraphRequest)(nil),            // 20: nichegit.v1.GetCommitGraphRequest
+	(*GetCommitGraphResponse)(nil),           // 21: nichegit.v1.GetCommitGraphResponse
+	(*GetCommitsRequest)(nil),                // 22: nichegit.v1.GetCommitsRequest
+	(*GetCommitsResponse)(nil),               // 23: nichegit.v1.GetCommitsResponse
+	(*GetCommitsSinceSemverTagRequest)(nil),  // 24: nichegit.v1.GetCommitsSinceSemverTagRequest
+	(*GetCommitsSinceSemverTagResponse)(nil), // 25: nichegit.v1.GetCommitsSinceSemverTagResponse
+	(*GetFileHistoryRequest)(nil),            // 26: nichegit.v1.GetFileHistoryRequest
+	(*GetFileHistoryResponse)(nil),           // 27: nichegit.v1.GetFileHistoryResponse
+	(*GetFileOwnersRequest)(nil),             // 28: nichegit.v1.GetFileOwnersRequest
+	(*GetFileOwnersResponse)(nil),            // 29: nichegit.v1.GetFileOwnersResponse
+	(*GetImpactedServicesRequest)(nil),       // 30: nichegit.v1.GetImpactedServicesRequest
+	(*GetImpactedServicesResponse)(nil),      // 31: nichegit.v1.GetImpactedServicesResponse
+	(*GetLatestSemverTagRequest)(nil),        // 32: nichegit.v1.GetLatestSemverTagRequest
+	(*GetLatestSemverTagResponse)(nil),       // 33: nichegit.v1.GetLatestSemverTagResponse
+	(*GetModifiedFilesRequest)(nil),          // 34: nichegit.v1.GetModifiedFilesRequest
+	(*GetModifiedFilesResponse)(nil),         // 35: nichegit.v1.GetModifiedFilesResponse
+	(*GetObjectRequest)(nil),                 // 36: nichegit.v1.GetObjectRequest
+	(*GetObjectResponse)(nil),                // 37: nichegit.v1.GetObjectResponse
+	(*GetTreeRequest)(nil),                   // 38: nichegit.v1.GetTreeRequest
+	(*GetTreeResponse)(nil),                  // 39: nichegit.v1.GetTreeResponse
+	(*GetTreeStatsRequest)(nil),              // 40: nichegit.v1.GetTreeStatsRequest
+	(*GetTreeStatsResponse)(nil),             // 41: nichegit.v1.GetTreeStatsResponse
+	(*HasObjectsRequest)(nil),                // 42: nichegit.v1.HasObjectsRequest
+	(*HasObjectsResponse)(nil),               // 43: nichegit.v1.HasObjectsResponse
+	(*LsRefsRequest)(nil),                    // 44: nichegit.v1.LsRefsRequest
+	(*LsRefsResponse)(nil),                   // 45: nichegit.v1.LsRefsResponse
+	(*MergeBranchesRequest)(nil),             // 46: nichegit.v1.MergeBranchesRequest
+	(*MergeBranchesResponse)(nil),            // 47: nichegit.v1.MergeBranchesResponse
+	(*PathsExistRequest)(nil),                // 48: nichegit.v1.PathsExistRequest
+	(*PathsExistResponse)(nil),               // 49: nichegit.v1.PathsExistResponse
+	(*PushNextSemverTagRequest)(nil),         // 50: nichegit.v1.PushNextSemverTagRequest
+	(*PushNextSemverTagResponse)(nil),        // 51: nichegit.v1.PushNextSemverTagResponse
+	(*RebaseRefsRequest)(nil),                // 52: nichegit.v1.RebaseRefsRequest
+	(*RebaseRefsResponse)(nil),               // 53: nichegit.v1.RebaseRefsResponse
+	(*ResolveConflictsRequest)(nil),          // 54: nichegit.v1.ResolveConflictsRequest
+	(*ResolveConflictsResponse)(nil),         // 55: nichegit.v1.ResolveConflictsResponse
+	(*RestoreRefsRequest)(nil),               // 56: nichegit.v1.RestoreRefsRequest
+	(*RestoreRefsResponse)(nil),              // 57: nichegit.v1.RestoreRefsResponse
+	(*RevertRequest)(nil),                    // 58: nichegit.v1.RevertRequest
+	(*RevertResponse)(nil),                   // 59: nichegit.v1.RevertResponse
+	(*RevertMergeRequest)(nil),               // 60: nichegit.v1.RevertMergeRequest
+	(*RevertMergeResponse)(nil),              // 61: nichegit.v1.RevertMergeResponse
+	(*RewordCommitsRequest)(nil),             // 62: nichegit.v1.RewordCommitsRequest
+	(*RewordCommitsResponse)(nil),            // 63: nichegit.v1.RewordCommitsResponse
+	(*SnapshotRefsRequest)(nil),              // 64: nichegit.v1.SnapshotRefsRequest
+	(*SnapshotRefsResponse)(nil),             // 65: nichegit.v1.SnapshotRefsResponse
+	(*SquashCherryPickRequest)(nil),          // 66: nichegit.v1.SquashCherryPickRequest
+	(*SquashCherryPickResponse)(nil),         // 67: nichegit.v1.SquashCherryPickResponse
+	(*UpdateRefsRequest)(nil),                // 68: nichegit.v1.UpdateRefsRequest
+	(*UpdateRefsResponse)(nil),               // 69: nichegit.v1.UpdateRefsResponse
+	(*PathIgnored)(nil),                      // 70: nichegit.v1.PathIgnored
+	(*FetchDebugInfo)(nil),                   // 71: nichegit.v1.FetchDebugInfo
+	(*CommitInfo)(nil),                       // 72: nichegit.v1.CommitInfo
+	(*CherryPickedCommit)(nil),               // 73: nichegit.v1.CherryPickedCommit
+	(*PushDebugInfo)(nil),                    // 74: nichegit.v1.PushDebugInfo
+	(*ScratchRefOutput)(nil),                 // 75: nichegit.v1.ScratchRefOutput
+	(*Changelog)(nil),                        // 76: nichegit.v1.Changelog
+	(*PathAttributes)(nil),                   // 77: nichegit.v1.PathAttributes
+	(*BlameLine)(nil),                        // 78: nichegit.v1.BlameLine
+	(*CommitGraphNode)(nil),                  // 79: nichegit.v1.CommitGraphNode
+	(*SemverTag)(nil),                        // 80: nichegit.v1.SemverTag
+	(*FileOwners)(nil),                       // 81: nichegit.v1.FileOwners
+	(*ImpactedService)(nil),                  // 82: nichegit.v1.ImpactedService
+	(*LsRefsDebugInfo)(nil),                  // 83: nichegit.v1.LsRefsDebugInfo
+	(*RenamedFile)(nil),                      // 84: nichegit.v1.RenamedFile
+	(*FileMatches)(nil),                      // 85: nichegit.v1.FileMatches
+	(*TreeEntry)(nil),                        // 86: nichegit.v1.TreeEntry
+	(*FileSize)(nil),                         // 87: nichegit.v1.FileSize
+	(*RefInfo)(nil),                          // 88: nichegit.v1.RefInfo
+	(*ConflictContent)(nil),                  // 89: nichegit.v1.ConflictContent
+	(*PathExistence)(nil),                    // 90: nichegit.v1.PathExistence
+	(*RebasedRef)(nil),                       // 91: nichegit.v1.RebasedRef
+	(*RewordedCommit)(nil),                   // 92: nichegit.v1.RewordedCommit
+	(*BrokenLeaseOutput)(nil),                // 93: nichegit.v1.BrokenLeaseOutput
+	(*BudgetDebugInfo)(nil),                  // 94: nichegit.v1.BudgetDebugInfo
+	(*ObjectStats)(nil),                      // 95: nichegit.v1.ObjectStats
+	(*FetchIteration)(nil),                   // 96: nichegit.v1.FetchIteration
+	(*CommitSignature)(nil),                  // 97: nichegit.v1.CommitSignature
+	(*PushCommandStatus)(nil),                // 98: nichegit.v1.PushCommandStatus
+	(*ChangelogGroup)(nil),                   // 99: nichegit.v1.ChangelogGroup
+	(*FileAuthor)(nil),                       // 100: nichegit.v1.FileAuthor
+	(*PatternMatch)(nil),                     // 101: nichegit.v1.PatternMatch
+	(*ConflictHunk)(nil),                     // 102: nichegit.v1.ConflictHunk
+	(*ChangelogEntry)(nil),                   // 103: nichegit.v1.ChangelogEntry
+	nil,                                      // 104: nichegit.v1.SnapshotRefsResponse.RefsEntry
+	nil,                                      // 105: nichegit.v1.FetchDebugInfo.ResponseHeadersEntry
+	nil,                                      // 106: nichegit.v1.PushDebugInfo.RefAdvResponseHeadersEntry
+	nil,                                      // 107: nichegit.v1.PushDebugInfo.PushResponseHeadersEntry
+	nil,                                      // 108: nichegit.v1.PathAttributes.AttributesEntry
+	nil,                                      // 109: nichegit.v1.LsRefsDebugInfo.ResponseHeadersEntry
+	nil,                                      // 110: nichegit.v1.PatternMatch.NamedGroupsEntry
+	(*durationpb.Duration)(nil),              // 111: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),            // 112: google.protobuf.Timestamp
+	(*structpb.ListValue)(nil),               // 113: google.protobuf.ListValue
+}
+var file_nichegit_proto_depIdxs = []int32{
+	111, // 0: nichegit.v1.CheckIgnoredRequest.timeout:type_name -> google.protobuf.Duration
+	70,  // 1: nichegit.v1.CheckIgnoredResponse.paths:type_name -> nichegit.v1.PathIgnored
+	71,  // 2: nichegit.v1.CheckIgnoredResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 3: nichegit.v1.CheckIgnoredResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 4: nichegit.v1.CheckLinearHistoryRequest.timeout:type_name -> google.protobuf.Duration
+	72,  // 5: nichegit.v1.CheckLinearHistoryResponse.merge_commits:type_name -> nichegit.v1.CommitInfo
+	71,  // 6: nichegit.v1.CheckLinearHistoryResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 7: nichegit.v1.CheckLinearHistoryResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 8: nichegit.v1.CheckRemergeRequest.timeout:type_name -> google.protobuf.Duration
+	71,  // 9: nichegit.v1.CheckRemergeResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 10: nichegit.v1.CheckRemergeResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 11: nichegit.v1.CherryPickRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 12: nichegit.v1.CherryPickRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 13: nichegit.v1.CherryPickRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	73,  // 14: nichegit.v1.CherryPickResponse.commits:type_name -> nichegit.v1.CherryPickedCommit
+	71,  // 15: nichegit.v1.CherryPickResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 16: nichegit.v1.CherryPickResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 17: nichegit.v1.CherryPickResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 18: nichegit.v1.CleanupScratchRefsRequest.max_age:type_name -> google.protobuf.Duration
+	111, // 19: nichegit.v1.CleanupScratchRefsRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 20: nichegit.v1.CleanupScratchRefsRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 21: nichegit.v1.CleanupScratchRefsRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	75,  // 22: nichegit.v1.CleanupScratchRefsResponse.deleted_refs:type_name -> nichegit.v1.ScratchRefOutput
+	74,  // 23: nichegit.v1.CleanupScratchRefsResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 24: nichegit.v1.CleanupScratchRefsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 25: nichegit.v1.CompareRefsRequest.timeout:type_name -> google.protobuf.Duration
+	72,  // 26: nichegit.v1.CompareRefsResponse.ahead_commits:type_name -> nichegit.v1.CommitInfo
+	72,  // 27: nichegit.v1.CompareRefsResponse.behind_commits:type_name -> nichegit.v1.CommitInfo
+	71,  // 28: nichegit.v1.CompareRefsResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 29: nichegit.v1.CompareRefsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 30: nichegit.v1.EmptyCommitRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 31: nichegit.v1.EmptyCommitRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 32: nichegit.v1.EmptyCommitRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	71,  // 33: nichegit.v1.EmptyCommitResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 34: nichegit.v1.EmptyCommitResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 35: nichegit.v1.EmptyCommitResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 36: nichegit.v1.GenerateChangelogRequest.timeout:type_name -> google.protobuf.Duration
+	76,  // 37: nichegit.v1.GenerateChangelogResponse.changelog:type_name -> nichegit.v1.Changelog
+	71,  // 38: nichegit.v1.GenerateChangelogResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 39: nichegit.v1.GenerateChangelogResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 40: nichegit.v1.GetAttributesRequest.timeout:type_name -> google.protobuf.Duration
+	77,  // 41: nichegit.v1.GetAttributesResponse.paths:type_name -> nichegit.v1.PathAttributes
+	71,  // 42: nichegit.v1.GetAttributesResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 43: nichegit.v1.GetAttributesResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 44: nichegit.v1.GetBlameRequest.timeout:type_name -> google.protobuf.Duration
+	78,  // 45: nichegit.v1.GetBlameResponse.lines:type_name -> nichegit.v1.BlameLine
+	71,  // 46: nichegit.v1.GetBlameResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 47: nichegit.v1.GetBlameResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 48: nichegit.v1.GetCommitGraphRequest.timeout:type_name -> google.protobuf.Duration
+	79,  // 49: nichegit.v1.GetCommitGraphResponse.commits:type_name -> nichegit.v1.CommitGraphNode
+	71,  // 50: nichegit.v1.GetCommitGraphResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 51: nichegit.v1.GetCommitGraphResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 52: nichegit.v1.GetCommitsRequest.timeout:type_name -> google.protobuf.Duration
+	72,  // 53: nichegit.v1.GetCommitsResponse.commits:type_name -> nichegit.v1.CommitInfo
+	71,  // 54: nichegit.v1.GetCommitsResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 55: nichegit.v1.GetCommitsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 56: nichegit.v1.GetCommitsSinceSemverTagRequest.timeout:type_name -> google.protobuf.Duration
+	80,  // 57: nichegit.v1.GetCommitsSinceSemverTagResponse.tag:type_name -> nichegit.v1.SemverTag
+	72,  // 58: nichegit.v1.GetCommitsSinceSemverTagResponse.commits:type_name -> nichegit.v1.CommitInfo
+	71,  // 59: nichegit.v1.GetCommitsSinceSemverTagResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 60: nichegit.v1.GetCommitsSinceSemverTagResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 61: nichegit.v1.GetFileHistoryRequest.timeout:type_name -> google.protobuf.Duration
+	72,  // 62: nichegit.v1.GetFileHistoryResponse.commits:type_name -> nichegit.v1.CommitInfo
+	71,  // 63: nichegit.v1.GetFileHistoryResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 64: nichegit.v1.GetFileHistoryResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 65: nichegit.v1.GetFileOwnersRequest.timeout:type_name -> google.protobuf.Duration
+	81,  // 66: nichegit.v1.GetFileOwnersResponse.files:type_name -> nichegit.v1.FileOwners
+	71,  // 67: nichegit.v1.GetFileOwnersResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 68: nichegit.v1.GetFileOwnersResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 69: nichegit.v1.GetImpactedServicesRequest.timeout:type_name -> google.protobuf.Duration
+	82,  // 70: nichegit.v1.GetImpactedServicesResponse.services:type_name -> nichegit.v1.ImpactedService
+	71,  // 71: nichegit.v1.GetImpactedServicesResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 72: nichegit.v1.GetImpactedServicesResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 73: nichegit.v1.GetLatestSemverTagRequest.timeout:type_name -> google.protobuf.Duration
+	80,  // 74: nichegit.v1.GetLatestSemverTagResponse.tag:type_name -> nichegit.v1.SemverTag
+	83,  // 75: nichegit.v1.GetLatestSemverTagResponse.debug_info:type_name -> nichegit.v1.LsRefsDebugInfo
+	94,  // 76: nichegit.v1.GetLatestSemverTagResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 77: nichegit.v1.GetModifiedFilesRequest.timeout:type_name -> google.protobuf.Duration
+	84,  // 78: nichegit.v1.GetModifiedFilesResponse.renames:type_name -> nichegit.v1.RenamedFile
+	85,  // 79: nichegit.v1.GetModifiedFilesResponse.matches:type_name -> nichegit.v1.FileMatches
+	71,  // 80: nichegit.v1.GetModifiedFilesResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 81: nichegit.v1.GetModifiedFilesResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 82: nichegit.v1.GetObjectRequest.timeout:type_name -> google.protobuf.Duration
+	71,  // 83: nichegit.v1.GetObjectResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 84: nichegit.v1.GetObjectResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 85: nichegit.v1.GetTreeRequest.timeout:type_name -> google.protobuf.Duration
+	86,  // 86: nichegit.v1.GetTreeResponse.entries:type_name -> nichegit.v1.TreeEntry
+	71,  // 87: nichegit.v1.GetTreeResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 88: nichegit.v1.GetTreeResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 89: nichegit.v1.GetTreeStatsRequest.timeout:type_name -> google.protobuf.Duration
+	87,  // 90: nichegit.v1.GetTreeStatsResponse.largest_files:type_name -> nichegit.v1.FileSize
+	71,  // 91: nichegit.v1.GetTreeStatsResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 92: nichegit.v1.GetTreeStatsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 93: nichegit.v1.HasObjectsRequest.timeout:type_name -> google.protobuf.Duration
+	71,  // 94: nichegit.v1.HasObjectsResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 95: nichegit.v1.HasObjectsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 96: nichegit.v1.LsRefsRequest.timeout:type_name -> google.protobuf.Duration
+	88,  // 97: nichegit.v1.LsRefsResponse.refs:type_name -> nichegit.v1.RefInfo
+	83,  // 98: nichegit.v1.LsRefsResponse.debug_info:type_name -> nichegit.v1.LsRefsDebugInfo
+	94,  // 99: nichegit.v1.LsRefsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 100: nichegit.v1.MergeBranchesRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 101: nichegit.v1.MergeBranchesRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 102: nichegit.v1.MergeBranchesRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	89,  // 103: nichegit.v1.MergeBranchesResponse.conflict_contents:type_name -> nichegit.v1.ConflictContent
+	84,  // 104: nichegit.v1.MergeBranchesResponse.renames:type_name -> nichegit.v1.RenamedFile
+	71,  // 105: nichegit.v1.MergeBranchesResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 106: nichegit.v1.MergeBranchesResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 107: nichegit.v1.MergeBranchesResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 108: nichegit.v1.PathsExistRequest.timeout:type_name -> google.protobuf.Duration
+	90,  // 109: nichegit.v1.PathsExistResponse.paths:type_name -> nichegit.v1.PathExistence
+	71,  // 110: nichegit.v1.PathsExistResponse.debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	94,  // 111: nichegit.v1.PathsExistResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 112: nichegit.v1.PushNextSemverTagRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 113: nichegit.v1.PushNextSemverTagRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 114: nichegit.v1.PushNextSemverTagRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	80,  // 115: nichegit.v1.PushNextSemverTagResponse.previous_tag:type_name -> nichegit.v1.SemverTag
+	80,  // 116: nichegit.v1.PushNextSemverTagResponse.tag:type_name -> nichegit.v1.SemverTag
+	71,  // 117: nichegit.v1.PushNextSemverTagResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 118: nichegit.v1.PushNextSemverTagResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 119: nichegit.v1.PushNextSemverTagResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 120: nichegit.v1.RebaseRefsRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 121: nichegit.v1.RebaseRefsRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 122: nichegit.v1.RebaseRefsRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	91,  // 123: nichegit.v1.RebaseRefsResponse.refs:type_name -> nichegit.v1.RebasedRef
+	71,  // 124: nichegit.v1.RebaseRefsResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 125: nichegit.v1.RebaseRefsResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 126: nichegit.v1.RebaseRefsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 127: nichegit.v1.ResolveConflictsRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 128: nichegit.v1.ResolveConflictsRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 129: nichegit.v1.ResolveConflictsRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	71,  // 130: nichegit.v1.ResolveConflictsResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 131: nichegit.v1.ResolveConflictsResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 132: nichegit.v1.ResolveConflictsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 133: nichegit.v1.RestoreRefsRequest.timeout:type_name -> google.protobuf.Duration
+	71,  // 134: nichegit.v1.RestoreRefsResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 135: nichegit.v1.RestoreRefsResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 136: nichegit.v1.RestoreRefsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 137: nichegit.v1.RevertRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 138: nichegit.v1.RevertRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 139: nichegit.v1.RevertRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	89,  // 140: nichegit.v1.RevertResponse.conflict_contents:type_name -> nichegit.v1.ConflictContent
+	71,  // 141: nichegit.v1.RevertResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 142: nichegit.v1.RevertResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 143: nichegit.v1.RevertResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 144: nichegit.v1.RevertMergeRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 145: nichegit.v1.RevertMergeRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 146: nichegit.v1.RevertMergeRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	89,  // 147: nichegit.v1.RevertMergeResponse.conflict_contents:type_name -> nichegit.v1.ConflictContent
+	71,  // 148: nichegit.v1.RevertMergeResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 149: nichegit.v1.RevertMergeResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 150: nichegit.v1.RevertMergeResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 151: nichegit.v1.RewordCommitsRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 152: nichegit.v1.RewordCommitsRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 153: nichegit.v1.RewordCommitsRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	92,  // 154: nichegit.v1.RewordCommitsResponse.commits:type_name -> nichegit.v1.RewordedCommit
+	71,  // 155: nichegit.v1.RewordCommitsResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 156: nichegit.v1.RewordCommitsResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 157: nichegit.v1.RewordCommitsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 158: nichegit.v1.SnapshotRefsRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 159: nichegit.v1.SnapshotRefsRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 160: nichegit.v1.SnapshotRefsRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	104, // 161: nichegit.v1.SnapshotRefsResponse.refs:type_name -> nichegit.v1.SnapshotRefsResponse.RefsEntry
+	83,  // 162: nichegit.v1.SnapshotRefsResponse.debug_info:type_name -> nichegit.v1.LsRefsDebugInfo
+	94,  // 163: nichegit.v1.SnapshotRefsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 164: nichegit.v1.SquashCherryPickRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 165: nichegit.v1.SquashCherryPickRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 166: nichegit.v1.SquashCherryPickRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	89,  // 167: nichegit.v1.SquashCherryPickResponse.conflict_contents:type_name -> nichegit.v1.ConflictContent
+	84,  // 168: nichegit.v1.SquashCherryPickResponse.renames:type_name -> nichegit.v1.RenamedFile
+	71,  // 169: nichegit.v1.SquashCherryPickResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 170: nichegit.v1.SquashCherryPickResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 171: nichegit.v1.SquashCherryPickResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	111, // 172: nichegit.v1.UpdateRefsRequest.timeout:type_name -> google.protobuf.Duration
+	111, // 173: nichegit.v1.UpdateRefsRequest.push_timeout:type_name -> google.protobuf.Duration
+	111, // 174: nichegit.v1.UpdateRefsRequest.ref_adv_timeout:type_name -> google.protobuf.Duration
+	93,  // 175: nichegit.v1.UpdateRefsResponse.broken_leases:type_name -> nichegit.v1.BrokenLeaseOutput
+	71,  // 176: nichegit.v1.UpdateRefsResponse.fetch_debug_info:type_name -> nichegit.v1.FetchDebugInfo
+	74,  // 177: nichegit.v1.UpdateRefsResponse.push_debug_info:type_name -> nichegit.v1.PushDebugInfo
+	94,  // 178: nichegit.v1.UpdateRefsResponse.budget_debug_info:type_name -> nichegit.v1.BudgetDebugInfo
+	105, // 179: nichegit.v1.FetchDebugInfo.response_headers:type_name -> nichegit.v1.FetchDebugInfo.ResponseHeadersEntry
+	95,  // 180: nichegit.v1.FetchDebugInfo.object_stats:type_name -> nichegit.v1.ObjectStats
+	96,  // 181: nichegit.v1.FetchDebugInfo.iterations:type_name -> nichegit.v1.FetchIteration
+	97,  // 182: nichegit.v1.CommitInfo.author:type_name -> nichegit.v1.CommitSignature
+	97,  // 183: nichegit.v1.CommitInfo.committer:type_name -> nichegit.v1.CommitSignature
+	106, // 184: nichegit.v1.PushDebugInfo.ref_adv_response_headers:type_name -> nichegit.v1.PushDebugInfo.RefAdvResponseHeadersEntry
+	107, // 185: nichegit.v1.PushDebugInfo.push_response_headers:type_name -> nichegit.v1.PushDebugInfo.PushResponseHeadersEntry
+	98,  // 186: nichegit.v1.PushDebugInfo.command_statuses:type_name -> nichegit.v1.PushCommandStatus
+	112, // 187: nichegit.v1.ScratchRefOutput.created_at:type_name -> google.protobuf.Timestamp
+	99,  // 188: nichegit.v1.Changelog.groups:type_name -> nichegit.v1.ChangelogGroup
+	108, // 189: nichegit.v1.PathAttributes.attributes:type_name -> nichegit.v1.PathAttributes.AttributesEntry
+	97,  // 190: nichegit.v1.BlameLine.author:type_name -> nichegit.v1.CommitSignature
+	112, // 191: nichegit.v1.CommitGraphNode.author_time:type_name -> google.protobuf.Timestamp
+	100, // 192: nichegit.v1.FileOwners.authors:type_name -> nichegit.v1.FileAuthor
+	109, // 193: nichegit.v1.LsRefsDebugInfo.response_headers:type_name -> nichegit.v1.LsRefsDebugInfo.ResponseHeadersEntry
+	101, // 194: nichegit.v1.FileMatches.before:type_name -> nichegit.v1.PatternMatch
+	101, // 195: nichegit.v1.FileMatches.after:type_name -> nichegit.v1.PatternMatch
+	102, // 196: nichegit.v1.ConflictContent.hunks:type_name -> nichegit.v1.ConflictHunk
+	73,  // 197: nichegit.v1.RebasedRef.commits:type_name -> nichegit.v1.CherryPickedCommit
+	112, // 198: nichegit.v1.CommitSignature.timestamp:type_name -> google.protobuf.Timestamp
+	103, // 199: nichegit.v1.ChangelogGroup.entries:type_name -> nichegit.v1.ChangelogEntry
+	110, // 200: nichegit.v1.PatternMatch.named_groups:type_name -> nichegit.v1.PatternMatch.NamedGroupsEntry
+	97,  // 201: nichegit.v1.ChangelogEntry.author:type_name -> nichegit.v1.CommitSignature
+	113, // 202: nichegit.v1.FetchDebugInfo.ResponseHeadersEntry.value:type_name -> google.protobuf.ListValue
+	113, // 203: nichegit.v1.PushDebugInfo.RefAdvResponseHeadersEntry.value:type_name -> google.protobuf.ListValue
+	113, // 204: nichegit.v1.PushDebugInfo.PushResponseHeadersEntry.value:type_name -> google.protobuf.ListValue
+	113, // 205: nichegit.v1.LsRefsDebugInfo.ResponseHeadersEntry.value:type_name -> google.protobuf.ListValue
+	0,   // 206: nichegit.v1.NicheGit.CheckIgnored:input_type -> nichegit.v1.CheckIgnoredRequest
+	2,   // 207: nichegit.v1.NicheGit.CheckLinearHistory:input_type -> nichegit.v1.CheckLinearHistoryRequest
+	4,   // 208: nichegit.v1.NicheGit.CheckRemerge:input_type -> nichegit.v1.CheckRemergeRequest
+	6,   // 209: nichegit.v1.NicheGit.CherryPick:input_type -> nichegit.v1.CherryPickRequest
+	8,   // 210: nichegit.v1.NicheGit.CleanupScratchRefs:input_type -> nichegit.v1.CleanupScratchRefsRequest
+	10,  // 211: nichegit.v1.NicheGit.CompareRefs:input_type -> nichegit.v1.CompareRefsRequest
+	12,  // 212: nichegit.v1.NicheGit.EmptyCommit:input_type -> nichegit.v1.EmptyCommitRequest
+	14,  // 213: nichegit.v1.NicheGit.GenerateChangelog:input_type -> nichegit.v1.GenerateChangelogRequest
+	16,  // 214: nichegit.v1.NicheGit.GetAttributes:input_type -> nichegit.v1.GetAttributesRequest
+	18,  // 215: nichegit.v1.NicheGit.GetBlame:input_type -> nichegit.v1.GetBlameRequest
+	20,  // 216: nichegit.v1.NicheGit.GetCommitGraph:input_type -> nichegit.v1.GetCommitGraphRequest
+	22,  // 217: nichegit.v1.NicheGit.GetCommits:input_type -> nichegit.v1.GetCommitsRequest
+	24,  // 218: nichegit.v1.NicheGit.GetCommitsSinceSemverTag:input_type -> nichegit.v1.GetCommitsSinceSemverTagRequest
+	26,  // 219: nichegit.v1.NicheGit.GetFileHistory:input_type -> nichegit.v1.GetFileHistoryRequest
+	28,  // 220: nichegit.v1.NicheGit.GetFileOwners:input_type -> nichegit.v1.GetFileOwnersRequest
+	30,  // 221: nichegit.v1.NicheGit.GetImpactedServices:input_type -> nichegit.v1.GetImpactedServicesRequest
+	32,  // 222: nichegit.v1.NicheGit.GetLatestSemverTag:input_type -> nichegit.v1.GetLatestSemverTagRequest
+	34,  // 223: nichegit.v1.NicheGit.GetModifiedFiles:input_type -> nichegit.v1.GetModifiedFilesRequest
+	36,  // 224: nichegit.v1.NicheGit.GetObject:input_type -> nichegit.v1.GetObjectRequest
+	38,  // 225: nichegit.v1.NicheGit.GetTree:input_type -> nichegit.v1.GetTreeRequest
+	40,  // 226: nichegit.v1.NicheGit.GetTreeStats:input_type -> nichegit.v1.GetTreeStatsRequest
+	42,  // 227: nichegit.v1.NicheGit.HasObjects:input_type -> nichegit.v1.HasObjectsRequest
+	44,  // 228: nichegit.v1.NicheGit.LsRefs:input_type -> nichegit.v1.LsRefsRequest
+	46,  // 229: nichegit.v1.NicheGit.MergeBranches:input_type -> nichegit.v1.MergeBranchesRequest
+	48,  // 230: nichegit.v1.NicheGit.PathsExist:input_type -> nichegit.v1.PathsExistRequest
+	50,  // 231: nichegit.v1.NicheGit.PushNextSemverTag:input_type -> nichegit.v1.PushNextSemverTagRequest
+	52,  // 232: nichegit.v1.NicheGit.RebaseRefs:input_type -> nichegit.v1.RebaseRefsRequest
+	54,  // 233: nichegit.v1.NicheGit.ResolveConflicts:input_type -> nichegit.v1.ResolveConflictsRequest
+	56,  // 234: nichegit.v1.NicheGit.RestoreRefs:input_type -> nichegit.v1.RestoreRefsRequest
+	58,  // 235: nichegit.v1.NicheGit.Revert:input_type -> nichegit.v1.RevertRequest
+	60,  // 236: nichegit.v1.NicheGit.RevertMerge:input_type -> nichegit.v1.RevertMergeRequest
+	62,  // 237: nichegit.v1.NicheGit.RewordCommits:input_type -> nichegit.v1.RewordCommitsRequest
+	64,  // 238: nichegit.v1.NicheGit.SnapshotRefs:input_type -> nichegit.v1.SnapshotRefsRequest
+	66,  // 239: nichegit.v1.NicheGit.SquashCherryPick:input_type -> nichegit.v1.SquashCherryPickRequest
+	68,  // 240: nichegit.v1.NicheGit.UpdateRefs:input_type -> nichegit.v1.UpdateRefsRequest
+	1,   // 241: nichegit.v1.NicheGit.CheckIgnored:output_type -> nichegit.v1.CheckIgnoredResponse
+	3,   // 242: nichegit.v1.NicheGit.CheckLinearHistory:output_type -> nichegit.v1.CheckLinearHistoryResponse
+	5,   // 243: nichegit.v1.NicheGit.CheckRemerge:output_type -> nichegit.v1.CheckRemergeResponse
+	7,   // 244: nichegit.v1.NicheGit.CherryPick:output_type -> nichegit.v1.CherryPickResponse
+	9,   // 245: nichegit.v1.NicheGit.CleanupScratchRefs:output_type -> nichegit.v1.CleanupScratchRefsResponse
+	11,  // 246: nichegit.v1.NicheGit.CompareRefs:output_type -> nichegit.v1.CompareRefsResponse
+	13,  // 247: nichegit.v1.NicheGit.EmptyCommit:output_type -> nichegit.v1.EmptyCommitResponse
+	15,  // 248: nichegit.v1.NicheGit.GenerateChangelog:output_type -> nichegit.v1.GenerateChangelogResponse
+	17,  // 249: nichegit.v1.NicheGit.GetAttributes:output_type -> nichegit.v1.GetAttributesResponse
+	19,  // 250: nichegit.v1.NicheGit.GetBlame:output_type -> nichegit.v1.GetBlameResponse
+	21,  // 251: nichegit.v1.NicheGit.GetCommitGraph:output_type -> nichegit.v1.GetCommitGraphResponse
+	23,  // 252: nichegit.v1.NicheGit.GetCommits:output_type -> nichegit.v1.GetCommitsResponse
+	25,  // 253: nichegit.v1.NicheGit.GetCommitsSinceSemverTag:output_type -> nichegit.v1.GetCommitsSinceSemverTagResponse
+	27,  // 254: nichegit.v1.NicheGit.GetFileHistory:output_type -> nichegit.v1.GetFileHistoryResponse
+	29,  // 255: nichegit.v1.NicheGit.GetFileOwners:output_type -> nichegit.v1.GetFileOwnersResponse
+	31,  // 256: nichegit.v1.NicheGit.GetImpactedServices:output_type -> nichegit.v1.GetImpactedServicesResponse
+	33,  // 257: nichegit.v1.NicheGit.GetLatestSemverTag:output_type -> nichegit.v1.GetLatestSemverTagResponse
+	35,  // 258: nichegit.v1.NicheGit.GetModifiedFiles:output_type -> nichegit.v1.GetModifiedFilesResponse
+	37,  // 259: nichegit.v1.NicheGit.GetObject:output_type -> nichegit.v1.GetObjectResponse
+	39,  // 260: nichegit.v1.NicheGit.GetTree:output_type -> nichegit.v1.GetTreeResponse
+	41,  // 261: nichegit.v1.NicheGit.GetTreeStats:output_type -> nichegit.v1.GetTreeStatsResponse
+	43,  // 262: nichegit.v1.NicheGit.HasObjects:output_type -> nichegit.v1.HasObjectsResponse
+	45,  // 263: nichegit.v1.NicheGit.LsRefs:output_type -> nichegit.v1.LsRefsResponse
+	47,  // 264: nichegit.v1.NicheGit.MergeBranches:output_type -> nichegit.v1.MergeBranchesResponse
+	49,  // 265: nichegit.v1.NicheGit.PathsExist:output_type -> nichegit.v1.PathsExistResponse
+	51,  // 266: nichegit.v1.NicheGit.PushNextSemverTag:output_type -> nichegit.v1.PushNextSemverTagResponse
+	53,  // 267: nichegit.v1.NicheGit.RebaseRefs:output_type -> nichegit.v1.RebaseRefsResponse
+	55,  // 268: nichegit.v1.NicheGit.ResolveConflicts:output_type -> nichegit.v1.ResolveConflictsResponse
+	57,  // 269: nichegit.v1.NicheGit.RestoreRefs:output_type -> nichegit.v1.RestoreRefsResponse
+	59,  // 270: nichegit.v1.NicheGit.Revert:output_type -> nichegit.v1.RevertResponse
+	61,  // 271: nichegit.v1.NicheGit.RevertMerge:output_type -> nichegit.v1.RevertMergeResponse
+	63,  // 272: nichegit.v1.NicheGit.RewordCommits:output_type -> nichegit.v1.RewordCommitsResponse
+	65,  // 273: nichegit.v1.NicheGit.SnapshotRefs:output_type -> nichegit.v1.SnapshotRefsResponse
+	67,  // 274: nichegit.v1.NicheGit.SquashCherryPick:output_type -> nichegit.v1.SquashCherryPickResponse
+	69,  // 275: nichegit.v1.NicheGit.UpdateRefs:output_type -> nichegit.v1.UpdateRefsResponse
+	241, // [241:276] is the sub-list for method output_type
+	206, // [206:241] is the sub-list for method input_type
+	206, // [206:206] is the sub-list for extension type_name
+	206, // [206:206] is the sub-list for extension extendee
+	0,   // [0:206] is the sub-list for field type_name
+}
+
+func init() { file_nichegit_proto_init() }
+func file_nichegit_proto_init() {
+	if File_nichegit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_nichegit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckIgnoredRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckIgnoredResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckLinearHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckLinearHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckRemergeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckRemergeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CherryPickRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CherryPickResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupScratchRefsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupScratchRefsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareRefsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareRefsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyCommitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateChangelogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateChangelogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttributesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttributesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommitGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommitGraphResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommitsSinceSemverTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommitsSinceSemverTagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileOwnersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileOwnersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImpactedServicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImpactedServicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLatestSemverTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLatestSemverTagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModifiedFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModifiedFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetObjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetObjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTreeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTreeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTreeStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTreeStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasObjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasObjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsRefsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsRefsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeBranchesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeBranchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathsExistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathsExistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushNextSemverTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushNextSemverTagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebaseRefsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebaseRefsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveConflictsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveConflictsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreRefsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreRefsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertMergeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertMergeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewordCommitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewordCommitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotRefsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotRefsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SquashCherryPickRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SquashCherryPickResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRefsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRefsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathIgnored); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchDebugInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CherryPickedCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushDebugInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScratchRefOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Changelog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlameLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitGraphNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SemverTag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileOwners); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImpactedService); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsRefsDebugInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenamedFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileMatches); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TreeEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConflictContent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathExistence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebasedRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewordedCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrokenLeaseOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BudgetDebugInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchIteration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushCommandStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangelogGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileAuthor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatternMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConflictHunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nichegit_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangelogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_nichegit_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[40].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[42].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[44].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[46].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[50].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[52].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[54].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[56].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[58].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[60].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[64].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[66].OneofWrappers = []interface{}{}
+	file_nichegit_proto_msgTypes[68].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_nichegit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   111,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nichegit_proto_goTypes,
+		DependencyIndexes: file_nichegit_proto_depIdxs,
+		MessageInfos:      file_nichegit_proto_msgTypes,
+	}.Build()
+	File_nichegit_proto = out.File
+	file_nichegit_proto_rawDesc = nil
+	file_nichegit_proto_goTypes = nil
+	file_nichegit_proto_depIdxs = nil
+}
@@ -0,0 +1,1492 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: nichegit.proto
+
+package nichegitpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NicheGit_CheckIgnored_FullMethodName             = "/nichegit.v1.NicheGit/CheckIgnored"
+	NicheGit_CheckLinearHistory_FullMethodName       = "/nichegit.v1.NicheGit/CheckLinearHistory"
+	NicheGit_CheckRemerge_FullMethodName             = "/nichegit.v1.NicheGit/CheckRemerge"
+	NicheGit_CherryPick_FullMethodName               = "/nichegit.v1.NicheGit/CherryPick"
+	NicheGit_CleanupScratchRefs_FullMethodName       = "/nichegit.v1.NicheGit/CleanupScratchRefs"
+	NicheGit_CompareRefs_FullMethodName              = "/nichegit.v1.NicheGit/CompareRefs"
+	NicheGit_EmptyCommit_FullMethodName              = "/nichegit.v1.NicheGit/EmptyCommit"
+	NicheGit_GenerateChangelog_FullMethodName        = "/nichegit.v1.NicheGit/GenerateChangelog"
+	NicheGit_GetAttributes_FullMethodName            = "/nichegit.v1.NicheGit/GetAttributes"
+	NicheGit_GetBlame_FullMethodName                 = "/nichegit.v1.NicheGit/GetBlame"
+	NicheGit_GetCommitGraph_FullMethodName           = "/nichegit.v1.NicheGit/GetCommitGraph"
+	NicheGit_GetCommits_FullMethodName               = "/nichegit.v1.NicheGit/GetCommits"
+	NicheGit_GetCommitsSinceSemverTag_FullMethodName = "/nichegit.v1.NicheGit/GetCommitsSinceSemverTag"
+	NicheGit_GetFileHistory_FullMethodName           = "/nichegit.v1.NicheGit/GetFileHistory"
+	NicheGit_GetFileOwners_FullMethodName            = "/nichegit.v1.NicheGit/GetFileOwners"
+	NicheGit_GetImpactedServices_FullMethodName      = "/nichegit.v1.NicheGit/GetImpactedServices"
+	NicheGit_GetLatestSemverTag_FullMethodName       = "/nichegit.v1.NicheGit/GetLatestSemverTag"
+	NicheGit_GetModifiedFiles_FullMethodName         = "/nichegit.v1.NicheGit/GetModifiedFiles"
+	NicheGit_GetObject_FullMethodName                = "/nichegit.v1.NicheGit/GetObject"
+	NicheGit_GetTree_FullMethodName                  = "/nichegit.v1.NicheGit/GetTree"
+	NicheGit_GetTreeStats_FullMethodName             = "/nichegit.v1.NicheGit/GetTreeStats"
+	NicheGit_HasObjects_FullMethodName               = "/nichegit.v1.NicheGit/HasObjects"
+	NicheGit_LsRefs_FullMethodName                   = "/nichegit.v1.NicheGit/LsRefs"
+	NicheGit_MergeBranches_FullMethodName            = "/nichegit.v1.NicheGit/MergeBranches"
+	NicheGit_PathsExist_FullMethodName               = "/nichegit.v1.NicheGit/PathsExist"
+	NicheGit_PushNextSemverTag_FullMethodName        = "/nichegit.v1.NicheGit/PushNextSemverTag"
+	NicheGit_RebaseRefs_FullMethodName               = "/nichegit.v1.NicheGit/RebaseRefs"
+	NicheGit_ResolveConflicts_FullMethodName         = "/nichegit.v1.NicheGit/ResolveConflicts"
+	NicheGit_RestoreRefs_FullMethodName              = "/nichegit.v1.NicheGit/RestoreRefs"
+	NicheGit_Revert_FullMethodName                   = "/nichegit.v1.NicheGit/Revert"
+	NicheGit_RevertMerge_FullMethodName              = "/nichegit.v1.NicheGit/RevertMerge"
+	NicheGit_RewordCommits_FullMethodName            = "/nichegit.v1.NicheGit/RewordCommits"
+	NicheGit_SnapshotRefs_FullMethodName             = "/nichegit.v1.NicheGit/SnapshotRefs"
+	NicheGit_SquashCherryPick_FullMethodName         = "/nichegit.v1.NicheGit/SquashCherryPick"
+	NicheGit_UpdateRefs_FullMethodName               = "/nichegit.v1.NicheGit/UpdateRefs"
+)
+
+// NicheGitClient is the client API for NicheGit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NicheGit runs the niche-git commands. The fields of a request are the flags of the command,
+// and the fields of a response are its JSON output.
+type NicheGitClient interface {
+	// CheckIgnored runs check-ignored.
+	CheckIgnored(ctx context.Context, in *CheckIgnoredRequest, opts ...grpc.CallOption) (*CheckIgnoredResponse, error)
+	// CheckLinearHistory runs check-linear-history.
+	CheckLinearHistory(ctx context.Context, in *CheckLinearHistoryRequest, opts ...grpc.CallOption) (*CheckLinearHistoryResponse, error)
+	// CheckRemerge runs check-remerge.
+	CheckRemerge(ctx context.Context, in *CheckRemergeRequest, opts ...grpc.CallOption) (*CheckRemergeResponse, error)
+	// CherryPick runs cherry-pick.
+	CherryPick(ctx context.Context, in *CherryPickRequest, opts ...grpc.CallOption) (*CherryPickResponse, error)
+	// CleanupScratchRefs runs cleanup-scratch-refs.
+	CleanupScratchRefs(ctx context.Context, in *CleanupScratchRefsRequest, opts ...grpc.CallOption) (*CleanupScratchRefsResponse, error)
+	// CompareRefs runs compare-refs.
+	CompareRefs(ctx context.Context, in *CompareRefsRequest, opts ...grpc.CallOption) (*CompareRefsResponse, error)
+	// EmptyCommit runs empty-commit.
+	EmptyCommit(ctx context.Context, in *EmptyCommitRequest, opts ...grpc.CallOption) (*EmptyCommitResponse, error)
+	// GenerateChangelog runs generate-changelog.
+	GenerateChangelog(ctx context.Context, in *GenerateChangelogRequest, opts ...grpc.CallOption) (*GenerateChangelogResponse, error)
+	// GetAttributes runs get-attributes.
+	GetAttributes(ctx context.Context, in *GetAttributesRequest, opts ...grpc.CallOption) (*GetAttributesResponse, error)
+	// GetBlame runs get-blame.
+	GetBlame(ctx context.Context, in *GetBlameRequest, opts ...grpc.CallOption) (*GetBlameResponse, error)
+	// GetCommitGraph runs get-commit-graph.
+	GetCommitGraph(ctx context.Context, in *GetCommitGraphRequest, opts ...grpc.CallOption) (*GetCommitGraphResponse, error)
+	// GetCommits runs get-commits.
+	GetCommits(ctx context.Context, in *GetCommitsRequest, opts ...grpc.CallOption) (*GetCommitsResponse, error)
+	// GetCommitsSinceSemverTag runs get-commits-since-semver-tag.
+	GetCommitsSinceSemverTag(ctx context.Context, in *GetCommitsSinceSemverTagRequest, opts ...grpc.CallOption) (*GetCommitsSinceSemverTagResponse, error)
+	// GetFileHistory runs get-file-history.
+	GetFileHistory(ctx context.Context, in *GetFileHistoryRequest, opts ...grpc.CallOption) (*GetFileHistoryResponse, error)
+	// GetFileOwners runs get-file-owners.
+	GetFileOwners(ctx context.Context, in *GetFileOwnersRequest, opts ...grpc.CallOption) (*GetFileOwnersResponse, error)
+	// GetImpactedServices runs get-impacted-services.
+	GetImpactedServices(ctx context.Context, in *GetImpactedServicesRequest, opts ...grpc.CallOption) (*GetImpactedServicesResponse, error)
+	// GetLatestSemverTag runs get-latest-semver-tag.
+	GetLatestSemverTag(ctx context.Context, in *GetLatestSemverTagRequest, opts ...grpc.CallOption) (*GetLatestSemverTagResponse, error)
+	// GetModifiedFiles runs get-modified-files.
+	GetModifiedFiles(ctx context.Context, in *GetModifiedFilesRequest, opts ...grpc.CallOption) (*GetModifiedFilesResponse, error)
+	// GetObject runs get-object.
+	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error)
+	// GetTree runs get-tree.
+	GetTree(ctx context.Context, in *GetTreeRequest, opts ...grpc.CallOption) (*GetTreeResponse, error)
+	// GetTreeStats runs get-tree-stats.
+	GetTreeStats(ctx context.Context, in *GetTreeStatsRequest, opts ...grpc.CallOption) (*GetTreeStatsResponse, error)
+	// HasObjects runs has-objects.
+	HasObjects(ctx context.Context, in *HasObjectsRequest, opts ...grpc.CallOption) (*HasObjectsResponse, error)
+	// LsRefs runs ls-refs.
+	LsRefs(ctx context.Context, in *LsRefsRequest, opts ...grpc.CallOption) (*LsRefsResponse, error)
+	// MergeBranches runs merge-branches.
+	MergeBranches(ctx context.Context, in *MergeBranchesRequest, opts ...grpc.CallOption) (*MergeBranchesResponse, error)
+	// PathsExist runs paths-exist.
+	PathsExist(ctx context.Context, in *PathsExistRequest, opts ...grpc.CallOption) (*PathsExistResponse, error)
+	// PushNextSemverTag runs push-next-semver-tag.
+	PushNextSemverTag(ctx context.Context, in *PushNextSemverTagRequest, opts ...grpc.CallOption) (*PushNextSemverTagResponse, error)
+	// RebaseRefs runs rebase-refs.
+	RebaseRefs(ctx context.Context, in *RebaseRefsRequest, opts ...grpc.CallOption) (*RebaseRefsResponse, error)
+	// ResolveConflicts runs resolve-conflicts.
+	ResolveConflicts(ctx context.Context, in *ResolveConflictsRequest, opts ...grpc.CallOption) (*ResolveConflictsResponse, error)
+	// RestoreRefs runs restore-refs.
+	RestoreRefs(ctx context.Context, in *RestoreRefsRequest, opts ...grpc.CallOption) (*RestoreRefsResponse, error)
+	// Revert runs revert.
+	Revert(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error)
+	// RevertMerge runs revert-merge.
+	RevertMerge(ctx context.Context, in *RevertMergeRequest, opts ...grpc.CallOption) (*RevertMergeResponse, error)
+	// RewordCommits runs reword-commits.
+	RewordCommits(ctx context.Context, in *RewordCommitsRequest, opts ...grpc.CallOption) (*RewordCommitsResponse, error)
+	// SnapshotRefs runs snapshot-refs.
+	SnapshotRefs(ctx context.Context, in *SnapshotRefsRequest, opts ...grpc.CallOption) (*SnapshotRefsResponse, error)
+	// SquashCherryPick runs squash-cherry-pick.
+	SquashCherryPick(ctx context.Context, in *SquashCherryPickRequest, opts ...grpc.CallOption) (*SquashCherryPickResponse, error)
+	// UpdateRefs runs update-refs.
+	UpdateRefs(ctx context.Context, in *UpdateRefsRequest, opts ...grpc.CallOption) (*UpdateRefsResponse, error)
+}
+
+type nicheGitClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNicheGitClient(cc grpc.ClientConnInterface) NicheGitClient {
+	return &nicheGitClient{cc}
+}
+
+func (c *nicheGitClient) CheckIgnored(ctx context.Context, in *CheckIgnoredRequest, opts ...grpc.CallOption) (*CheckIgnoredResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckIgnoredResponse)
+	err := c.cc.Invoke(ctx, NicheGit_CheckIgnored_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) CheckLinearHistory(ctx context.Context, in *CheckLinearHistoryRequest, opts ...grpc.CallOption) (*CheckLinearHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckLinearHistoryResponse)
+	err := c.cc.Invoke(ctx, NicheGit_CheckLinearHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) CheckRemerge(ctx context.Context, in *CheckRemergeRequest, opts ...grpc.CallOption) (*CheckRemergeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckRemergeResponse)
+	err := c.cc.Invoke(ctx, NicheGit_CheckRemerge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) CherryPick(ctx context.Context, in *CherryPickRequest, opts ...grpc.CallOption) (*CherryPickResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CherryPickResponse)
+	err := c.cc.Invoke(ctx, NicheGit_CherryPick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) CleanupScratchRefs(ctx context.Context, in *CleanupScratchRefsRequest, opts ...grpc.CallOption) (*CleanupScratchRefsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CleanupScratchRefsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_CleanupScratchRefs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) CompareRefs(ctx context.Context, in *CompareRefsRequest, opts ...grpc.CallOption) (*CompareRefsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareRefsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_CompareRefs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) EmptyCommit(ctx context.Context, in *EmptyCommitRequest, opts ...grpc.CallOption) (*EmptyCommitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyCommitResponse)
+	err := c.cc.Invoke(ctx, NicheGit_EmptyCommit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GenerateChangelog(ctx context.Context, in *GenerateChangelogRequest, opts ...grpc.CallOption) (*GenerateChangelogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateChangelogResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GenerateChangelog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetAttributes(ctx context.Context, in *GetAttributesRequest, opts ...grpc.CallOption) (*GetAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttributesResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetBlame(ctx context.Context, in *GetBlameRequest, opts ...grpc.CallOption) (*GetBlameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlameResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetBlame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetCommitGraph(ctx context.Context, in *GetCommitGraphRequest, opts ...grpc.CallOption) (*GetCommitGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommitGraphResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetCommitGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetCommits(ctx context.Context, in *GetCommitsRequest, opts ...grpc.CallOption) (*GetCommitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommitsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetCommits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetCommitsSinceSemverTag(ctx context.Context, in *GetCommitsSinceSemverTagRequest, opts ...grpc.CallOption) (*GetCommitsSinceSemverTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommitsSinceSemverTagResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetCommitsSinceSemverTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetFileHistory(ctx context.Context, in *GetFileHistoryRequest, opts ...grpc.CallOption) (*GetFileHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileHistoryResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetFileHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetFileOwners(ctx context.Context, in *GetFileOwnersRequest, opts ...grpc.CallOption) (*GetFileOwnersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileOwnersResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetFileOwners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetImpactedServices(ctx context.Context, in *GetImpactedServicesRequest, opts ...grpc.CallOption) (*GetImpactedServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetImpactedServicesResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetImpactedServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetLatestSemverTag(ctx context.Context, in *GetLatestSemverTagRequest, opts ...grpc.CallOption) (*GetLatestSemverTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLatestSemverTagResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetLatestSemverTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetModifiedFiles(ctx context.Context, in *GetModifiedFilesRequest, opts ...grpc.CallOption) (*GetModifiedFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModifiedFilesResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetModifiedFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetTree(ctx context.Context, in *GetTreeRequest, opts ...grpc.CallOption) (*GetTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTreeResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) GetTreeStats(ctx context.Context, in *GetTreeStatsRequest, opts ...grpc.CallOption) (*GetTreeStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTreeStatsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_GetTreeStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) HasObjects(ctx context.Context, in *HasObjectsRequest, opts ...grpc.CallOption) (*HasObjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HasObjectsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_HasObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) LsRefs(ctx context.Context, in *LsRefsRequest, opts ...grpc.CallOption) (*LsRefsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LsRefsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_LsRefs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) MergeBranches(ctx context.Context, in *MergeBranchesRequest, opts ...grpc.CallOption) (*MergeBranchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeBranchesResponse)
+	err := c.cc.Invoke(ctx, NicheGit_MergeBranches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) PathsExist(ctx context.Context, in *PathsExistRequest, opts ...grpc.CallOption) (*PathsExistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PathsExistResponse)
+	err := c.cc.Invoke(ctx, NicheGit_PathsExist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) PushNextSemverTag(ctx context.Context, in *PushNextSemverTagRequest, opts ...grpc.CallOption) (*PushNextSemverTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushNextSemverTagResponse)
+	err := c.cc.Invoke(ctx, NicheGit_PushNextSemverTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) RebaseRefs(ctx context.Context, in *RebaseRefsRequest, opts ...grpc.CallOption) (*RebaseRefsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebaseRefsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_RebaseRefs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) ResolveConflicts(ctx context.Context, in *ResolveConflictsRequest, opts ...grpc.CallOption) (*ResolveConflictsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveConflictsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_ResolveConflicts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) RestoreRefs(ctx context.Context, in *RestoreRefsRequest, opts ...grpc.CallOption) (*RestoreRefsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreRefsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_RestoreRefs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) Revert(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertResponse)
+	err := c.cc.Invoke(ctx, NicheGit_Revert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) RevertMerge(ctx context.Context, in *RevertMergeRequest, opts ...grpc.CallOption) (*RevertMergeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertMergeResponse)
+	err := c.cc.Invoke(ctx, NicheGit_RevertMerge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) RewordCommits(ctx context.Context, in *RewordCommitsRequest, opts ...grpc.CallOption) (*RewordCommitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewordCommitsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_RewordCommits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) SnapshotRefs(ctx context.Context, in *SnapshotRefsRequest, opts ...grpc.CallOption) (*SnapshotRefsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnapshotRefsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_SnapshotRefs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) SquashCherryPick(ctx context.Context, in *SquashCherryPickRequest, opts ...grpc.CallOption) (*SquashCherryPickResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SquashCherryPickResponse)
+	err := c.cc.Invoke(ctx, NicheGit_SquashCherryPick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nicheGitClient) UpdateRefs(ctx context.Context, in *UpdateRefsRequest, opts ...grpc.CallOption) (*UpdateRefsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRefsResponse)
+	err := c.cc.Invoke(ctx, NicheGit_UpdateRefs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NicheGitServer is the server API for NicheGit service.
+// All implementations must embed UnimplementedNicheGitServer
+// for forward compatibility.
+//
+// NicheGit runs the niche-git commands. The fields of a request are the flags of the command,
+// and the fields of a response are its JSON output.
+type NicheGitServer interface {
+	// CheckIgnored runs check-ignored.
+	CheckIgnored(context.Context, *CheckIgnoredRequest) (*CheckIgnoredResponse, error)
+	// CheckLinearHistory runs check-linear-history.
+	CheckLinearHistory(context.Context, *CheckLinearHistoryRequest) (*CheckLinearHistoryResponse, error)
+	// CheckRemerge runs check-remerge.
+	CheckRemerge(context.Context, *CheckRemergeRequest) (*CheckRemergeResponse, error)
+	// CherryPick runs cherry-pick.
+	CherryPick(context.Context, *CherryPickRequest) (*CherryPickResponse, error)
+	// CleanupScratchRefs runs cleanup-scratch-refs.
+	CleanupScratchRefs(context.Context, *CleanupScratchRefsRequest) (*CleanupScratchRefsResponse, error)
+	// CompareRefs runs compare-refs.
+	CompareRefs(context.Context, *CompareRefsRequest) (*CompareRefsResponse, error)
+	// EmptyCommit runs empty-commit.
+	EmptyCommit(context.Context, *EmptyCommitRequest) (*EmptyCommitResponse, error)
+	// GenerateChangelog runs generate-changelog.
+	GenerateChangelog(context.Context, *GenerateChangelogRequest) (*GenerateChangelogResponse, error)
+	// GetAttributes runs get-attributes.
+	GetAttributes(context.Context, *GetAttributesRequest) (*GetAttributesResponse, error)
+	// GetBlame runs get-blame.
+	GetBlame(context.Context, *GetBlameRequest) (*GetBlameResponse, error)
+	// GetCommitGraph runs get-commit-graph.
+	GetCommitGraph(context.Context, *GetCommitGraphRequest) (*GetCommitGraphResponse, error)
+	// GetCommits runs get-commits.
+	GetCommits(context.Context, *GetCommitsRequest) (*GetCommitsResponse, error)
+	// GetCommitsSinceSemverTag runs get-commits-since-semver-tag.
+	GetCommitsSinceSemverTag(context.Context, *GetCommitsSinceSemverTagRequest) (*GetCommitsSinceSemverTagResponse, error)
+	// GetFileHistory runs get-file-history.
+	GetFileHistory(context.Context, *GetFileHistoryRequest) (*GetFileHistoryResponse, error)
+	// GetFileOwners runs get-file-owners.
+	GetFileOwners(context.Context, *GetFileOwnersRequest) (*GetFileOwnersResponse, error)
+	// GetImpactedServices runs get-impacted-services.
+	GetImpactedServices(context.Context, *GetImpactedServicesRequest) (*GetImpactedServicesResponse, error)
+	// GetLatestSemverTag runs get-latest-semver-tag.
+	GetLatestSemverTag(context.Context, *GetLatestSemverTagRequest) (*GetLatestSemverTagResponse, error)
+	// GetModifiedFiles runs get-modified-files.
+	GetModifiedFiles(context.Context, *GetModifiedFilesRequest) (*GetModifiedFilesResponse, error)
+	// GetObject runs get-object.
+	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
+	// GetTree runs get-tree.
+	GetTree(context.Context, *GetTreeRequest) (*GetTreeResponse, error)
+	// GetTreeStats runs get-tree-stats.
+	GetTreeStats(context.Context, *GetTreeStatsRequest) (*GetTreeStatsResponse, error)
+	// HasObjects runs has-objects.
+	HasObjects(context.Context, *HasObjectsRequest) (*HasObjectsResponse, error)
+	// LsRefs runs ls-refs.
+	LsRefs(context.Context, *LsRefsRequest) (*LsRefsResponse, error)
+	// MergeBranches runs merge-branches.
+	MergeBranches(context.Context, *MergeBranchesRequest) (*MergeBranchesResponse, error)
+	// PathsExist runs paths-exist.
+	PathsExist(context.Context, *PathsExistRequest) (*PathsExistResponse, error)
+	// PushNextSemverTag runs push-next-semver-tag.
+	PushNextSemverTag(context.Context, *PushNextSemverTagRequest) (*PushNextSemverTagResponse, error)
+	// RebaseRefs runs rebase-refs.
+	RebaseRefs(context.Context, *RebaseRefsRequest) (*RebaseRefsResponse, error)
+	// ResolveConflicts runs resolve-conflicts.
+	ResolveConflicts(context.Context, *ResolveConflictsRequest) (*ResolveConflictsResponse, error)
+	// RestoreRefs runs restore-refs.
+	RestoreRefs(context.Context, *RestoreRefsRequest) (*RestoreRefsResponse, error)
+	// Revert runs revert.
+	Revert(context.Context, *RevertRequest) (*RevertResponse, error)
+	// RevertMerge runs revert-merge.
+	RevertMerge(context.Context, *RevertMergeRequest) (*RevertMergeResponse, error)
+	// RewordCommits runs reword-commits.
+	RewordCommits(context.Context, *RewordCommitsRequest) (*RewordCommitsResponse, error)
+	// SnapshotRefs runs snapshot-refs.
+	SnapshotRefs(context.Context, *SnapshotRefsRequest) (*SnapshotRefsResponse, error)
+	// SquashCherryPick runs squash-cherry-pick.
+	SquashCherryPick(context.Context, *SquashCherryPickRequest) (*SquashCherryPickResponse, error)
+	// UpdateRefs runs update-refs.
+	UpdateRefs(context.Context, *UpdateRefsRequest) (*UpdateRefsResponse, error)
+	mustEmbedUnimplementedNicheGitServer()
+}
+
+// UnimplementedNicheGitServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNicheGitServer struct{}
+
+func (UnimplementedNicheGitServer) CheckIgnored(context.Context, *CheckIgnoredRequest) (*CheckIgnoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIgnored not implemented")
+}
+func (UnimplementedNicheGitServer) CheckLinearHistory(context.Context, *CheckLinearHistoryRequest) (*CheckLinearHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckLinearHistory not implemented")
+}
+func (UnimplementedNicheGitServer) CheckRemerge(context.Context, *CheckRemergeRequest) (*CheckRemergeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRemerge not implemented")
+}
+func (UnimplementedNicheGitServer) CherryPick(context.Context, *CherryPickRequest) (*CherryPickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CherryPick not implemented")
+}
+func (UnimplementedNicheGitServer) CleanupScratchRefs(context.Context, *CleanupScratchRefsRequest) (*CleanupScratchRefsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanupScratchRefs not implemented")
+}
+func (UnimplementedNicheGitServer) CompareRefs(context.Context, *CompareRefsRequest) (*CompareRefsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareRefs not implemented")
+}
+func (UnimplementedNicheGitServer) EmptyCommit(context.Context, *EmptyCommitRequest) (*EmptyCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmptyCommit not implemented")
+}
+func (UnimplementedNicheGitServer) GenerateChangelog(context.Context, *GenerateChangelogRequest) (*GenerateChangelogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateChangelog not implemented")
+}
+func (UnimplementedNicheGitServer) GetAttributes(context.Context, *GetAttributesRequest) (*GetAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttributes not implemented")
+}
+func (UnimplementedNicheGitServer) GetBlame(context.Context, *GetBlameRequest) (*GetBlameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlame not implemented")
+}
+func (UnimplementedNicheGitServer) GetCommitGraph(context.Context, *GetCommitGraphRequest) (*GetCommitGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitGraph not implemented")
+}
+func (UnimplementedNicheGitServer) GetCommits(context.Context, *GetCommitsRequest) (*GetCommitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommits not implemented")
+}
+func (UnimplementedNicheGitServer) GetCommitsSinceSemverTag(context.Context, *GetCommitsSinceSemverTagRequest) (*GetCommitsSinceSemverTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitsSinceSemverTag not implemented")
+}
+func (UnimplementedNicheGitServer) GetFileHistory(context.Context, *GetFileHistoryRequest) (*GetFileHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileHistory not implemented")
+}
+func (UnimplementedNicheGitServer) GetFileOwners(context.Context, *GetFileOwnersRequest) (*GetFileOwnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileOwners not implemented")
+}
+func (UnimplementedNicheGitServer) GetImpactedServices(context.Context, *GetImpactedServicesRequest) (*GetImpactedServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImpactedServices not implemented")
+}
+func (UnimplementedNicheGitServer) GetLatestSemverTag(context.Context, *GetLatestSemverTagRequest) (*GetLatestSemverTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestSemverTag not implemented")
+}
+func (UnimplementedNicheGitServer) GetModifiedFiles(context.Context, *GetModifiedFilesRequest) (*GetModifiedFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModifiedFiles not implemented")
+}
+func (UnimplementedNicheGitServer) GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObject not implemented")
+}
+func (UnimplementedNicheGitServer) GetTree(context.Context, *GetTreeRequest) (*GetTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTree not implemented")
+}
+func (UnimplementedNicheGitServer) GetTreeStats(context.Context, *GetTreeStatsRequest) (*GetTreeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTreeStats not implemented")
+}
+func (UnimplementedNicheGitServer) HasObjects(context.Context, *HasObjectsRequest) (*HasObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasObjects not implemented")
+}
+func (UnimplementedNicheGitServer) LsRefs(context.Context, *LsRefsRequest) (*LsRefsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LsRefs not implemented")
+}
+func (UnimplementedNicheGitServer) MergeBranches(context.Context, *MergeBranchesRequest) (*MergeBranchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeBranches not implemented")
+}
+func (UnimplementedNicheGitServer) PathsExist(context.Context, *PathsExistRequest) (*PathsExistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PathsExist not implemented")
+}
+func (UnimplementedNicheGitServer) PushNextSemverTag(context.Context, *PushNextSemverTagRequest) (*PushNextSemverTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushNextSemverTag not implemented")
+}
+func (UnimplementedNicheGitServer) RebaseRefs(context.Context, *RebaseRefsRequest) (*RebaseRefsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebaseRefs not implemented")
+}
+func (UnimplementedNicheGitServer) ResolveConflicts(context.Context, *ResolveConflictsRequest) (*ResolveConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveConflicts not implemented")
+}
+func (UnimplementedNicheGitServer) RestoreRefs(context.Context, *RestoreRefsRequest) (*RestoreRefsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRefs not implemented")
+}
+func (UnimplementedNicheGitServer) Revert(context.Context, *RevertRequest) (*RevertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revert not implemented")
+}
+func (UnimplementedNicheGitServer) RevertMerge(context.Context, *RevertMergeRequest) (*RevertMergeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertMerge not implemented")
+}
+func (UnimplementedNicheGitServer) RewordCommits(context.Context, *RewordCommitsRequest) (*RewordCommitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewordCommits not implemented")
+}
+func (UnimplementedNicheGitServer) SnapshotRefs(context.Context, *SnapshotRefsRequest) (*SnapshotRefsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotRefs not implemented")
+}
+func (UnimplementedNicheGitServer) SquashCherryPick(context.Context, *SquashCherryPickRequest) (*SquashCherryPickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SquashCherryPick not implemented")
+}
+func (UnimplementedNicheGitServer) UpdateRefs(context.Context, *UpdateRefsRequest) (*UpdateRefsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRefs not implemented")
+}
+func (UnimplementedNicheGitServer) mustEmbedUnimplementedNicheGitServer() {}
+func (UnimplementedNicheGitServer) testEmbeddedByValue()                  {}
+
+// UnsafeNicheGitServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NicheGitServer will
+// result in compilation errors.
+type UnsafeNicheGitServer interface {
+	mustEmbedUnimplementedNicheGitServer()
+}
+
+func RegisterNicheGitServer(s grpc.ServiceRegistrar, srv NicheGitServer) {
+	// If the following call pancis, it indicates UnimplementedNicheGitServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NicheGit_ServiceDesc, srv)
+}
+
+func _NicheGit_CheckIgnored_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIgnoredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).CheckIgnored(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_CheckIgnored_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).CheckIgnored(ctx, req.(*CheckIgnoredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_CheckLinearHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckLinearHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).CheckLinearHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_CheckLinearHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).CheckLinearHistory(ctx, req.(*CheckLinearHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_CheckRemerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRemergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).CheckRemerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_CheckRemerge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).CheckRemerge(ctx, req.(*CheckRemergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_CherryPick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CherryPickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).CherryPick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_CherryPick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).CherryPick(ctx, req.(*CherryPickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_CleanupScratchRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupScratchRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).CleanupScratchRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_CleanupScratchRefs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).CleanupScratchRefs(ctx, req.(*CleanupScratchRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_CompareRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).CompareRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_CompareRefs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).CompareRefs(ctx, req.(*CompareRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_EmptyCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).EmptyCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_EmptyCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).EmptyCommit(ctx, req.(*EmptyCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GenerateChangelog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateChangelogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GenerateChangelog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GenerateChangelog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GenerateChangelog(ctx, req.(*GenerateChangelogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetAttributes(ctx, req.(*GetAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetBlame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetBlame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetBlame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetBlame(ctx, req.(*GetBlameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetCommitGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetCommitGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetCommitGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetCommitGraph(ctx, req.(*GetCommitGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetCommits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetCommits(ctx, req.(*GetCommitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetCommitsSinceSemverTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitsSinceSemverTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetCommitsSinceSemverTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetCommitsSinceSemverTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetCommitsSinceSemverTag(ctx, req.(*GetCommitsSinceSemverTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetFileHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetFileHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetFileHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetFileHistory(ctx, req.(*GetFileHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetFileOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileOwnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetFileOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetFileOwners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetFileOwners(ctx, req.(*GetFileOwnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetImpactedServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImpactedServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetImpactedServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetImpactedServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetImpactedServices(ctx, req.(*GetImpactedServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetLatestSemverTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestSemverTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetLatestSemverTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetLatestSemverTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetLatestSemverTag(ctx, req.(*GetLatestSemverTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetModifiedFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModifiedFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetModifiedFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetModifiedFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetModifiedFiles(ctx, req.(*GetModifiedFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetObject(ctx, req.(*GetObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetTree(ctx, req.(*GetTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_GetTreeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTreeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).GetTreeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_GetTreeStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).GetTreeStats(ctx, req.(*GetTreeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_HasObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).HasObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_HasObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).HasObjects(ctx, req.(*HasObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_LsRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LsRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).LsRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_LsRefs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).LsRefs(ctx, req.(*LsRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_MergeBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeBranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).MergeBranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_MergeBranches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).MergeBranches(ctx, req.(*MergeBranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_PathsExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PathsExistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).PathsExist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_PathsExist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).PathsExist(ctx, req.(*PathsExistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_PushNextSemverTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushNextSemverTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).PushNextSemverTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_PushNextSemverTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).PushNextSemverTag(ctx, req.(*PushNextSemverTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_RebaseRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebaseRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).RebaseRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_RebaseRefs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).RebaseRefs(ctx, req.(*RebaseRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_ResolveConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).ResolveConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_ResolveConflicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).ResolveConflicts(ctx, req.(*ResolveConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_RestoreRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).RestoreRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_RestoreRefs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).RestoreRefs(ctx, req.(*RestoreRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_Revert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).Revert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_Revert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).Revert(ctx, req.(*RevertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_RevertMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).RevertMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_RevertMerge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).RevertMerge(ctx, req.(*RevertMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_RewordCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewordCommitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).RewordCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_RewordCommits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).RewordCommits(ctx, req.(*RewordCommitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_SnapshotRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).SnapshotRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_SnapshotRefs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).SnapshotRefs(ctx, req.(*SnapshotRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_SquashCherryPick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquashCherryPickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).SquashCherryPick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_SquashCherryPick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).SquashCherryPick(ctx, req.(*SquashCherryPickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NicheGit_UpdateRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicheGitServer).UpdateRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicheGit_UpdateRefs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicheGitServer).UpdateRefs(ctx, req.(*UpdateRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NicheGit_ServiceDesc is the grpc.ServiceDesc for NicheGit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NicheGit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nichegit.v1.NicheGit",
+	HandlerType: (*NicheGitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckIgnored",
+			Handler:    _NicheGit_CheckIgnored_Handler,
+		},
+		{
+			MethodName: "CheckLinearHistory",
+			Handler:    _NicheGit_CheckLinearHistory_Handler,
+		},
+		{
+			MethodName: "CheckRemerge",
+			Handler:    _NicheGit_CheckRemerge_Handler,
+		},
+		{
+			MethodName: "CherryPick",
+			Handler:    _NicheGit_CherryPick_Handler,
+		},
+		{
+			MethodName: "CleanupScratchRefs",
+			Handler:    _NicheGit_CleanupScratchRefs_Handler,
+		},
+		{
+			MethodName: "CompareRefs",
+			Handler:    _NicheGit_CompareRefs_Handler,
+		},
+		{
+			MethodName: "EmptyCommit",
+			Handler:    _NicheGit_EmptyCommit_Handler,
+		},
+		{
+			MethodName: "GenerateChangelog",
+			Handler:    _NicheGit_GenerateChangelog_Handler,
+		},
+		{
+			MethodName: "GetAttributes",
+			Handler:    _NicheGit_GetAttributes_Handler,
+		},
+		{
+			MethodName: "GetBlame",
+			Handler:    _NicheGit_GetBlame_Handler,
+		},
+		{
+			MethodName: "GetCommitGraph",
+			Handler:    _NicheGit_GetCommitGraph_Handler,
+		},
+		{
+			MethodName: "GetCommits",
+			Handler:    _NicheGit_GetCommits_Handler,
+		},
+		{
+			MethodName: "GetCommitsSinceSemverTag",
+			Handler:    _NicheGit_GetCommitsSinceSemverTag_Handler,
+		},
+		{
+			MethodName: "GetFileHistory",
+			Handler:    _NicheGit_GetFileHistory_Handler,
+		},
+		{
+			MethodName: "GetFileOwners",
+			Handler:    _NicheGit_GetFileOwners_Handler,
+		},
+		{
+			MethodName: "GetImpactedServices",
+			Handler:    _NicheGit_GetImpactedServices_Handler,
+		},
+		{
+			MethodName: "GetLatestSemverTag",
+			Handler:    _NicheGit_GetLatestSemverTag_Handler,
+		},
+		{
+			MethodName: "GetModifiedFiles",
+			Handler:    _NicheGit_GetModifiedFiles_Handler,
+		},
+		{
+			MethodName: "GetObject",
+			Handler:    _NicheGit_GetObject_Handler,
+		},
+		{
+			MethodName: "GetTree",
+			Handler:    _NicheGit_GetTree_Handler,
+		},
+		{
+			MethodName: "GetTreeStats",
+			Handler:    _NicheGit_GetTreeStats_Handler,
+		},
+		{
+			MethodName: "HasObjects",
+			Handler:    _NicheGit_HasObjects_Handler,
+		},
+		{
+			MethodName: "LsRefs",
+			Handler:    _NicheGit_LsRefs_Handler,
+		},
+		{
+			MethodName: "MergeBranches",
+			Handler:    _NicheGit_MergeBranches_Handler,
+		},
+		{
+			MethodName: "PathsExist",
+			Handler:    _NicheGit_PathsExist_Handler,
+		},
+		{
+			MethodName: "PushNextSemverTag",
+			Handler:    _NicheGit_PushNextSemverTag_Handler,
+		},
+		{
+			MethodName: "RebaseRefs",
+			Handler:    _NicheGit_RebaseRefs_Handler,
+		},
+		{
+			MethodName: "ResolveConflicts",
+			Handler:    _NicheGit_ResolveConflicts_Handler,
+		},
+		{
+			MethodName: "RestoreRefs",
+			Handler:    _NicheGit_RestoreRefs_Handler,
+		},
+		{
+			MethodName: "Revert",
+			Handler:    _NicheGit_Revert_Handler,
+		},
+		{
+			MethodName: "RevertMerge",
+			Handler:    _NicheGit_RevertMerge_Handler,
+		},
+		{
+			MethodName: "RewordCommits",
+			Handler:    _NicheGit_RewordCommits_Handler,
+		},
+		{
+			MethodName: "SnapshotRefs",
+			Handler:    _NicheGit_SnapshotRefs_Handler,
+		},
+		{
+			MethodName: "SquashCherryPick",
+			Handler:    _NicheGit_SquashCherryPick_Handler,
+		},
+		{
+			MethodName: "UpdateRefs",
+			Handler:    _NicheGit_UpdateRefs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nichegit.proto",
+}
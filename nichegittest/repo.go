@@ -0,0 +1,157 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Package nichegittest provides utilities for testing the code that uses niche-git against a
+// real git repository.
+//
+// The utilities require the git command to be installed.
+package nichegittest
+
+import (
+	"bytes"
+	"crypto"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/hash"
+)
+
+// TempRepo is a temporary git repository for tests.
+//
+// It consists of a bare repository, which is served by Server, and a working tree cloned from
+// it. The working tree has the bare repository as "origin".
+type TempRepo struct {
+	t testing.TB
+
+	// BareDir is the path of the bare repository.
+	BareDir string
+	// Dir is the path of the working tree.
+	Dir string
+}
+
+// NewTempRepo creates a new temporary repository. The repository is removed when the test
+// finishes. The object format is the one of this build: sha256 with the sha256 build tag, and
+// sha1 otherwise.
+func NewTempRepo(t testing.TB) *TempRepo {
+	t.Helper()
+	return NewTempRepoWithObjectFormat(t, ObjectFormat())
+}
+
+// ObjectFormat returns the object format of the repositories that this build works with. go-git
+// fixes the hash algorithm at build time, and SHA-256 is enabled with the sha256 build tag.
+func ObjectFormat() string {
+	if hash.CryptoType == crypto.SHA256 {
+		return "sha256"
+	}
+	return "sha1"
+}
+
+// NewTempRepoWithObjectFormat is NewTempRepo with the object format (sha1 or sha256) of the
+// repositories. Empty means git's default.
+func NewTempRepoWithObjectFormat(t testing.TB, objectFormat string) *TempRepo {
+	t.Helper()
+	root := t.TempDir()
+	r := &TempRepo{
+		t:       t,
+		BareDir: filepath.Join(root, "repo.git"),
+		Dir:     filepath.Join(root, "work"),
+	}
+	initArgs := []string{"init", "--quiet", "--initial-branch=main"}
+	if objectFormat != "" {
+		initArgs = append(initArgs, "--object-format="+objectFormat)
+	}
+	r.run(root, append(initArgs, "--bare", r.BareDir)...)
+	r.run(r.BareDir, "config", "http.receivepack", "true")
+	r.run(r.BareDir, "config", "uploadpack.allowFilter", "true")
+	r.run(r.BareDir, "config", "uploadpack.allowAnySHA1InWant", "true")
+	r.run(root, append(initArgs, r.Dir)...)
+	r.Git("config", "user.name", "niche-git test")
+	r.Git("config", "user.email", "nichegittest@example.com")
+	r.Git("remote", "add", "origin", r.BareDir)
+	return r
+}
+
+// FileURL returns the file:// URL of the bare repository.
+func (r *TempRepo) FileURL() string {
+	return "file://" + filepath.ToSlash(r.BareDir)
+}
+
+// Git runs a git command in the working tree and returns the trimmed stdout. The test fails
+// if the command fails.
+func (r *TempRepo) Git(args ...string) string {
+	r.t.Helper()
+	return r.run(r.Dir, args...)
+}
+
+// CommitFile writes the file in the working tree and commits it. Returns the commit hash.
+func (r *TempRepo) CommitFile(pth, content, message string) plumbing.Hash {
+	r.t.Helper()
+	fpath := filepath.Join(r.Dir, filepath.FromSlash(pth))
+	if err := os.MkdirAll(filepath.Dir(fpath), 0755); err != nil {
+		r.t.Fatal(err)
+	}
+	if err := os.WriteFile(fpath, []byte(content), 0644); err != nil {
+		r.t.Fatal(err)
+	}
+	r.Git("add", pth)
+	r.Git("commit", "--quiet", "--message", message)
+	return r.RevParse("HEAD")
+}
+
+// RevParse returns the hash of the revision in the working tree.
+func (r *TempRepo) RevParse(rev string) plumbing.Hash {
+	r.t.Helper()
+	return plumbing.NewHash(r.Git("rev-parse", rev))
+}
+
+// Push pushes the refspecs from the working tree to the bare repository.
+func (r *TempRepo) Push(refspecs ...string) {
+	r.t.Helper()
+	r.Git(append([]string{"push", "--quiet", "--force", "origin"}, refspecs...)...)
+}
+
+// RemoteRefHash returns the hash of the ref in the bare repository, or ZeroHash if the ref
+// doesn't exist.
+func (r *TempRepo) RemoteRefHash(ref string) plumbing.Hash {
+	r.t.Helper()
+	out, err := r.output(r.BareDir, "rev-parse", "--verify", "--quiet", ref)
+	if err != nil {
+		return plumbing.ZeroHash
+	}
+	return plumbing.NewHash(out)
+}
+
+func (r *TempRepo) run(dir string, args ...string) string {
+	r.t.Helper()
+	out, err := r.output(dir, args...)
+	if err != nil {
+		r.t.Fatalf("git %s: %v", strings.Join(args, " "), err)
+	}
+	return out
+}
+
+func (r *TempRepo) output(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_CONFIG_NOSYSTEM=1", "GIT_TERMINAL_PROMPT=0")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", &gitError{err: err, stderr: stderr.String()}
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+type gitError struct {
+	err    error
+	stderr string
+}
+
+func (e *gitError) Error() string {
+	return e.err.Error() + ": " + strings.TrimSpace(e.stderr)
+}
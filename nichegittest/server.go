@@ -0,0 +1,193 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegittest
+
+import (
+	"net/http"
+	"net/http/cgi"
+	"net/http/httptest"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Server serves a TempRepo over the smart HTTP protocol with git-http-backend. Both
+// upload-pack (fetch) and receive-pack (push) are available.
+//
+// Faults can be injected to the responses with InjectFault and RequireBasicAuth.
+type Server struct {
+	*httptest.Server
+
+	backend string
+	repo    *TempRepo
+
+	mu           sync.Mutex
+	faults       []*Fault
+	authUser     string
+	authPassword string
+}
+
+// Fault is a failure injected to the server responses.
+type Fault struct {
+	// Match, if set, limits the fault to the requests that it returns true for. See
+	// MatchUploadPack and MatchReceivePack.
+	Match func(*http.Request) bool
+	// Times, if positive, limits the fault to the first N matching requests.
+	Times int
+
+	// Delay delays the response.
+	Delay time.Duration
+	// StatusCode, if non-zero, makes the server respond with the status code without serving
+	// the request. RetryAfter is sent as the Retry-After header if set.
+	StatusCode int
+	RetryAfter string
+	// StatusAfterServing makes the server serve the request and discard the response before
+	// responding with StatusCode, like a server that applies a push but fails to respond.
+	StatusAfterServing bool
+	// TruncateAfter, if positive, cuts the response body after this many bytes.
+	TruncateAfter int
+
+	applied int
+}
+
+// MatchUploadPack matches the fetch and ls-refs requests.
+func MatchUploadPack(r *http.Request) bool {
+	return strings.HasSuffix(r.URL.Path, "/git-upload-pack") || r.URL.Query().Get("service") == "git-upload-pack"
+}
+
+// MatchReceivePack matches the push requests.
+func MatchReceivePack(r *http.Request) bool {
+	return strings.HasSuffix(r.URL.Path, "/git-receive-pack") || r.URL.Query().Get("service") == "git-receive-pack"
+}
+
+// NewServer starts a server for the repository. The server is closed when the test finishes.
+func NewServer(t testing.TB, repo *TempRepo) *Server {
+	t.Helper()
+	execPath, err := exec.Command("git", "--exec-path").Output()
+	if err != nil {
+		t.Fatalf("cannot find the git exec path: %v", err)
+	}
+	s := &Server{
+		backend: filepath.Join(strings.TrimSpace(string(execPath)), "git-http-backend"),
+		repo:    repo,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// RepoURL returns the URL of the served repository.
+func (s *Server) RepoURL() string {
+	return s.URL + "/repo.git"
+}
+
+// InjectFault adds a fault. When multiple faults match a request, the first one added is used.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all the injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// RequireBasicAuth makes the server respond with an authentication challenge (401) unless the
+// request has the given HTTP Basic credentials.
+func (s *Server) RequireBasicAuth(user, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.authUser = user
+	s.authPassword = password
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	fault, authUser, authPassword := s.takeFault(r)
+	if authUser != "" {
+		if user, password, ok := r.BasicAuth(); !ok || user != authUser || password != authPassword {
+			w.Header().Set("WWW-Authenticate", `Basic realm="nichegittest"`)
+			http.Error(w, "authentication required", http.StatusUnauthorized)
+			return
+		}
+	}
+	if fault != nil {
+		if fault.Delay > 0 {
+			select {
+			case <-time.After(fault.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault.StatusCode != 0 {
+			if fault.StatusAfterServing {
+				s.serveGit(httptest.NewRecorder(), r)
+			}
+			if fault.RetryAfter != "" {
+				w.Header().Set("Retry-After", fault.RetryAfter)
+			}
+			http.Error(w, http.StatusText(fault.StatusCode), fault.StatusCode)
+			return
+		}
+		if fault.TruncateAfter > 0 {
+			w = &truncatingResponseWriter{ResponseWriter: w, remaining: fault.TruncateAfter}
+		}
+	}
+	s.serveGit(w, r)
+}
+
+// serveGit serves the request with git http-backend.
+func (s *Server) serveGit(w http.ResponseWriter, r *http.Request) {
+	h := &cgi.Handler{
+		Path: s.backend,
+		Env: []string{
+			"GIT_PROJECT_ROOT=" + filepath.Dir(s.repo.BareDir),
+			"GIT_HTTP_EXPORT_ALL=1",
+			"GIT_CONFIG_NOSYSTEM=1",
+			// Needed to allow receive-pack.
+			"REMOTE_USER=nichegittest",
+			"GIT_PROTOCOL=" + r.Header.Get("Git-Protocol"),
+		},
+	}
+	h.ServeHTTP(w, r)
+}
+
+func (s *Server) takeFault(r *http.Request) (*Fault, string, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range s.faults {
+		if f.Match != nil && !f.Match(r) {
+			continue
+		}
+		if f.Times > 0 && f.applied >= f.Times {
+			continue
+		}
+		f.applied++
+		return f, s.authUser, s.authPassword
+	}
+	return nil, s.authUser, s.authPassword
+}
+
+type truncatingResponseWriter struct {
+	http.ResponseWriter
+	remaining int
+}
+
+func (w *truncatingResponseWriter) Write(bs []byte) (int, error) {
+	n := len(bs)
+	if len(bs) > w.remaining {
+		bs = bs[:w.remaining]
+	}
+	w.remaining -= len(bs)
+	if _, err := w.ResponseWriter.Write(bs); err != nil {
+		return 0, err
+	}
+	// Pretend the whole data is written so that the handler doesn't fail early.
+	return n, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegittest_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	nichegit "github.com/aviator-co/niche-git"
+	"github.com/aviator-co/niche-git/nichegittest"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestServer_Faults(t *testing.T) {
+	repo := nichegittest.NewTempRepo(t)
+	head := repo.CommitFile("file.txt", "content\n", "initial")
+	repo.Push("main")
+	server := nichegittest.NewServer(t, repo)
+	fetchCommits := func(client *http.Client) error {
+		_, _, err := nichegit.FetchCommits(server.RepoURL(), client, nichegit.FetchCommitsArgs{
+			WantCommitHashes: []plumbing.Hash{head},
+		})
+		return err
+	}
+
+	if err := fetchCommits(&http.Client{}); err != nil {
+		t.Fatalf("fetch without faults failed: %v", err)
+	}
+
+	server.InjectFault(nichegittest.Fault{Match: nichegittest.MatchUploadPack, Times: 1, StatusCode: http.StatusTooManyRequests})
+	if err := fetchCommits(&http.Client{}); err == nil || !strings.Contains(err.Error(), "429") {
+		t.Errorf("want a 429 error, got %v", err)
+	}
+	if err := fetchCommits(&http.Client{}); err != nil {
+		t.Errorf("the fault should be applied only once, got %v", err)
+	}
+
+	server.InjectFault(nichegittest.Fault{Match: nichegittest.MatchUploadPack, Times: 1, TruncateAfter: 100})
+	if err := fetchCommits(&http.Client{}); err == nil {
+		t.Error("want an error for a truncated response")
+	}
+
+	server.RequireBasicAuth("user", "password")
+	if err := fetchCommits(&http.Client{}); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("want a 401 error, got %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+type GetObjectArgs struct {
+	Hash plumbing.Hash
+	// Pretty formats the content like git cat-file -p. This changes only the trees, which are
+	// listed as "MODE TYPE HASH\tNAME" lines like git ls-tree. The other objects are the same as
+	// the raw content.
+	Pretty bool
+}
+
+type GetObjectResult struct {
+	Hash plumbing.Hash
+	// Type is the object type: commit, tree, blob, or tag.
+	Type plumbing.ObjectType
+	// Size is the size of the raw content, like git cat-file -s.
+	Size int64
+	// Content is the raw content of the object, or the pretty-printed one if Pretty is set.
+	Content []byte
+}
+
+// GetObject returns a single object, like git cat-file. The object is fetched without the
+// history and, for a commit or a tag, without the trees and the blobs.
+func GetObject(repoURL string, client *http.Client, args GetObjectArgs) (*GetObjectResult, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "GetObject", args, func() (*GetObjectResult, debug.FetchDebugInfo, error) {
+		return getObject(repoURL, client, args)
+	})
+}
+
+func getObject(repoURL string, client *http.Client, args GetObjectArgs) (*GetObjectResult, debug.FetchDebugInfo, error) {
+	// The wanted object is sent regardless of the filter.
+	pack, debugInfo, err := fetch.FetchCommitOnlyHistoryPackfile(repoURL, client, []plumbing.Hash{args.Hash}, nil, nil, fetch.FetchOptions{Depth: 1})
+	defer pack.Close()
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	defer storage.Close()
+	if err := parseFetchedPackfile(storage, pack, &debugInfo); err != nil {
+		return nil, debugInfo, err
+	}
+	obj, err := storage.EncodedObject(plumbing.AnyObject, args.Hash)
+	if err != nil {
+		return nil, debugInfo, fmt.Errorf("cannot find %q in the fetched packfile: %w", args.Hash.String(), err)
+	}
+	rd, err := obj.Reader()
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	defer rd.Close()
+	content, err := io.ReadAll(rd)
+	if err != nil {
+		return nil, debugInfo, fmt.Errorf("cannot read %q: %w", args.Hash.String(), err)
+	}
+	result := &GetObjectResult{
+		Hash:    args.Hash,
+		Type:    obj.Type(),
+		Size:    obj.Size(),
+		Content: content,
+	}
+	if args.Pretty && obj.Type() == plumbing.TreeObject {
+		tree, err := object.DecodeTree(storage, obj)
+		if err != nil {
+			return nil, debugInfo, fmt.Errorf("cannot decode the tree %q: %w", args.Hash.String(), err)
+		}
+		result.Content = prettyTree(tree)
+	}
+	return result, debugInfo, nil
+}
+
+// prettyTree formats the tree entries like git cat-file -p.
+func prettyTree(tree *object.Tree) []byte {
+	var buf bytes.Buffer
+	for _, entry := range tree.Entries {
+		typ := plumbing.BlobObject
+		switch {
+		case entry.Mode == filemode.Dir:
+			typ = plumbing.TreeObject
+		case entry.Mode == filemode.Submodule:
+			typ = plumbing.CommitObject
+		}
+		fmt.Fprintf(&buf, "%06o %s %s\t%s\n", uint32(entry.Mode), typ, entry.Hash, entry.Name)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,335 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"container/list"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// objectCache is a process-wide cache of the commits and the trees fetched without blobs, keyed
+// by the repository URL, the CacheScope of the client, and the object hash. The objects are
+// immutable, but the repository and the credentials are part of the key so that an operation
+// never sees the objects that its credentials might not have access to.
+var objectCache = &objCache{entries: map[objCacheKey]*list.Element{}, lru: list.New()}
+
+type objCache struct {
+	mu      sync.Mutex
+	maxSize int64
+	size    int64
+	// lru has the entries in the order of the last use. The front is the most recent one.
+	lru     *list.List
+	entries map[objCacheKey]*list.Element
+}
+
+type objCacheKey struct {
+	repo objCacheRepo
+	hash plumbing.Hash
+}
+
+// objCacheRepo is the repository URL and the identity of the credentials that the objects are
+// fetched with.
+type objCacheRepo struct {
+	url   string
+	scope string
+}
+
+type objCacheEntry struct {
+	key     objCacheKey
+	typ     plumbing.ObjectType
+	content []byte
+}
+
+// SetObjectCacheSize enables caching the commits and the trees in the process up to the size in
+// bytes, so that repeated operations on the same commits don't fetch them again. The least
+// recently used objects are evicted beyond the size. Zero disables the cache and drops the cached
+// objects, which is the default.
+//
+// The objects are shared only among the clients with the same Session.SetCacheScope. Set it for
+// each set of the credentials if the process serves multiple callers.
+func SetObjectCacheSize(size int64) {
+	objectCache.mu.Lock()
+	defer objectCache.mu.Unlock()
+	objectCache.maxSize = size
+	objectCache.evict()
+}
+
+func (c *objCache) enabled() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.maxSize > 0
+}
+
+func (c *objCache) get(repo objCacheRepo, hash plumbing.Hash) (*objCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[objCacheKey{repo, hash}]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*objCacheEntry), true
+}
+
+func (c *objCache) add(repo objCacheRepo, obj plumbing.EncodedObject) error {
+	key := objCacheKey{repo, obj.Hash()}
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		c.mu.Unlock()
+		return nil
+	}
+	c.mu.Unlock()
+
+	rd, err := obj.Reader()
+	if err != nil {
+		return err
+	}
+	defer rd.Close()
+	content, err := io.ReadAll(rd)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; ok || int64(len(content)) > c.maxSize {
+		return nil
+	}
+	c.entries[key] = c.lru.PushFront(&objCacheEntry{key: key, typ: obj.Type(), content: content})
+	c.size += int64(len(content))
+	c.evict()
+	return nil
+}
+
+// evict drops the least recently used entries beyond the size. c.mu must be held.
+func (c *objCache) evict() {
+	for c.size > c.maxSize && c.lru.Len() > 0 {
+		entry := c.lru.Remove(c.lru.Back()).(*objCacheEntry)
+		delete(c.entries, entry.key)
+		c.size -= int64(len(entry.content))
+	}
+}
+
+// load stores the commits and all the trees under them into the storage if they are all cached.
+// The storage is not changed if any of them is missing.
+func (c *objCache) load(repo objCacheRepo, storage *objectStorage, commitHashes []plumbing.Hash) bool {
+	entries, ok := c.collect(repo, commitHashes, nil)
+	if !ok {
+		return false
+	}
+	for _, entry := range entries {
+		if _, err := storage.SetEncodedObject(entry.encodedObject()); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// collect returns the cached entries of the commits and all the trees under them, or false if
+// any of them is missing. The trees in complete are known to be cached with all the trees under
+// them, so they are skipped.
+func (c *objCache) collect(repo objCacheRepo, commitHashes []plumbing.Hash, complete map[plumbing.Hash]bool) ([]*objCacheEntry, bool) {
+	var entries []*objCacheEntry
+	visited := map[plumbing.Hash]bool{}
+	var walkTree func(hash plumbing.Hash) bool
+	walkTree = func(hash plumbing.Hash) bool {
+		if visited[hash] || complete[hash] {
+			return true
+		}
+		visited[hash] = true
+		entry, ok := c.get(repo, hash)
+		if !ok || entry.typ != plumbing.TreeObject {
+			return false
+		}
+		entries = append(entries, entry)
+		tree := &object.Tree{}
+		if err := tree.Decode(entry.encodedObject()); err != nil {
+			return false
+		}
+		for _, e := range tree.Entries {
+			if e.Mode == filemode.Dir && !walkTree(e.Hash) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, hash := range commitHashes {
+		if visited[hash] {
+			continue
+		}
+		visited[hash] = true
+		entry, ok := c.get(repo, hash)
+		if !ok || entry.typ != plumbing.CommitObject {
+			return nil, false
+		}
+		entries = append(entries, entry)
+		commit := &object.Commit{}
+		if err := commit.Decode(entry.encodedObject()); err != nil {
+			return nil, false
+		}
+		if !walkTree(commit.TreeHash) {
+			return nil, false
+		}
+	}
+	return entries, true
+}
+
+// completeCommits returns the cached commits of the repository whose trees are all cached, most
+// recently used first, up to max. These can be offered to the server as the haves.
+func (c *objCache) completeCommits(repo objCacheRepo, max int) []plumbing.Hash {
+	var candidates []plumbing.Hash
+	c.mu.Lock()
+	for elem := c.lru.Front(); elem != nil; elem = elem.Next() {
+		entry := elem.Value.(*objCacheEntry)
+		if entry.key.repo == repo && entry.typ == plumbing.CommitObject {
+			candidates = append(candidates, entry.key.hash)
+		}
+	}
+	c.mu.Unlock()
+
+	var ret []plumbing.Hash
+	// The trees shared among the commits are walked once.
+	complete := map[plumbing.Hash]bool{}
+	for _, hash := range candidates {
+		if len(ret) >= max {
+			break
+		}
+		entries, ok := c.collect(repo, []plumbing.Hash{hash}, complete)
+		if !ok {
+			continue
+		}
+		for _, entry := range entries {
+			complete[entry.key.hash] = true
+		}
+		ret = append(ret, hash)
+	}
+	return ret
+}
+
+func (e *objCacheEntry) encodedObject() plumbing.EncodedObject {
+	obj := &plumbing.MemoryObject{}
+	obj.SetType(e.typ)
+	// MemoryObject's Write doesn't fail.
+	_, _ = obj.Write(e.content)
+	return obj
+}
+
+// fetchBlobNone fetches the commits and their trees without blobs into the storage, and adds the
+// fetch to debugInfo. If the object cache is enabled, the cached objects are used instead if all
+// of them are cached, and the fetched ones are added to the cache. Otherwise, the cached commits
+// are offered as the haves, so that the trees shared with them are loaded from the cache instead
+// of being fetched again.
+func fetchBlobNone(repoURL string, client *http.Client, storage *objectStorage, commitHashes []plumbing.Hash, debugInfo *debug.FetchDebugInfo) error {
+	cacheEnabled := objectCache.enabled()
+	cacheRepo := objCacheRepo{url: repoURL, scope: fetch.CacheScope(client)}
+	var haves []plumbing.Hash
+	if cacheEnabled {
+		numObjects := storage.numObjects()
+		if objectCache.load(cacheRepo, storage, commitHashes) {
+			debugInfo.CachedObjects += storage.numObjects() - numObjects
+			return nil
+		}
+		haves = objectCache.completeCommits(cacheRepo, fetch.MaxNegotiationHaves)
+	}
+
+	pack, common, err := fetchBlobNonePackfile(repoURL, client, commitHashes, haves, debugInfo)
+	defer pack.Close()
+	if err != nil {
+		return err
+	}
+	complete := true
+	if len(common) > 0 {
+		// The packfile can have deltas against the trees of the acknowledged haves.
+		numObjects := storage.numObjects()
+		complete = objectCache.load(cacheRepo, storage, common)
+		debugInfo.CachedObjects += storage.numObjects() - numObjects
+	}
+	if complete {
+		if err := parseFetchedPackfile(storage, pack, debugInfo); err != nil {
+			return err
+		}
+		// The server doesn't send the wants reachable from the haves either.
+		complete = len(common) == 0 || hasTrees(storage, commitHashes)
+	}
+	if !complete {
+		debugInfo.Warnings = append(debugInfo.Warnings, "the objects left out of the packfile for the cached haves are not all available; fetched them again without the haves")
+		pack, _, err := fetchBlobNonePackfile(repoURL, client, commitHashes, nil, debugInfo)
+		defer pack.Close()
+		if err != nil {
+			return err
+		}
+		if err := parseFetchedPackfile(storage, pack, debugInfo); err != nil {
+			return err
+		}
+	}
+	if !cacheEnabled {
+		return nil
+	}
+	for _, typ := range []plumbing.ObjectType{plumbing.CommitObject, plumbing.TreeObject} {
+		for _, hash := range storage.hashes(typ) {
+			obj, err := storage.EncodedObject(typ, hash)
+			if err != nil {
+				return err
+			}
+			if err := objectCache.add(cacheRepo, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fetchBlobNonePackfile fetches the commits and their trees without blobs, negotiating the haves
+// with the server, and adds the fetch to debugInfo. It returns the haves that the server
+// acknowledged, whose trees are left out of the packfile.
+func fetchBlobNonePackfile(repoURL string, client *http.Client, commitHashes, haves []plumbing.Hash, debugInfo *debug.FetchDebugInfo) (*fetch.Packfile, []plumbing.Hash, error) {
+	pack, common, packDebugInfo, err := fetch.NegotiateBlobNonePackfile(repoURL, client, commitHashes, haves)
+	debugInfo.ResponseHeaders = packDebugInfo.ResponseHeaders
+	debugInfo.PackfileSize += packDebugInfo.PackfileSize
+	if packDebugInfo.Fallback != "" {
+		debugInfo.Fallback = packDebugInfo.Fallback
+	}
+	debugInfo.Spooled = debugInfo.Spooled || packDebugInfo.Spooled
+	debugInfo.NegotiationRounds += packDebugInfo.NegotiationRounds
+	debugInfo.CommonHaves += packDebugInfo.CommonHaves
+	return pack, common, err
+}
+
+// hasTrees returns true if the storage has the commits and all the trees under them.
+func hasTrees(storage *objectStorage, commitHashes []plumbing.Hash) bool {
+	visited := map[plumbing.Hash]bool{}
+	var walkTree func(hash plumbing.Hash) bool
+	walkTree = func(hash plumbing.Hash) bool {
+		if visited[hash] {
+			return true
+		}
+		visited[hash] = true
+		tree, err := object.GetTree(storage, hash)
+		if err != nil {
+			return false
+		}
+		for _, e := range tree.Entries {
+			if e.Mode == filemode.Dir && !walkTree(e.Hash) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, hash := range commitHashes {
+		commit, err := object.GetCommit(storage, hash)
+		if err != nil || !walkTree(commit.TreeHash) {
+			return false
+		}
+	}
+	return true
+}
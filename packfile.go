@@ -0,0 +1,194 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/packfile"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// parsePackfile parses the packfile and stores the objects into the storage. If debugInfo is not
+// nil, the parsed objects are counted into it, and a warning is added if the packfile has objects
+// that are already in the storage, i.e. fetched more than once in the operation.
+func parsePackfile(storage *objectStorage, rd io.Reader, debugInfo *debug.FetchDebugInfo) error {
+	observer := &objectCollector{progress: storage.progress}
+	numObjects := storage.numObjects()
+	parser, err := packfile.NewParserWithStorage(packfile.NewScanner(rd), storage, observer)
+	if err != nil {
+		return fmt.Errorf("failed to parse packfile: %w", err)
+	}
+	if _, err := parser.Parse(); err != nil {
+		return fmt.Errorf("failed to parse packfile: %w", err)
+	}
+	if debugInfo == nil {
+		return nil
+	}
+	for _, hash := range observer.hashes {
+		obj, err := storage.EncodedObject(plumbing.AnyObject, hash)
+		if err != nil {
+			return fmt.Errorf("cannot find %q in the parsed packfile: %w", hash, err)
+		}
+		debugInfo.ObjectStats.Add(obj.Type(), obj.Size())
+	}
+	if duplicates := len(observer.hashes) - (storage.numObjects() - numObjects); duplicates > 0 {
+		debugInfo.ObjectStats.Duplicates += duplicates
+		debugInfo.Warnings = append(debugInfo.Warnings, fmt.Sprintf("%d objects were fetched more than once", duplicates))
+	}
+	return nil
+}
+
+// parseFetchedPackfile parses the fetched packfile, after the packfiles that the server offloaded
+// to the packfile URIs.
+func parseFetchedPackfile(storage *objectStorage, pack *fetch.Packfile, debugInfo *debug.FetchDebugInfo) error {
+	for _, uriPack := range pack.URIPacks() {
+		if err := parsePackfile(storage, uriPack.Reader(), debugInfo); err != nil {
+			return err
+		}
+	}
+	if debugInfo != nil {
+		debugInfo.PackfileURIs += len(pack.URIPacks())
+	}
+	return parsePackfile(storage, pack.Reader(), debugInfo)
+}
+
+// objectCollector is a packfile.Observer that collects the hashes of the parsed objects. It
+// reports the progress to the progress function of the storage.
+type objectCollector struct {
+	hashes   []plumbing.Hash
+	total    int
+	progress FetchProgressFunc
+}
+
+func (c *objectCollector) OnHeader(count uint32) error {
+	c.hashes = make([]plumbing.Hash, 0, count)
+	c.total = int(count)
+	return nil
+}
+
+func (c *objectCollector) OnInflatedObjectHeader(t plumbing.ObjectType, objSize int64, pos int64) error {
+	return nil
+}
+
+func (c *objectCollector) OnInflatedObjectContent(h plumbing.Hash, pos int64, crc uint32, content []byte) error {
+	c.hashes = append(c.hashes, h)
+	if f := c.progress; f != nil {
+		f(FetchProgress{Phase: FetchPhaseIndexing, IndexedObjects: len(c.hashes), TotalObjects: c.total})
+	}
+	return nil
+}
+
+func (c *objectCollector) OnFooter(h plumbing.Hash) error {
+	return nil
+}
+
+// SetPackfileSpoolThreshold sets the default packfile size in bytes beyond which a fetched
+// packfile is spooled to a temporary file instead of being kept in memory.
+// SessionOptions.PackfileSpoolThreshold overrides it. Zero disables spooling, which is the
+// default.
+func SetPackfileSpoolThreshold(threshold int64) {
+	fetch.SpoolThreshold = threshold
+}
+
+// SetPackfileURIProtocols sets the URI protocols (e.g. "https") of the packfiles that the server
+// can offload to the packfile URIs, usually on a CDN, instead of sending them in the fetch
+// responses. The packfiles are downloaded with the client of the operation, and parsed together
+// with the response. Empty disables it, which is the default. Only set it for the servers that
+// advertise packfile-uris, as the others reject the fetches. This is the default of the process,
+// and SessionOptions.PackfileURIProtocols overrides it.
+func SetPackfileURIProtocols(protocols []string) {
+	fetch.PackfileURIProtocols = protocols
+}
+
+// IsPackfileURIRequest returns true if the request downloads a packfile URI. The custom
+// transports can use it to not send the credentials of the repository to the CDN.
+func IsPackfileURIRequest(req *http.Request) bool {
+	return fetch.IsPackfileURIRequest(req)
+}
+
+// PackOptions are the options of the packfile that an operation pushes.
+type PackOptions struct {
+	// DeltaCompression encodes the new trees and blobs as deltas to the ones at the same paths
+	// in the parent commit when that is smaller, like git push. Only the parent's objects that
+	// the operation has fetched are used as the bases.
+	DeltaCompression bool
+	// CompressionLevel is the zlib compression level from 1 (fastest) to 9 (smallest). Zero
+	// uses the zlib default.
+	CompressionLevel int
+}
+
+// referencedNewHashes returns the objects among the candidates that the tree references, including
+// the tree itself, in the order of the candidates. The objects created while building the tree but
+// not left in it, e.g. the intermediate trees, are dropped so that they are not pushed. The trees
+// that are not candidates are on the server with all their contents, so they are not walked into.
+func referencedNewHashes(storage *objectStorage, treeHash plumbing.Hash, candidates []plumbing.Hash) ([]plumbing.Hash, error) {
+	isCandidate := map[plumbing.Hash]bool{}
+	for _, hash := range candidates {
+		isCandidate[hash] = true
+	}
+	referenced := map[plumbing.Hash]bool{}
+	queue := []plumbing.Hash{treeHash}
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+		if !isCandidate[hash] || referenced[hash] {
+			continue
+		}
+		referenced[hash] = true
+		if !storage.has(plumbing.TreeObject, hash) {
+			continue
+		}
+		tree, err := object.GetTree(storage, hash)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get the created tree %q: %w", hash.String(), err)
+		}
+		for _, entry := range tree.Entries {
+			queue = append(queue, entry.Hash)
+		}
+	}
+	var ret []plumbing.Hash
+	for _, hash := range candidates {
+		if referenced[hash] {
+			ret = append(ret, hash)
+			delete(referenced, hash)
+		}
+	}
+	return ret, nil
+}
+
+// encodePushPackfile encodes the objects to push into a packfile.
+func encodePushPackfile(storage *objectStorage, hashes []plumbing.Hash, opts PackOptions) (*bytes.Buffer, error) {
+	if opts.CompressionLevel < 0 || opts.CompressionLevel > zlib.BestCompression {
+		return nil, fmt.Errorf("invalid compression level %d", opts.CompressionLevel)
+	}
+	if !opts.DeltaCompression && opts.CompressionLevel == 0 {
+		var buf bytes.Buffer
+		if _, err := packfile.NewEncoder(&buf, storage, false).Encode(hashes, 0); err != nil {
+			return nil, fmt.Errorf("failed to create a packfile: %w", err)
+		}
+		return &buf, nil
+	}
+	level := opts.CompressionLevel
+	if level == 0 {
+		level = zlib.DefaultCompression
+	}
+	var deltaBases map[plumbing.Hash]plumbing.Hash
+	if opts.DeltaCompression {
+		deltaBases = push.DeltaBases(storage, hashes)
+	}
+	buf, err := push.EncodePackfile(storage, hashes, deltaBases, level)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a packfile: %w", err)
+	}
+	return buf, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+type PathExistence struct {
+	Path   string `json:"path"`
+	Exists bool   `json:"exists"`
+	// Type is the object type of the entry, same as git ls-tree: "blob", "tree", or "commit"
+	// for a submodule. Empty if the path doesn't exist.
+	Type string `json:"type,omitempty"`
+	// Mode is the file mode of the entry in octal (e.g. 100644). Empty if the path doesn't
+	// exist.
+	Mode string `json:"mode,omitempty"`
+	// Hash is the object hash of the entry. Empty if the path doesn't exist.
+	Hash string `json:"hash,omitempty"`
+}
+
+// PathsExist returns whether the paths exist in the commit and their entry types.
+//
+// Only the trees on the way to the paths are fetched, level by level. Each tree is fetched once
+// even if it's shared by multiple paths, and the number of requests is the depth of the deepest
+// path plus one.
+func PathsExist(repoURL string, client *http.Client, commitHash plumbing.Hash, paths []string) ([]*PathExistence, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "PathsExist", struct {
+		CommitHash plumbing.Hash
+		Paths      []string
+	}{commitHash, paths}, func() ([]*PathExistence, debug.FetchDebugInfo, error) {
+		return pathsExist(repoURL, client, commitHash, paths)
+	})
+}
+
+func pathsExist(repoURL string, client *http.Client, commitHash plumbing.Hash, paths []string) ([]*PathExistence, debug.FetchDebugInfo, error) {
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, err
+	}
+	defer storage.Close()
+	debugInfo, err := fetchTreeOnly(repoURL, client, storage, []plumbing.Hash{commitHash}, debug.FetchDebugInfo{})
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	commit, err := object.GetCommit(storage, commitHash)
+	if err != nil {
+		return nil, debugInfo, fmt.Errorf("cannot find %q in the fetched packfile: %w", commitHash.String(), err)
+	}
+
+	segments := make([][]string, len(paths))
+	for i, pth := range paths {
+		segments[i] = strings.Split(strings.Trim(path.Clean(pth), "/"), "/")
+	}
+	// Each path walks down from the root tree. cursors[i] is the tree to look up segments[i][depth].
+	cursors := make([]plumbing.Hash, len(paths))
+	entries := make([]*object.TreeEntry, len(paths))
+	for i := range paths {
+		cursors[i] = commit.TreeHash
+	}
+	for depth := 0; ; depth++ {
+		var missing []plumbing.Hash
+		seen := map[plumbing.Hash]bool{}
+		for i := range paths {
+			if cursors[i].IsZero() || depth >= len(segments[i]) {
+				continue
+			}
+			if _, err := storage.EncodedObject(plumbing.TreeObject, cursors[i]); err != nil && !seen[cursors[i]] {
+				seen[cursors[i]] = true
+				missing = append(missing, cursors[i])
+			}
+		}
+		if len(missing) > 0 {
+			debugInfo, err = fetchTreeOnly(repoURL, client, storage, missing, debugInfo)
+			if err != nil {
+				return nil, debugInfo, err
+			}
+		}
+
+		walking := false
+		for i := range paths {
+			if cursors[i].IsZero() || depth >= len(segments[i]) {
+				continue
+			}
+			tree, err := object.GetTree(storage, cursors[i])
+			if err != nil {
+				return nil, debugInfo, fmt.Errorf("cannot find the tree of %q: %w", path.Join(segments[i][:depth]...), err)
+			}
+			entry, err := tree.FindEntry(segments[i][depth])
+			if err != nil {
+				cursors[i] = plumbing.ZeroHash
+				continue
+			}
+			if depth == len(segments[i])-1 {
+				entries[i] = entry
+				cursors[i] = plumbing.ZeroHash
+				continue
+			}
+			if entry.Mode.IsFile() || entry.Mode == filemode.Submodule {
+				// A file cannot have children.
+				cursors[i] = plumbing.ZeroHash
+				continue
+			}
+			cursors[i] = entry.Hash
+			walking = true
+		}
+		if !walking {
+			break
+		}
+	}
+
+	var ret []*PathExistence
+	for i, pth := range paths {
+		pe := &PathExistence{Path: pth}
+		if entry := entries[i]; entry != nil {
+			pe.Exists = true
+			pe.Mode = fmt.Sprintf("%06o", uint32(entry.Mode))
+			pe.Hash = entry.Hash.String()
+			switch {
+			case entry.Mode == filemode.Submodule:
+				pe.Type = plumbing.CommitObject.String()
+			case entry.Mode.IsFile():
+				pe.Type = plumbing.BlobObject.String()
+			default:
+				pe.Type = plumbing.TreeObject.String()
+			}
+		}
+		ret = append(ret, pe)
+	}
+	return ret, debugInfo, nil
+}
+
+// fetchTreeOnly fetches the objects with fetch.FetchTreeOnlyPackfile into the storage, and adds
+// the debug info to the one given.
+func fetchTreeOnly(repoURL string, client *http.Client, storage *objectStorage, oids []plumbing.Hash, debugInfo debug.FetchDebugInfo) (debug.FetchDebugInfo, error) {
+	pack, packDebugInfo, err := fetch.FetchTreeOnlyPackfile(repoURL, client, oids)
+	defer pack.Close()
+	debugInfo.ResponseHeaders = packDebugInfo.ResponseHeaders
+	debugInfo.PackfileSize += packDebugInfo.PackfileSize
+	if packDebugInfo.Fallback != "" {
+		debugInfo.Fallback = packDebugInfo.Fallback
+	}
+	debugInfo.Spooled = debugInfo.Spooled || packDebugInfo.Spooled
+	if err != nil {
+		return debugInfo, err
+	}
+	if err := parseFetchedPackfile(storage, pack, &debugInfo); err != nil {
+		return debugInfo, err
+	}
+	return debugInfo, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"bytes"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/merge"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// DefaultMaxMatches is the number of the matches reported per side of a file if not specified.
+const DefaultMaxMatches = 100
+
+// FileMatches are the matches of a pattern in a modified file before and after the change.
+type FileMatches struct {
+	Path string `json:"path"`
+	// Before are the matches in the file of CommitHash1. Empty if the file is added.
+	Before []*PatternMatch `json:"before"`
+	// After are the matches in the file of CommitHash2. Empty if the file is deleted.
+	After []*PatternMatch `json:"after"`
+	// Truncated is true if either side has more matches than MaxMatches.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// PatternMatch is a match of a pattern in a file.
+type PatternMatch struct {
+	// Line is the 1-based line number where the match starts.
+	Line int `json:"line"`
+	// Text is the matched text.
+	Text string `json:"text"`
+	// Groups are the capture groups in order. An unmatched group is an empty string.
+	Groups []string `json:"groups,omitempty"`
+	// NamedGroups are the named capture groups, e.g. (?P<id>[0-9]+).
+	NamedGroups map[string]string `json:"namedGroups,omitempty"`
+}
+
+// findPatternMatches returns the matches of the pattern in the files before and after the change.
+// The files without matches on both sides, the binary files, and the submodules are omitted. The
+// blobs of the files are fetched.
+func findPatternMatches(repoURL string, client *http.Client, storage *objectStorage, tree1, tree2 *object.Tree, paths []string, pattern *regexp.Regexp, maxMatches int, debugInfo *debug.FetchDebugInfo) ([]*FileMatches, error) {
+	if maxMatches <= 0 {
+		maxMatches = DefaultMaxMatches
+	}
+	// fileEntry returns the entry of the file at the path, or nil if it's not a file.
+	fileEntry := func(tree *object.Tree, pth string) *object.TreeEntry {
+		entry := findEntry(tree, pth)
+		if entry == nil || !entry.Mode.IsFile() {
+			return nil
+		}
+		return entry
+	}
+	type sides struct {
+		before, after *object.TreeEntry
+	}
+	entries := map[string]sides{}
+	var blobHashes []plumbing.Hash
+	for _, pth := range paths {
+		s := sides{before: fileEntry(tree1, pth), after: fileEntry(tree2, pth)}
+		for _, entry := range []*object.TreeEntry{s.before, s.after} {
+			if entry != nil {
+				blobHashes = append(blobHashes, entry.Hash)
+			}
+		}
+		entries[pth] = s
+	}
+	if err := fetchBlobs(repoURL, client, storage, blobHashes, debugInfo); err != nil {
+		return nil, err
+	}
+
+	matchBlob := func(entry *object.TreeEntry) ([]*PatternMatch, bool, error) {
+		if entry == nil {
+			return nil, false, nil
+		}
+		content, err := readBlob(storage, entry.Hash)
+		if err != nil {
+			return nil, false, err
+		}
+		if merge.IsBinary(content) {
+			return nil, false, nil
+		}
+		matches, truncated := matchContent(content, pattern, maxMatches)
+		return matches, truncated, nil
+	}
+	ret := []*FileMatches{}
+	for _, pth := range paths {
+		s := entries[pth]
+		before, truncated1, err := matchBlob(s.before)
+		if err != nil {
+			return nil, err
+		}
+		after, truncated2, err := matchBlob(s.after)
+		if err != nil {
+			return nil, err
+		}
+		if len(before) == 0 && len(after) == 0 {
+			continue
+		}
+		fm := &FileMatches{Path: pth, Before: before, After: after, Truncated: truncated1 || truncated2}
+		if fm.Before == nil {
+			fm.Before = []*PatternMatch{}
+		}
+		if fm.After == nil {
+			fm.After = []*PatternMatch{}
+		}
+		ret = append(ret, fm)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Path < ret[j].Path })
+	return ret, nil
+}
+
+// matchContent returns up to maxMatches matches of the pattern in the content, and whether there
+// are more.
+func matchContent(content []byte, pattern *regexp.Regexp, maxMatches int) ([]*PatternMatch, bool) {
+	indexes := pattern.FindAllSubmatchIndex(content, maxMatches+1)
+	truncated := len(indexes) > maxMatches
+	if truncated {
+		indexes = indexes[:maxMatches]
+	}
+	names := pattern.SubexpNames()
+	var ret []*PatternMatch
+	line, lineOffset := 1, 0
+	for _, index := range indexes {
+		line += bytes.Count(content[lineOffset:index[0]], []byte("\n"))
+		lineOffset = index[0]
+		m := &PatternMatch{Line: line, Text: string(content[index[0]:index[1]])}
+		for i := 1; i < len(index)/2; i++ {
+			var group string
+			if index[2*i] >= 0 {
+				group = string(content[index[2*i]:index[2*i+1]])
+			}
+			m.Groups = append(m.Groups, group)
+			if names[i] != "" {
+				if m.NamedGroups == nil {
+					m.NamedGroups = map[string]string{}
+				}
+				m.NamedGroups[names[i]] = group
+			}
+		}
+		ret = append(ret, m)
+	}
+	return ret, truncated
+}
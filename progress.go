@@ -0,0 +1,62 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"github.com/aviator-co/niche-git/internal/fetch"
+)
+
+// FetchPhase is the phase of a fetch that FetchProgress reports.
+type FetchPhase string
+
+const (
+	// FetchPhaseRemote is a progress message of the server, e.g. "Counting objects: 50% (1/2)".
+	FetchPhaseRemote FetchPhase = "remote"
+	// FetchPhaseReceiving is the transfer of the packfile.
+	FetchPhaseReceiving FetchPhase = "receiving"
+	// FetchPhaseIndexing is the parse of the received packfile.
+	FetchPhaseIndexing FetchPhase = "indexing"
+)
+
+// FetchProgress is the progress of a fetch of a packfile.
+type FetchProgress struct {
+	Phase FetchPhase
+	// Message is the message of the server in FetchPhaseRemote. It can have a trailing "\r" or
+	// "\n" as the server sends it.
+	Message string
+	// ReceivedBytes is the size of the packfile received so far.
+	ReceivedBytes int64
+	// IndexedObjects and TotalObjects are the number of the parsed objects and the number of
+	// the objects in the packfile in FetchPhaseIndexing.
+	IndexedObjects int
+	TotalObjects   int
+}
+
+// FetchProgressFunc is called with the progress of the fetches. It can be called from multiple
+// goroutines.
+type FetchProgressFunc func(FetchProgress)
+
+var fetchProgressFunc FetchProgressFunc
+
+// SetFetchProgressFunc sets the function that is called with the progress of the fetches of all
+// the operations, unless SessionOptions.FetchProgressFunc overrides it. nil disables it, which is
+// the default. While it's set, the servers are asked to send their progress messages.
+func SetFetchProgressFunc(f FetchProgressFunc) {
+	fetchProgressFunc = f
+	fetch.ProgressFunc = fetchProgress(f)
+}
+
+// fetchProgress adapts f to the progress function of the fetch package.
+func fetchProgress(f FetchProgressFunc) func(message string, receivedBytes int64) {
+	if f == nil {
+		return nil
+	}
+	return func(message string, receivedBytes int64) {
+		phase := FetchPhaseReceiving
+		if message != "" {
+			phase = FetchPhaseRemote
+		}
+		f(FetchProgress{Phase: phase, Message: message, ReceivedBytes: receivedBytes})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"time"
+
+	"github.com/aviator-co/niche-git/internal/push"
+)
+
+// PushTimeouts are the time limits of each attempt of a push. A timed-out attempt fails with
+// PushTimeoutError, which is retried with the retry policy. Zero means no limit.
+type PushTimeouts struct {
+	// RefAdvertisement is the time limit of getting the refs advertised by receive-pack
+	// (GET /info/refs) before sending the pack.
+	RefAdvertisement time.Duration
+	// Push is the time limit of the whole attempt, including the ref advertisement.
+	Push time.Duration
+}
+
+// SetPushTimeouts sets the default time limits of the pushes in the process.
+// SessionOptions.PushTimeouts overrides them. The default is no limit, other than the deadlines
+// of the request contexts set by the HTTP client.
+func SetPushTimeouts(t PushTimeouts) {
+	push.SetTimeouts(push.Timeouts(t))
+}
+
+// PushTimeoutError is returned when a push exceeds PushTimeouts.
+type PushTimeoutError = push.TimeoutError
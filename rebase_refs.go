@@ -0,0 +1,550 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/pathmatch"
+	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/aviator-co/niche-git/internal/trailer"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// MergeCommitMode is how PushRebaseRefs rebases the merge commits of the refs.
+type MergeCommitMode string
+
+const (
+	// MergeCommitReject fails the operation if a ref has a merge commit. This is the default.
+	MergeCommitReject MergeCommitMode = "reject"
+	// MergeCommitFlatten drops the merge commits and cherry-picks the other commits in a line,
+	// like `git rebase`. The merged commits that are not reachable from Onto are cherry-picked
+	// as well, so a merge from the branch of Onto is just dropped.
+	MergeCommitFlatten MergeCommitMode = "flatten"
+	// MergeCommitPreserve recreates the merge commits, like `git rebase --rebase-merges`. A
+	// merge commit gets the changes it made to its first parent, and keeps the other parents
+	// that are not rebased as they are.
+	MergeCommitPreserve MergeCommitMode = "preserve"
+)
+
+type PushRebaseRefsArgs struct {
+	// RefPrefix is the prefix of the refs to rebase (e.g. refs/heads/stack/user1/).
+	RefPrefix string
+	// Onto is the commit to rebase the refs onto.
+	Onto plumbing.Hash
+
+	// Committer is the committer of the created commits. The messages and the authors are
+	// kept.
+	Committer object.Signature
+	// Timezone, if set, is the timezone of the Committer time. By default, it keeps its own
+	// timezone.
+	Timezone *time.Location
+	// SignOff appends a Signed-off-by trailer for the committer to the messages of the created
+	// commits if it's not already there.
+	SignOff bool
+	// Trailers are appended to the messages of the created commits, e.g. Co-authored-by or
+	// Change-Id. A trailer that already exists in a message is not added again.
+	Trailers []Trailer
+
+	// AbortOnConflict makes the operation fail without pushing if any commit has a conflict.
+	AbortOnConflict bool
+	// MergeCommits is how the merge commits of the refs are rebased. Empty means
+	// MergeCommitReject.
+	MergeCommits MergeCommitMode
+
+	// ProtectedPaths are the path patterns that the operation must not modify. If a rebased ref
+	// modifies any of them from the commit it's rebased onto, i.e. Onto or the rebased base, the
+	// operation fails with ProtectedPathError without pushing. See pathmatch.Match for the
+	// pattern syntax.
+	ProtectedPaths []string
+	// AllowProtectedPathChanges overrides ProtectedPaths and lets the operation modify them.
+	AllowProtectedPathChanges bool
+
+	// Signer, if set, signs the created commits.
+	Signer signing.Signer
+	// PackOptions are the options of the pushed packfile.
+	PackOptions PackOptions
+
+	// DryRun makes the operation report the rebased refs without pushing them. The commits are
+	// not signed in the dry-run mode.
+	DryRun bool
+
+	// PushUnaffectedRefs makes the operation push the other refs without the atomicity when the
+	// atomic push fails because some refs are updated by others meanwhile. Those refs and the
+	// refs stacked on them are deferred and not pushed. See RebasedRef.Deferred.
+	PushUnaffectedRefs bool
+}
+
+type PushRebaseRefsResult struct {
+	// Refs are the refs under RefPrefix, with the bases before the refs stacked on them.
+	Refs []*RebasedRef
+	// NewObjects is the number of the objects to push, including the commits.
+	NewObjects int
+}
+
+// RebasedRef is a ref rebased by PushRebaseRefs.
+type RebasedRef struct {
+	Name    plumbing.ReferenceName
+	OldHash plumbing.Hash
+	NewHash plumbing.Hash
+	// Base is the ref that this ref is stacked on. Empty if the ref is directly on Onto.
+	Base plumbing.ReferenceName
+	// Commits are the cherry-picked commits of the ref, excluding the ones of Base.
+	Commits []*CherryPickedCommit
+	// Deferred is true if the ref is not pushed because it or its base is updated by others
+	// during the operation. Set only when PushUnaffectedRefs is used.
+	Deferred bool
+	// ProtectedFiles are the files that match ProtectedPaths among the files that the rebased
+	// ref modifies from the commit it's rebased onto.
+	ProtectedFiles []string
+}
+
+// PushRebaseRefs rebases the stack of the refs under a prefix onto a commit, and pushes them in
+// one atomic push.
+//
+// The commits of a ref are the ones that are not reachable from Onto. A ref is stacked on
+// another if its commits include all the commits of the other, and the nearest one is the base.
+// The commits of a ref excluding the ones of the base are cherry-picked onto the rebased base,
+// like `git rebase --onto`. The refs whose commits are all reachable from Onto are left as is.
+// The merge commits are rebased as specified by MergeCommits. The independent stacks are rebased
+// in parallel, so Signer needs to be safe for concurrent use.
+func PushRebaseRefs(repoURL string, client *http.Client, args PushRebaseRefsArgs) (*PushRebaseRefsResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	args.Committer = signatureIn(args.Committer, args.Timezone)
+	if args.RefPrefix == "" {
+		return nil, debug.FetchDebugInfo{}, nil, errors.New("the ref prefix is empty")
+	}
+	if err := trailer.Validate(args.Trailers); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := pathmatch.Validate(args.ProtectedPaths); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	switch args.MergeCommits {
+	case "":
+		args.MergeCommits = MergeCommitReject
+	case MergeCommitReject, MergeCommitFlatten, MergeCommitPreserve:
+	default:
+		return nil, debug.FetchDebugInfo{}, nil, fmt.Errorf("unknown merge commit mode %q; must be %s, %s, or %s", args.MergeCommits, MergeCommitReject, MergeCommitFlatten, MergeCommitPreserve)
+	}
+	if args.DryRun {
+		args.Signer = nil
+	}
+	refInfos, _, err := LsRefs(repoURL, client, []string{args.RefPrefix})
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	var refs []*RebasedRef
+	for _, info := range refInfos {
+		if !strings.HasPrefix(info.Name, args.RefPrefix) || info.SymbolicTarget != "" || !plumbing.IsHash(info.Hash) {
+			continue
+		}
+		hash := plumbing.NewHash(info.Hash)
+		refs = append(refs, &RebasedRef{Name: plumbing.ReferenceName(info.Name), OldHash: hash, NewHash: hash})
+	}
+	if len(refs) == 0 {
+		return nil, debug.FetchDebugInfo{}, nil, fmt.Errorf("no refs under %s", args.RefPrefix)
+	}
+	var refNames []plumbing.ReferenceName
+	for _, ref := range refs {
+		refNames = append(refNames, ref.Name)
+	}
+	unlock, err := lockRefs(repoURL, refNames...)
+	defer unlock()
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+
+	// The server doesn't send the commits reachable from Onto.
+	commitStorage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	defer commitStorage.Close()
+	f := &historyFetcher{
+		repoURL: repoURL,
+		client:  client,
+		storage: commitStorage,
+		haves:   []plumbing.Hash{args.Onto},
+	}
+	var tips []plumbing.Hash
+	for _, ref := range refs {
+		tips = append(tips, ref.OldHash)
+	}
+	if err := f.fetchCommits(tips); err != nil {
+		return nil, f.debugInfo, nil, err
+	}
+	fetchDebugInfo := f.debugInfo
+	commitSets := map[plumbing.ReferenceName]map[plumbing.Hash]bool{}
+	for _, ref := range refs {
+		commits, err := commitsNotIn(f.storage, ref.OldHash, args.Onto, nil)
+		if err != nil {
+			return nil, fetchDebugInfo, nil, err
+		}
+		commitSets[ref.Name] = map[plumbing.Hash]bool{}
+		for _, hash := range commits {
+			commitSets[ref.Name][hash] = true
+		}
+	}
+	// The bases have fewer commits than the refs stacked on them.
+	sort.SliceStable(refs, func(i, j int) bool {
+		if len(commitSets[refs[i].Name]) != len(commitSets[refs[j].Name]) {
+			return len(commitSets[refs[i].Name]) < len(commitSets[refs[j].Name])
+		}
+		return refs[i].Name < refs[j].Name
+	})
+	var ontoHashes, allCommits []plumbing.Hash
+	ontoHashes = append(ontoHashes, args.Onto)
+	commitsOfRef := map[plumbing.ReferenceName][]plumbing.Hash{}
+	for i, ref := range refs {
+		if len(commitSets[ref.Name]) == 0 {
+			continue
+		}
+		var baseCommits map[plumbing.Hash]bool
+		for _, base := range refs[:i] {
+			if len(commitSets[base.Name]) > 0 && commitSets[ref.Name][base.OldHash] {
+				ref.Base, baseCommits = base.Name, commitSets[base.Name]
+			}
+		}
+		commits, err := commitsNotIn(f.storage, ref.OldHash, args.Onto, baseCommits)
+		if err != nil {
+			return nil, fetchDebugInfo, nil, err
+		}
+		if commits, err = filterMergeCommits(f.storage, commits, args.MergeCommits); err != nil {
+			return nil, fetchDebugInfo, nil, err
+		}
+		commitsOfRef[ref.Name] = commits
+		allCommits = append(allCommits, commits...)
+	}
+
+	result := &PushRebaseRefsResult{Refs: refs}
+	if len(allCommits) == 0 {
+		return result, fetchDebugInfo, nil, nil
+	}
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	defer storage.Close()
+	if err := fetchCherryPickTrees(repoURL, client, storage, ontoHashes, allCommits, &fetchDebugInfo); err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	newHashesOfRef, err := rebaseStacks(&lockedStorage{storage: storage}, refs, commitsOfRef, args)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	var newHashes []plumbing.Hash
+	var conflictFiles []string
+	var refUpdates []push.RefUpdate
+	for _, ref := range refs {
+		if len(commitSets[ref.Name]) == 0 {
+			continue
+		}
+		for _, c := range ref.Commits {
+			conflictFiles = append(conflictFiles, c.ConflictOpenFiles...)
+		}
+		newHashes = append(newHashes, newHashesOfRef[ref.Name]...)
+		oldHash := ref.OldHash
+		refUpdates = append(refUpdates, push.RefUpdate{Name: ref.Name, OldHash: &oldHash, NewHash: ref.NewHash})
+	}
+	result.NewObjects = len(newHashes)
+	if args.AbortOnConflict && len(conflictFiles) > 0 {
+		return result, fetchDebugInfo, nil, &ConflictError{Files: conflictFiles}
+	}
+	if err := checkRebasedProtectedPaths(storage, refs, commitsOfRef, args); err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+	if args.DryRun {
+		return result, fetchDebugInfo, nil, nil
+	}
+
+	buf, err := encodePushPackfile(storage, newHashes, args.PackOptions)
+	if err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+	pushDebugInfo, err := push.PushAtomic(repoURL, client, buf, refUpdates)
+	var refUpdateErr *RefUpdateError
+	if err != nil && args.PushUnaffectedRefs && errors.As(err, &refUpdateErr) {
+		return pushUnaffectedRefs(repoURL, client, storage, result, newHashesOfRef, args, fetchDebugInfo, pushDebugInfo, err)
+	}
+	if err != nil {
+		return result, fetchDebugInfo, &pushDebugInfo, err
+	}
+	return result, fetchDebugInfo, &pushDebugInfo, nil
+}
+
+// pushUnaffectedRefs pushes the rebased refs that are not updated by others after the atomic push
+// of all of them failed with pushErr. The refs whose current values differ from OldHash and the
+// refs stacked on them are deferred. pushErr is returned as is if no refs or all of them are
+// affected, as the failure is not because of the updates in that case.
+func pushUnaffectedRefs(repoURL string, client *http.Client, storage *objectStorage, result *PushRebaseRefsResult, newHashesOfRef map[plumbing.ReferenceName][]plumbing.Hash, args PushRebaseRefsArgs, fetchDebugInfo debug.FetchDebugInfo, pushDebugInfo debug.PushDebugInfo, pushErr error) (*PushRebaseRefsResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	refInfos, _, err := LsRefs(repoURL, client, []string{args.RefPrefix})
+	if err != nil {
+		return result, fetchDebugInfo, &pushDebugInfo, err
+	}
+	current := map[plumbing.ReferenceName]plumbing.Hash{}
+	for _, info := range refInfos {
+		if plumbing.IsHash(info.Hash) {
+			current[plumbing.ReferenceName(info.Name)] = plumbing.NewHash(info.Hash)
+		}
+	}
+	deferred := map[plumbing.ReferenceName]bool{}
+	var newHashes []plumbing.Hash
+	var refUpdates []push.RefUpdate
+	// The bases come before the refs stacked on them.
+	for _, ref := range result.Refs {
+		hashes, ok := newHashesOfRef[ref.Name]
+		if !ok {
+			continue
+		}
+		if current[ref.Name] != ref.OldHash || deferred[ref.Base] {
+			deferred[ref.Name] = true
+			continue
+		}
+		newHashes = append(newHashes, hashes...)
+		oldHash := ref.OldHash
+		refUpdates = append(refUpdates, push.RefUpdate{Name: ref.Name, OldHash: &oldHash, NewHash: ref.NewHash})
+	}
+	if len(deferred) == 0 || len(refUpdates) == 0 {
+		return result, fetchDebugInfo, &pushDebugInfo, pushErr
+	}
+	for _, ref := range result.Refs {
+		ref.Deferred = deferred[ref.Name]
+	}
+	buf, err := encodePushPackfile(storage, newHashes, args.PackOptions)
+	if err != nil {
+		return result, fetchDebugInfo, &pushDebugInfo, err
+	}
+	pushDebugInfo, err = push.Push(repoURL, client, buf, refUpdates)
+	if err != nil {
+		return result, fetchDebugInfo, &pushDebugInfo, err
+	}
+	return result, fetchDebugInfo, &pushDebugInfo, nil
+}
+
+// rebaseStacks cherry-picks the commits of the refs onto the rebased bases, and returns the new
+// objects of each ref. The refs need to be sorted with the bases first. The stacks that don't
+// share a base are independent of each other, so they are rebased in parallel up to GOMAXPROCS,
+// each stack from the bottom in one goroutine.
+func rebaseStacks(storage *lockedStorage, refs []*RebasedRef, commitsOfRef map[plumbing.ReferenceName][]plumbing.Hash, args PushRebaseRefsArgs) (map[plumbing.ReferenceName][]plumbing.Hash, error) {
+	refsByName := map[plumbing.ReferenceName]*RebasedRef{}
+	var bottoms []plumbing.ReferenceName
+	stacks := map[plumbing.ReferenceName][]*RebasedRef{}
+	for _, ref := range refs {
+		if _, ok := commitsOfRef[ref.Name]; !ok {
+			continue
+		}
+		refsByName[ref.Name] = ref
+		bottom := ref
+		for bottom.Base != "" {
+			bottom = refsByName[bottom.Base]
+		}
+		if _, ok := stacks[bottom.Name]; !ok {
+			bottoms = append(bottoms, bottom.Name)
+		}
+		stacks[bottom.Name] = append(stacks[bottom.Name], ref)
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		ret   = map[plumbing.ReferenceName][]plumbing.Hash{}
+		first error
+	)
+	slots := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for _, bottom := range bottoms {
+		stack := stacks[bottom]
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			for _, ref := range stack {
+				onto := args.Onto
+				if ref.Base != "" {
+					// The base is in the same stack and is rebased before in this goroutine.
+					onto = refsByName[ref.Base].NewHash
+				}
+				trailers := trailer.WithSignOff(args.Trailers, args.SignOff, args.Committer.Name, args.Committer.Email)
+				var commits []*CherryPickedCommit
+				var hashes []plumbing.Hash
+				var err error
+				if args.MergeCommits == MergeCommitPreserve {
+					commits, hashes, err = rebaseMergeCommits(storage, commitsOfRef[ref.Name], onto, args.Committer, trailers, args.Signer)
+				} else {
+					commits, hashes, err = cherryPickCommits(storage, commitsOfRef[ref.Name], onto, args.Committer, trailers, args.Signer)
+				}
+				mu.Lock()
+				if err != nil && first == nil {
+					first = err
+				}
+				failed := first != nil
+				mu.Unlock()
+				if failed {
+					return
+				}
+				ref.Commits = commits
+				ref.NewHash = onto
+				if len(commits) > 0 {
+					ref.NewHash = commits[len(commits)-1].CommitHash
+				}
+				mu.Lock()
+				ret[ref.Name] = hashes
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if first != nil {
+		return nil, first
+	}
+	return ret, nil
+}
+
+// checkRebasedProtectedPaths sets ProtectedFiles of the rebased refs, and fails with
+// ProtectedPathError that has the protected files of all the refs if any ref modifies them and
+// AllowProtectedPathChanges is false.
+func checkRebasedProtectedPaths(storage *objectStorage, refs []*RebasedRef, commitsOfRef map[plumbing.ReferenceName][]plumbing.Hash, args PushRebaseRefsArgs) error {
+	if len(args.ProtectedPaths) == 0 {
+		return nil
+	}
+	refsByName := map[plumbing.ReferenceName]*RebasedRef{}
+	seen := map[string]bool{}
+	var protectedFiles []string
+	for _, ref := range refs {
+		refsByName[ref.Name] = ref
+		if _, ok := commitsOfRef[ref.Name]; !ok {
+			continue
+		}
+		onto := args.Onto
+		if ref.Base != "" {
+			onto = refsByName[ref.Base].NewHash
+		}
+		ontoTree, err := getTreeFromCommit(storage, onto)
+		if err != nil {
+			return err
+		}
+		newCommit, err := object.GetCommit(storage, ref.NewHash)
+		if err != nil {
+			return fmt.Errorf("cannot find the rebased commit %q: %w", ref.NewHash.String(), err)
+		}
+		// The check is done for all the refs first, so that the error has all the files.
+		ref.ProtectedFiles, err = checkProtectedPaths(storage, ontoTree, newCommit.TreeHash, args.ProtectedPaths, true)
+		if err != nil {
+			return err
+		}
+		for _, pth := range ref.ProtectedFiles {
+			if !seen[pth] {
+				seen[pth] = true
+				protectedFiles = append(protectedFiles, pth)
+			}
+		}
+	}
+	if len(protectedFiles) > 0 && !args.AllowProtectedPathChanges {
+		sort.Strings(protectedFiles)
+		return &ProtectedPathError{Paths: protectedFiles}
+	}
+	return nil
+}
+
+// filterMergeCommits returns the commits to rebase for the merge commit mode. The merge commits
+// are dropped with MergeCommitFlatten, and fail with MergeCommitReject.
+func filterMergeCommits(storage *objectStorage, commitHashes []plumbing.Hash, mode MergeCommitMode) ([]plumbing.Hash, error) {
+	if mode == MergeCommitPreserve {
+		return commitHashes, nil
+	}
+	var ret []plumbing.Hash
+	for _, hash := range commitHashes {
+		commit, err := object.GetCommit(storage, hash)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %q in the fetched packfile: %w", hash.String(), err)
+		}
+		if len(commit.ParentHashes) <= 1 {
+			ret = append(ret, hash)
+			continue
+		}
+		if mode == MergeCommitReject {
+			return nil, fmt.Errorf("%q is a merge commit and cannot be rebased; use the flatten or preserve merge commit mode", hash.String())
+		}
+	}
+	return ret, nil
+}
+
+// rebaseMergeCommits rebases the commits onto onto, keeping the merge commits. The commits need
+// to have the parents before the children. The parents that are rebased are replaced with the
+// rebased ones, and the first parents that are not are replaced with onto. The other parents
+// that are not rebased are kept. Returns the created commits and the new objects to push.
+func rebaseMergeCommits(storage storer.EncodedObjectStorer, commitHashes []plumbing.Hash, onto plumbing.Hash, committer object.Signature, trailers []Trailer, signer signing.Signer) ([]*CherryPickedCommit, []plumbing.Hash, error) {
+	var ret []*CherryPickedCommit
+	var newHashes []plumbing.Hash
+	rebased := map[plumbing.Hash]plumbing.Hash{}
+	for _, hash := range commitHashes {
+		original, err := object.GetCommit(storage, hash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+		}
+		var parents []plumbing.Hash
+		for i, parent := range original.ParentHashes {
+			switch newHash, ok := rebased[parent]; {
+			case ok:
+				parents = append(parents, newHash)
+			case i == 0:
+				parents = append(parents, onto)
+			default:
+				parents = append(parents, parent)
+			}
+		}
+		picked, hashes, err := pickCommit(storage, hash, parents, committer, trailers, signer)
+		if err != nil {
+			return nil, nil, err
+		}
+		ret = append(ret, picked)
+		newHashes = append(newHashes, hashes...)
+		rebased[hash] = picked.CommitHash
+	}
+	return ret, newHashes, nil
+}
+
+// commitsNotIn returns the commits reachable from tip that are not reachable from onto nor in
+// excluded, with the parents before the children. The commits reachable from onto are not in
+// the storage.
+func commitsNotIn(storage *objectStorage, tip, onto plumbing.Hash, excluded map[plumbing.Hash]bool) ([]plumbing.Hash, error) {
+	var ret []plumbing.Hash
+	visited := map[plumbing.Hash]bool{}
+	var visit func(hash plumbing.Hash) error
+	visit = func(hash plumbing.Hash) error {
+		if visited[hash] || hash == onto || excluded[hash] {
+			return nil
+		}
+		visited[hash] = true
+		commit, err := object.GetCommit(storage, hash)
+		if err == plumbing.ErrObjectNotFound {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("cannot parse %q in the fetched packfile: %w", hash.String(), err)
+		}
+		for _, parent := range commit.ParentHashes {
+			if err := visit(parent); err != nil {
+				return err
+			}
+		}
+		ret = append(ret, hash)
+		return nil
+	}
+	if err := visit(tip); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
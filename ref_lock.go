@@ -0,0 +1,104 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// RefLocker serializes the mutating operations on the same refs, so that the overlapping
+// operations don't fail the compare-and-swap of each other. An implementation can use an external
+// lock service to fence the operations across processes.
+type RefLocker interface {
+	// Lock blocks until the refs of the repository are locked, and returns the function to
+	// unlock them. The ref names are sorted and distinct.
+	Lock(repoURL string, refNames []plumbing.ReferenceName) (unlock func(), err error)
+}
+
+var (
+	refLockerMu sync.Mutex
+	refLocker   RefLocker
+)
+
+// SetRefLocker makes the mutating operations (e.g. PushSquashCherryPick and PushUpdateRefs) hold
+// the locks of the refs they push to from the beginning to the end. Nil disables the locks, which
+// is the default. See NewInProcessRefLocker.
+func SetRefLocker(locker RefLocker) {
+	refLockerMu.Lock()
+	defer refLockerMu.Unlock()
+	refLocker = locker
+}
+
+// lockRefs locks the refs with the RefLocker if set. The empty names are ignored. The returned
+// function is never nil.
+func lockRefs(repoURL string, refNames ...plumbing.ReferenceName) (func(), error) {
+	refLockerMu.Lock()
+	locker := refLocker
+	refLockerMu.Unlock()
+	if locker == nil {
+		return func() {}, nil
+	}
+	seen := map[plumbing.ReferenceName]bool{}
+	var names []plumbing.ReferenceName
+	for _, name := range refNames {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	unlock, err := locker.Lock(repoURL, names)
+	if err != nil {
+		return func() {}, fmt.Errorf("failed to lock the refs: %w", err)
+	}
+	return unlock, nil
+}
+
+type inProcessRefLocker struct {
+	mu sync.Mutex
+	// locks are the held locks. The channel is closed when the lock is released.
+	locks map[refLockKey]chan struct{}
+}
+
+type refLockKey struct {
+	repoURL string
+	refName plumbing.ReferenceName
+}
+
+// NewInProcessRefLocker returns a RefLocker that serializes the operations in this process.
+func NewInProcessRefLocker() RefLocker {
+	return &inProcessRefLocker{locks: map[refLockKey]chan struct{}{}}
+}
+
+func (l *inProcessRefLocker) Lock(repoURL string, refNames []plumbing.ReferenceName) (func(), error) {
+	// The refs are locked in the sorted order, so two operations never wait for each other.
+	var held []refLockKey
+	for _, name := range refNames {
+		key := refLockKey{repoURL, name}
+		for {
+			l.mu.Lock()
+			ch, ok := l.locks[key]
+			if !ok {
+				l.locks[key] = make(chan struct{})
+				l.mu.Unlock()
+				break
+			}
+			l.mu.Unlock()
+			<-ch
+		}
+		held = append(held, key)
+	}
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		for _, key := range held {
+			close(l.locks[key])
+			delete(l.locks, key)
+		}
+	}, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// validateRefNames checks that the refs can be pushed. Returns InvalidRefNameError for the first
+// invalid one.
+func validateRefNames(names ...plumbing.ReferenceName) error {
+	for _, name := range names {
+		if reason := invalidRefNameReason(string(name)); reason != "" {
+			return &InvalidRefNameError{Name: string(name), Reason: reason}
+		}
+	}
+	return nil
+}
+
+// invalidRefNameReason returns the git check-ref-format rule that the name breaks, or an empty
+// string if the name is valid. The name needs to be a full ref name under refs/. A component
+// cannot start with "-" either, like the branch names.
+func invalidRefNameReason(name string) string {
+	if name == "" {
+		return "empty"
+	}
+	if !strings.HasPrefix(name, "refs/") {
+		return "not under refs/"
+	}
+	if strings.HasSuffix(name, "/") {
+		return "ends with /"
+	}
+	if strings.HasSuffix(name, ".") {
+		return "ends with ."
+	}
+	if strings.Contains(name, "..") {
+		return "contains .."
+	}
+	if strings.Contains(name, "@{") {
+		return "contains @{"
+	}
+	for _, r := range name {
+		if r < 0x20 || r == 0x7f {
+			return "contains a control character"
+		}
+		switch r {
+		case ' ', '~', '^', ':', '?', '*', '[', '\\':
+			return "contains " + string(r)
+		}
+	}
+	for _, component := range strings.Split(name, "/") {
+		switch {
+		case component == "":
+			return "contains an empty component"
+		case strings.HasPrefix(component, "."):
+			return "a component starts with ."
+		case strings.HasPrefix(component, "-"):
+			return "a component starts with -"
+		case strings.HasSuffix(component, ".lock"):
+			return "a component ends with .lock"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/diff"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+type CheckRemergeArgs struct {
+	// PRHead is the head commit of the PR.
+	PRHead plumbing.Hash
+	// EnqueuedBase is the trunk commit that the squash result was computed on.
+	EnqueuedBase plumbing.Hash
+	// CurrentTrunk is the current trunk tip.
+	CurrentTrunk plumbing.Hash
+}
+
+type CheckRemergeResult struct {
+	// RemergeNeeded is true if the trunk has changed the files that the PR changes since the
+	// squash result was computed.
+	RemergeNeeded bool
+	// PRFiles are the files that differ between EnqueuedBase and PRHead.
+	PRFiles []string
+	// TrunkFiles are the files that differ between EnqueuedBase and CurrentTrunk.
+	TrunkFiles []string
+	// OverlappingFiles are the files in both PRFiles and TrunkFiles.
+	OverlappingFiles []string
+}
+
+// CheckRemerge reports whether the squash result of a queued PR is still valid on the current
+// trunk tip. The result is still valid if the trunk hasn't changed the files that the PR changes
+// since the PR was enqueued. This compares the tree hashes only, and doesn't fetch the blobs.
+//
+// The PR changes are taken relative to EnqueuedBase. If the PR is not up to date with it, the
+// trunk changes that the PR doesn't have are counted as the PR changes. This can report a
+// re-merge that is not needed, but never misses a needed one.
+func CheckRemerge(repoURL string, client *http.Client, args CheckRemergeArgs) (*CheckRemergeResult, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "CheckRemerge", args, func() (*CheckRemergeResult, debug.FetchDebugInfo, error) {
+		return checkRemerge(repoURL, client, args)
+	})
+}
+
+func checkRemerge(repoURL string, client *http.Client, args CheckRemergeArgs) (*CheckRemergeResult, debug.FetchDebugInfo, error) {
+	var debugInfo debug.FetchDebugInfo
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	defer storage.Close()
+	if err := fetchBlobNone(repoURL, client, storage, []plumbing.Hash{args.PRHead, args.EnqueuedBase, args.CurrentTrunk}, &debugInfo); err != nil {
+		return nil, debugInfo, err
+	}
+
+	headTree, err := getTreeFromCommit(storage, args.PRHead)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	baseTree, err := getTreeFromCommit(storage, args.EnqueuedBase)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	trunkTree, err := getTreeFromCommit(storage, args.CurrentTrunk)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+
+	prFiles, err := diff.DiffTree(storage, baseTree, headTree)
+	if err != nil {
+		return nil, debugInfo, fmt.Errorf("failed to take the PR diff: %w", err)
+	}
+	trunkFiles, err := diff.DiffTree(storage, baseTree, trunkTree)
+	if err != nil {
+		return nil, debugInfo, fmt.Errorf("failed to take the trunk diff: %w", err)
+	}
+
+	result := &CheckRemergeResult{}
+	for pth := range prFiles {
+		result.PRFiles = append(result.PRFiles, pth)
+		if _, ok := trunkFiles[pth]; ok {
+			result.OverlappingFiles = append(result.OverlappingFiles, pth)
+		}
+	}
+	for pth := range trunkFiles {
+		result.TrunkFiles = append(result.TrunkFiles, pth)
+	}
+	sort.Strings(result.PRFiles)
+	sort.Strings(result.TrunkFiles)
+	sort.Strings(result.OverlappingFiles)
+	result.RemergeNeeded = len(result.OverlappingFiles) > 0
+	return result, debugInfo, nil
+}
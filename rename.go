@@ -0,0 +1,89 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/diff"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/merge"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// DefaultRenameThreshold is the similarity in percent that a deleted file and an added file need
+// to be a rename, same as git's default.
+const DefaultRenameThreshold = diff.DefaultRenameThreshold
+
+type RenamedFile struct {
+	OldPath string `json:"oldPath"`
+	NewPath string `json:"newPath"`
+	// Similarity is the percentage of the content kept. 100 for an exact rename.
+	Similarity int `json:"similarity"`
+}
+
+// detectRenames returns the renames between the trees and their diff. The blobs of the deleted
+// and the added files are fetched to compare the contents.
+func detectRenames(repoURL string, client *http.Client, storage *objectStorage, tree1, tree2 *object.Tree, threshold int, debugInfo *debug.FetchDebugInfo) ([]diff.Rename, map[string]diff.BlobHashes, error) {
+	modified, err := diff.DiffTree(storage, tree1, tree2)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to take file diffs: %w", err)
+	}
+	if err := fetchBlobs(repoURL, client, storage, diff.RenameCandidates(modified), debugInfo); err != nil {
+		return nil, nil, err
+	}
+	renames, err := diff.DetectRenames(modified, threshold, func(hash plumbing.Hash) ([]byte, error) {
+		return readBlob(storage, hash)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to detect the renames: %w", err)
+	}
+	return renames, modified, nil
+}
+
+// followRenames rewrites the trees so that MergeTree merges the changes across the renames from
+// the merge base to each side. See merge.FollowRenames.
+func followRenames(repoURL string, client *http.Client, storage *objectStorage, tree1, tree2, mergeBase *object.Tree, threshold int, debugInfo *debug.FetchDebugInfo) (*merge.RenamedTrees, error) {
+	renames1, _, err := detectRenames(repoURL, client, storage, mergeBase, tree1, threshold, debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	renames2, _, err := detectRenames(repoURL, client, storage, mergeBase, tree2, threshold, debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	return merge.FollowRenames(storage, tree1, tree2, mergeBase, renames1, renames2)
+}
+
+func toRenamedFiles(renames []diff.Rename) []*RenamedFile {
+	var ret []*RenamedFile
+	for _, r := range renames {
+		ret = append(ret, &RenamedFile{OldPath: r.OldPath, NewPath: r.NewPath, Similarity: r.Similarity})
+	}
+	return ret
+}
+
+// fetchBlobs fetches the blobs that are not in the storage yet.
+func fetchBlobs(repoURL string, client *http.Client, storage *objectStorage, hashes []plumbing.Hash, debugInfo *debug.FetchDebugInfo) error {
+	var missing []plumbing.Hash
+	for _, hash := range hashes {
+		if storage.HasEncodedObject(hash) != nil {
+			missing = append(missing, hash)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	pack, blobDebugInfo, err := fetch.FetchFullPackfile(repoURL, client, missing, nil)
+	defer pack.Close()
+	debugInfo.PackfileSize += blobDebugInfo.PackfileSize
+	debugInfo.Spooled = debugInfo.Spooled || blobDebugInfo.Spooled
+	if err != nil {
+		return err
+	}
+	return parseFetchedPackfile(storage, pack, debugInfo)
+}
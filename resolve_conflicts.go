@@ -0,0 +1,293 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/merge"
+	"github.com/aviator-co/niche-git/internal/pathmatch"
+	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/aviator-co/niche-git/internal/trailer"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+type ResolveConflictsArgs struct {
+	// ConflictCommit is the commit with the conflicts, which is pushed to the conflict ref by an
+	// operation with ConflictRefNamespace.
+	ConflictCommit plumbing.Hash
+	// Resolutions are the resolved contents keyed by the paths of the conflicting files. A nil
+	// content deletes the file. The ".from-cherry-pick" and ".from-cherry-pick-base" files of
+	// the resolved paths are removed.
+	Resolutions map[string][]byte
+
+	// CommitMessage is the message of the resolved commit. If empty, the message of
+	// ConflictCommit is used.
+	CommitMessage string
+	// SignOff appends a Signed-off-by trailer for the committer to the commit message if it's
+	// not already there.
+	SignOff bool
+	// Trailers are appended to the commit message, e.g. Co-authored-by or Change-Id. A trailer
+	// that already exists in the message is not added again.
+	Trailers  []Trailer
+	Author    object.Signature
+	Committer object.Signature
+	// Timezone, if set, is the timezone of the Author and Committer times. By default, they
+	// keep their own timezones.
+	Timezone *time.Location
+
+	// Ref is the ref to push the resolved commit to.
+	Ref plumbing.ReferenceName
+	// CurrentRefHash, if set, is the expected current value of the ref. This is used for
+	// compare-and-swap.
+	CurrentRefHash *plumbing.Hash
+	// ConflictRef, if set, is the ref of ConflictCommit. It's deleted atomically with the push
+	// of the resolved commit, and the push fails if it no longer points to ConflictCommit.
+	ConflictRef plumbing.ReferenceName
+	// AdditionalRefUpdates are the ref updates pushed atomically with the update of Ref, e.g. to
+	// move a tracking ref to the created commit with ToCreatedCommit or to delete a temporary
+	// ref.
+	AdditionalRefUpdates []RefUpdate
+
+	// ProtectedPaths are the path patterns that the operation must not modify. If the resolved
+	// commit modifies any of them from its first parent, the operation fails with
+	// ProtectedPathError without pushing. See pathmatch.Match for the pattern syntax.
+	ProtectedPaths []string
+	// AllowProtectedPathChanges overrides ProtectedPaths and lets the operation modify them.
+	AllowProtectedPathChanges bool
+
+	// Signer, if set, signs the created commit.
+	Signer signing.Signer
+	// PackOptions are the options of the pushed packfile.
+	PackOptions PackOptions
+}
+
+type ResolveConflictsResult struct {
+	CommitHash    plumbing.Hash
+	ResolvedFiles []string
+	// UnresolvedFiles are the conflicting files of ConflictCommit that Resolutions don't cover.
+	// The operation fails without pushing if there are any.
+	UnresolvedFiles []string
+	// ProtectedFiles are the files that match ProtectedPaths among the files that the resolved
+	// commit modifies from its first parent.
+	ProtectedFiles []string
+}
+
+// ResolveConflicts replaces the conflicting files of a commit pushed to a conflict ref with the
+// resolved contents, and pushes the resolved commit to the specified ref. The resolved commit
+// has the same parents as the conflict commit.
+func ResolveConflicts(repoURL string, client *http.Client, args ResolveConflictsArgs) (*ResolveConflictsResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	args.Author = signatureIn(args.Author, args.Timezone)
+	args.Committer = signatureIn(args.Committer, args.Timezone)
+	refNames := withAdditionalRefNames(args.Ref, args.AdditionalRefUpdates)
+	if args.ConflictRef != "" {
+		refNames = append(refNames, args.ConflictRef)
+	}
+	if err := validateRefNames(refNames...); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := trailer.Validate(args.Trailers); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := pathmatch.Validate(args.ProtectedPaths); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	unlock, err := lockRefs(repoURL, refNames...)
+	defer unlock()
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	var fetchDebugInfo debug.FetchDebugInfo
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	defer storage.Close()
+	if err := fetchBlobNone(repoURL, client, storage, []plumbing.Hash{args.ConflictCommit}, &fetchDebugInfo); err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	conflictCommit, err := object.GetCommit(storage, args.ConflictCommit)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", args.ConflictCommit.String(), err)
+	}
+	tree, err := conflictCommit.Tree()
+	if err != nil {
+		return nil, fetchDebugInfo, nil, fmt.Errorf("cannot find the tree of %q in the fetched packfile: %w", args.ConflictCommit.String(), err)
+	}
+	// The files with the conflict suffixes that the parent already has are not conflicts. The
+	// parents are not included in the first fetch as it's depth 1.
+	var parentTree *object.Tree
+	if len(conflictCommit.ParentHashes) > 0 {
+		if err := fetchBlobNone(repoURL, client, storage, conflictCommit.ParentHashes[:1], &fetchDebugInfo); err != nil {
+			return nil, fetchDebugInfo, nil, err
+		}
+		parentTree, err = getTreeFromCommit(storage, conflictCommit.ParentHashes[0])
+		if err != nil {
+			return nil, fetchDebugInfo, nil, err
+		}
+	}
+
+	result := &ResolveConflictsResult{}
+	var newHashes []plumbing.Hash
+	edits := map[string]merge.TreeEdit{}
+	for pth, content := range args.Resolutions {
+		pth = strings.Trim(pth, "/")
+		result.ResolvedFiles = append(result.ResolvedFiles, pth)
+		for _, suffix := range []string{cherryPickFromSuffix, cherryPickBaseSuffix} {
+			if entryHash(parentTree, pth+suffix).IsZero() {
+				edits[pth+suffix] = merge.TreeEdit{}
+			}
+		}
+		if content == nil {
+			edits[pth] = merge.TreeEdit{}
+			continue
+		}
+		hash, err := storeBlob(storage, content)
+		if err != nil {
+			return nil, fetchDebugInfo, nil, fmt.Errorf("cannot save the resolved blob of %q: %w", pth, err)
+		}
+		newHashes = append(newHashes, hash)
+		edits[pth] = merge.TreeEdit{Hash: hash, Mode: resolvedFileMode(tree, pth)}
+	}
+	sort.Strings(result.ResolvedFiles)
+
+	unresolved, err := unresolvedConflictFiles(tree, parentTree, args.Resolutions)
+	if err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+	result.UnresolvedFiles = unresolved
+	if len(unresolved) > 0 {
+		return result, fetchDebugInfo, nil, &ConflictError{Files: unresolved}
+	}
+
+	editResult, err := merge.EditTree(storage, tree, edits)
+	if err != nil {
+		return result, fetchDebugInfo, nil, fmt.Errorf("failed to apply the resolutions: %w", err)
+	}
+	newHashes = append(newHashes, editResult.NewHashes...)
+	result.ProtectedFiles, err = checkProtectedPaths(storage, parentTree, editResult.TreeHash, args.ProtectedPaths, args.AllowProtectedPathChanges)
+	if err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+
+	commitMessage := args.CommitMessage
+	if commitMessage == "" {
+		commitMessage = conflictCommit.Message
+	}
+	commitMessage = trailer.Append(commitMessage, trailer.WithSignOff(args.Trailers, args.SignOff, args.Committer.Name, args.Committer.Email))
+	commit := &object.Commit{
+		Message:      commitMessage,
+		Author:       args.Author,
+		Committer:    args.Committer,
+		TreeHash:     editResult.TreeHash,
+		ParentHashes: conflictCommit.ParentHashes,
+	}
+	commitHash, err := storeCommit(storage, commit, args.Signer)
+	if err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+	result.CommitHash = commitHash
+	newHashes = append(newHashes, commitHash)
+
+	buf, err := encodePushPackfile(storage, newHashes, args.PackOptions)
+	if err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+	additional := args.AdditionalRefUpdates
+	if args.ConflictRef != "" {
+		conflictCommitHash := args.ConflictCommit
+		additional = append(additional, RefUpdate{
+			Name:    args.ConflictRef,
+			OldHash: &conflictCommitHash,
+			NewHash: plumbing.ZeroHash,
+		})
+	}
+	pushDebugInfo, err := pushCreatedCommit(repoURL, client, buf, push.RefUpdate{
+		Name:    args.Ref,
+		OldHash: args.CurrentRefHash,
+		NewHash: commitHash,
+	}, additional)
+	if err != nil {
+		return result, fetchDebugInfo, &pushDebugInfo, err
+	}
+	return result, fetchDebugInfo, &pushDebugInfo, nil
+}
+
+// unresolvedConflictFiles returns the conflicting files in the tree that are not resolved. A file
+// is conflicting if the tree has its ".from-cherry-pick" or ".from-cherry-pick-base" file that
+// the parent tree doesn't have.
+func unresolvedConflictFiles(tree, parentTree *object.Tree, resolutions map[string][]byte) ([]string, error) {
+	resolved := map[string]bool{}
+	for pth := range resolutions {
+		resolved[strings.Trim(pth, "/")] = true
+	}
+	seen := map[string]bool{}
+	var ret []string
+	walker := object.NewTreeWalker(tree, true, nil)
+	defer walker.Close()
+	for {
+		name, entry, err := walker.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to walk the tree: %w", err)
+		}
+		if entry.Mode == filemode.Dir {
+			continue
+		}
+		var pth string
+		switch {
+		case strings.HasSuffix(name, cherryPickBaseSuffix):
+			pth = strings.TrimSuffix(name, cherryPickBaseSuffix)
+		case strings.HasSuffix(name, cherryPickFromSuffix):
+			pth = strings.TrimSuffix(name, cherryPickFromSuffix)
+		default:
+			continue
+		}
+		if resolved[pth] || seen[pth] || !entryHash(parentTree, name).IsZero() {
+			continue
+		}
+		seen[pth] = true
+		ret = append(ret, pth)
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+// resolvedFileMode returns the mode of the conflicting file in the tree, taken from the file of
+// the ref side or the one from the cherry-pick.
+func resolvedFileMode(tree *object.Tree, pth string) filemode.FileMode {
+	for _, name := range []string{pth, pth + cherryPickFromSuffix} {
+		if entry, err := tree.FindEntry(name); err == nil && entry.Mode.IsFile() {
+			return entry.Mode
+		}
+	}
+	return filemode.Regular
+}
+
+func storeBlob(storage *objectStorage, content []byte) (plumbing.Hash, error) {
+	obj := storage.NewEncodedObject()
+	obj.SetType(plumbing.BlobObject)
+	w, err := obj.Writer()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if _, err := w.Write(content); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if err := w.Close(); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return storage.SetEncodedObject(obj)
+}
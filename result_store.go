@@ -0,0 +1,130 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+)
+
+// ResultStore persists the results of the read operations, so that rerunning a pipeline of them
+// skips the calls that have already succeeded. Set it with SessionOptions.ResultStore.
+//
+// The key is a hash of the operation, the repository URL, the arguments, and the cache scope of
+// the session, so that the results are not shared with the callers with other credentials. The
+// value is the JSON of the result.
+//
+// Only the read operations whose results are determined by the commit and object hashes in
+// their arguments use the store: CheckIgnored, CheckRemerge, FetchCommits without
+// ApplyReplaceRefs, FetchFileOwners, FetchModifiedFilesWithRenames, GetAttributes, GetBlame,
+// GetCommitGraph, GetFileHistory, GetObject, GetTree, GetTreeStats, and PathsExist. The
+// operations that resolve ref names or write to the repository always run.
+type ResultStore interface {
+	// Get returns the result stored for the key. ok is false if there's none.
+	Get(key string) (result []byte, ok bool, err error)
+	// Put stores the result of a successful call for the key.
+	Put(key string, result []byte) error
+}
+
+// NewDirResultStore returns a ResultStore that keeps each result in a file named after the key
+// in dir. The directory is created if it doesn't exist.
+func NewDirResultStore(dir string) (ResultStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("cannot create the result store directory: %w", err)
+	}
+	return &dirResultStore{dir: dir}, nil
+}
+
+type dirResultStore struct {
+	dir string
+}
+
+func (s *dirResultStore) Get(key string) ([]byte, bool, error) {
+	bs, err := os.ReadFile(filepath.Join(s.dir, key+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return bs, true, nil
+}
+
+// Put writes the result to a temporary file first so that an interrupted run doesn't leave a
+// truncated result behind.
+func (s *dirResultStore) Put(key string, result []byte) error {
+	f, err := os.CreateTemp(s.dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(result); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(s.dir, key+".json"))
+}
+
+// withResultStore returns the result stored for the call in the ResultStore of the client's
+// session, or calls run and stores its result if it succeeds. A failure of the store fails the
+// call rather than running it without the store.
+func withResultStore[T any](repoURL string, client *http.Client, operation string, args any, run func() (T, debug.FetchDebugInfo, error)) (T, debug.FetchDebugInfo, error) {
+	store := sessionOptionsOf(client).ResultStore
+	if store == nil {
+		return run()
+	}
+	var result T
+	key, err := resultKey(repoURL, client, operation, args)
+	if err != nil {
+		return result, debug.FetchDebugInfo{}, err
+	}
+	bs, ok, err := store.Get(key)
+	if err != nil {
+		return result, debug.FetchDebugInfo{}, fmt.Errorf("cannot look up the result store: %w", err)
+	}
+	if ok {
+		if err := json.Unmarshal(bs, &result); err != nil {
+			return result, debug.FetchDebugInfo{}, fmt.Errorf("cannot parse the stored result: %w", err)
+		}
+		return result, debug.FetchDebugInfo{StoredResult: true}, nil
+	}
+	result, debugInfo, err := run()
+	if err != nil {
+		return result, debugInfo, err
+	}
+	if bs, err = json.Marshal(result); err != nil {
+		return result, debugInfo, err
+	}
+	if err := store.Put(key, bs); err != nil {
+		return result, debugInfo, fmt.Errorf("cannot store the result: %w", err)
+	}
+	return result, debugInfo, nil
+}
+
+// resultKey returns the key of the call in the ResultStore.
+func resultKey(repoURL string, client *http.Client, operation string, args any) (string, error) {
+	bs, err := json.Marshal(struct {
+		Operation  string `json:"operation"`
+		RepoURL    string `json:"repoURL"`
+		CacheScope string `json:"cacheScope"`
+		Args       any    `json:"args"`
+	}{operation, repoURL, fetch.CacheScope(client), args})
+	if err != nil {
+		return "", fmt.Errorf("cannot compute the result store key: %w", err)
+	}
+	sum := sha256.Sum256(bs)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/packfile"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+const resumeStateFile = "state.json"
+
+// resumeState is the fetch request that the packfiles in the resume directory are for.
+type resumeState struct {
+	Wants []string `json:"wants"`
+	Haves []string `json:"haves"`
+}
+
+// fetchResumer persists the fetched packfiles, including the partially received ones, so that a
+// retried fetch can skip the objects that are already received.
+type fetchResumer struct {
+	dir   string
+	packs []string
+}
+
+// openFetchResumer opens the resume directory. If the directory has the data of a different
+// fetch request, the data is discarded.
+func openFetchResumer(dir string, wants, haves []plumbing.Hash) (*fetchResumer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("cannot create the resume directory: %w", err)
+	}
+	state := resumeState{Wants: hashStrings(wants), Haves: hashStrings(haves)}
+	packs, err := filepath.Glob(filepath.Join(dir, "*.pack"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(packs)
+	r := &fetchResumer{dir: dir, packs: packs}
+
+	var saved resumeState
+	bs, err := os.ReadFile(filepath.Join(dir, resumeStateFile))
+	if err == nil && json.Unmarshal(bs, &saved) == nil && slices.Equal(saved.Wants, state.Wants) && slices.Equal(saved.Haves, state.Haves) {
+		return r, nil
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("cannot read the resume state: %w", err)
+	}
+	if err := r.clear(); err != nil {
+		return nil, err
+	}
+	bs, err = json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, resumeStateFile), bs, 0o644); err != nil {
+		return nil, fmt.Errorf("cannot write the resume state: %w", err)
+	}
+	return r, nil
+}
+
+// load stores the objects in the persisted packfiles into the storage and returns the total size
+// of the packfiles. The objects in a truncated packfile are salvaged up to the truncation.
+func (r *fetchResumer) load(storage *objectStorage) (int, error) {
+	total := 0
+	for _, pack := range r.packs {
+		f, err := os.Open(pack)
+		if err != nil {
+			return total, fmt.Errorf("cannot read the saved packfile: %w", err)
+		}
+		stat, err := f.Stat()
+		if err == nil {
+			total += int(stat.Size())
+			if err := parsePackfile(storage, f, nil); err != nil {
+				if _, err = f.Seek(0, io.SeekStart); err == nil {
+					salvagePackfile(storage, f)
+				}
+			}
+		}
+		f.Close()
+		if err != nil {
+			return total, fmt.Errorf("cannot read the saved packfile: %w", err)
+		}
+	}
+	return total, nil
+}
+
+// save persists a fetched packfile, after the packfiles of its packfile URIs so that they are
+// loaded in the order of parseFetchedPackfile.
+func (r *fetchResumer) save(p *fetch.Packfile) error {
+	for _, uriPack := range p.URIPacks() {
+		if err := r.save(uriPack); err != nil {
+			return err
+		}
+	}
+	if p.Len() == 0 {
+		return nil
+	}
+	pack := filepath.Join(r.dir, fmt.Sprintf("%04d.pack", len(r.packs)))
+	f, err := os.Create(pack)
+	if err != nil {
+		return fmt.Errorf("cannot save the packfile: %w", err)
+	}
+	_, err = io.Copy(f, p.Reader())
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("cannot save the packfile: %w", err)
+	}
+	r.packs = append(r.packs, pack)
+	return nil
+}
+
+// clear removes the persisted data.
+func (r *fetchResumer) clear() error {
+	for _, pack := range r.packs {
+		if err := os.Remove(pack); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("cannot remove the saved packfile: %w", err)
+		}
+	}
+	r.packs = nil
+	if err := os.Remove(filepath.Join(r.dir, resumeStateFile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("cannot remove the resume state: %w", err)
+	}
+	return nil
+}
+
+// salvagePackfile stores the objects in a possibly truncated packfile up to the first object that
+// cannot be read.
+func salvagePackfile(storage *objectStorage, rd io.Reader) {
+	scanner := packfile.NewScanner(rd)
+	if _, _, err := scanner.Header(); err != nil {
+		return
+	}
+	byOffset := map[int64]plumbing.EncodedObject{}
+	for {
+		header, err := scanner.NextObjectHeader()
+		if err != nil {
+			return
+		}
+		var content bytes.Buffer
+		if _, _, err := scanner.NextObject(&content); err != nil {
+			return
+		}
+		var base plumbing.EncodedObject
+		switch header.Type {
+		case plumbing.OFSDeltaObject:
+			base = byOffset[header.OffsetReference]
+		case plumbing.REFDeltaObject:
+			base, _ = storage.EncodedObject(plumbing.AnyObject, header.Reference)
+		}
+		obj := storage.NewEncodedObject()
+		if header.Type.IsDelta() {
+			if base == nil {
+				return
+			}
+			if err := packfile.ApplyDelta(obj, base, content.Bytes()); err != nil {
+				return
+			}
+		} else {
+			obj.SetType(header.Type)
+			obj.SetSize(int64(content.Len()))
+			w, err := obj.Writer()
+			if err != nil {
+				return
+			}
+			if _, err := w.Write(content.Bytes()); err != nil {
+				return
+			}
+			w.Close()
+		}
+		if _, err := storage.SetEncodedObject(obj); err != nil {
+			return
+		}
+		byOffset[header.Offset] = obj
+	}
+}
+
+// missingCommits returns the commits that are not in the storage but need to be fetched to have
+// all the ancestors of the wants. This is the frontier of the received history, which is used as
+// the wants of a resumed fetch.
+func missingCommits(storage *objectStorage, wants []plumbing.Hash) []plumbing.Hash {
+	var ret []plumbing.Hash
+	visited := map[plumbing.Hash]bool{}
+	stack := slices.Clone(wants)
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[hash] {
+			continue
+		}
+		visited[hash] = true
+		commit, err := object.GetCommit(storage, hash)
+		if err != nil {
+			ret = append(ret, hash)
+			continue
+		}
+		stack = append(stack, commit.ParentHashes...)
+	}
+	return ret
+}
+
+func hashStrings(hashes []plumbing.Hash) []string {
+	var ret []string
+	for _, hash := range hashes {
+		ret = append(ret, hash.String())
+	}
+	return ret
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"time"
+
+	"github.com/aviator-co/niche-git/internal/fetch"
+)
+
+// RetryPolicy is how the fetches and the pushes are retried when they fail transiently: a 5xx,
+// 408, or 429 response, a timeout, or a refused or reset connection. The deadline of the request
+// context is not retried.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of the retries of a request. Zero disables retrying.
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry. Each following retry waits Multiplier
+	// times longer, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	// Jitter randomizes the waits by this fraction, e.g. 0.2 waits 80% to 120% of the backoff,
+	// so that the clients failed together don't retry together.
+	Jitter float64
+	// Budget is the number of the retry tokens shared by the requests in the process. Each retry
+	// takes a token and each successful request returns BudgetRatio tokens. The requests are not
+	// retried while half of the tokens or fewer are left, so that a failing server is not flooded
+	// with retries. Zero disables the budget.
+	Budget      float64
+	BudgetRatio float64
+}
+
+// DefaultRetryPolicy is a policy of 3 retries waiting from 200ms to 5s.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries:     3,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+	Budget:         10,
+	BudgetRatio:    0.1,
+}
+
+// SetRetryPolicy sets the default retry policy of the fetches and the pushes in the process.
+// SessionOptions.RetryPolicy overrides it. The zero policy disables retrying, which is the
+// default.
+func SetRetryPolicy(p RetryPolicy) {
+	fetch.SetRetryPolicy(fetch.RetryPolicy(p))
+}
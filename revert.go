@@ -0,0 +1,192 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/pathmatch"
+	"github.com/aviator-co/niche-git/internal/trailer"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+type PushRevertArgs struct {
+	// Commit is the commit to revert.
+	Commit plumbing.Hash
+	// Mainline is the 1-based parent number that is treated as the mainline if Commit is a
+	// merge commit. This is required for a merge commit and must be zero otherwise, same as
+	// `git revert -m`.
+	Mainline int
+	// RevertOnto is the commit where the revert commit is created on top of.
+	RevertOnto plumbing.Hash
+
+	// CommitMessage is the message of the revert commit. If empty, a message in the same
+	// format as git-revert is used.
+	CommitMessage string
+	// SignOff appends a Signed-off-by trailer for the committer to the commit message if it's
+	// not already there.
+	SignOff bool
+	// Trailers are appended to the commit message, e.g. Co-authored-by or Change-Id. A trailer
+	// that already exists in the message is not added again.
+	Trailers  []Trailer
+	Author    object.Signature
+	Committer object.Signature
+	// Timezone, if set, is the timezone of the Author and Committer times. By default, they
+	// keep their own timezones.
+	Timezone *time.Location
+
+	// Ref is the ref to push the created commit to.
+	Ref plumbing.ReferenceName
+	// CurrentRefHash, if set, is the expected current value of the ref. This is used for
+	// compare-and-swap.
+	CurrentRefHash *plumbing.Hash
+	// AdditionalRefUpdates are the ref updates pushed atomically with the update of Ref, e.g. to
+	// move a tracking ref to the created commit with ToCreatedCommit or to delete a temporary
+	// ref.
+	AdditionalRefUpdates []RefUpdate
+
+	// AbortOnConflict makes the operation fail without pushing if there is a conflict.
+	AbortOnConflict bool
+	// IncludeConflictHunks makes the result have the conflicting hunks of ConflictOpenFiles in
+	// ConflictContents. The blobs of the conflicting files are fetched for this.
+	IncludeConflictHunks bool
+
+	// ProtectedPaths are the path patterns that the operation must not modify. If the revert
+	// commit modifies any of them from RevertOnto, the operation fails with ProtectedPathError
+	// without pushing. See pathmatch.Match for the pattern syntax.
+	ProtectedPaths []string
+	// AllowProtectedPathChanges overrides ProtectedPaths and lets the operation modify them.
+	AllowProtectedPathChanges bool
+
+	// Signer, if set, signs the created commit.
+	Signer signing.Signer
+	// PackOptions are the options of the pushed packfile.
+	PackOptions PackOptions
+}
+
+type PushRevertResult struct {
+	CommitHash        plumbing.Hash
+	RevertedFiles     []string
+	ConflictOpenFiles []string
+	// ConflictContents are the conflicting hunks of ConflictOpenFiles. Set only when
+	// IncludeConflictHunks is used. Ours is RevertOnto and theirs is the parent of Commit.
+	ConflictContents []*ConflictContent
+	// ProtectedFiles are the files that match ProtectedPaths among the files that the revert
+	// commit modifies from RevertOnto.
+	ProtectedFiles []string
+}
+
+// PushRevert creates a new commit that reverts the changes a commit made relative to its parent
+// and push to the specified ref.
+func PushRevert(repoURL string, client *http.Client, args PushRevertArgs) (*PushRevertResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	args.Author = signatureIn(args.Author, args.Timezone)
+	args.Committer = signatureIn(args.Committer, args.Timezone)
+	return pushRevert(repoURL, client, args, false)
+}
+
+// pushRevert is PushRevert that fails for a non-merge commit if mergeOnly is true.
+func pushRevert(repoURL string, client *http.Client, args PushRevertArgs, mergeOnly bool) (*PushRevertResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	refNames := withAdditionalRefNames(args.Ref, args.AdditionalRefUpdates)
+	if err := validateRefNames(refNames...); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := trailer.Validate(args.Trailers); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := pathmatch.Validate(args.ProtectedPaths); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	unlock, err := lockRefs(repoURL, refNames...)
+	defer unlock()
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	var fetchDebugInfo debug.FetchDebugInfo
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+	defer storage.Close()
+	if err := fetchBlobNone(repoURL, client, storage, []plumbing.Hash{args.Commit, args.RevertOnto}, &fetchDebugInfo); err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+
+	commit, err := object.GetCommit(storage, args.Commit)
+	if err != nil {
+		return nil, fetchDebugInfo, nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", args.Commit.String(), err)
+	}
+	var parent plumbing.Hash
+	switch {
+	case len(commit.ParentHashes) == 0:
+		return nil, fetchDebugInfo, nil, fmt.Errorf("%q is a root commit and cannot be reverted", args.Commit.String())
+	case len(commit.ParentHashes) == 1:
+		if mergeOnly {
+			return nil, fetchDebugInfo, nil, fmt.Errorf("%q is not a merge commit", args.Commit.String())
+		}
+		if args.Mainline != 0 {
+			return nil, fetchDebugInfo, nil, fmt.Errorf("mainline is specified but %q is not a merge commit", args.Commit.String())
+		}
+		parent = commit.ParentHashes[0]
+	default:
+		if args.Mainline < 1 || args.Mainline > len(commit.ParentHashes) {
+			return nil, fetchDebugInfo, nil, fmt.Errorf("mainline %d is out of range; the merge commit has %d parents", args.Mainline, len(commit.ParentHashes))
+		}
+		parent = commit.ParentHashes[args.Mainline-1]
+	}
+
+	// The parents are not included in the first fetch as it's depth 1.
+	if err := fetchBlobNone(repoURL, client, storage, []plumbing.Hash{parent}, &fetchDebugInfo); err != nil {
+		return nil, fetchDebugInfo, nil, err
+	}
+
+	commitMessage := args.CommitMessage
+	if commitMessage == "" {
+		commitMessage = revertMessage(commit, parent)
+	}
+	// Reverting is cherry-picking the change from the commit to its parent.
+	cpResult, pushDebugInfo, err := pushSquashCherryPickFetched(repoURL, client, storage, PushSquashCherryPickArgs{
+		CherryPickFrom:       parent,
+		CherryPickTo:         args.RevertOnto,
+		CherryPickBase:       args.Commit,
+		CommitMessage:        commitMessage,
+		Trailers:             args.Trailers,
+		SignOff:              args.SignOff,
+		Author:               args.Author,
+		Committer:            args.Committer,
+		Ref:                  args.Ref,
+		CurrentRefHash:       args.CurrentRefHash,
+		AdditionalRefUpdates: args.AdditionalRefUpdates,
+		AbortOnConflict:      args.AbortOnConflict,
+		Signer:               args.Signer,
+		PackOptions:          args.PackOptions,
+		IncludeConflictHunks: args.IncludeConflictHunks,
+
+		ProtectedPaths:            args.ProtectedPaths,
+		AllowProtectedPathChanges: args.AllowProtectedPathChanges,
+	}, &fetchDebugInfo)
+	if cpResult == nil {
+		return nil, fetchDebugInfo, pushDebugInfo, err
+	}
+	return &PushRevertResult{
+		CommitHash:        cpResult.CommitHash,
+		RevertedFiles:     cpResult.CherryPickedFiles,
+		ConflictOpenFiles: cpResult.ConflictOpenFiles,
+		ConflictContents:  cpResult.ConflictContents,
+		ProtectedFiles:    cpResult.ProtectedFiles,
+	}, fetchDebugInfo, pushDebugInfo, err
+}
+
+func revertMessage(commit *object.Commit, parent plumbing.Hash) string {
+	subject, _, _ := strings.Cut(commit.Message, "\n")
+	if len(commit.ParentHashes) > 1 {
+		return fmt.Sprintf("Revert \"%s\"\n\nThis reverts commit %s, reversing\nchanges made to %s.\n", subject, commit.Hash.String(), parent.String())
+	}
+	return fmt.Sprintf("Revert \"%s\"\n\nThis reverts commit %s.\n", subject, commit.Hash.String())
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+type PushRevertMergeArgs struct {
+	// MergeCommit is the merge commit to revert.
+	MergeCommit plumbing.Hash
+	// Mainline is the 1-based parent number of the merge commit that is treated as the
+	// mainline. The changes that the merge brought relative to this parent are reverted. This
+	// is the same as `git revert -m`.
+	Mainline int
+	// RevertOnto is the commit where the revert commit is created on top of.
+	RevertOnto plumbing.Hash
+
+	// CommitMessage is the message of the revert commit. If empty, a message in the same
+	// format as git-revert is used.
+	CommitMessage string
+	// SignOff appends a Signed-off-by trailer for the committer to the commit message if it's
+	// not already there.
+	SignOff bool
+	// Trailers are appended to the commit message, e.g. Co-authored-by or Change-Id. A trailer
+	// that already exists in the message is not added again.
+	Trailers  []Trailer
+	Author    object.Signature
+	Committer object.Signature
+	// Timezone, if set, is the timezone of the Author and Committer times. By default, they
+	// keep their own timezones.
+	Timezone *time.Location
+
+	// Ref is the ref to push the created commit to.
+	Ref plumbing.ReferenceName
+	// CurrentRefHash, if set, is the expected current value of the ref. This is used for
+	// compare-and-swap.
+	CurrentRefHash *plumbing.Hash
+	// AdditionalRefUpdates are the ref updates pushed atomically with the update of Ref, e.g. to
+	// move a tracking ref to the created commit with ToCreatedCommit or to delete a temporary
+	// ref.
+	AdditionalRefUpdates []RefUpdate
+
+	// AbortOnConflict makes the operation fail without pushing if there is a conflict.
+	AbortOnConflict bool
+	// IncludeConflictHunks makes the result have the conflicting hunks of ConflictOpenFiles in
+	// ConflictContents. The blobs of the conflicting files are fetched for this.
+	IncludeConflictHunks bool
+
+	// ProtectedPaths are the path patterns that the operation must not modify. If the revert
+	// commit modifies any of them from RevertOnto, the operation fails with ProtectedPathError
+	// without pushing. See pathmatch.Match for the pattern syntax.
+	ProtectedPaths []string
+	// AllowProtectedPathChanges overrides ProtectedPaths and lets the operation modify them.
+	AllowProtectedPathChanges bool
+
+	// Signer, if set, signs the created commit.
+	Signer signing.Signer
+	// PackOptions are the options of the pushed packfile.
+	PackOptions PackOptions
+}
+
+type PushRevertMergeResult struct {
+	CommitHash        plumbing.Hash
+	RevertedFiles     []string
+	ConflictOpenFiles []string
+	// ConflictContents are the conflicting hunks of ConflictOpenFiles. Set only when
+	// IncludeConflictHunks is used. Ours is RevertOnto and theirs is the mainline parent.
+	ConflictContents []*ConflictContent
+	// ProtectedFiles are the files that match ProtectedPaths among the files that the revert
+	// commit modifies from RevertOnto.
+	ProtectedFiles []string
+}
+
+// PushRevertMerge creates a new commit that reverts the changes a merge commit made relative to
+// its mainline parent and push to the specified ref. This is PushRevert that fails for a
+// non-merge commit.
+func PushRevertMerge(repoURL string, client *http.Client, args PushRevertMergeArgs) (*PushRevertMergeResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	args.Author = signatureIn(args.Author, args.Timezone)
+	args.Committer = signatureIn(args.Committer, args.Timezone)
+	result, fetchDebugInfo, pushDebugInfo, err := pushRevert(repoURL, client, PushRevertArgs{
+		Commit:               args.MergeCommit,
+		Mainline:             args.Mainline,
+		RevertOnto:           args.RevertOnto,
+		CommitMessage:        args.CommitMessage,
+		Trailers:             args.Trailers,
+		SignOff:              args.SignOff,
+		Author:               args.Author,
+		Committer:            args.Committer,
+		Ref:                  args.Ref,
+		CurrentRefHash:       args.CurrentRefHash,
+		AdditionalRefUpdates: args.AdditionalRefUpdates,
+		AbortOnConflict:      args.AbortOnConflict,
+		Signer:               args.Signer,
+		PackOptions:          args.PackOptions,
+		IncludeConflictHunks: args.IncludeConflictHunks,
+
+		ProtectedPaths:            args.ProtectedPaths,
+		AllowProtectedPathChanges: args.AllowProtectedPathChanges,
+	}, true)
+	if result == nil {
+		return nil, fetchDebugInfo, pushDebugInfo, err
+	}
+	return &PushRevertMergeResult{
+		CommitHash:        result.CommitHash,
+		RevertedFiles:     result.RevertedFiles,
+		ConflictOpenFiles: result.ConflictOpenFiles,
+		ConflictContents:  result.ConflictContents,
+		ProtectedFiles:    result.ProtectedFiles,
+	}, fetchDebugInfo, pushDebugInfo, err
+}
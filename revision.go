@@ -0,0 +1,63 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// resolveRevision returns the commit that the revision points to. The revision is a commit
+// hash, a full ref name, or a branch or tag name.
+func resolveRevision(repoURL string, client *http.Client, rev string) (plumbing.Hash, error) {
+	if plumbing.IsHash(rev) {
+		return plumbing.NewHash(rev), nil
+	}
+	ref, err := findRevisionRef(repoURL, client, rev)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if ref.PeeledHash != "" {
+		return plumbing.NewHash(ref.PeeledHash), nil
+	}
+	return plumbing.NewHash(ref.Hash), nil
+}
+
+// resolveAnnotatedTag returns the annotated tag object that the revision points to, or ZeroHash
+// if the revision is not an annotated tag.
+func resolveAnnotatedTag(repoURL string, client *http.Client, rev string) (plumbing.Hash, error) {
+	if plumbing.IsHash(rev) {
+		return plumbing.ZeroHash, nil
+	}
+	ref, err := findRevisionRef(repoURL, client, rev)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if ref.PeeledHash == "" {
+		return plumbing.ZeroHash, nil
+	}
+	return plumbing.NewHash(ref.Hash), nil
+}
+
+// findRevisionRef returns the ref of the revision name, trying the full ref name, the branch,
+// and the tag in this order.
+func findRevisionRef(repoURL string, client *http.Client, rev string) (*RefInfo, error) {
+	candidates := []string{rev, "refs/heads/" + rev, "refs/tags/" + rev}
+	refs, _, err := LsRefs(repoURL, client, candidates)
+	if err != nil {
+		return nil, err
+	}
+	byName := map[string]*RefInfo{}
+	for _, ref := range refs {
+		byName[ref.Name] = ref
+	}
+	for _, name := range candidates {
+		if ref, ok := byName[name]; ok {
+			return ref, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot resolve %q", rev)
+}
@@ -0,0 +1,330 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/pathmatch"
+	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/aviator-co/niche-git/internal/trailer"
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+type PushRewordCommitsArgs struct {
+	// Ref is the branch whose commits are rewritten. Its current value is read with ls-refs and
+	// used for compare-and-swap, so the push fails if the branch is updated meanwhile.
+	Ref plumbing.ReferenceName
+	// Count is the number of the commits to rewrite, from the tip along the first parents.
+	Count int
+
+	// MessageTemplate is the text/template of the new commit messages. It's executed with
+	// *RewordCommitData for each commit. If empty, the messages are kept.
+	MessageTemplate string
+	// SignOff appends a Signed-off-by trailer for the committer to the messages of the created
+	// commits if it's not already there.
+	SignOff bool
+	// Trailers are appended to the messages after MessageTemplate, e.g. Co-authored-by or
+	// Change-Id. A trailer that already exists in a message is not added again.
+	Trailers []Trailer
+	// Author, if set, replaces the authors of the commits. If its time is zero, the times of
+	// the original authors are kept.
+	Author *object.Signature
+	// Committer is the committer of the created commits.
+	Committer object.Signature
+	// Timezone, if set, is the timezone of the Author and Committer times. By default, they
+	// keep their own timezones.
+	Timezone *time.Location
+
+	// AdditionalRefUpdates are the ref updates pushed atomically with the update of Ref, e.g. to
+	// move a tracking ref to the created commit with ToCreatedCommit or to delete a temporary
+	// ref.
+	AdditionalRefUpdates []RefUpdate
+
+	// ProtectedPaths are the path patterns that the rewritten commits must not modify. If the
+	// rewritten commits modify any of them from the parent of the oldest one, the operation
+	// fails with ProtectedPathError without pushing, so that the commits changing those paths
+	// are not re-authored. The trees of the two commits are fetched for this. See
+	// pathmatch.Match for the pattern syntax.
+	ProtectedPaths []string
+	// AllowProtectedPathChanges overrides ProtectedPaths and lets the operation rewrite them.
+	AllowProtectedPathChanges bool
+
+	// Signer, if set, signs the created commits.
+	Signer signing.Signer
+	// PackOptions are the options of the pushed packfile.
+	PackOptions PackOptions
+
+	// DryRun makes the operation report the rewritten commits without pushing them. The commits
+	// are not signed in the dry-run mode.
+	DryRun bool
+}
+
+// RewordCommitData is the data that MessageTemplate of PushRewordCommitsArgs is executed with.
+type RewordCommitData struct {
+	Hash      string
+	ShortHash string
+	// Message is the original commit message.
+	Message string
+	// Subject is the first line of Message.
+	Subject string
+	// Body is Message without the subject and the blank lines after it.
+	Body   string
+	Author CommitSignature
+	// Index is the position of the commit from the oldest rewritten one, starting from 0.
+	Index int
+}
+
+type PushRewordCommitsResult struct {
+	// CommitHash is the created commit of the tip, which is pushed to Ref.
+	CommitHash plumbing.Hash
+	// Commits are the rewritten commits from the oldest.
+	Commits []*RewordedCommit
+	// ProtectedFiles are the files that match ProtectedPaths among the files that the rewritten
+	// commits modify from the parent of the oldest one.
+	ProtectedFiles []string
+}
+
+// RewordedCommit is a commit rewritten by PushRewordCommits.
+type RewordedCommit struct {
+	// OriginalCommitHash is the commit that is rewritten.
+	OriginalCommitHash plumbing.Hash
+	// CommitHash is the created commit.
+	CommitHash plumbing.Hash
+	Message    string
+}
+
+// PushRewordCommits rewrites the messages and optionally the authors of the last commits of a
+// branch, and force-pushes the branch with compare-and-swap. The trees are kept, so only the
+// commits are fetched and pushed. The message encoding, the embedded merge tag, and the other
+// extra headers are kept too. The signatures are dropped.
+//
+// MessageTemplate can use the appendTrailer function to add a trailer, e.g. `{{appendTrailer
+// .Message "Change-Id" (printf "I%s" .Hash)}}`. A trailer that already exists is not added again.
+func PushRewordCommits(repoURL string, client *http.Client, args PushRewordCommitsArgs) (*PushRewordCommitsResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	if args.Author != nil {
+		author := signatureIn(*args.Author, args.Timezone)
+		args.Author = &author
+	}
+	args.Committer = signatureIn(args.Committer, args.Timezone)
+	refNames := withAdditionalRefNames(args.Ref, args.AdditionalRefUpdates)
+	if err := validateRefNames(refNames...); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := trailer.Validate(args.Trailers); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := pathmatch.Validate(args.ProtectedPaths); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if args.Count <= 0 {
+		return nil, debug.FetchDebugInfo{}, nil, errors.New("the number of the commits to rewrite must be positive")
+	}
+	var tmpl *template.Template
+	if args.MessageTemplate != "" {
+		var err error
+		tmpl, err = template.New("message").Funcs(template.FuncMap{
+			"contains":      strings.Contains,
+			"appendTrailer": appendTrailer,
+		}).Parse(args.MessageTemplate)
+		if err != nil {
+			return nil, debug.FetchDebugInfo{}, nil, fmt.Errorf("invalid message template: %w", err)
+		}
+	}
+	if args.DryRun {
+		args.Signer = nil
+	}
+	unlock, err := lockRefs(repoURL, refNames...)
+	defer unlock()
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+
+	refInfos, _, err := LsRefs(repoURL, client, []string{args.Ref.String()})
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	var tip plumbing.Hash
+	for _, info := range refInfos {
+		if info.Name == args.Ref.String() && plumbing.IsHash(info.Hash) {
+			tip = plumbing.NewHash(info.Hash)
+		}
+	}
+	if tip.IsZero() {
+		return nil, debug.FetchDebugInfo{}, nil, fmt.Errorf("%s does not exist", args.Ref)
+	}
+
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	defer storage.Close()
+	f := &historyFetcher{
+		repoURL: repoURL,
+		client:  client,
+		storage: storage,
+		opts:    fetch.FetchOptions{Depth: args.Count},
+	}
+	if err := f.fetchCommits([]plumbing.Hash{tip}); err != nil {
+		return nil, f.debugInfo, nil, err
+	}
+	fetchDebugInfo := f.debugInfo
+	// The commits from the tip along the first parents.
+	var originals []*object.Commit
+	for hash := tip; len(originals) < args.Count; {
+		commit, err := object.GetCommit(f.storage, hash)
+		if err != nil {
+			return nil, fetchDebugInfo, nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", hash.String(), err)
+		}
+		originals = append(originals, commit)
+		if len(commit.ParentHashes) == 0 {
+			break
+		}
+		hash = commit.ParentHashes[0]
+	}
+	if len(originals) < args.Count {
+		return nil, fetchDebugInfo, nil, fmt.Errorf("%s has only %d commits", args.Ref, len(originals))
+	}
+
+	result := &PushRewordCommitsResult{}
+	if len(args.ProtectedPaths) > 0 {
+		result.ProtectedFiles, err = rewordedProtectedFiles(repoURL, client, f.storage, tip, originals[len(originals)-1], args, &fetchDebugInfo)
+		if err != nil {
+			return result, fetchDebugInfo, nil, err
+		}
+	}
+	trailers := trailer.WithSignOff(args.Trailers, args.SignOff, args.Committer.Name, args.Committer.Email)
+	var newHashes []plumbing.Hash
+	var parent plumbing.Hash
+	for i := len(originals) - 1; i >= 0; i-- {
+		original := originals[i]
+		message := original.Message
+		if tmpl != nil {
+			if message, err = rewordMessage(tmpl, original, len(originals)-1-i); err != nil {
+				return nil, fetchDebugInfo, nil, err
+			}
+		}
+		message = trailer.Append(message, trailers)
+		author := original.Author
+		if args.Author != nil {
+			author.Name, author.Email = args.Author.Name, args.Author.Email
+			if !args.Author.When.IsZero() {
+				author.When = args.Author.When
+			}
+		}
+		parentHashes := append([]plumbing.Hash{}, original.ParentHashes...)
+		if !parent.IsZero() {
+			parentHashes[0] = parent
+		}
+		extraHeaders, err := commitExtraHeaders(f.storage, original.Hash)
+		if err != nil {
+			return nil, fetchDebugInfo, nil, err
+		}
+		commitHash, err := storeCommitWithHeaders(f.storage, &object.Commit{
+			Message:      message,
+			Author:       author,
+			Committer:    args.Committer,
+			TreeHash:     original.TreeHash,
+			ParentHashes: parentHashes,
+			Encoding:     original.Encoding,
+			MergeTag:     original.MergeTag,
+		}, extraHeaders, args.Signer)
+		if err != nil {
+			return nil, fetchDebugInfo, nil, err
+		}
+		result.Commits = append(result.Commits, &RewordedCommit{
+			OriginalCommitHash: original.Hash,
+			CommitHash:         commitHash,
+			Message:            message,
+		})
+		newHashes = append(newHashes, commitHash)
+		parent = commitHash
+	}
+	result.CommitHash = parent
+	if args.DryRun {
+		return result, fetchDebugInfo, nil, nil
+	}
+
+	buf, err := encodePushPackfile(f.storage, newHashes, args.PackOptions)
+	if err != nil {
+		return result, fetchDebugInfo, nil, err
+	}
+	pushDebugInfo, err := pushCreatedCommit(repoURL, client, buf, push.RefUpdate{
+		Name:    args.Ref,
+		OldHash: &tip,
+		NewHash: result.CommitHash,
+	}, args.AdditionalRefUpdates)
+	if err != nil {
+		return result, fetchDebugInfo, &pushDebugInfo, err
+	}
+	return result, fetchDebugInfo, &pushDebugInfo, nil
+}
+
+// rewordedProtectedFiles returns the protected files that the commits from oldest to tip modify
+// from the parent of oldest. Only the commits are fetched for rewording, so the trees of the two
+// commits are fetched.
+func rewordedProtectedFiles(repoURL string, client *http.Client, storage *objectStorage, tip plumbing.Hash, oldest *object.Commit, args PushRewordCommitsArgs, debugInfo *debug.FetchDebugInfo) ([]string, error) {
+	hashes := []plumbing.Hash{tip}
+	if len(oldest.ParentHashes) > 0 {
+		hashes = append(hashes, oldest.ParentHashes[0])
+	}
+	if err := fetchBlobNone(repoURL, client, storage, hashes, debugInfo); err != nil {
+		return nil, err
+	}
+	var parentTree *object.Tree
+	if len(oldest.ParentHashes) > 0 {
+		var err error
+		parentTree, err = getTreeFromCommit(storage, oldest.ParentHashes[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+	tipCommit, err := object.GetCommit(storage, tip)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", tip.String(), err)
+	}
+	return checkProtectedPaths(storage, parentTree, tipCommit.TreeHash, args.ProtectedPaths, args.AllowProtectedPathChanges)
+}
+
+// rewordMessage executes the message template for the commit. The message ends with a newline
+// like the ones that git creates.
+func rewordMessage(tmpl *template.Template, commit *object.Commit, index int) (string, error) {
+	subject, body, _ := strings.Cut(commit.Message, "\n")
+	data := &RewordCommitData{
+		Hash:      commit.Hash.String(),
+		ShortHash: commit.Hash.String()[:7],
+		Message:   commit.Message,
+		Subject:   subject,
+		Body:      strings.TrimLeft(body, "\n"),
+		Author: CommitSignature{
+			Name:      commit.Author.Name,
+			Email:     commit.Author.Email,
+			Timestamp: commit.Author.When,
+		},
+		Index: index,
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("cannot render the message of %q: %w", commit.Hash.String(), err)
+	}
+	message := strings.TrimRight(sb.String(), "\n")
+	if message == "" {
+		return "", fmt.Errorf("the message of %q is empty", commit.Hash.String())
+	}
+	return message + "\n", nil
+}
+
+// appendTrailer is the template function that appends a "key: value" trailer to the message.
+func appendTrailer(message, key, value string) string {
+	return trailer.Append(message, []trailer.Trailer{{Key: key, Value: value}})
+}
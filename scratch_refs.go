@@ -0,0 +1,190 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// DefaultScratchRefNamespace is the default namespace of the scratch refs, which are the
+// temporary refs created by the operations such as conflict pushes and dry-run artifacts.
+const DefaultScratchRefNamespace = "refs/niche-git/tmp/"
+
+// ScratchRef is a scratch ref.
+type ScratchRef struct {
+	Name plumbing.ReferenceName
+	Hash plumbing.Hash
+	// Purpose is the operation that created the ref.
+	Purpose string
+	// CreatedAt is the time when the ref name was created.
+	CreatedAt time.Time
+}
+
+// NewScratchRefName returns a new unique scratch ref name under the namespace. The name is
+// "<namespace><purpose>/<unix time>-<random>", which lets ListScratchRefs tell the purpose and
+// the age of the ref without fetching it.
+func NewScratchRefName(namespace, purpose string, now time.Time) (plumbing.ReferenceName, error) {
+	bs := make([]byte, 4)
+	if _, err := rand.Read(bs); err != nil {
+		return "", err
+	}
+	name := plumbing.ReferenceName(fmt.Sprintf("%s%s/%d-%s", scratchRefNamespace(namespace), purpose, now.Unix(), hex.EncodeToString(bs)))
+	if err := validateRefNames(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+type CreateScratchRefArgs struct {
+	// Namespace is the namespace of the scratch ref. Defaults to DefaultScratchRefNamespace.
+	Namespace string
+	// Purpose is the operation that creates the ref.
+	Purpose string
+	// Hash is the object that the ref points to. It must exist in the repository.
+	Hash plumbing.Hash
+	// Now is the creation time recorded in the ref name. Defaults to time.Now().
+	Now time.Time
+}
+
+// CreateScratchRef pushes a new scratch ref named with NewScratchRefName.
+//
+// The ref is created with compare-and-swap against ZeroHash, so an existing ref is never
+// overwritten.
+func CreateScratchRef(repoURL string, client *http.Client, args CreateScratchRefArgs) (*ScratchRef, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	if args.Purpose == "" {
+		return nil, debug.FetchDebugInfo{}, nil, errors.New("the purpose of the scratch ref is required")
+	}
+	now := args.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	name, err := NewScratchRefName(args.Namespace, args.Purpose, now)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	_, fetchDebugInfo, pushDebugInfo, err := PushUpdateRefs(repoURL, client, PushUpdateRefsArgs{
+		RefUpdates: []RefUpdate{{Name: name, OldHash: &plumbing.ZeroHash, NewHash: args.Hash}},
+	})
+	if err != nil {
+		return nil, fetchDebugInfo, pushDebugInfo, err
+	}
+	return &ScratchRef{
+		Name:      name,
+		Hash:      args.Hash,
+		Purpose:   args.Purpose,
+		CreatedAt: time.Unix(now.Unix(), 0),
+	}, fetchDebugInfo, pushDebugInfo, nil
+}
+
+// ListScratchRefs returns the scratch refs under the namespace. The refs whose names are not
+// created by NewScratchRefName are not included.
+func ListScratchRefs(repoURL string, client *http.Client, namespace string) ([]*ScratchRef, debug.LsRefsDebugInfo, error) {
+	namespace = scratchRefNamespace(namespace)
+	refs, debugInfo, err := LsRefs(repoURL, client, []string{namespace})
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	var ret []*ScratchRef
+	for _, ref := range refs {
+		sr, ok := parseScratchRef(namespace, ref)
+		if ok {
+			ret = append(ret, sr)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Name < ret[j].Name })
+	return ret, debugInfo, nil
+}
+
+func parseScratchRef(namespace string, ref *RefInfo) (*ScratchRef, bool) {
+	if ref.SymbolicTarget != "" || !strings.HasPrefix(ref.Name, namespace) {
+		return nil, false
+	}
+	purpose, base := path.Split(strings.TrimPrefix(ref.Name, namespace))
+	ts, _, ok := strings.Cut(base, "-")
+	if !ok || purpose == "" {
+		return nil, false
+	}
+	sec, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &ScratchRef{
+		Name:      plumbing.ReferenceName(ref.Name),
+		Hash:      plumbing.NewHash(ref.Hash),
+		Purpose:   strings.TrimSuffix(purpose, "/"),
+		CreatedAt: time.Unix(sec, 0),
+	}, true
+}
+
+type CleanupScratchRefsArgs struct {
+	// Namespace is the namespace of the scratch refs. Defaults to DefaultScratchRefNamespace.
+	Namespace string
+	// MaxAge is the age of the scratch refs to delete. It must be positive.
+	MaxAge time.Duration
+	// Now is the current time to calculate the age. Defaults to time.Now().
+	Now time.Time
+	// DryRun makes the operation report the refs to delete without deleting them.
+	DryRun bool
+}
+
+type CleanupScratchRefsResult struct {
+	// DeletedRefs are the deleted refs, or the refs to delete in the dry-run mode.
+	DeletedRefs []*ScratchRef
+}
+
+// CleanupScratchRefs deletes the scratch refs older than MaxAge.
+//
+// The refs are deleted with compare-and-swap, so a ref that is reused after listing is not
+// deleted.
+func CleanupScratchRefs(repoURL string, client *http.Client, args CleanupScratchRefsArgs) (*CleanupScratchRefsResult, *debug.PushDebugInfo, error) {
+	// A non-positive MaxAge would delete the refs that the operations in flight just created.
+	if args.MaxAge <= 0 {
+		return nil, nil, fmt.Errorf("MaxAge must be positive, got %s", args.MaxAge)
+	}
+	now := args.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	refs, _, err := ListScratchRefs(repoURL, client, args.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := &CleanupScratchRefsResult{}
+	var refUpdates []RefUpdate
+	for _, ref := range refs {
+		if now.Sub(ref.CreatedAt) < args.MaxAge {
+			continue
+		}
+		result.DeletedRefs = append(result.DeletedRefs, ref)
+		oldHash := ref.Hash
+		refUpdates = append(refUpdates, RefUpdate{Name: ref.Name, OldHash: &oldHash, NewHash: plumbing.ZeroHash})
+	}
+	if args.DryRun || len(refUpdates) == 0 {
+		return result, nil, nil
+	}
+	_, _, pushDebugInfo, err := PushUpdateRefs(repoURL, client, PushUpdateRefsArgs{RefUpdates: refUpdates})
+	return result, pushDebugInfo, err
+}
+
+func scratchRefNamespace(namespace string) string {
+	if namespace == "" {
+		return DefaultScratchRefNamespace
+	}
+	if !strings.HasSuffix(namespace, "/") {
+		namespace += "/"
+	}
+	return namespace
+}
@@ -0,0 +1,207 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/aviator-co/niche-git/internal/semver"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/packfile"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+type SemverTag struct {
+	// Name is the tag name without refs/tags/ (e.g. v1.2.3).
+	Name string `json:"name"`
+	// Version is the semantic version part of the tag name (e.g. 1.2.3).
+	Version string `json:"version"`
+	// Hash is the hash of the object that the tag ref points to.
+	Hash string `json:"hash"`
+	// CommitHash is the hash of the tagged commit. This is different from Hash if the tag is
+	// an annotated tag.
+	CommitHash string `json:"commitHash"`
+}
+
+type FindLatestSemverTagArgs struct {
+	// TagPrefix is the part of the tag names before the version (e.g. "v" or "service-a/v").
+	// Only the tags whose name is TagPrefix followed by a semantic version are considered.
+	TagPrefix string
+	// IncludePrereleases makes the pre-release versions (e.g. 1.2.3-rc.1) candidates.
+	IncludePrereleases bool
+}
+
+// FindLatestSemverTag returns the tag with the highest semantic version. This returns nil if no
+// tag matches.
+func FindLatestSemverTag(repoURL string, client *http.Client, args FindLatestSemverTagArgs) (*SemverTag, debug.LsRefsDebugInfo, error) {
+	refs, debugInfo, err := LsRefs(repoURL, client, []string{"refs/tags/" + args.TagPrefix})
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	var latest *SemverTag
+	var latestVersion semver.Version
+	for _, ref := range refs {
+		name := strings.TrimPrefix(ref.Name, "refs/tags/")
+		s, ok := strings.CutPrefix(name, args.TagPrefix)
+		if !ok {
+			continue
+		}
+		v, err := semver.Parse(s)
+		if err != nil {
+			continue
+		}
+		if v.IsPrerelease() && !args.IncludePrereleases {
+			continue
+		}
+		if latest != nil && semver.Compare(v, latestVersion) <= 0 {
+			continue
+		}
+		latest = &SemverTag{Name: name, Version: v.String(), Hash: ref.Hash, CommitHash: ref.Hash}
+		if ref.PeeledHash != "" {
+			latest.CommitHash = ref.PeeledHash
+		}
+		latestVersion = v
+	}
+	return latest, debugInfo, nil
+}
+
+type FetchCommitsSinceSemverTagArgs struct {
+	FindLatestSemverTagArgs
+
+	// HeadCommitHash is the end of the range.
+	HeadCommitHash plumbing.Hash
+}
+
+// FetchCommitsSinceSemverTag returns the latest semver tag and the commits reachable from
+// HeadCommitHash but not from the tag. If there's no tag, all the commits reachable from
+// HeadCommitHash are returned.
+func FetchCommitsSinceSemverTag(repoURL string, client *http.Client, args FetchCommitsSinceSemverTagArgs) (*SemverTag, []*CommitInfo, debug.FetchDebugInfo, error) {
+	tag, _, err := FindLatestSemverTag(repoURL, client, args.FindLatestSemverTagArgs)
+	if err != nil {
+		return nil, nil, debug.FetchDebugInfo{}, err
+	}
+	fetchArgs := FetchCommitsArgs{WantCommitHashes: []plumbing.Hash{args.HeadCommitHash}}
+	if tag != nil {
+		fetchArgs.HaveCommitHashes = []plumbing.Hash{plumbing.NewHash(tag.CommitHash)}
+		fetchArgs.OnlyNewCommits = true
+	}
+	commits, debugInfo, err := FetchCommits(repoURL, client, fetchArgs)
+	return tag, commits, debugInfo, err
+}
+
+type PushNextSemverTagArgs struct {
+	FindLatestSemverTagArgs
+
+	// Bump is the version part to increment: "major", "minor", or "patch". If there's no tag
+	// yet, the version is InitialVersion.
+	Bump string
+	// InitialVersion is the version to use if there's no tag yet. Defaults to 0.1.0.
+	InitialVersion string
+	// CommitHash is the commit to tag.
+	CommitHash plumbing.Hash
+
+	// Message, if set, makes the tag an annotated tag with the message.
+	Message string
+	// Tagger is the tagger of the annotated tag.
+	Tagger object.Signature
+	// Timezone, if set, is the timezone of the Tagger time. By default, it keeps its own
+	// timezone.
+	Timezone *time.Location
+}
+
+type PushNextSemverTagResult struct {
+	// PreviousTag is the latest tag before this operation. Nil if there was none.
+	PreviousTag *SemverTag
+	// Tag is the created tag.
+	Tag *SemverTag
+}
+
+// PushNextSemverTag creates the tag for the next version of the latest semver tag. The push
+// fails if the tag is created concurrently.
+func PushNextSemverTag(repoURL string, client *http.Client, args PushNextSemverTagArgs) (*PushNextSemverTagResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	args.Tagger = signatureIn(args.Tagger, args.Timezone)
+	previous, _, err := FindLatestSemverTag(repoURL, client, args.FindLatestSemverTagArgs)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	var next semver.Version
+	if previous == nil {
+		initial := args.InitialVersion
+		if initial == "" {
+			initial = "0.1.0"
+		}
+		next, err = semver.Parse(initial)
+	} else {
+		next, err = semver.Parse(previous.Version)
+		if err == nil {
+			next, err = next.Bump(args.Bump)
+		}
+	}
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+
+	result := &PushNextSemverTagResult{
+		PreviousTag: previous,
+		Tag: &SemverTag{
+			Name:       args.TagPrefix + next.String(),
+			Version:    next.String(),
+			Hash:       args.CommitHash.String(),
+			CommitHash: args.CommitHash.String(),
+		},
+	}
+	refName := plumbing.NewTagReferenceName(result.Tag.Name)
+	if err := validateRefNames(refName); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if args.Message == "" {
+		_, fetchDebugInfo, pushDebugInfo, err := PushUpdateRefs(repoURL, client, PushUpdateRefsArgs{
+			RefUpdates: []RefUpdate{{Name: refName, OldHash: &plumbing.ZeroHash, NewHash: args.CommitHash}},
+		})
+		return result, fetchDebugInfo, pushDebugInfo, err
+	}
+
+	storage := memory.NewStorage()
+	tag := &object.Tag{
+		Name:       result.Tag.Name,
+		Tagger:     args.Tagger,
+		Message:    args.Message,
+		TargetType: plumbing.CommitObject,
+		Target:     args.CommitHash,
+	}
+	obj := storage.NewEncodedObject()
+	if err := tag.Encode(obj); err != nil {
+		return result, debug.FetchDebugInfo{}, nil, fmt.Errorf("failed to create a tag: %w", err)
+	}
+	tagHash, err := storage.SetEncodedObject(obj)
+	if err != nil {
+		return result, debug.FetchDebugInfo{}, nil, fmt.Errorf("failed to create a tag: %w", err)
+	}
+	result.Tag.Hash = tagHash.String()
+
+	var buf bytes.Buffer
+	if _, err := packfile.NewEncoder(&buf, storage, false).Encode([]plumbing.Hash{tagHash}, 0); err != nil {
+		return result, debug.FetchDebugInfo{}, nil, fmt.Errorf("failed to create a packfile: %w", err)
+	}
+	unlock, err := lockRefs(repoURL, refName)
+	defer unlock()
+	if err != nil {
+		return result, debug.FetchDebugInfo{}, nil, err
+	}
+	pushDebugInfo, err := push.Push(repoURL, client, &buf, []push.RefUpdate{
+		{
+			Name:    refName,
+			OldHash: &plumbing.ZeroHash,
+			NewHash: tagHash,
+		},
+	})
+	return result, debug.FetchDebugInfo{}, &pushDebugInfo, err
+}
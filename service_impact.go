@@ -0,0 +1,82 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/pathmatch"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// ServicePatterns maps the paths to a service.
+type ServicePatterns struct {
+	// Name is the service name.
+	Name string `json:"name"`
+	// Patterns are the path patterns of the service files (e.g. "services/api/**"). See
+	// pathmatch.Match for the pattern syntax.
+	Patterns []string `json:"patterns"`
+}
+
+type FetchImpactedServicesArgs struct {
+	CommitHash1 plumbing.Hash
+	CommitHash2 plumbing.Hash
+	// Services are the services to map the modified files onto. A file can belong to multiple
+	// services.
+	Services []ServicePatterns
+}
+
+type ImpactedService struct {
+	Name string `json:"name"`
+	// Files are the modified files that match the service patterns.
+	Files []string `json:"files"`
+}
+
+type FetchImpactedServicesResult struct {
+	// Services are the services with modified files, in the order of the arguments.
+	Services []*ImpactedService `json:"services"`
+	// UnmatchedFiles are the modified files that don't belong to any service.
+	UnmatchedFiles []string `json:"unmatchedFiles"`
+}
+
+// FetchImpactedServices returns the services whose files are modified between two commits.
+func FetchImpactedServices(repoURL string, client *http.Client, args FetchImpactedServicesArgs) (*FetchImpactedServicesResult, debug.FetchDebugInfo, error) {
+	for _, service := range args.Services {
+		if err := pathmatch.Validate(service.Patterns); err != nil {
+			return nil, debug.FetchDebugInfo{}, fmt.Errorf("service %q: %w", service.Name, err)
+		}
+	}
+	files, debugInfo, err := FetchModifiedFiles(repoURL, client, args.CommitHash1, args.CommitHash2)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	sort.Strings(files)
+
+	result := &FetchImpactedServicesResult{
+		Services:       []*ImpactedService{},
+		UnmatchedFiles: []string{},
+	}
+	matched := map[string]bool{}
+	for _, service := range args.Services {
+		impacted := &ImpactedService{Name: service.Name}
+		for _, file := range files {
+			if pathmatch.MatchAny(service.Patterns, file) {
+				impacted.Files = append(impacted.Files, file)
+				matched[file] = true
+			}
+		}
+		if len(impacted.Files) > 0 {
+			result.Services = append(result.Services, impacted)
+		}
+	}
+	for _, file := range files {
+		if !matched[file] {
+			result.UnmatchedFiles = append(result.UnmatchedFiles, file)
+		}
+	}
+	return result, debugInfo, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/push"
+)
+
+// Session shares the state among the operations that are called with its Client, e.g. an
+// orchestrator that runs PushSquashCherryPick and then PushUpdateRefs on the same repository:
+//
+//   - The LsRefs results are reused until the session's client pushes to the repository. A push
+//     by others is not noticed, so a session is meant for a sequence of operations rather than a
+//     long-lived process. Use SetLsRefsCacheTTL for that instead.
+//   - The HTTP connections are reused across the requests. The response bodies are drained up to
+//     64 KiB when they are closed, so that a connection is kept even if an operation stops
+//     reading a response early, e.g. on an error.
+//
+// The protocol v2 requests don't need the capability advertisement, so there is nothing to cache
+// for it. The receive-pack advertisement has the refs, and is fetched for each push.
+type Session struct {
+	client *http.Client
+}
+
+// NewSession returns a session that sends the requests with the client, e.g. the one that sets
+// the authorization. A nil client is http.DefaultClient.
+func NewSession(client *http.Client) *Session {
+	return NewSessionWithContext(context.Background(), client)
+}
+
+// NewSessionWithContext is NewSession whose operations stop when the context is done, e.g. to
+// limit the time of an operation with a deadline. The deadline covers all the requests of the
+// operations, the waits before their retries, and git upload-pack run for the file:// repositories.
+// A retry that cannot finish its wait before the deadline is not made.
+func NewSessionWithContext(ctx context.Context, client *http.Client) *Session {
+	return NewSessionWithOptions(ctx, client, SessionOptions{})
+}
+
+// SessionOptions are the settings of the operations with a Session's client. The set fields
+// override the process-wide defaults of the Set functions, e.g. SetRetryPolicy, so that the
+// operations with different settings can run concurrently in a process.
+type SessionOptions struct {
+	// RetryPolicy overrides SetRetryPolicy. The session has its own retry budget.
+	RetryPolicy *RetryPolicy
+	// PushTimeouts overrides SetPushTimeouts.
+	PushTimeouts *PushTimeouts
+	// PackfileSpoolThreshold overrides SetPackfileSpoolThreshold.
+	PackfileSpoolThreshold *int64
+	// PackfileURIProtocols, if not nil, overrides SetPackfileURIProtocols. Empty disables the
+	// packfile URIs.
+	PackfileURIProtocols []string
+	// FetchProgressFunc overrides SetFetchProgressFunc.
+	FetchProgressFunc FetchProgressFunc
+	// StorageProvider overrides SetStorageProvider.
+	StorageProvider StorageProvider
+	// ResultStore, if set, stores the results of the read operations, and returns the stored
+	// ones for the identical calls instead of fetching again. See ResultStore.
+	ResultStore ResultStore
+}
+
+type sessionOptionsKey struct{}
+
+// NewSessionWithOptions is NewSessionWithContext whose operations use the options.
+func NewSessionWithOptions(ctx context.Context, client *http.Client, opts SessionOptions) *Session {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	fetchOpts := fetch.Options{
+		SpoolThreshold:       opts.PackfileSpoolThreshold,
+		PackfileURIProtocols: opts.PackfileURIProtocols,
+		Progress:             fetchProgress(opts.FetchProgressFunc),
+	}
+	if opts.RetryPolicy != nil {
+		p := fetch.RetryPolicy(*opts.RetryPolicy)
+		fetchOpts.RetryPolicy = &p
+	}
+	ctx = fetch.WithOptions(ctx, fetchOpts)
+	if opts.PushTimeouts != nil {
+		ctx = push.WithTimeouts(ctx, push.Timeouts(*opts.PushTimeouts))
+	}
+	ctx = context.WithValue(ctx, sessionOptionsKey{}, opts)
+	return &Session{
+		client: &http.Client{
+			Transport:     fetch.NewSessionWithContext(ctx, client.Transport),
+			CheckRedirect: client.CheckRedirect,
+			Jar:           client.Jar,
+			Timeout:       client.Timeout,
+		},
+	}
+}
+
+// SetCacheScope sets the identity of the credentials of the session's client, e.g. a hash of its
+// authorization header. The results cached in the process with SetLsRefsCacheTTL and
+// SetObjectCacheSize are shared only among the clients with the same scope, so that an operation
+// never sees the refs or the objects fetched with other credentials. The clients without a
+// Session have the empty scope. This needs to be called before the client is used.
+func (s *Session) SetCacheScope(scope string) {
+	s.client.Transport.(*fetch.Session).SetCacheScope(scope)
+}
+
+// Client returns the client to pass to the operations.
+func (s *Session) Client() *http.Client {
+	return s.client
+}
+
+// sessionOptionsOf returns the options of the client's session. They are empty if the client
+// doesn't have a Session.
+func sessionOptionsOf(client *http.Client) SessionOptions {
+	opts, _ := fetch.Context(client).Value(sessionOptionsKey{}).(SessionOptions)
+	return opts
+}
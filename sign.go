@@ -0,0 +1,46 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+
+	"github.com/aviator-co/niche-git/signing"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// storeCommit encodes the commit into the storage and returns its hash. If signer is set, the
+// commit is signed.
+func storeCommit(storage storer.EncodedObjectStorer, commit *object.Commit, signer signing.Signer) (plumbing.Hash, error) {
+	if signer != nil && commitSignatureHeader() != "gpgsig" {
+		return storeCommitWithHeaders(storage, commit, "", signer)
+	}
+	if signer != nil {
+		unsigned := storage.NewEncodedObject()
+		if err := commit.EncodeWithoutSignature(unsigned); err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("failed to create a commit: %w", err)
+		}
+		rd, err := unsigned.Reader()
+		if err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("failed to create a commit: %w", err)
+		}
+		sig, err := signer.Sign(rd)
+		rd.Close()
+		if err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("failed to sign the commit: %w", err)
+		}
+		commit.PGPSignature = string(sig)
+	}
+	obj := storage.NewEncodedObject()
+	if err := commit.Encode(obj); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to create a commit: %w", err)
+	}
+	commitHash, err := storage.SetEncodedObject(obj)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to create a commit: %w", err)
+	}
+	return commitHash, nil
+}
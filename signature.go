@@ -0,0 +1,20 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// signatureIn returns the signature with its time in the timezone. The signature is returned as
+// is if the timezone is nil or the time is not set.
+func signatureIn(sig object.Signature, tz *time.Location) object.Signature {
+	if tz == nil || sig.When.IsZero() {
+		return sig
+	}
+	sig.When = sig.When.In(tz)
+	return sig
+}
@@ -0,0 +1,174 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Package signing signs the commits that niche-git creates. The signature is stored in the
+// gpgsig header of the commit, same as `git commit -S`.
+package signing
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"golang.org/x/crypto/ssh"
+)
+
+// Signer creates a detached signature of the message in the armored format that git expects.
+// Sign can be called from multiple goroutines.
+type Signer interface {
+	Sign(message io.Reader) ([]byte, error)
+}
+
+// Format is the format of the signing key.
+type Format string
+
+const (
+	// FormatOpenPGP is an armored OpenPGP private key. Same as gpg.format=openpgp.
+	FormatOpenPGP Format = "openpgp"
+	// FormatSSH is an OpenSSH private key. Same as gpg.format=ssh.
+	FormatSSH Format = "ssh"
+)
+
+// NewSigner creates a signer from the private key in the format. The passphrase is used only if
+// the key is encrypted.
+func NewSigner(format Format, key, passphrase []byte) (Signer, error) {
+	switch format {
+	case FormatOpenPGP:
+		return NewOpenPGPSigner(key, passphrase)
+	case FormatSSH:
+		return NewSSHSigner(key, passphrase)
+	}
+	return nil, fmt.Errorf("unknown signing key format %q", format)
+}
+
+type openPGPSigner struct {
+	entity *openpgp.Entity
+}
+
+// NewOpenPGPSigner creates a signer from an armored OpenPGP private key.
+func NewOpenPGPSigner(armoredKey, passphrase []byte) (Signer, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(armoredKey))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the OpenPGP key: %w", err)
+	}
+	if len(entities) != 1 {
+		return nil, fmt.Errorf("expected one OpenPGP key, got %d", len(entities))
+	}
+	entity := entities[0]
+	if entity.PrivateKey == nil {
+		return nil, errors.New("the OpenPGP key doesn't have a private key")
+	}
+	if entity.PrivateKey.Encrypted {
+		if err := entity.PrivateKey.Decrypt(passphrase); err != nil {
+			return nil, fmt.Errorf("cannot decrypt the OpenPGP key: %w", err)
+		}
+	}
+	for _, subkey := range entity.Subkeys {
+		if subkey.PrivateKey != nil && subkey.PrivateKey.Encrypted {
+			if err := subkey.PrivateKey.Decrypt(passphrase); err != nil {
+				return nil, fmt.Errorf("cannot decrypt the OpenPGP subkey: %w", err)
+			}
+		}
+	}
+	return &openPGPSigner{entity: entity}, nil
+}
+
+func (s *openPGPSigner) Sign(message io.Reader) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&buf, s.entity, message, nil); err != nil {
+		return nil, fmt.Errorf("failed to create an OpenPGP signature: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// sshNamespace is the namespace that git uses for the commit signatures.
+const sshNamespace = "git"
+
+type sshSigner struct {
+	signer ssh.Signer
+}
+
+// NewSSHSigner creates a signer from an OpenSSH private key. The signature is in the SSHSIG
+// format that `ssh-keygen -Y sign` creates.
+func NewSSHSigner(privateKey, passphrase []byte) (Signer, error) {
+	var signer ssh.Signer
+	var err error
+	if len(passphrase) > 0 {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(privateKey, passphrase)
+	} else {
+		signer, err = ssh.ParsePrivateKey(privateKey)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the SSH key: %w", err)
+	}
+	return &sshSigner{signer: signer}, nil
+}
+
+func (s *sshSigner) Sign(message io.Reader) ([]byte, error) {
+	h := sha512.New()
+	if _, err := io.Copy(h, message); err != nil {
+		return nil, err
+	}
+	signedData := ssh.Marshal(struct {
+		Magic         [6]byte
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Hash          string
+	}{
+		Magic:         sshSigMagic,
+		Namespace:     sshNamespace,
+		HashAlgorithm: "sha512",
+		Hash:          string(h.Sum(nil)),
+	})
+	var sig *ssh.Signature
+	var err error
+	if algSigner, ok := s.signer.(ssh.AlgorithmSigner); ok && s.signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		// ssh-rsa (SHA-1) signatures are rejected by ssh-keygen.
+		sig, err = algSigner.SignWithAlgorithm(rand.Reader, signedData, ssh.KeyAlgoRSASHA512)
+	} else {
+		sig, err = s.signer.Sign(rand.Reader, signedData)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create an SSH signature: %w", err)
+	}
+	blob := ssh.Marshal(struct {
+		Magic         [6]byte
+		Version       uint32
+		PublicKey     string
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Signature     string
+	}{
+		Magic:         sshSigMagic,
+		Version:       1,
+		PublicKey:     string(s.signer.PublicKey().Marshal()),
+		Namespace:     sshNamespace,
+		HashAlgorithm: "sha512",
+		Signature:     string(ssh.Marshal(sig)),
+	})
+	return armorSSHSignature(blob), nil
+}
+
+var sshSigMagic = [6]byte{'S', 'S', 'H', 'S', 'I', 'G'}
+
+func armorSSHSignature(blob []byte) []byte {
+	encoded := base64.StdEncoding.EncodeToString(blob)
+	var sb strings.Builder
+	sb.WriteString("-----BEGIN SSH SIGNATURE-----\n")
+	for len(encoded) > 70 {
+		sb.WriteString(encoded[:70])
+		sb.WriteString("\n")
+		encoded = encoded[70:]
+	}
+	sb.WriteString(encoded)
+	sb.WriteString("\n-----END SSH SIGNATURE-----\n")
+	return []byte(sb.String())
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package signing
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestOpenPGPSigner(t *testing.T) {
+	entity, err := openpgp.NewEntity("niche-git", "", "niche-git@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var key bytes.Buffer
+	w, err := armor.Encode(&key, openpgp.PrivateKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.SerializePrivate(w, nil); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	signer, err := NewSigner(FormatOpenPGP, key.Bytes(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n\nmessage\n"
+	sig, err := signer.Sign(strings.NewReader(message))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{entity}, strings.NewReader(message), bytes.NewReader(sig), nil); err != nil {
+		t.Errorf("the signature doesn't verify: %v", err)
+	}
+}
+
+func TestSSHSigner(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := NewSigner(FormatSSH, pem.EncodeToMemory(block), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n\nmessage\n"
+	armored, err := signer.Sign(strings.NewReader(message))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := strings.TrimSpace(string(armored))
+	body, ok := strings.CutPrefix(body, "-----BEGIN SSH SIGNATURE-----\n")
+	if !ok {
+		t.Fatalf("unexpected armor: %q", armored)
+	}
+	body, ok = strings.CutSuffix(body, "\n-----END SSH SIGNATURE-----")
+	if !ok {
+		t.Fatalf("unexpected armor: %q", armored)
+	}
+	blob, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\n", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parsed struct {
+		Magic         [6]byte
+		Version       uint32
+		PublicKey     string
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Signature     string
+	}
+	if err := ssh.Unmarshal(blob, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Magic != sshSigMagic || parsed.Version != 1 || parsed.Namespace != "git" || parsed.HashAlgorithm != "sha512" {
+		t.Errorf("unexpected signature header: %+v", parsed)
+	}
+	pub, err := ssh.ParsePublicKey([]byte(parsed.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig ssh.Signature
+	if err := ssh.Unmarshal([]byte(parsed.Signature), &sig); err != nil {
+		t.Fatal(err)
+	}
+	h := sha512.Sum512([]byte(message))
+	signedData := ssh.Marshal(struct {
+		Magic         [6]byte
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Hash          string
+	}{sshSigMagic, "git", "", "sha512", string(h[:])})
+	if err := pub.Verify(signedData, &sig); err != nil {
+		t.Errorf("the signature doesn't verify: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// RefSnapshot is the values of the refs at a point of time.
+type RefSnapshot struct {
+	// RefPrefixes are the prefixes of the refs in the snapshot.
+	RefPrefixes []string `json:"refPrefixes"`
+	// Refs is a map from the ref names to their hashes. Symbolic refs are not included.
+	Refs map[string]string `json:"refs"`
+}
+
+type RestoreRefsResult struct {
+	// UpdatedRefs are the refs that are updated or recreated to the snapshot value.
+	UpdatedRefs []string
+	// DeletedRefs are the refs that didn't exist in the snapshot and are deleted.
+	DeletedRefs []string
+}
+
+// SnapshotRefs returns the current values of the refs that match the prefixes.
+func SnapshotRefs(repoURL string, client *http.Client, refPrefixes []string) (*RefSnapshot, debug.LsRefsDebugInfo, error) {
+	refs, debugInfo, err := LsRefs(repoURL, client, refPrefixes)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	return &RefSnapshot{RefPrefixes: refPrefixes, Refs: directRefs(refs)}, debugInfo, nil
+}
+
+// RestoreRefs resets the refs that match the snapshot prefixes to the snapshot. The refs that are
+// created after the snapshot are deleted.
+//
+// All the updates are pushed atomically with compare-and-swap against the current values, so
+// that nothing is changed if any of the refs is updated concurrently.
+func RestoreRefs(repoURL string, client *http.Client, snapshot *RefSnapshot) (*RestoreRefsResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	refs, _, err := LsRefs(repoURL, client, snapshot.RefPrefixes)
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	current := directRefs(refs)
+
+	result := &RestoreRefsResult{}
+	var refUpdates []RefUpdate
+	for name, hash := range snapshot.Refs {
+		if current[name] == hash {
+			continue
+		}
+		oldHash := plumbing.ZeroHash
+		if h, ok := current[name]; ok {
+			oldHash = plumbing.NewHash(h)
+		}
+		refUpdates = append(refUpdates, RefUpdate{Name: plumbing.ReferenceName(name), OldHash: &oldHash, NewHash: plumbing.NewHash(hash)})
+		result.UpdatedRefs = append(result.UpdatedRefs, name)
+	}
+	for name, hash := range current {
+		if _, ok := snapshot.Refs[name]; ok {
+			continue
+		}
+		oldHash := plumbing.NewHash(hash)
+		refUpdates = append(refUpdates, RefUpdate{Name: plumbing.ReferenceName(name), OldHash: &oldHash, NewHash: plumbing.ZeroHash})
+		result.DeletedRefs = append(result.DeletedRefs, name)
+	}
+	sort.Strings(result.UpdatedRefs)
+	sort.Strings(result.DeletedRefs)
+	if len(refUpdates) == 0 {
+		return result, debug.FetchDebugInfo{}, nil, nil
+	}
+	sort.Slice(refUpdates, func(i, j int) bool { return refUpdates[i].Name < refUpdates[j].Name })
+
+	_, fetchDebugInfo, pushDebugInfo, err := PushUpdateRefs(repoURL, client, PushUpdateRefsArgs{
+		RefUpdates: refUpdates,
+		Atomic:     true,
+	})
+	return result, fetchDebugInfo, pushDebugInfo, err
+}
+
+// directRefs returns a map from the ref names to their hashes excluding the symbolic refs.
+func directRefs(refs []*RefInfo) map[string]string {
+	ret := map[string]string{}
+	for _, ref := range refs {
+		if ref.SymbolicTarget != "" {
+			continue
+		}
+		ret[ref.Name] = ref.Hash
+	}
+	return ret
+}
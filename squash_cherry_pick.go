@@ -4,136 +4,350 @@
 package nichegit
 
 import (
-	"bytes"
-	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"time"
 
 	"github.com/aviator-co/niche-git/debug"
-	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/diff"
 	"github.com/aviator-co/niche-git/internal/merge"
+	"github.com/aviator-co/niche-git/internal/pathmatch"
 	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/aviator-co/niche-git/internal/trailer"
+	"github.com/aviator-co/niche-git/signing"
 	"github.com/go-git/go-git/v5/plumbing"
-	"github.com/go-git/go-git/v5/plumbing/format/packfile"
 	"github.com/go-git/go-git/v5/plumbing/object"
-	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/go-git/go-git/v5/plumbing/storer"
 )
 
+type PushSquashCherryPickArgs struct {
+	// CherryPickFrom is the commit whose changes are cherry-picked.
+	CherryPickFrom plumbing.Hash
+	// CherryPickTo is the commit where the changes are applied to.
+	CherryPickTo plumbing.Hash
+	// CherryPickBase is the merge base of CherryPickFrom. The changes from this commit to
+	// CherryPickFrom will be applied to CherryPickTo.
+	CherryPickBase plumbing.Hash
+
+	CommitMessage string
+	// Trailers are appended to the commit message, e.g. Co-authored-by or Change-Id. A trailer
+	// that already exists in the message is not added again.
+	Trailers  []Trailer
+	Author    object.Signature
+	Committer object.Signature
+	// Timezone, if set, is the timezone of the Author and Committer times. By default, they
+	// keep their own timezones.
+	Timezone *time.Location
+
+	// Ref is the ref to push the created commit to.
+	Ref plumbing.ReferenceName
+	// CurrentRefHash, if set, is the expected current value of the ref. This is used for
+	// compare-and-swap.
+	CurrentRefHash *plumbing.Hash
+	// AdditionalRefUpdates are the ref updates pushed atomically with the update of Ref, e.g. to
+	// move a tracking ref to the created commit with ToCreatedCommit or to delete a temporary
+	// ref.
+	AdditionalRefUpdates []RefUpdate
+
+	// AbortOnConflict makes the operation fail without pushing if there is a conflict.
+	AbortOnConflict bool
+	// ConflictRefNamespace, if set, makes an operation aborted by AbortOnConflict push the commit
+	// with the conflicts to a new scratch ref under this namespace instead of Ref, so that the
+	// conflicts can be inspected. The ref name records the creation time and the operation, and
+	// CleanupScratchRefs expires it. See NewScratchRefName.
+	ConflictRefNamespace string
+
+	// IncludeConflictHunks makes the result have the conflicting hunks of ConflictOpenFiles in
+	// ConflictContents. The blobs of the conflicting files are fetched for this.
+	IncludeConflictHunks bool
+
+	// RenameThreshold, if positive, makes the cherry-pick follow the files renamed on one side
+	// whose contents are at least this percent similar, so that the changes to the old path are
+	// applied to the renamed file. The blobs of the deleted and the added files are fetched for
+	// this. See DefaultRenameThreshold.
+	RenameThreshold int
+
+	// PathScope, if set, restricts the cherry-pick to the changes under these path prefixes.
+	// The changes outside of them are discarded and reported as SkippedFiles.
+	PathScope []string
+
+	// ProtectedPaths are the path patterns that the operation must not modify. If the created
+	// commit modifies any of them, the operation fails with ProtectedPathError without pushing,
+	// including the push of a conflict commit to ConflictRefNamespace. See pathmatch.Match for
+	// the pattern syntax.
+	ProtectedPaths []string
+	// AllowProtectedPathChanges overrides ProtectedPaths and lets the operation modify them.
+	AllowProtectedPathChanges bool
+
+	// MaxChangedFiles, if positive, is the maximum number of files that the created commit can
+	// modify. If exceeded, the operation fails with ChangeTooLargeError without pushing.
+	MaxChangedFiles int
+	// MaxNewBlobBytes, if positive, is the maximum total size of the blobs of the files that the
+	// created commit adds or modifies from CherryPickTo. If exceeded, the operation fails with
+	// ChangeTooLargeError without pushing.
+	MaxNewBlobBytes int64
+
+	// VerifyLFSLocks makes the operation call the Git LFS lock verification endpoint before
+	// pushing. If the change modifies files locked by others, the operation fails with
+	// LFSLockError. The file:// repositories don't have the endpoint, so nothing is locked there.
+	VerifyLFSLocks bool
+
+	// SignOff appends a Signed-off-by trailer for the committer to the commit message if it's
+	// not already there.
+	SignOff bool
+
+	// Signer, if set, signs the created commit.
+	Signer signing.Signer
+	// PackOptions are the options of the pushed packfile.
+	PackOptions PackOptions
+}
+
 type PushSquashCherryPickResult struct {
 	CommitHash            plumbing.Hash
 	CherryPickedFiles     []string
 	ConflictOpenFiles     []string
 	ConflictResolvedFiles []string
+	SkippedFiles          []string
+	ProtectedFiles        []string
+	// ConflictRef is the scratch ref where the commit with the conflicts is pushed. Set only
+	// when ConflictRefNamespace is used.
+	ConflictRef plumbing.ReferenceName
+	// ConflictContents are the conflicting hunks of ConflictOpenFiles. Set only when
+	// IncludeConflictHunks is used. Ours is CherryPickTo and theirs is CherryPickFrom.
+	ConflictContents []*ConflictContent
+	// Renames are the renames that the cherry-pick followed. Set only when RenameThreshold is
+	// used.
+	Renames []*RenamedFile
 }
 
 // PushSquashCherryPick creates a new commit with the changes between the two commits and push to
 // the specified ref.
-func PushSquashCherryPick(
-	repoURL string,
-	client *http.Client,
-	commitHashCherryPickFrom, commitHashCherryPickTo, commitHashCherryPickBase plumbing.Hash,
-	commitMessage string,
-	author, comitter object.Signature,
-	ref plumbing.ReferenceName,
-	currentRefhash *plumbing.Hash,
-	abortOnConflict bool,
-) (*PushSquashCherryPickResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
-	packfilebs, fetchDebugInfo, err := fetch.FetchBlobNonePackfile(repoURL, client, []plumbing.Hash{commitHashCherryPickFrom, commitHashCherryPickBase, commitHashCherryPickTo})
+func PushSquashCherryPick(repoURL string, client *http.Client, args PushSquashCherryPickArgs) (*PushSquashCherryPickResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	args.Author = signatureIn(args.Author, args.Timezone)
+	args.Committer = signatureIn(args.Committer, args.Timezone)
+	refNames := withAdditionalRefNames(args.Ref, args.AdditionalRefUpdates)
+	if err := validateRefNames(refNames...); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := trailer.Validate(args.Trailers); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	if err := pathmatch.Validate(args.ProtectedPaths); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	unlock, err := lockRefs(repoURL, refNames...)
+	defer unlock()
 	if err != nil {
-		return nil, fetchDebugInfo, nil, err
+		return nil, debug.FetchDebugInfo{}, nil, err
 	}
-
-	storage := memory.NewStorage()
-	parser, err := packfile.NewParserWithStorage(packfile.NewScanner(bytes.NewReader(packfilebs)), storage)
+	var fetchDebugInfo debug.FetchDebugInfo
+	storage, err := newObjectStorage(client)
 	if err != nil {
-		return nil, fetchDebugInfo, nil, fmt.Errorf("failed to parse packfile: %v", err)
+		return nil, fetchDebugInfo, nil, err
 	}
-	if _, err := parser.Parse(); err != nil {
-		return nil, fetchDebugInfo, nil, fmt.Errorf("failed to parse packfile: %v", err)
+	defer storage.Close()
+	if err := fetchBlobNone(repoURL, client, storage, []plumbing.Hash{args.CherryPickFrom, args.CherryPickBase, args.CherryPickTo}, &fetchDebugInfo); err != nil {
+		return nil, fetchDebugInfo, nil, err
 	}
+	result, pushDebugInfo, err := pushSquashCherryPickFetched(repoURL, client, storage, args, &fetchDebugInfo)
+	return result, fetchDebugInfo, pushDebugInfo, err
+}
 
-	treeCPFrom, err := getTreeFromCommit(storage, commitHashCherryPickFrom)
+// pushSquashCherryPickFetched does the squash cherry-pick with the objects that are already
+// fetched into the storage. The blobs fetched for the conflicts are added to fetchDebugInfo.
+func pushSquashCherryPickFetched(repoURL string, client *http.Client, storage *objectStorage, args PushSquashCherryPickArgs, fetchDebugInfo *debug.FetchDebugInfo) (*PushSquashCherryPickResult, *debug.PushDebugInfo, error) {
+	treeCPFrom, err := getTreeFromCommit(storage, args.CherryPickFrom)
 	if err != nil {
-		return nil, fetchDebugInfo, nil, err
+		return nil, nil, err
 	}
-	treeCPBase, err := getTreeFromCommit(storage, commitHashCherryPickBase)
+	treeCPBase, err := getTreeFromCommit(storage, args.CherryPickBase)
 	if err != nil {
-		return nil, fetchDebugInfo, nil, err
+		return nil, nil, err
 	}
-	treeCPTo, err := getTreeFromCommit(storage, commitHashCherryPickTo)
+	treeCPTo, err := getTreeFromCommit(storage, args.CherryPickTo)
 	if err != nil {
-		return nil, fetchDebugInfo, nil, err
+		return nil, nil, err
+	}
+
+	var skippedFiles []string
+	var scopeNewHashes []plumbing.Hash
+	if len(args.PathScope) > 0 {
+		scope := merge.PathScope(args.PathScope)
+		modified, err := diff.DiffTree(storage, treeCPBase, treeCPFrom)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to take file diffs: %w", err)
+		}
+		for pth := range modified {
+			if !scope.Contains(pth) {
+				skippedFiles = append(skippedFiles, pth)
+			}
+		}
+		sort.Strings(skippedFiles)
+		restricted, err := merge.RestrictTree(storage, treeCPFrom, treeCPBase, scope)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to restrict the tree to the path scope: %w", err)
+		}
+		treeCPFrom, err = object.GetTree(storage, restricted.TreeHash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot get the restricted tree: %w", err)
+		}
+		scopeNewHashes = restricted.NewHashes
+	}
+
+	// The guards compare the result with the tree of CherryPickTo before following the renames.
+	targetTree := treeCPTo
+	var renamedFiles []*RenamedFile
+	if args.RenameThreshold > 0 {
+		renamed, err := followRenames(repoURL, client, storage, treeCPFrom, treeCPTo, treeCPBase, args.RenameThreshold, fetchDebugInfo)
+		if err != nil {
+			return nil, nil, err
+		}
+		treeCPFrom, treeCPTo, treeCPBase = renamed.Tree1, renamed.Tree2, renamed.MergeBase
+		scopeNewHashes = append(scopeNewHashes, renamed.NewHashes...)
+		renamedFiles = toRenamedFiles(renamed.Followed)
 	}
 
 	mergeResult, err := merge.MergeTree(storage, treeCPFrom, treeCPTo, treeCPBase, conflictResolver)
 	if err != nil {
-		return nil, fetchDebugInfo, nil, fmt.Errorf("failed to merge the trees: %v", err)
+		return nil, nil, fmt.Errorf("failed to merge the trees: %w", err)
 	}
 	cpResult := &PushSquashCherryPickResult{
 		CherryPickedFiles: mergeResult.FilesPickedEntry1,
 		ConflictOpenFiles: mergeResult.FilesConflict,
+		SkippedFiles:      skippedFiles,
+		Renames:           renamedFiles,
+	}
+	if args.IncludeConflictHunks && len(mergeResult.FilesConflict) > 0 {
+		labels := merge.Labels{Ours: args.CherryPickTo.String(), Base: args.CherryPickBase.String(), Theirs: args.CherryPickFrom.String()}
+		cpResult.ConflictContents, err = conflictContents(repoURL, client, storage, mergeResult.FilesConflict, treeCPTo, treeCPBase, treeCPFrom, labels, fetchDebugInfo)
+		if err != nil {
+			return cpResult, nil, fmt.Errorf("failed to get the conflict contents: %w", err)
+		}
 	}
-	if abortOnConflict && len(mergeResult.FilesConflict) > 0 {
-		return cpResult, fetchDebugInfo, nil, errors.New("conflict detected")
+	pushingConflict := false
+	if args.AbortOnConflict && len(mergeResult.FilesConflict) > 0 {
+		if args.ConflictRefNamespace == "" {
+			return cpResult, nil, &ConflictError{Files: mergeResult.FilesConflict}
+		}
+		conflictRef, err := NewScratchRefName(args.ConflictRefNamespace, "squash-cherry-pick", time.Now())
+		if err != nil {
+			return cpResult, nil, fmt.Errorf("failed to create a conflict ref name: %w", err)
+		}
+		cpResult.ConflictRef = conflictRef
+		args.Ref = conflictRef
+		args.CurrentRefHash = &plumbing.ZeroHash
+		// The additional refs are for the commit without the conflicts.
+		args.AdditionalRefUpdates = nil
+		pushingConflict = true
 	}
+	// The guards apply to the conflict commit too, as it's pushed to the repository as well.
+	if len(args.ProtectedPaths) > 0 || args.MaxChangedFiles > 0 || args.MaxNewBlobBytes > 0 || args.VerifyLFSLocks {
+		modified, err := diffFromTarget(storage, targetTree, mergeResult.TreeHash)
+		if err != nil {
+			return cpResult, nil, err
+		}
+		modifiedFiles := sortedPaths(modified)
+		protectedFiles := findProtectedFiles(modifiedFiles, args.ProtectedPaths)
+		cpResult.ProtectedFiles = protectedFiles
+		if len(protectedFiles) > 0 && !args.AllowProtectedPathChanges {
+			return cpResult, nil, &ProtectedPathError{Paths: protectedFiles}
+		}
+		if args.MaxChangedFiles > 0 && len(modifiedFiles) > args.MaxChangedFiles {
+			return cpResult, nil, &ChangeTooLargeError{ChangedFiles: len(modifiedFiles), MaxChangedFiles: args.MaxChangedFiles}
+		}
+		if args.MaxNewBlobBytes > 0 {
+			blobBytes, err := newBlobBytes(repoURL, client, storage, modified, fetchDebugInfo)
+			if err != nil {
+				return cpResult, nil, err
+			}
+			if blobBytes > args.MaxNewBlobBytes {
+				return cpResult, nil, &ChangeTooLargeError{NewBlobBytes: blobBytes, MaxNewBlobBytes: args.MaxNewBlobBytes}
+			}
+		}
+		if args.VerifyLFSLocks {
+			lockedFiles, err := findLFSLockedFiles(repoURL, client, args.Ref, modifiedFiles)
+			if err != nil {
+				return cpResult, nil, err
+			}
+			if len(lockedFiles) > 0 {
+				return cpResult, nil, &LFSLockError{Paths: lockedFiles}
+			}
+		}
+	}
+	trailers := trailer.WithSignOff(args.Trailers, args.SignOff, args.Committer.Name, args.Committer.Email)
+	commitMessage := trailer.Append(args.CommitMessage, trailers)
 	commit := &object.Commit{
 		Message:      commitMessage,
-		Author:       author,
-		Committer:    comitter,
+		Author:       args.Author,
+		Committer:    args.Committer,
 		TreeHash:     mergeResult.TreeHash,
-		ParentHashes: []plumbing.Hash{commitHashCherryPickTo},
-	}
-	obj := storage.NewEncodedObject()
-	if err := commit.Encode(obj); err != nil {
-		return cpResult, fetchDebugInfo, nil, fmt.Errorf("failed to create a commit: %v", err)
+		ParentHashes: []plumbing.Hash{args.CherryPickTo},
 	}
-	commitHash, err := storage.SetEncodedObject(obj)
+	commitHash, err := storeCommit(storage, commit, args.Signer)
 	if err != nil {
-		return cpResult, fetchDebugInfo, nil, fmt.Errorf("failed to create a commit: %v", err)
+		return cpResult, nil, err
 	}
 	cpResult.CommitHash = commitHash
 
-	var buf bytes.Buffer
-	packEncoder := packfile.NewEncoder(&buf, storage, false)
-	if _, err := packEncoder.Encode(append([]plumbing.Hash{commitHash}, mergeResult.NewHashes...), 0); err != nil {
-		return cpResult, fetchDebugInfo, nil, fmt.Errorf("failed to create a packfile: %v", err)
+	// The trees restricted to the path scope and renamed for the merge are pushed only if the
+	// merged tree references them.
+	pushedHashes, err := referencedNewHashes(storage, mergeResult.TreeHash, append(append([]plumbing.Hash{}, mergeResult.NewHashes...), scopeNewHashes...))
+	if err != nil {
+		return cpResult, nil, err
 	}
+	newHashes := append([]plumbing.Hash{commitHash}, pushedHashes...)
 
-	pushDebugInfo, err := push.Push(repoURL, client, &buf, []push.RefUpdate{
-		{
-			Name:    plumbing.ReferenceName(ref),
-			OldHash: currentRefhash,
-			NewHash: commitHash,
-		},
-	})
+	buf, err := encodePushPackfile(storage, newHashes, args.PackOptions)
 	if err != nil {
-		return cpResult, fetchDebugInfo, &pushDebugInfo, err
+		return cpResult, nil, err
 	}
-	return cpResult, fetchDebugInfo, &pushDebugInfo, nil
+
+	pushDebugInfo, err := pushCreatedCommit(repoURL, client, buf, push.RefUpdate{
+		Name:    args.Ref,
+		OldHash: args.CurrentRefHash,
+		NewHash: commitHash,
+	}, args.AdditionalRefUpdates)
+	if err != nil {
+		return cpResult, &pushDebugInfo, err
+	}
+	if pushingConflict {
+		return cpResult, &pushDebugInfo, &ConflictError{Files: mergeResult.FilesConflict}
+	}
+	return cpResult, &pushDebugInfo, nil
 }
 
+const (
+	// cherryPickFromSuffix is the suffix of the conflicting files from CherryPickFrom in the
+	// conflict commit.
+	cherryPickFromSuffix = ".from-cherry-pick"
+	// cherryPickBaseSuffix is the suffix of the conflicting files from CherryPickBase in the
+	// conflict commit.
+	cherryPickBaseSuffix = ".from-cherry-pick-base"
+)
+
 func conflictResolver(parentPath string, cpFromEntry, cpToEntry, base *object.TreeEntry) ([]object.TreeEntry, error) {
 	var ret []object.TreeEntry
 	if cpFromEntry != nil {
-		ret = append(ret, object.TreeEntry{Name: cpFromEntry.Name + ".from-cherry-pick", Hash: cpFromEntry.Hash, Mode: cpFromEntry.Mode})
+		ret = append(ret, object.TreeEntry{Name: cpFromEntry.Name + cherryPickFromSuffix, Hash: cpFromEntry.Hash, Mode: cpFromEntry.Mode})
 	}
 	if cpToEntry != nil {
 		ret = append(ret, *cpToEntry)
 	}
 	if base != nil {
-		ret = append(ret, object.TreeEntry{Name: base.Name + ".from-cherry-pick-base", Hash: base.Hash, Mode: base.Mode})
+		ret = append(ret, object.TreeEntry{Name: base.Name + cherryPickBaseSuffix, Hash: base.Hash, Mode: base.Mode})
 	}
 	return ret, nil
 }
 
-func getTreeFromCommit(storage *memory.Storage, commitHash plumbing.Hash) (*object.Tree, error) {
+func getTreeFromCommit(storage storer.EncodedObjectStorer, commitHash plumbing.Hash) (*object.Tree, error) {
 	commit, err := object.GetCommit(storage, commitHash)
 	if err != nil {
-		return nil, fmt.Errorf("cannot find %q in the fetched packfile: %v", commitHash.String(), err)
+		return nil, fmt.Errorf("cannot find %q in the fetched packfile: %w", commitHash.String(), err)
 	}
 	tree, err := commit.Tree()
 	if err != nil {
-		return nil, fmt.Errorf("cannot find the tree of %q in the fetched packfile: %v", commitHash.String(), err)
+		return nil, fmt.Errorf("cannot find the tree of %q in the fetched packfile: %w", commitHash.String(), err)
 	}
 	return tree, nil
 }
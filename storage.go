@@ -0,0 +1,187 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// StorageProvider creates the storages of the objects that the operations fetch and create, e.g.
+// to keep the objects of a large repository on disk or in a blob store rather than in memory.
+type StorageProvider interface {
+	// NewStorage returns an empty storage for an operation. A storage is used by one operation.
+	// It doesn't need to be safe for concurrent use, as the operations that read the objects in
+	// parallel, e.g. the tree diffs, serialize the accesses to it. If the storage implements
+	// io.Closer, it's closed when the operation finishes. Any storer.Storer, e.g.
+	// filesystem.Storage of go-git, can be returned.
+	NewStorage() (storer.EncodedObjectStorer, error)
+}
+
+var (
+	storageProviderMu sync.Mutex
+	storageProvider   StorageProvider
+)
+
+// SetStorageProvider sets the default provider of the object storages of the operations.
+// SessionOptions.StorageProvider overrides it. Nil keeps the objects in memory, which is the
+// default. See NewDiskStorageProvider.
+func SetStorageProvider(provider StorageProvider) {
+	storageProviderMu.Lock()
+	defer storageProviderMu.Unlock()
+	storageProvider = provider
+}
+
+// NewDiskStorageProvider returns a StorageProvider that keeps the objects of each operation in a
+// new temporary directory under dir, and removes the directory when the operation finishes. Empty
+// dir means the default directory for temporary files. The fetched packfiles are parsed into the
+// loose objects one at a time, so the memory usage doesn't grow with the size of the packfiles.
+func NewDiskStorageProvider(dir string) StorageProvider {
+	return &diskStorageProvider{dir: dir}
+}
+
+type diskStorageProvider struct {
+	dir string
+}
+
+func (p *diskStorageProvider) NewStorage() (storer.EncodedObjectStorer, error) {
+	dir, err := os.MkdirTemp(p.dir, "niche-git-objects-")
+	if err != nil {
+		return nil, fmt.Errorf("cannot create the object storage directory: %w", err)
+	}
+	return &diskStorage{
+		Storage: filesystem.NewStorageWithOptions(osfs.New(dir), cache.NewObjectLRUDefault(), filesystem.Options{ExclusiveAccess: true}),
+		dir:     dir,
+	}, nil
+}
+
+// diskStorage is the storage of NewDiskStorageProvider.
+type diskStorage struct {
+	*filesystem.Storage
+	dir string
+}
+
+// Close removes the objects.
+func (s *diskStorage) Close() error {
+	return os.RemoveAll(s.dir)
+}
+
+// objectStorage is the object storage of an operation. The hashes and the types of the stored
+// objects are indexed in memory, so that the operations can count and list the objects without
+// reading them back from the storage, which may be on disk.
+//
+// The reads are safe for concurrent use, as DiffTree needs. Those of the storage of the
+// StorageProvider are serialized, while memory.Storage is read in parallel.
+type objectStorage struct {
+	storer.EncodedObjectStorer
+	types  map[plumbing.Hash]plumbing.ObjectType
+	closer io.Closer
+	// readMu is set for the storage of the StorageProvider.
+	readMu *sync.Mutex
+	// progress is the function that the parses of the packfiles report the progress to.
+	progress FetchProgressFunc
+}
+
+// newObjectStorage returns a storage from the StorageProvider of the client's session or of the
+// process, or a memory.Storage if neither is set. The storage needs to be closed.
+func newObjectStorage(client *http.Client) (*objectStorage, error) {
+	opts := sessionOptionsOf(client)
+	provider := opts.StorageProvider
+	if provider == nil {
+		storageProviderMu.Lock()
+		provider = storageProvider
+		storageProviderMu.Unlock()
+	}
+	s := &objectStorage{types: map[plumbing.Hash]plumbing.ObjectType{}, progress: opts.FetchProgressFunc}
+	if s.progress == nil {
+		s.progress = fetchProgressFunc
+	}
+	if provider == nil {
+		s.EncodedObjectStorer = memory.NewStorage()
+		return s, nil
+	}
+	storage, err := provider.NewStorage()
+	if err != nil {
+		return nil, fmt.Errorf("cannot create the object storage: %w", err)
+	}
+	s.EncodedObjectStorer = storage
+	s.readMu = &sync.Mutex{}
+	if closer, ok := storage.(io.Closer); ok {
+		s.closer = closer
+	}
+	return s, nil
+}
+
+func (s *objectStorage) SetEncodedObject(obj plumbing.EncodedObject) (plumbing.Hash, error) {
+	hash, err := s.EncodedObjectStorer.SetEncodedObject(obj)
+	if err != nil {
+		return hash, err
+	}
+	s.types[hash] = obj.Type()
+	return hash, nil
+}
+
+func (s *objectStorage) EncodedObject(typ plumbing.ObjectType, hash plumbing.Hash) (plumbing.EncodedObject, error) {
+	if s.readMu != nil {
+		s.readMu.Lock()
+		defer s.readMu.Unlock()
+	}
+	return s.EncodedObjectStorer.EncodedObject(typ, hash)
+}
+
+func (s *objectStorage) HasEncodedObject(hash plumbing.Hash) error {
+	if s.readMu != nil {
+		s.readMu.Lock()
+		defer s.readMu.Unlock()
+	}
+	return s.EncodedObjectStorer.HasEncodedObject(hash)
+}
+
+func (s *objectStorage) EncodedObjectSize(hash plumbing.Hash) (int64, error) {
+	if s.readMu != nil {
+		s.readMu.Lock()
+		defer s.readMu.Unlock()
+	}
+	return s.EncodedObjectStorer.EncodedObjectSize(hash)
+}
+
+// numObjects returns the number of the stored objects.
+func (s *objectStorage) numObjects() int {
+	return len(s.types)
+}
+
+// has returns true if the storage has the object of the type.
+func (s *objectStorage) has(typ plumbing.ObjectType, hash plumbing.Hash) bool {
+	t, ok := s.types[hash]
+	return ok && (typ == plumbing.AnyObject || t == typ)
+}
+
+// hashes returns the hashes of the stored objects of the type in no particular order.
+func (s *objectStorage) hashes(typ plumbing.ObjectType) []plumbing.Hash {
+	var ret []plumbing.Hash
+	for hash, t := range s.types {
+		if typ == plumbing.AnyObject || t == typ {
+			ret = append(ret, hash)
+		}
+	}
+	return ret
+}
+
+// Close releases the storage of the StorageProvider.
+func (s *objectStorage) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
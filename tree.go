@@ -0,0 +1,146 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+type GetTreeArgs struct {
+	CommitHash plumbing.Hash
+	// Path is the directory to list. Empty means the root directory.
+	Path string
+	// Depth is the number of the levels to list under Path. 1 lists only the direct entries of
+	// Path. Zero, which is the default, lists all the entries recursively.
+	Depth int
+	// SkipSizes skips looking up the blob sizes.
+	SkipSizes bool
+}
+
+type TreeEntry struct {
+	// Path is the path of the entry from the root directory.
+	Path string `json:"path"`
+	// Type is the object type of the entry, same as git ls-tree: "blob", "tree", or "commit"
+	// for a submodule.
+	Type string `json:"type"`
+	// Mode is the file mode of the entry in octal (e.g. 100644).
+	Mode string `json:"mode"`
+	// Hash is the object hash of the entry.
+	Hash string `json:"hash"`
+	// Size is the size of the blob. Zero for the trees and the submodules, and if the sizes are
+	// not looked up.
+	Size int64 `json:"size,omitempty"`
+	// WebURL is the page of the blob on the web UI. Left empty, same as CommitInfo.WebURL.
+	WebURL string `json:"webURL,omitempty"`
+}
+
+// GetTree lists the entries under a directory of the commit, like `git ls-tree -r -t`. The
+// entries of a directory come right after it, sorted in the git tree order.
+//
+// Only the trees are fetched. The blob sizes are looked up with the object-info command. If the
+// server doesn't support it, the sizes are left zero and a warning is added to the debug info.
+func GetTree(repoURL string, client *http.Client, args GetTreeArgs) ([]*TreeEntry, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "GetTree", args, func() ([]*TreeEntry, debug.FetchDebugInfo, error) {
+		return getTree(repoURL, client, args)
+	})
+}
+
+func getTree(repoURL string, client *http.Client, args GetTreeArgs) ([]*TreeEntry, debug.FetchDebugInfo, error) {
+	var debugInfo debug.FetchDebugInfo
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	defer storage.Close()
+	if err := fetchBlobNone(repoURL, client, storage, []plumbing.Hash{args.CommitHash}, &debugInfo); err != nil {
+		return nil, debugInfo, err
+	}
+	tree, err := getTreeFromCommit(storage, args.CommitHash)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	dir := strings.Trim(path.Clean("/"+args.Path), "/")
+	if dir != "" {
+		entry, err := tree.FindEntry(dir)
+		if err != nil || entry.Mode != filemode.Dir {
+			return nil, debugInfo, fmt.Errorf("%q is not a directory in %s", dir, args.CommitHash.String())
+		}
+		if tree, err = object.GetTree(storage, entry.Hash); err != nil {
+			return nil, debugInfo, fmt.Errorf("cannot find the tree of %q: %w", dir, err)
+		}
+	}
+
+	entries := []*TreeEntry{}
+	if err := listTree(storage, tree, dir, args.Depth, &entries); err != nil {
+		return nil, debugInfo, err
+	}
+	if args.SkipSizes {
+		return entries, debugInfo, nil
+	}
+	seen := map[plumbing.Hash]bool{}
+	var blobHashes []plumbing.Hash
+	for _, entry := range entries {
+		hash := plumbing.NewHash(entry.Hash)
+		if entry.Type == plumbing.BlobObject.String() && !seen[hash] {
+			seen[hash] = true
+			blobHashes = append(blobHashes, hash)
+		}
+	}
+	if len(blobHashes) == 0 {
+		return entries, debugInfo, nil
+	}
+	sizes, _, err := fetch.ObjectSizes(repoURL, client, blobHashes)
+	if err != nil {
+		debugInfo.Warnings = append(debugInfo.Warnings, fmt.Sprintf("cannot look up the blob sizes with object-info: %v", err))
+		return entries, debugInfo, nil
+	}
+	for _, entry := range entries {
+		if entry.Type == plumbing.BlobObject.String() {
+			entry.Size = sizes[plumbing.NewHash(entry.Hash)]
+		}
+	}
+	return entries, debugInfo, nil
+}
+
+// listTree appends the entries of the tree to entries, down to depth levels. Zero depth means no
+// limit.
+func listTree(storage *objectStorage, tree *object.Tree, dir string, depth int, entries *[]*TreeEntry) error {
+	for _, entry := range tree.Entries {
+		pth := path.Join(dir, entry.Name)
+		te := &TreeEntry{
+			Path: pth,
+			Mode: fmt.Sprintf("%06o", uint32(entry.Mode)),
+			Hash: entry.Hash.String(),
+		}
+		*entries = append(*entries, te)
+		switch {
+		case entry.Mode == filemode.Submodule:
+			te.Type = plumbing.CommitObject.String()
+		case entry.Mode.IsFile():
+			te.Type = plumbing.BlobObject.String()
+		default:
+			te.Type = plumbing.TreeObject.String()
+			if depth == 1 {
+				continue
+			}
+			subtree, err := object.GetTree(storage, entry.Hash)
+			if err != nil {
+				return fmt.Errorf("cannot find the tree of %q: %w", pth, err)
+			}
+			if err := listTree(storage, subtree, pth, max(depth-1, 0), entries); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// DefaultLargestFiles is the number of the largest files reported by GetTreeStats if not
+// specified.
+const DefaultLargestFiles = 10
+
+type GetTreeStatsArgs struct {
+	CommitHash plumbing.Hash
+	// LargestFiles is the number of the largest files to report. Defaults to
+	// DefaultLargestFiles. Negative disables the size lookup.
+	LargestFiles int
+}
+
+type TreeStats struct {
+	// Files is the number of the regular and executable files.
+	Files       int `json:"files"`
+	Directories int `json:"directories"`
+	Symlinks    int `json:"symlinks"`
+	Submodules  int `json:"submodules"`
+	// MaxDepth is the depth of the deepest entry. The entries in the root tree are depth 1.
+	MaxDepth int `json:"maxDepth"`
+	// TotalFileBytes is the total size of the files. A blob shared by multiple paths is
+	// counted for each path.
+	TotalFileBytes int64 `json:"totalFileBytes"`
+	// LargestFiles is the largest files in the descending order of the size.
+	LargestFiles []*FileSize `json:"largestFiles"`
+}
+
+type FileSize struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// GetTreeStats returns the file count and the tree entry statistics of the commit. Only the
+// trees are fetched. The file sizes are looked up with the object-info command. If the server
+// doesn't support it, the sizes are left zero and a warning is added to the debug info.
+func GetTreeStats(repoURL string, client *http.Client, args GetTreeStatsArgs) (*TreeStats, debug.FetchDebugInfo, error) {
+	return withResultStore(repoURL, client, "GetTreeStats", args, func() (*TreeStats, debug.FetchDebugInfo, error) {
+		return getTreeStats(repoURL, client, args)
+	})
+}
+
+func getTreeStats(repoURL string, client *http.Client, args GetTreeStatsArgs) (*TreeStats, debug.FetchDebugInfo, error) {
+	var debugInfo debug.FetchDebugInfo
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+	defer storage.Close()
+	if err := fetchBlobNone(repoURL, client, storage, []plumbing.Hash{args.CommitHash}, &debugInfo); err != nil {
+		return nil, debugInfo, err
+	}
+	tree, err := getTreeFromCommit(storage, args.CommitHash)
+	if err != nil {
+		return nil, debugInfo, err
+	}
+
+	stats := &TreeStats{}
+	files := map[string]plumbing.Hash{}
+	if err := collectTreeStats(storage, tree, "", 1, stats, files); err != nil {
+		return nil, debugInfo, err
+	}
+	largest := args.LargestFiles
+	if largest == 0 {
+		largest = DefaultLargestFiles
+	}
+	if largest < 0 || len(files) == 0 {
+		return stats, debugInfo, nil
+	}
+
+	seen := map[plumbing.Hash]bool{}
+	var blobHashes []plumbing.Hash
+	for _, hash := range files {
+		if !seen[hash] {
+			seen[hash] = true
+			blobHashes = append(blobHashes, hash)
+		}
+	}
+	sizes, _, err := fetch.ObjectSizes(repoURL, client, blobHashes)
+	if err != nil {
+		debugInfo.Warnings = append(debugInfo.Warnings, fmt.Sprintf("cannot look up the file sizes with object-info: %v", err))
+		return stats, debugInfo, nil
+	}
+	var fileSizes []*FileSize
+	for pth, hash := range files {
+		stats.TotalFileBytes += sizes[hash]
+		fileSizes = append(fileSizes, &FileSize{Path: pth, Size: sizes[hash]})
+	}
+	sort.Slice(fileSizes, func(i, j int) bool {
+		if fileSizes[i].Size != fileSizes[j].Size {
+			return fileSizes[i].Size > fileSizes[j].Size
+		}
+		return fileSizes[i].Path < fileSizes[j].Path
+	})
+	stats.LargestFiles = fileSizes[:min(largest, len(fileSizes))]
+	return stats, debugInfo, nil
+}
+
+// collectTreeStats counts the entries of the tree into stats, and records the blob hashes of the
+// files in files.
+func collectTreeStats(storage *objectStorage, tree *object.Tree, dir string, depth int, stats *TreeStats, files map[string]plumbing.Hash) error {
+	for _, entry := range tree.Entries {
+		stats.MaxDepth = max(stats.MaxDepth, depth)
+		pth := path.Join(dir, entry.Name)
+		switch entry.Mode {
+		case filemode.Dir:
+			stats.Directories++
+			subtree, err := object.GetTree(storage, entry.Hash)
+			if err != nil {
+				return fmt.Errorf("cannot find the tree of %q: %w", pth, err)
+			}
+			if err := collectTreeStats(storage, subtree, pth, depth+1, stats, files); err != nil {
+				return err
+			}
+		case filemode.Symlink:
+			stats.Symlinks++
+		case filemode.Submodule:
+			stats.Submodules++
+		default:
+			stats.Files++
+			files[pth] = entry.Hash
+		}
+	}
+	return nil
+}
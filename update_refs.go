@@ -0,0 +1,206 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+package nichegit
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aviator-co/niche-git/debug"
+	"github.com/aviator-co/niche-git/internal/fetch"
+	"github.com/aviator-co/niche-git/internal/push"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/packfile"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+type RefUpdate struct {
+	Name plumbing.ReferenceName
+
+	// OldHash, if set, is the expected current value of the ref. This is used for
+	// compare-and-swap. Use ZeroHash if you expect the ref to not exist.
+	OldHash *plumbing.Hash
+	// NewHash is the value that the ref will be updated to. ZeroHash deletes the ref.
+	NewHash plumbing.Hash
+	// ToCreatedCommit makes an operation that creates a commit update the ref to the created
+	// commit instead of NewHash. See AdditionalRefUpdates of the operations. PushUpdateRefs
+	// doesn't support this.
+	ToCreatedCommit bool
+}
+
+type PushUpdateRefsArgs struct {
+	RefUpdates []RefUpdate
+
+	// SourceRepoURL, if set, is the repository to copy the objects from when the new values
+	// are missing in the target repository. If not set, the operation fails with
+	// MissingObjectError in that case.
+	SourceRepoURL string
+
+	// Atomic makes the server apply all the updates or none of them.
+	Atomic bool
+}
+
+type PushUpdateRefsResult struct {
+	// ForwardedObjects are the new values that were missing in the target repository and
+	// copied from the source repository.
+	ForwardedObjects []plumbing.Hash
+}
+
+// PushUpdateRefs updates the refs to the objects that exist in the repository.
+//
+// Before pushing, this checks that the repository has the new values of the refs. The missing
+// objects are copied from SourceRepoURL if it's set.
+func PushUpdateRefs(repoURL string, client *http.Client, args PushUpdateRefsArgs) (*PushUpdateRefsResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	return pushUpdateRefs(repoURL, client, args, false)
+}
+
+// pushUpdateRefs is PushUpdateRefs that checks the leases right before receive-pack if
+// withLease is true. See PushWithLease.
+func pushUpdateRefs(repoURL string, client *http.Client, args PushUpdateRefsArgs, withLease bool) (*PushUpdateRefsResult, debug.FetchDebugInfo, *debug.PushDebugInfo, error) {
+	var refNames []plumbing.ReferenceName
+	for _, u := range args.RefUpdates {
+		if u.ToCreatedCommit {
+			return nil, debug.FetchDebugInfo{}, nil, fmt.Errorf("%s: ToCreatedCommit is not supported by PushUpdateRefs", u.Name)
+		}
+		refNames = append(refNames, u.Name)
+	}
+	if err := validateRefNames(refNames...); err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+	unlock, err := lockRefs(repoURL, refNames...)
+	defer unlock()
+	if err != nil {
+		return nil, debug.FetchDebugInfo{}, nil, err
+	}
+
+	var newHashes []plumbing.Hash
+	for _, u := range args.RefUpdates {
+		if !u.NewHash.IsZero() {
+			newHashes = append(newHashes, u.NewHash)
+		}
+	}
+	var missing []plumbing.Hash
+	var fetchDebugInfo debug.FetchDebugInfo
+	if len(newHashes) > 0 {
+		var err error
+		missing, fetchDebugInfo, err = fetch.MissingObjects(repoURL, client, newHashes)
+		if err != nil {
+			return nil, fetchDebugInfo, nil, fmt.Errorf("failed to check the objects: %w", err)
+		}
+	}
+	if len(missing) > 0 && args.SourceRepoURL == "" {
+		return nil, fetchDebugInfo, nil, &MissingObjectError{Hashes: missing}
+	}
+
+	result := &PushUpdateRefsResult{}
+	var buf *bytes.Buffer
+	if len(missing) > 0 {
+		// The objects that the target already has are excluded with its ref tips as haves.
+		refs, _, err := LsRefs(repoURL, client, []string{"refs/"})
+		if err != nil {
+			return nil, fetchDebugInfo, nil, err
+		}
+		var haves []plumbing.Hash
+		for _, ref := range refs {
+			if ref.SymbolicTarget == "" && plumbing.IsHash(ref.Hash) {
+				haves = append(haves, plumbing.NewHash(ref.Hash))
+			}
+		}
+		pack, sourceFetchDebugInfo, err := fetch.FetchFullPackfile(args.SourceRepoURL, client, missing, haves)
+		defer pack.Close()
+		fetchDebugInfo.PackfileSize += sourceFetchDebugInfo.PackfileSize
+		if err != nil {
+			return nil, fetchDebugInfo, nil, fmt.Errorf("failed to fetch the missing objects from the source repository: %w", err)
+		}
+		if buf, err = forwardedPackfile(client, pack); err != nil {
+			return nil, fetchDebugInfo, nil, err
+		}
+		result.ForwardedObjects = missing
+	}
+
+	if buf == nil && len(newHashes) > 0 {
+		// receive-pack expects a packfile unless all the commands are deletions.
+		buf = bytes.NewBuffer(nil)
+		if _, err := packfile.NewEncoder(buf, memory.NewStorage(), false).Encode(nil, 0); err != nil {
+			return result, fetchDebugInfo, nil, fmt.Errorf("failed to create a packfile: %w", err)
+		}
+	}
+
+	var refUpdates []push.RefUpdate
+	for _, u := range args.RefUpdates {
+		refUpdates = append(refUpdates, push.RefUpdate{
+			Name:    u.Name,
+			OldHash: u.OldHash,
+			NewHash: u.NewHash,
+		})
+	}
+	if withLease {
+		if err := checkLeases(repoURL, client, args.RefUpdates); err != nil {
+			return result, fetchDebugInfo, nil, err
+		}
+	}
+	pushFunc := push.Push
+	if args.Atomic {
+		pushFunc = push.PushAtomic
+	}
+	pushDebugInfo, err := pushFunc(repoURL, client, buf, refUpdates)
+	if err != nil {
+		return result, fetchDebugInfo, &pushDebugInfo, err
+	}
+	return result, fetchDebugInfo, &pushDebugInfo, nil
+}
+
+// forwardedPackfile returns the fetched packfile to push as is. If the source repository
+// offloaded some objects to the packfile URIs, the objects are encoded into one packfile since a
+// push has only one.
+func forwardedPackfile(client *http.Client, pack *fetch.Packfile) (*bytes.Buffer, error) {
+	buf := bytes.NewBuffer(nil)
+	if len(pack.URIPacks()) == 0 {
+		if _, err := io.Copy(buf, pack.Reader()); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}
+	storage, err := newObjectStorage(client)
+	if err != nil {
+		return nil, err
+	}
+	defer storage.Close()
+	if err := parseFetchedPackfile(storage, pack, nil); err != nil {
+		return nil, err
+	}
+	if _, err := packfile.NewEncoder(buf, storage, false).Encode(storage.hashes(plumbing.AnyObject), 0); err != nil {
+		return nil, fmt.Errorf("failed to create a packfile: %w", err)
+	}
+	return buf, nil
+}
+
+// withAdditionalRefNames returns the ref and the refs of the additional ref updates, to validate
+// and lock them together.
+func withAdditionalRefNames(ref plumbing.ReferenceName, additional []RefUpdate) []plumbing.ReferenceName {
+	ret := []plumbing.ReferenceName{ref}
+	for _, u := range additional {
+		ret = append(ret, u.Name)
+	}
+	return ret
+}
+
+// pushCreatedCommit pushes the packfile with the update of a ref to the created commit. The
+// additional ref updates are in the same push, which is atomic if there are any.
+func pushCreatedCommit(repoURL string, client *http.Client, packfile *bytes.Buffer, update push.RefUpdate, additional []RefUpdate) (debug.PushDebugInfo, error) {
+	refUpdates := []push.RefUpdate{update}
+	for _, u := range additional {
+		newHash := u.NewHash
+		if u.ToCreatedCommit {
+			newHash = update.NewHash
+		}
+		refUpdates = append(refUpdates, push.RefUpdate{Name: u.Name, OldHash: u.OldHash, NewHash: newHash})
+	}
+	if len(additional) == 0 {
+		return push.Push(repoURL, client, packfile, refUpdates)
+	}
+	return push.PushAtomic(repoURL, client, packfile, refUpdates)
+}
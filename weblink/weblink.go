@@ -0,0 +1,148 @@
+// Copyright 2024 Aviator Technologies, Inc.
+// SPDX-License-Identifier: MIT
+
+// Package weblink builds the URLs of the commits, the files, and the comparisons on the web UIs
+// of the git hosting services, so that the callers can link the hashes that niche-git returns
+// without a link builder per hosting service.
+package weblink
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Provider is a hosting service with built-in templates.
+type Provider string
+
+const (
+	ProviderGitHub    Provider = "github"
+	ProviderGitLab    Provider = "gitlab"
+	ProviderBitbucket Provider = "bitbucket"
+)
+
+// Templates are the URL templates of a hosting service. The placeholders are replaced as follows:
+//
+//   - {repo}: the URL of the repository on the web UI, without a trailing slash.
+//   - {commit}: the commit hash. The blob URLs link the file at this commit.
+//   - {path}: the slash-separated file path. Each segment is escaped.
+//   - {base} and {head}: the commit hashes of a comparison. The changes from base to head are
+//     shown.
+//
+// An empty template disables the URLs of the kind.
+type Templates struct {
+	// Commit can have {repo} and {commit}.
+	Commit string
+	// Blob can have {repo}, {commit}, and {path}.
+	Blob string
+	// Compare can have {repo}, {base}, and {head}.
+	Compare string
+}
+
+var providerTemplates = map[Provider]Templates{
+	ProviderGitHub: {
+		Commit:  "{repo}/commit/{commit}",
+		Blob:    "{repo}/blob/{commit}/{path}",
+		Compare: "{repo}/compare/{base}...{head}",
+	},
+	ProviderGitLab: {
+		Commit:  "{repo}/-/commit/{commit}",
+		Blob:    "{repo}/-/blob/{commit}/{path}",
+		Compare: "{repo}/-/compare/{base}...{head}",
+	},
+	ProviderBitbucket: {
+		Commit: "{repo}/commits/{commit}",
+		Blob:   "{repo}/src/{commit}/{path}",
+		// Bitbucket takes the head first, separated by a carriage return.
+		Compare: "{repo}/branches/compare/{head}%0D{base}",
+	},
+}
+
+// ProviderTemplates returns the built-in templates of the provider.
+func ProviderTemplates(provider Provider) (Templates, error) {
+	templates, ok := providerTemplates[provider]
+	if !ok {
+		return Templates{}, fmt.Errorf("unknown web link provider %q; must be %s, %s, or %s", provider, ProviderGitHub, ProviderGitLab, ProviderBitbucket)
+	}
+	return templates, nil
+}
+
+// WebRepoURL returns the web UI URL of the repository from its git URL, which is the git URL
+// without the credentials and the .git suffix. This is the repository page on GitHub, GitLab,
+// and Bitbucket Cloud. Other layouts, such as /scm/ of Bitbucket Data Center, need the web URL to
+// be given to NewLinker separately.
+func WebRepoURL(repoURL string) (string, error) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid repository URL %q: %w", repoURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("invalid repository URL %q: must be an HTTP(S) URL", repoURL)
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), ".git")
+	u.RawPath = ""
+	return u.String(), nil
+}
+
+// Linker builds the URLs of a repository. The methods of a nil Linker return empty strings, so
+// that the callers can use one without checking whether the links are enabled.
+type Linker struct {
+	repo      string
+	templates Templates
+}
+
+var placeholderPattern = regexp.MustCompile(`\{[^{}]*\}`)
+
+// NewLinker creates a Linker for the repository at the web UI URL. It fails if a template has a
+// placeholder that its kind doesn't support, which is usually a typo.
+func NewLinker(webRepoURL string, templates Templates) (*Linker, error) {
+	for _, t := range []struct {
+		kind         string
+		template     string
+		placeholders []string
+	}{
+		{"commit", templates.Commit, []string{"{repo}", "{commit}"}},
+		{"blob", templates.Blob, []string{"{repo}", "{commit}", "{path}"}},
+		{"compare", templates.Compare, []string{"{repo}", "{base}", "{head}"}},
+	} {
+		for _, placeholder := range placeholderPattern.FindAllString(t.template, -1) {
+			if !slices.Contains(t.placeholders, placeholder) {
+				return nil, fmt.Errorf("invalid %s URL template %q: unknown placeholder %s; must be one of %s", t.kind, t.template, placeholder, strings.Join(t.placeholders, ", "))
+			}
+		}
+	}
+	return &Linker{repo: strings.TrimSuffix(webRepoURL, "/"), templates: templates}, nil
+}
+
+// Commit returns the URL of the commit.
+func (l *Linker) Commit(commitHash string) string {
+	if l == nil || l.templates.Commit == "" {
+		return ""
+	}
+	return strings.NewReplacer("{repo}", l.repo, "{commit}", commitHash).Replace(l.templates.Commit)
+}
+
+// Blob returns the URL of the file at the commit.
+func (l *Linker) Blob(commitHash, path string) string {
+	if l == nil || l.templates.Blob == "" {
+		return ""
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.NewReplacer("{repo}", l.repo, "{commit}", commitHash, "{path}", strings.Join(segments, "/")).Replace(l.templates.Blob)
+}
+
+// Compare returns the URL of the changes from the base commit to the head commit.
+func (l *Linker) Compare(baseHash, headHash string) string {
+	if l == nil || l.templates.Compare == "" {
+		return ""
+	}
+	return strings.NewReplacer("{repo}", l.repo, "{base}", baseHash, "{head}", headHash).Replace(l.templates.Compare)
+}